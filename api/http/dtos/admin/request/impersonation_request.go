@@ -0,0 +1,27 @@
+package user
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// StartImpersonationRequest explains why support needs to act as the user.
+// The reason is kept in the audit log.
+type StartImpersonationRequest struct {
+	Reason string `json:"reason" validate:"required,min=3,max=500"`
+}
+
+func (r *StartImpersonationRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *StartImpersonationRequest) ToCommand(actorID, userID, ip, ua string) commands.StartImpersonationCommand {
+	return commands.StartImpersonationCommand{
+		ActorID:      actorID,
+		TargetUserID: userID,
+		Reason:       r.Reason,
+		IPAddress:    ip,
+		UserAgent:    ua,
+	}
+}
@@ -1,8 +1,37 @@
 package user
 
+import (
+    "authentication/internal/application/queries"
+
+    "github.com/go-playground/validator/v10"
+)
+
 type ListUsersRequest struct {
-    Page     int    `json:"page" validate:"min=1"`
-    PageSize int    `json:"page_size" validate:"min=1,max=100"`
-    Role     string `json:"role,omitempty"`
-    IsActive *bool  `json:"is_active,omitempty"`
-}
\ No newline at end of file
+    Page      int    `json:"page" validate:"min=1"`
+    PageSize  int    `json:"page_size" validate:"min=1,max=100"`
+    Role      string `json:"role,omitempty"`
+    IsActive  *bool  `json:"is_active,omitempty"`
+    Search    string `json:"search,omitempty" validate:"max=255"`
+    SortBy    string `json:"sort_by,omitempty" validate:"omitempty,oneof=created_at email username last_login_at"`
+    SortOrder string `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
+}
+
+func (r *ListUsersRequest) Validate(v *validator.Validate) error {
+    return v.Struct(r)
+}
+
+// ToQuery sorts newest first unless an ascending order is requested.
+func (r *ListUsersRequest) ToQuery() queries.ListUsersQuery {
+    query := queries.ListUsersQuery{
+        Page:       r.Page,
+        PageSize:   r.PageSize,
+        IsActive:   r.IsActive,
+        Search:     r.Search,
+        SortBy:     r.SortBy,
+        Descending: r.SortOrder != "asc",
+    }
+    if r.Role != "" {
+        query.Role = &r.Role
+    }
+    return query
+}
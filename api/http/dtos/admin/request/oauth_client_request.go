@@ -0,0 +1,51 @@
+package user
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type RegisterOAuthClientRequest struct {
+	Name         string   `json:"name" validate:"required,min=2,max=100"`
+	RedirectURIs []string `json:"redirect_uris" validate:"required,min=1,max=20,dive,required,max=2048"`
+	Scopes       []string `json:"scopes" validate:"omitempty,max=50,dive,required,max=128"`
+	Public       bool     `json:"public"`
+}
+
+func (r *RegisterOAuthClientRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *RegisterOAuthClientRequest) ToCommand(actorID, ip, ua string) commands.RegisterOAuthClientCommand {
+	return commands.RegisterOAuthClientCommand{
+		ActorID:      actorID,
+		Name:         r.Name,
+		RedirectURIs: r.RedirectURIs,
+		Scopes:       r.Scopes,
+		Public:       r.Public,
+		IPAddress:    ip,
+		UserAgent:    ua,
+	}
+}
+
+// UpdateOAuthClientRequest leaves omitted fields unchanged.
+type UpdateOAuthClientRequest struct {
+	RedirectURIs []string `json:"redirect_uris,omitempty" validate:"omitempty,min=1,max=20,dive,required,max=2048"`
+	Scopes       []string `json:"scopes,omitempty" validate:"omitempty,max=50,dive,required,max=128"`
+}
+
+func (r *UpdateOAuthClientRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *UpdateOAuthClientRequest) ToCommand(actorID, clientID, ip, ua string) commands.UpdateOAuthClientCommand {
+	return commands.UpdateOAuthClientCommand{
+		ActorID:      actorID,
+		ClientID:     clientID,
+		RedirectURIs: r.RedirectURIs,
+		Scopes:       r.Scopes,
+		IPAddress:    ip,
+		UserAgent:    ua,
+	}
+}
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name" validate:"required,min=2,max=50"`
+	Description string   `json:"description" validate:"max=255"`
+	Permissions []string `json:"permissions" validate:"omitempty,max=200,dive,required,max=128"`
+	Parents     []string `json:"parents" validate:"omitempty,max=20,dive,required,max=50"`
+}
+
+func (r *CreateRoleRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *CreateRoleRequest) ToCommand(actorID, ip, ua string) commands.CreateRoleCommand {
+	return commands.CreateRoleCommand{
+		ActorID:     actorID,
+		Name:        r.Name,
+		Description: r.Description,
+		Permissions: r.Permissions,
+		Parents:     r.Parents,
+		IPAddress:   ip,
+		UserAgent:   ua,
+	}
+}
+
+// UpdateRoleRequest replaces the whole definition; omitted lists are cleared.
+type UpdateRoleRequest struct {
+	Description string   `json:"description" validate:"max=255"`
+	Permissions []string `json:"permissions" validate:"omitempty,max=200,dive,required,max=128"`
+	Parents     []string `json:"parents" validate:"omitempty,max=20,dive,required,max=50"`
+}
+
+func (r *UpdateRoleRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *UpdateRoleRequest) ToCommand(actorID, name, ip, ua string) commands.UpdateRoleCommand {
+	return commands.UpdateRoleCommand{
+		ActorID:     actorID,
+		Name:        name,
+		Description: r.Description,
+		Permissions: r.Permissions,
+		Parents:     r.Parents,
+		IPAddress:   ip,
+		UserAgent:   ua,
+	}
+}
+
+type AssignRoleRequest struct {
+	Role string `json:"role" validate:"required,min=2,max=50"`
+}
+
+func (r *AssignRoleRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *AssignRoleRequest) ToCommand(actorID, userID, ip, ua string) commands.AssignRoleCommand {
+	return commands.AssignRoleCommand{
+		ActorID:   actorID,
+		UserID:    userID,
+		Role:      r.Role,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type CreateSAMLConnectionRequest struct {
+	OrganizationID   string            `json:"organization_id,omitempty" validate:"omitempty,uuid"`
+	Name             string            `json:"name" validate:"required,min=2,max=100"`
+	MetadataXML      string            `json:"metadata_xml" validate:"required,max=1048576"`
+	AttributeMapping map[string]string `json:"attribute_mapping,omitempty" validate:"omitempty,max=20,dive,keys,required,max=64,endkeys,required,max=512"`
+	Domains          []string          `json:"domains,omitempty" validate:"omitempty,max=50,dive,required,fqdn"`
+	JITProvisioning  bool              `json:"jit_provisioning"`
+	DefaultRole      string            `json:"default_role,omitempty" validate:"omitempty,max=64"`
+}
+
+func (r *CreateSAMLConnectionRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *CreateSAMLConnectionRequest) ToCommand(actorID, ip, ua string) commands.CreateSAMLConnectionCommand {
+	return commands.CreateSAMLConnectionCommand{
+		ActorID:          actorID,
+		OrganizationID:   r.OrganizationID,
+		Name:             r.Name,
+		MetadataXML:      r.MetadataXML,
+		AttributeMapping: r.AttributeMapping,
+		Domains:          r.Domains,
+		JITProvisioning:  r.JITProvisioning,
+		DefaultRole:      r.DefaultRole,
+		IPAddress:        ip,
+		UserAgent:        ua,
+	}
+}
+
+// UpdateSAMLConnectionRequest leaves omitted fields unchanged.
+type UpdateSAMLConnectionRequest struct {
+	MetadataXML      *string           `json:"metadata_xml,omitempty" validate:"omitempty,min=1,max=1048576"`
+	AttributeMapping map[string]string `json:"attribute_mapping,omitempty" validate:"omitempty,max=20,dive,keys,required,max=64,endkeys,required,max=512"`
+	Domains          *[]string         `json:"domains,omitempty" validate:"omitempty,max=50,dive,required,fqdn"`
+	JITProvisioning  *bool             `json:"jit_provisioning,omitempty"`
+	DefaultRole      *string           `json:"default_role,omitempty" validate:"omitempty,min=1,max=64"`
+	IsActive         *bool             `json:"is_active,omitempty"`
+}
+
+func (r *UpdateSAMLConnectionRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *UpdateSAMLConnectionRequest) ToCommand(actorID, connectionID, ip, ua string) commands.UpdateSAMLConnectionCommand {
+	var domains []string
+	if r.Domains != nil {
+		domains = append([]string{}, *r.Domains...)
+	}
+
+	return commands.UpdateSAMLConnectionCommand{
+		ActorID:          actorID,
+		ConnectionID:     connectionID,
+		MetadataXML:      r.MetadataXML,
+		AttributeMapping: r.AttributeMapping,
+		Domains:          domains,
+		JITProvisioning:  r.JITProvisioning,
+		DefaultRole:      r.DefaultRole,
+		IsActive:         r.IsActive,
+		IPAddress:        ip,
+		UserAgent:        ua,
+	}
+}
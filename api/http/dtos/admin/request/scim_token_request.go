@@ -0,0 +1,24 @@
+package user
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type CreateSCIMTokenRequest struct {
+	Name string `json:"name" validate:"required,min=2,max=100"`
+}
+
+func (r *CreateSCIMTokenRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *CreateSCIMTokenRequest) ToCommand(actorID, ip, ua string) commands.CreateSCIMTokenCommand {
+	return commands.CreateSCIMTokenCommand{
+		ActorID:   actorID,
+		Name:      r.Name,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
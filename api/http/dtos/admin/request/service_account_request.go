@@ -0,0 +1,30 @@
+package user
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type CreateServiceAccountRequest struct {
+	Name         string   `json:"name" validate:"required,min=2,max=100"`
+	Role         string   `json:"role" validate:"required"`
+	Scopes       []string `json:"scopes" validate:"omitempty,max=50,dive,required,max=128"`
+	PublicKeyPEM string   `json:"public_key_pem,omitempty" validate:"omitempty,max=8192"`
+}
+
+func (r *CreateServiceAccountRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *CreateServiceAccountRequest) ToCommand(actorID, ip, ua string) commands.CreateServiceAccountCommand {
+	return commands.CreateServiceAccountCommand{
+		ActorID:      actorID,
+		Name:         r.Name,
+		Role:         r.Role,
+		Scopes:       r.Scopes,
+		PublicKeyPEM: r.PublicKeyPEM,
+		IPAddress:    ip,
+		UserAgent:    ua,
+	}
+}
@@ -1,7 +1,48 @@
 package user
 
+import (
+    "authentication/internal/application/commands"
+
+    "github.com/go-playground/validator/v10"
+)
+
 type UpdateUserRequest struct {
-    FirstName string `json:"first_name,omitempty"`
-    LastName  string `json:"last_name,omitempty"`
+    FirstName string `json:"first_name,omitempty" validate:"max=100"`
+    LastName  string `json:"last_name,omitempty" validate:"max=100"`
     Phone     string `json:"phone,omitempty" validate:"omitempty,e164"`
-}
\ No newline at end of file
+}
+
+func (r *UpdateUserRequest) Validate(v *validator.Validate) error {
+    return v.Struct(r)
+}
+
+func (r *UpdateUserRequest) ToCommand(actorID, userID, ip, ua string) commands.UpdateUserCommand {
+    return commands.UpdateUserCommand{
+        ActorID:   actorID,
+        UserID:    userID,
+        FirstName: r.FirstName,
+        LastName:  r.LastName,
+        Phone:     r.Phone,
+        IPAddress: ip,
+        UserAgent: ua,
+    }
+}
+
+// ChangeUserRoleRequest replaces the user's primary role.
+type ChangeUserRoleRequest struct {
+    Role string `json:"role" validate:"required,min=2,max=50"`
+}
+
+func (r *ChangeUserRoleRequest) Validate(v *validator.Validate) error {
+    return v.Struct(r)
+}
+
+func (r *ChangeUserRoleRequest) ToCommand(actorID, userID, ip, ua string) commands.ChangeUserRoleCommand {
+    return commands.ChangeUserRoleCommand{
+        ActorID:   actorID,
+        UserID:    userID,
+        Role:      r.Role,
+        IPAddress: ip,
+        UserAgent: ua,
+    }
+}
@@ -0,0 +1,17 @@
+package response
+
+import "time"
+
+// ImpersonationResponse is a bearer token acting as UserID on behalf of
+// ActorID. It cannot be refreshed.
+type ImpersonationResponse struct {
+	UserID      string    `json:"user_id"`
+	Email       string    `json:"email"`
+	Username    string    `json:"username"`
+	ActorID     string    `json:"actor_id"`
+	SessionID   string    `json:"session_id"`
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	ExpiresIn   int64     `json:"expires_in"`
+}
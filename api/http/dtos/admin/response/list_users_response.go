@@ -1,6 +1,6 @@
 package response
 
-import user "authentication/api/http/dtos/user"
+import user "authentication/api/http/dtos/admin"
 
 type ListUsersResponse struct {
 	Users      []user.UserDTO `json:"users"`
@@ -8,3 +8,8 @@ type ListUsersResponse struct {
 	Page       int            `json:"page"`
 	PageSize   int            `json:"page_size"`
 }
+
+type DeleteUserResponse struct {
+	ID   string `json:"id"`
+	Hard bool   `json:"hard"`
+}
@@ -0,0 +1,26 @@
+package response
+
+type OAuthClientResponse struct {
+	ID           string   `json:"id"`
+	ClientID     string   `json:"client_id"`
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+	Public       bool     `json:"public"`
+	IsActive     bool     `json:"is_active"`
+}
+
+// OAuthClientSecretResponse is only returned on registration and rotation;
+// the plain secret is never retrievable afterwards. Public clients have
+// none.
+type OAuthClientSecretResponse struct {
+	OAuthClientResponse
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+type ListOAuthClientsResponse struct {
+	Clients    []OAuthClientResponse `json:"clients"`
+	TotalCount int64                 `json:"total_count"`
+	Page       int                   `json:"page"`
+	PageSize   int                   `json:"page_size"`
+}
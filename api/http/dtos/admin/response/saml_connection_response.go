@@ -0,0 +1,22 @@
+package response
+
+import "time"
+
+type SAMLConnectionResponse struct {
+	ID               string            `json:"id"`
+	OrganizationID   string            `json:"organization_id,omitempty"`
+	Name             string            `json:"name"`
+	IdPEntityID      string            `json:"idp_entity_id"`
+	SSOURL           string            `json:"sso_url"`
+	AttributeMapping map[string]string `json:"attribute_mapping"`
+	Domains          []string          `json:"domains"`
+	JITProvisioning  bool              `json:"jit_provisioning"`
+	DefaultRole      string            `json:"default_role"`
+	IsActive         bool              `json:"is_active"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+}
+
+type ListSAMLConnectionsResponse struct {
+	Connections []SAMLConnectionResponse `json:"connections"`
+}
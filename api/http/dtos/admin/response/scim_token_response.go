@@ -0,0 +1,24 @@
+package response
+
+import "time"
+
+type SCIMTokenResponse struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	DisplayPrefix string     `json:"display_prefix"`
+	CreatedBy     string     `json:"created_by"`
+	LastUsedAt    *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// SCIMTokenSecretResponse is only returned when the token is created; the
+// token cannot be retrieved again.
+type SCIMTokenSecretResponse struct {
+	SCIMTokenResponse
+	Token string `json:"token"`
+}
+
+type ListSCIMTokensResponse struct {
+	Tokens []SCIMTokenResponse `json:"tokens"`
+}
@@ -0,0 +1,27 @@
+package response
+
+import "time"
+
+type ServiceAccountResponse struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	ClientID          string    `json:"client_id"`
+	Role              string    `json:"role"`
+	Scopes            []string  `json:"scopes"`
+	UsesPrivateKeyJWT bool      `json:"uses_private_key_jwt"`
+	IsActive          bool      `json:"is_active"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// ServiceAccountSecretResponse is only returned on creation and rotation.
+type ServiceAccountSecretResponse struct {
+	ServiceAccountResponse
+	ClientSecret string `json:"client_secret"`
+}
+
+type ListServiceAccountsResponse struct {
+	ServiceAccounts []ServiceAccountResponse `json:"service_accounts"`
+	TotalCount      int64                    `json:"total_count"`
+	Page            int                      `json:"page"`
+	PageSize        int                      `json:"page_size"`
+}
@@ -3,14 +3,18 @@ package user
 import "time"
 
 type UserDTO struct {
-    ID        string    `json:"id"`
-    Username  string    `json:"username"`
-    Email     string    `json:"email"`
-    Phone     string    `json:"phone,omitempty"`
-    FirstName string    `json:"first_name"`
-    LastName  string    `json:"last_name"`
-    Role      string    `json:"role"`
-    IsActive  bool      `json:"is_active"`
-    CreatedAt time.Time `json:"created_at"`
-    UpdatedAt time.Time `json:"updated_at"`
+    ID            string     `json:"id"`
+    Username      string     `json:"username"`
+    Email         string     `json:"email"`
+    Phone         string     `json:"phone,omitempty"`
+    PhoneVerified bool       `json:"phone_verified"`
+    FirstName     string     `json:"first_name"`
+    LastName      string     `json:"last_name"`
+    Role          string     `json:"role"`
+    Provider      string     `json:"provider"`
+    IsActive      bool       `json:"is_active"`
+    IsVerified    bool       `json:"is_verified"`
+    LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
+    CreatedAt     time.Time  `json:"created_at"`
+    UpdatedAt     time.Time  `json:"updated_at"`
 }
\ No newline at end of file
@@ -0,0 +1,32 @@
+package request
+
+import (
+	"time"
+
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type CreateAPIKeyRequest struct {
+	Name       string     `json:"name" validate:"required,min=1,max=100"`
+	Scopes     []string   `json:"scopes" validate:"omitempty,max=50,dive,required,max=128"`
+	AllowedIPs []string   `json:"allowed_ips" validate:"omitempty,max=50,dive,required,max=64"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+func (r *CreateAPIKeyRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *CreateAPIKeyRequest) ToCommand(userID, ip, ua string) commands.CreateAPIKeyCommand {
+	return commands.CreateAPIKeyCommand{
+		UserID:     userID,
+		Name:       r.Name,
+		Scopes:     r.Scopes,
+		AllowedIPs: r.AllowedIPs,
+		ExpiresAt:  r.ExpiresAt,
+		IPAddress:  ip,
+		UserAgent:  ua,
+	}
+}
@@ -1,13 +1,47 @@
 package request
 
-import "time"
+import (
+    "time"
+
+    "authentication/internal/application/queries"
+
+    "github.com/go-playground/validator/v10"
+)
 
 type GetAuditLogsRequest struct {
-    UserID       string     `json:"user_id,omitempty"`
-    Action       string     `json:"action,omitempty"`
-    ResourceType string     `json:"resource_type,omitempty"`
+    UserID       string     `json:"user_id,omitempty" validate:"max=36"`
+    Action       string     `json:"action,omitempty" validate:"max=100"`
+    ResourceType string     `json:"resource_type,omitempty" validate:"max=100"`
+    ResourceID   string     `json:"resource_id,omitempty" validate:"max=36"`
+    Status       string     `json:"status,omitempty" validate:"max=20"`
     StartDate    *time.Time `json:"start_date,omitempty"`
     EndDate      *time.Time `json:"end_date,omitempty"`
     Page         int        `json:"page" validate:"min=1"`
     PageSize     int        `json:"page_size" validate:"min=1,max=100"`
-}
\ No newline at end of file
+}
+
+func (r *GetAuditLogsRequest) Validate(v *validator.Validate) error {
+    return v.Struct(r)
+}
+
+// ToQuery leaves empty filters unset so they match every entry.
+func (r *GetAuditLogsRequest) ToQuery() queries.GetAuditLogsQuery {
+    return queries.GetAuditLogsQuery{
+        UserID:       optionalString(r.UserID),
+        Action:       optionalString(r.Action),
+        ResourceType: optionalString(r.ResourceType),
+        ResourceID:   optionalString(r.ResourceID),
+        Status:       optionalString(r.Status),
+        StartDate:    r.StartDate,
+        EndDate:      r.EndDate,
+        Page:         r.Page,
+        PageSize:     r.PageSize,
+    }
+}
+
+func optionalString(value string) *string {
+    if value == "" {
+        return nil
+    }
+    return &value
+}
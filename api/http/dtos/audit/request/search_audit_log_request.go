@@ -1,11 +1,34 @@
 package request
 
-import "time"
+import (
+	"time"
 
+	"authentication/internal/application/queries"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// SearchAuditLogsRequest is a full-text search. Query accepts web search
+// syntax: quoted phrases, OR and -word. Cursor is the next_cursor of the
+// previous page.
 type SearchAuditLogsRequest struct {
-	Query     string     `json:"query"`
+	Query     string     `json:"query" validate:"required,max=256"`
 	StartDate *time.Time `json:"start_date,omitempty"`
 	EndDate   *time.Time `json:"end_date,omitempty"`
-	Page      int        `json:"page" validate:"min=1"`
+	Cursor    string     `json:"cursor,omitempty" validate:"max=512"`
 	PageSize  int        `json:"page_size" validate:"min=1,max=100"`
 }
+
+func (r *SearchAuditLogsRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *SearchAuditLogsRequest) ToQuery() queries.SearchAuditLogsQuery {
+	return queries.SearchAuditLogsQuery{
+		Query:     r.Query,
+		StartDate: r.StartDate,
+		EndDate:   r.EndDate,
+		Cursor:    r.Cursor,
+		PageSize:  r.PageSize,
+	}
+}
@@ -8,3 +8,10 @@ type GetAuditLogsResponse struct {
 	Page       int                 `json:"page"`
 	PageSize   int                 `json:"page_size"`
 }
+
+// SearchAuditLogsResponse is one page of search hits. next_cursor is absent
+// on the last page.
+type SearchAuditLogsResponse struct {
+	Logs       []audit.AuditLogDTO `json:"logs"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,45 @@
+package request
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DeviceAuthorizationRequest is decoded from an RFC 8628 form-encoded body.
+type DeviceAuthorizationRequest struct {
+	Scope string `json:"scope" validate:"omitempty,max=1024"`
+}
+
+func (r *DeviceAuthorizationRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *DeviceAuthorizationRequest) ToCommand(clientID, clientSecret, ip, ua string) commands.RequestDeviceCodeCommand {
+	return commands.RequestDeviceCodeCommand{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scope:        r.Scope,
+		IPAddress:    ip,
+		UserAgent:    ua,
+	}
+}
+
+type VerifyDeviceCodeRequest struct {
+	UserCode string `json:"user_code" validate:"required,min=8,max=16"`
+	Approve  bool   `json:"approve"`
+}
+
+func (r *VerifyDeviceCodeRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *VerifyDeviceCodeRequest) ToCommand(userID, ip, ua string) commands.VerifyDeviceCodeCommand {
+	return commands.VerifyDeviceCodeCommand{
+		UserID:    userID,
+		UserCode:  r.UserCode,
+		Approve:   r.Approve,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
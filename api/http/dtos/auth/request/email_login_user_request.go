@@ -10,6 +10,12 @@ type LoginEmailUserCommand struct {
 	IPAddress string
 	UserAgent string
 	DeviceID  string
+	// ChallengeResponse is required after repeated failed logins; see
+	// GET /api/v1/auth/challenge.
+	ChallengeResponse string `json:"challenge_response"`
+	// TrustedDeviceToken is the token returned when DeviceID was trusted;
+	// it skips the login code step on that device.
+	TrustedDeviceToken string `json:"trusted_device_token"`
 }
 
 func (r *LoginEmailUserCommand) Validate(v *validator.Validate) error {
@@ -12,6 +12,9 @@ type EmailRegistrationRequest struct {
 	Username  string `json:"username" validate:"omitempty,min=3,max=50,alphanum"`
 	Email     string `json:"email" validate:"required,email"`
 	Password  string `json:"password" validate:"required,min=8,max=200"`
+	// ChallengeResponse is required after repeated failed logins; see
+	// GET /api/v1/auth/challenge.
+	ChallengeResponse string `json:"challenge_response" validate:"omitempty,max=4096"`
 }
 
 func (r *EmailRegistrationRequest) Validate(v *validator.Validate) error {
@@ -27,6 +30,7 @@ func (r *EmailRegistrationRequest) ToCommand(ip, ua string) commands.RegisterEma
 		Username:  r.Username,
 		IPAddress: ip,
 		UserAgent: ua,
+		ChallengeResponse: r.ChallengeResponse,
 		//IsOAuth:   false,
 		Role:      "user",
 	}
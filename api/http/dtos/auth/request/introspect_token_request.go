@@ -0,0 +1,26 @@
+package request
+
+import (
+	"authentication/internal/application/queries"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// IntrospectTokenRequest is decoded from an RFC 7662 form-encoded body.
+type IntrospectTokenRequest struct {
+	Token         string `json:"token" validate:"required"`
+	TokenTypeHint string `json:"token_type_hint" validate:"omitempty,max=32"`
+}
+
+func (r *IntrospectTokenRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *IntrospectTokenRequest) ToQuery(clientID, clientSecret string) queries.IntrospectTokenQuery {
+	return queries.IntrospectTokenQuery{
+		Token:         r.Token,
+		TokenTypeHint: r.TokenTypeHint,
+		ClientID:      clientID,
+		ClientSecret:  clientSecret,
+	}
+}
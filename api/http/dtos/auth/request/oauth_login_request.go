@@ -14,6 +14,8 @@ type LoginOAuthUserCommand struct {
 	IPAddress     string
 	UserAgent     string
 	DeviceID      string
+	// TrustedDeviceToken skips the login code step on a trusted DeviceID.
+	TrustedDeviceToken string `json:"trusted_device_token"`
 }
 
 
@@ -28,5 +30,8 @@ func (r *LoginOAuthUserCommand) ToCommand(ip, ua string) commands.LoginOAuthUser
 		AccessToken:   r.AccessToken,
 		IPAddress:     ip,
 		UserAgent:     ua,
+		DeviceID:      r.DeviceID,
+
+		TrustedDeviceToken: r.TrustedDeviceToken,
 	}
 }
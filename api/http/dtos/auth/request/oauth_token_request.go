@@ -0,0 +1,49 @@
+package request
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	GrantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// OAuthTokenRequest is decoded from the form-encoded token endpoint body.
+// Which fields are required depends on the grant type.
+type OAuthTokenRequest struct {
+	GrantType  string `json:"grant_type" validate:"required"`
+	DeviceCode string `json:"device_code" validate:"required_if=GrantType urn:ietf:params:oauth:grant-type:device_code"`
+	Scope      string `json:"scope" validate:"omitempty,max=1024"`
+
+	ClientAssertionType string `json:"client_assertion_type" validate:"required_with=ClientAssertion"`
+	ClientAssertion     string `json:"client_assertion" validate:"omitempty,max=8192"`
+}
+
+func (r *OAuthTokenRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *OAuthTokenRequest) ToDeviceCommand(clientID, clientSecret, ip, ua string) commands.PollDeviceTokenCommand {
+	return commands.PollDeviceTokenCommand{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		DeviceCode:   r.DeviceCode,
+		IPAddress:    ip,
+		UserAgent:    ua,
+	}
+}
+
+func (r *OAuthTokenRequest) ToClientCredentialsCommand(clientID, clientSecret, ip, ua string) commands.ClientCredentialsTokenCommand {
+	return commands.ClientCredentialsTokenCommand{
+		ClientID:            clientID,
+		ClientSecret:        clientSecret,
+		ClientAssertionType: r.ClientAssertionType,
+		ClientAssertion:     r.ClientAssertion,
+		Scope:               r.Scope,
+		IPAddress:           ip,
+		UserAgent:           ua,
+	}
+}
@@ -1,5 +1,28 @@
 package request
 
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RevokeTokenRequest is decoded from an RFC 7009 form-encoded body.
 type RevokeTokenRequest struct {
-	Token string `json:"token" validate:"required"`
+	Token         string `json:"token" validate:"required"`
+	TokenTypeHint string `json:"token_type_hint" validate:"omitempty,max=32"`
+}
+
+func (r *RevokeTokenRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *RevokeTokenRequest) ToCommand(clientID, clientSecret, ip, ua string) commands.RevokeTokenCommand {
+	return commands.RevokeTokenCommand{
+		Token:         r.Token,
+		TokenTypeHint: r.TokenTypeHint,
+		ClientID:      clientID,
+		ClientSecret:  clientSecret,
+		IPAddress:     ip,
+		UserAgent:     ua,
+	}
 }
@@ -0,0 +1,15 @@
+package response
+
+import "time"
+
+// ChallengeResponse tells the client what to solve. For "pow", find a
+// nonce such that SHA-256("<token>:<nonce>") starts with difficulty zero
+// bits and send "<token>:<nonce>" as challenge_response; for "captcha",
+// render the widget for site_key and send its response token.
+type ChallengeResponse struct {
+	Provider   string     `json:"provider"`
+	Token      string     `json:"token,omitempty"`
+	Difficulty int        `json:"difficulty,omitempty"`
+	SiteKey    string     `json:"site_key,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,21 @@
+package response
+
+// IntrospectTokenResponse follows RFC 7662 section 2.2. Inactive tokens are
+// reported with only "active": false.
+type IntrospectTokenResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	Username  string `json:"username,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Sid       string `json:"sid,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+}
+
+// OAuthErrorResponse is the RFC 6749 section 5.2 error body.
+type OAuthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
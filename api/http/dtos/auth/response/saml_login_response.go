@@ -0,0 +1,11 @@
+package response
+
+type SAMLLoginResponse struct {
+	User           UserInfo `json:"user"`
+	IsNewUser      bool     `json:"is_new_user"`
+	OrganizationID string   `json:"organization_id,omitempty"`
+	AccessToken    string   `json:"access_token"`
+	RefreshToken   string   `json:"refresh_token"`
+	TokenType      string   `json:"token_type"`
+	ExpiresIn      int64    `json:"expires_in"`
+}
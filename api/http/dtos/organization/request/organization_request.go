@@ -0,0 +1,79 @@
+package request
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+}
+
+func (r *CreateOrganizationRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *CreateOrganizationRequest) ToCommand(actorID, ip, ua string) commands.CreateOrganizationCommand {
+	return commands.CreateOrganizationCommand{
+		ActorID:   actorID,
+		Name:      r.Name,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+type InviteMemberRequest struct {
+	Email string `json:"email" validate:"required,email,max=255"`
+	Role  string `json:"role" validate:"required,oneof=ADMIN MEMBER"`
+}
+
+func (r *InviteMemberRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *InviteMemberRequest) ToCommand(actorID, orgID, ip, ua string) commands.InviteOrganizationMemberCommand {
+	return commands.InviteOrganizationMemberCommand{
+		ActorID:        actorID,
+		OrganizationID: orgID,
+		Email:          r.Email,
+		Role:           r.Role,
+		IPAddress:      ip,
+		UserAgent:      ua,
+	}
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token" validate:"required,max=2048"`
+}
+
+func (r *AcceptInvitationRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *AcceptInvitationRequest) ToCommand(actorID, ip, ua string) commands.AcceptInvitationCommand {
+	return commands.AcceptInvitationCommand{
+		ActorID:   actorID,
+		Token:     r.Token,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+type TransferOwnershipRequest struct {
+	NewOwnerID string `json:"new_owner_id" validate:"required,max=64"`
+}
+
+func (r *TransferOwnershipRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *TransferOwnershipRequest) ToCommand(actorID, orgID, ip, ua string) commands.TransferOrganizationOwnershipCommand {
+	return commands.TransferOrganizationOwnershipCommand{
+		ActorID:        actorID,
+		OrganizationID: orgID,
+		NewOwnerID:     r.NewOwnerID,
+		IPAddress:      ip,
+		UserAgent:      ua,
+	}
+}
@@ -0,0 +1,146 @@
+package request
+
+import (
+	"authentication/internal/application/commands"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// UpdateProfileRequest changes the signed-in user's profile. Omitted fields
+// are left unchanged. Version must be the one returned by GET /me, so a
+// stale tab cannot overwrite a newer change.
+type UpdateProfileRequest struct {
+	Username  string `json:"username,omitempty" validate:"omitempty,min=3,max=30"`
+	FirstName string `json:"first_name,omitempty" validate:"max=100"`
+	LastName  string `json:"last_name,omitempty" validate:"max=100"`
+	Phone     string `json:"phone,omitempty" validate:"omitempty,e164"`
+	Version   int    `json:"version" validate:"required,min=1"`
+}
+
+func (r *UpdateProfileRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *UpdateProfileRequest) ToCommand(userID, ip, ua string) commands.UpdateUserCommand {
+	return commands.UpdateUserCommand{
+		ActorID:   userID,
+		UserID:    userID,
+		Username:  r.Username,
+		FirstName: r.FirstName,
+		LastName:  r.LastName,
+		Phone:     r.Phone,
+		Version:   r.Version,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+type ChangeEmailRequest struct {
+	Email    string `json:"email" validate:"required,email,max=255"`
+	Password string `json:"password,omitempty" validate:"max=128"`
+}
+
+func (r *ChangeEmailRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *ChangeEmailRequest) ToCommand(userID, ip, ua string) commands.RequestEmailChangeCommand {
+	return commands.RequestEmailChangeCommand{
+		UserID:    userID,
+		NewEmail:  r.Email,
+		Password:  r.Password,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+func (r *ConfirmEmailChangeRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *ConfirmEmailChangeRequest) ToCommand(userID, ip, ua string) commands.ConfirmEmailChangeCommand {
+	return commands.ConfirmEmailChangeCommand{
+		ActorID:   userID,
+		Token:     r.Token,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+// ReportSignInRequest carries the token from a "this wasn't me" link.
+type ReportSignInRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+func (r *ReportSignInRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *ReportSignInRequest) ToCommand(ip, ua string) commands.ReportSignInCommand {
+	return commands.ReportSignInCommand{
+		Token:     r.Token,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+type VerifyPhoneRequest struct {
+	Code string `json:"code" validate:"required,numeric,min=4,max=10"`
+}
+
+func (r *VerifyPhoneRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *VerifyPhoneRequest) ToCommand(userID, ip, ua string) commands.VerifyPhoneCommand {
+	return commands.VerifyPhoneCommand{
+		UserID:    userID,
+		Code:      r.Code,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+// DeleteAccountRequest re-confirms the password before the account is
+// deleted. Accounts without a password (social logins) may omit it.
+type DeleteAccountRequest struct {
+	Password string `json:"password,omitempty" validate:"max=128"`
+}
+
+func (r *DeleteAccountRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *DeleteAccountRequest) ToCommand(userID, ip, ua string) commands.DeleteAccountCommand {
+	return commands.DeleteAccountCommand{
+		UserID:    userID,
+		Password:  r.Password,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
+
+// ReauthenticateRequest confirms the signed-in user with their password or
+// an emailed code. An empty body asks for the code to be sent.
+type ReauthenticateRequest struct {
+	Password string `json:"password,omitempty" validate:"max=128"`
+	OTPCode  string `json:"otp_code,omitempty" validate:"omitempty,numeric,min=4,max=10"`
+}
+
+func (r *ReauthenticateRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *ReauthenticateRequest) ToCommand(userID, ip, ua string) commands.ReauthenticateCommand {
+	return commands.ReauthenticateCommand{
+		UserID:    userID,
+		Password:  r.Password,
+		OTPCode:   r.OTPCode,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+}
@@ -0,0 +1,97 @@
+package response
+
+import "time"
+
+type ProfileResponse struct {
+	ID            string     `json:"id"`
+	Username      string     `json:"username"`
+	Email         string     `json:"email"`
+	Phone         string     `json:"phone,omitempty"`
+	PhoneVerified bool       `json:"phone_verified"`
+	FirstName     string     `json:"first_name"`
+	LastName      string     `json:"last_name"`
+	Role          string     `json:"role"`
+	Provider      string     `json:"provider,omitempty"`
+	IsVerified    bool       `json:"is_verified"`
+	LastLoginAt   *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Version       int        `json:"version"`
+}
+
+type PhoneVerificationResponse struct {
+	Phone string `json:"phone"`
+}
+
+type EmailChangeResponse struct {
+	NewEmail  string    `json:"new_email"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type SignInReportResponse struct {
+	SessionID             string `json:"session_id"`
+	Scope                 string `json:"scope"`
+	PasswordResetRequired bool   `json:"password_reset_required"`
+}
+
+type TrustedDeviceResponse struct {
+	ID         string     `json:"id"`
+	DeviceID   string     `json:"device_id"`
+	Name       string     `json:"name"`
+	IPAddress  string     `json:"ip_address,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+// ReauthenticationResponse carries the upgraded access token, or only
+// otp_sent when a code was requested.
+type ReauthenticationResponse struct {
+	OTPSent     bool       `json:"otp_sent,omitempty"`
+	AccessToken string     `json:"access_token,omitempty"`
+	TokenType   string     `json:"token_type,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	AuthTime    *time.Time `json:"auth_time,omitempty"`
+	AMR         []string   `json:"amr,omitempty"`
+	ACR         string     `json:"acr,omitempty"`
+}
+
+type AccountDeletionResponse struct {
+	PurgeAfter time.Time `json:"purge_after"`
+}
+
+// UserDataExportResponse is the downloadable archive served by GET
+// /me/export.
+type UserDataExportResponse struct {
+	GeneratedAt      time.Time                `json:"generated_at"`
+	Profile          ProfileResponse          `json:"profile"`
+	Sessions         []ExportedSession        `json:"sessions"`
+	LinkedIdentities []ExportedLinkedIdentity `json:"linked_identities"`
+	AuditHistory     []ExportedAuditEntry     `json:"audit_history"`
+}
+
+type ExportedSession struct {
+	ID        string    `json:"id"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Revoked   bool      `json:"revoked"`
+}
+
+type ExportedLinkedIdentity struct {
+	Provider  string     `json:"provider"`
+	Scope     string     `json:"scope,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+type ExportedAuditEntry struct {
+	Action       string    `json:"action"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	ResourceID   string    `json:"resource_id,omitempty"`
+	Status       string    `json:"status"`
+	IPAddress    string    `json:"ip_address,omitempty"`
+	UserAgent    string    `json:"user_agent,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+}
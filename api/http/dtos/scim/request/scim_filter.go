@@ -0,0 +1,111 @@
+package request
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/utils"
+)
+
+// filterRegex matches the single `attribute eq value` expression identity
+// providers use to look resources up. Logical operators and the other
+// comparison operators are not supported.
+var filterRegex = regexp.MustCompile(`(?i)^\s*([a-z][a-z0-9_.]*)\s+eq\s+("(?:[^"\\]|\\.)*"|true|false)\s*$`)
+
+// ListRequest holds the query parameters of a SCIM list request.
+type ListRequest struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+// NewListRequest reads filter, startIndex and count. A missing count means
+// the default page size; count=0 asks for the total only.
+func NewListRequest(values url.Values) ListRequest {
+	req := ListRequest{
+		Filter:     values.Get("filter"),
+		StartIndex: 1,
+		Count:      utils.DefaultPageSize,
+	}
+	if v, err := strconv.Atoi(values.Get("startIndex")); err == nil && v > 1 {
+		req.StartIndex = v
+	}
+	if raw := values.Get("count"); raw != "" {
+		if v, err := strconv.Atoi(raw); err == nil {
+			req.Count = v
+		}
+		if req.Count < 0 {
+			req.Count = 0
+		}
+	}
+	return req
+}
+
+func (r ListRequest) ToUsersQuery() (queries.ListSCIMUsersQuery, error) {
+	query := queries.ListSCIMUsersQuery{StartIndex: r.StartIndex, Count: r.Count}
+	if strings.TrimSpace(r.Filter) == "" {
+		return query, nil
+	}
+
+	attribute, value, err := parseFilter(r.Filter)
+	if err != nil {
+		return queries.ListSCIMUsersQuery{}, err
+	}
+
+	switch attribute {
+	case "username":
+		query.UserName = value
+	case "emails", "emails.value":
+		query.Email = value
+	case "active":
+		active, err := strconv.ParseBool(value)
+		if err != nil {
+			return queries.ListSCIMUsersQuery{}, domain.ErrInvalidSCIMFilter
+		}
+		query.Active = &active
+	default:
+		return queries.ListSCIMUsersQuery{}, domain.ErrInvalidSCIMFilter
+	}
+	return query, nil
+}
+
+func (r ListRequest) ToGroupsQuery() (queries.ListSCIMGroupsQuery, error) {
+	query := queries.ListSCIMGroupsQuery{StartIndex: r.StartIndex, Count: r.Count}
+	if strings.TrimSpace(r.Filter) == "" {
+		return query, nil
+	}
+
+	attribute, value, err := parseFilter(r.Filter)
+	if err != nil {
+		return queries.ListSCIMGroupsQuery{}, err
+	}
+	if attribute != "displayname" {
+		return queries.ListSCIMGroupsQuery{}, domain.ErrInvalidSCIMFilter
+	}
+	query.DisplayName = value
+	return query, nil
+}
+
+// parseFilter returns the lower-cased attribute path and the unquoted value.
+func parseFilter(filter string) (string, string, error) {
+	match := filterRegex.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", domain.ErrInvalidSCIMFilter
+	}
+
+	value := match[2]
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return "", "", domain.ErrInvalidSCIMFilter
+		}
+		value = unquoted
+	} else {
+		value = strings.ToLower(value)
+	}
+	return strings.ToLower(match[1]), value, nil
+}
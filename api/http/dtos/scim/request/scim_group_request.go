@@ -0,0 +1,155 @@
+package request
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/domain"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// memberFilterRegex matches the members[value eq "id"] path used to remove
+// a single member.
+var memberFilterRegex = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]+)"\s*\]$`)
+
+type Member struct {
+	Value string `json:"value" validate:"required,max=64"`
+}
+
+type GroupRequest struct {
+	DisplayName string   `json:"displayName" validate:"required,max=255"`
+	Members     []Member `json:"members" validate:"max=1000,dive"`
+}
+
+func (r *GroupRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *GroupRequest) ToCreateCommand(tokenID, ip, ua string) commands.CreateSCIMGroupCommand {
+	return commands.CreateSCIMGroupCommand{
+		TokenID:     tokenID,
+		DisplayName: r.DisplayName,
+		Members:     memberIDs(r.Members),
+		IPAddress:   ip,
+		UserAgent:   ua,
+	}
+}
+
+func (r *GroupRequest) ToReplaceCommand(tokenID, groupID, ip, ua string) commands.UpdateSCIMGroupCommand {
+	return commands.UpdateSCIMGroupCommand{
+		TokenID:        tokenID,
+		GroupID:        groupID,
+		DisplayName:    &r.DisplayName,
+		ReplaceMembers: true,
+		Members:        memberIDs(r.Members),
+		IPAddress:      ip,
+		UserAgent:      ua,
+	}
+}
+
+// ToGroupCommand folds the operations into one membership change.
+func (r *PatchRequest) ToGroupCommand(tokenID, groupID, ip, ua string) (commands.UpdateSCIMGroupCommand, error) {
+	cmd := commands.UpdateSCIMGroupCommand{
+		TokenID:   tokenID,
+		GroupID:   groupID,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+
+	for _, op := range r.Operations {
+		var err error
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			replace := strings.EqualFold(op.Op, "replace")
+			if op.Path == "" {
+				err = applyGroupAttributes(&cmd, op.Value, replace)
+			} else {
+				err = applyGroupAttribute(&cmd, op.Path, op.Value, replace)
+			}
+		case "remove":
+			err = removeGroupMembers(&cmd, op.Path, op.Value)
+		default:
+			err = domain.ErrInvalidSCIMPatch
+		}
+		if err != nil {
+			return commands.UpdateSCIMGroupCommand{}, err
+		}
+	}
+	return cmd, nil
+}
+
+func applyGroupAttributes(cmd *commands.UpdateSCIMGroupCommand, value json.RawMessage, replace bool) error {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(value, &attributes); err != nil {
+		return domain.ErrInvalidSCIMPatch
+	}
+	for path, v := range attributes {
+		if err := applyGroupAttribute(cmd, path, v, replace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyGroupAttribute(cmd *commands.UpdateSCIMGroupCommand, path string, value json.RawMessage, replace bool) error {
+	switch strings.ToLower(strings.TrimSpace(path)) {
+	case "displayname":
+		return decodeString(value, &cmd.DisplayName)
+	case "members":
+		var members []Member
+		if err := json.Unmarshal(value, &members); err != nil {
+			return domain.ErrInvalidSCIMPatch
+		}
+		if replace {
+			cmd.ReplaceMembers = true
+			cmd.Members = memberIDs(members)
+			return nil
+		}
+		if cmd.ReplaceMembers {
+			cmd.Members = append(cmd.Members, memberIDs(members)...)
+			return nil
+		}
+		cmd.AddMembers = append(cmd.AddMembers, memberIDs(members)...)
+	}
+	return nil
+}
+
+// removeGroupMembers handles members[value eq "id"], a members path with
+// the members to drop in the value, and a bare members path that empties
+// the group.
+func removeGroupMembers(cmd *commands.UpdateSCIMGroupCommand, path string, value json.RawMessage) error {
+	path = strings.TrimSpace(path)
+	if match := memberFilterRegex.FindStringSubmatch(path); match != nil {
+		cmd.RemoveMembers = append(cmd.RemoveMembers, match[1])
+		return nil
+	}
+	if !strings.EqualFold(path, "members") {
+		return domain.ErrInvalidSCIMPatch
+	}
+
+	if len(value) == 0 || string(value) == "null" {
+		cmd.ReplaceMembers = true
+		cmd.Members = nil
+		return nil
+	}
+
+	var members []Member
+	if err := json.Unmarshal(value, &members); err != nil {
+		return domain.ErrInvalidSCIMPatch
+	}
+	cmd.RemoveMembers = append(cmd.RemoveMembers, memberIDs(members)...)
+	return nil
+}
+
+func memberIDs(members []Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		if m.Value != "" {
+			ids = append(ids, m.Value)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,244 @@
+package request
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/domain"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type Name struct {
+	GivenName  string `json:"givenName"`
+	FamilyName string `json:"familyName"`
+}
+
+type MultiValue struct {
+	Value   string `json:"value" validate:"max=255"`
+	Type    string `json:"type"`
+	Primary bool   `json:"primary"`
+}
+
+// UserRequest is the body of a SCIM User create or replace. Attributes this
+// service does not store, such as externalId or locale, are accepted and
+// ignored.
+type UserRequest struct {
+	UserName     string       `json:"userName" validate:"required,max=255"`
+	Name         *Name        `json:"name"`
+	Emails       []MultiValue `json:"emails" validate:"max=10,dive"`
+	PhoneNumbers []MultiValue `json:"phoneNumbers" validate:"max=10,dive"`
+	Active       *bool        `json:"active"`
+}
+
+func (r *UserRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+func (r *UserRequest) ToCreateCommand(tokenID, ip, ua string) commands.CreateSCIMUserCommand {
+	cmd := commands.CreateSCIMUserCommand{
+		TokenID:   tokenID,
+		UserName:  r.UserName,
+		Email:     primaryValue(r.Emails),
+		Phone:     primaryValue(r.PhoneNumbers),
+		Active:    r.Active == nil || *r.Active,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+	if r.Name != nil {
+		cmd.GivenName = r.Name.GivenName
+		cmd.FamilyName = r.Name.FamilyName
+	}
+	return cmd
+}
+
+// ToReplaceCommand sets every attribute, clearing the ones the body leaves
+// out. An omitted active flag leaves the account state unchanged.
+func (r *UserRequest) ToReplaceCommand(tokenID, userID, ip, ua string) commands.UpdateSCIMUserCommand {
+	email := primaryValue(r.Emails)
+	phone := primaryValue(r.PhoneNumbers)
+	var givenName, familyName string
+	if r.Name != nil {
+		givenName = r.Name.GivenName
+		familyName = r.Name.FamilyName
+	}
+
+	return commands.UpdateSCIMUserCommand{
+		TokenID:    tokenID,
+		UserID:     userID,
+		UserName:   &r.UserName,
+		Email:      &email,
+		GivenName:  &givenName,
+		FamilyName: &familyName,
+		Phone:      &phone,
+		Active:     r.Active,
+		IPAddress:  ip,
+		UserAgent:  ua,
+	}
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op" validate:"required"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// PatchRequest is a SCIM PatchOp message.
+type PatchRequest struct {
+	Operations []PatchOperation `json:"Operations" validate:"required,min=1,max=100,dive"`
+}
+
+func (r *PatchRequest) Validate(v *validator.Validate) error {
+	return v.Struct(r)
+}
+
+// ToUserCommand folds the operations into one update. Operations on
+// attributes this service does not store are ignored so that identity
+// providers can send their full attribute set.
+func (r *PatchRequest) ToUserCommand(tokenID, userID, ip, ua string) (commands.UpdateSCIMUserCommand, error) {
+	cmd := commands.UpdateSCIMUserCommand{
+		TokenID:   tokenID,
+		UserID:    userID,
+		IPAddress: ip,
+		UserAgent: ua,
+	}
+
+	for _, op := range r.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				var attributes map[string]json.RawMessage
+				if err := json.Unmarshal(op.Value, &attributes); err != nil {
+					return commands.UpdateSCIMUserCommand{}, domain.ErrInvalidSCIMPatch
+				}
+				for path, value := range attributes {
+					if err := applyUserAttribute(&cmd, path, value); err != nil {
+						return commands.UpdateSCIMUserCommand{}, err
+					}
+				}
+				continue
+			}
+			if err := applyUserAttribute(&cmd, op.Path, op.Value); err != nil {
+				return commands.UpdateSCIMUserCommand{}, err
+			}
+		case "remove":
+			if err := removeUserAttribute(&cmd, op.Path); err != nil {
+				return commands.UpdateSCIMUserCommand{}, err
+			}
+		default:
+			return commands.UpdateSCIMUserCommand{}, domain.ErrInvalidSCIMPatch
+		}
+	}
+	return cmd, nil
+}
+
+func applyUserAttribute(cmd *commands.UpdateSCIMUserCommand, path string, value json.RawMessage) error {
+	path = strings.ToLower(strings.TrimSpace(path))
+	switch {
+	case path == "username":
+		return decodeString(value, &cmd.UserName)
+	case path == "name.givenname":
+		return decodeString(value, &cmd.GivenName)
+	case path == "name.familyname":
+		return decodeString(value, &cmd.FamilyName)
+	case path == "name":
+		var name Name
+		if err := json.Unmarshal(value, &name); err != nil {
+			return domain.ErrInvalidSCIMPatch
+		}
+		cmd.GivenName = &name.GivenName
+		cmd.FamilyName = &name.FamilyName
+	case path == "emails":
+		return decodeMultiValue(value, &cmd.Email)
+	case isValueSubPath(path, "emails"):
+		return decodeString(value, &cmd.Email)
+	case path == "phonenumbers":
+		return decodeMultiValue(value, &cmd.Phone)
+	case isValueSubPath(path, "phonenumbers"):
+		return decodeString(value, &cmd.Phone)
+	case path == "active":
+		active, err := decodeBool(value)
+		if err != nil {
+			return err
+		}
+		cmd.Active = &active
+	}
+	return nil
+}
+
+func removeUserAttribute(cmd *commands.UpdateSCIMUserCommand, path string) error {
+	empty := ""
+	path = strings.ToLower(strings.TrimSpace(path))
+	switch {
+	case path == "":
+		return domain.ErrInvalidSCIMPatch
+	case path == "username", path == "active":
+		// Required attributes cannot be removed.
+		return domain.ErrInvalidSCIMPatch
+	case path == "name":
+		cmd.GivenName = &empty
+		cmd.FamilyName = &empty
+	case path == "name.givenname":
+		cmd.GivenName = &empty
+	case path == "name.familyname":
+		cmd.FamilyName = &empty
+	case path == "phonenumbers", isValueSubPath(path, "phonenumbers"):
+		cmd.Phone = &empty
+	}
+	return nil
+}
+
+// isValueSubPath matches value paths such as emails[type eq "work"].value.
+func isValueSubPath(path, attribute string) bool {
+	return strings.HasPrefix(path, attribute+"[") && strings.HasSuffix(path, "].value")
+}
+
+func decodeString(value json.RawMessage, target **string) error {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return domain.ErrInvalidSCIMPatch
+	}
+	*target = &s
+	return nil
+}
+
+func decodeMultiValue(value json.RawMessage, target **string) error {
+	var values []MultiValue
+	if err := json.Unmarshal(value, &values); err != nil {
+		return domain.ErrInvalidSCIMPatch
+	}
+	primary := primaryValue(values)
+	*target = &primary
+	return nil
+}
+
+// decodeBool accepts a JSON boolean or, as some identity providers send, a
+// boolean in a string.
+func decodeBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if parsed, err := strconv.ParseBool(s); err == nil {
+			return parsed, nil
+		}
+	}
+	return false, domain.ErrInvalidSCIMPatch
+}
+
+// primaryValue returns the value marked primary, or the first one.
+func primaryValue(values []MultiValue) string {
+	for _, v := range values {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	"authentication/api/http/dtos/apikey/request"
+	"authentication/api/http/dtos/apikey/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type APIKeyHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewAPIKeyHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "api_key")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *APIKeyHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.CreateAPIKeyRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateAPIKeyCommand, appDtos.APIKeySecretResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusCreated, "API key created. Store it now; it will not be shown again.", response.CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(appResult.APIKeyResult),
+		Key:            appResult.Key,
+	})
+}
+
+// List returns the caller's own keys.
+func (h *APIKeyHandler) List(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, actorID(r))
+}
+
+// AdminList returns every key, optionally filtered by the user_id parameter.
+func (h *APIKeyHandler) AdminList(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, r.URL.Query().Get("user_id"))
+}
+
+// Revoke revokes one of the caller's own keys.
+func (h *APIKeyHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	h.revoke(w, r, actorID(r))
+}
+
+// AdminRevoke revokes any user's key.
+func (h *APIKeyHandler) AdminRevoke(w http.ResponseWriter, r *http.Request) {
+	h.revoke(w, r, "")
+}
+
+func (h *APIKeyHandler) list(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+
+	page, pageSize := utils.ParsePagination(r)
+
+	appResult, err := messaging.ExecuteQuery[queries.ListAPIKeysQuery, appDtos.ListAPIKeysResult](
+		h.queryBus,
+		ctx,
+		queries.ListAPIKeysQuery{UserID: userID, Page: page, PageSize: pageSize},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	keys := make([]response.APIKeyResponse, 0, len(appResult.Keys))
+	for _, key := range appResult.Keys {
+		keys = append(keys, toAPIKeyResponse(key))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "API keys retrieved", response.ListAPIKeysResponse{
+		Keys:       keys,
+		TotalCount: appResult.TotalCount,
+		Page:       appResult.Page,
+		PageSize:   appResult.PageSize,
+	})
+}
+
+func (h *APIKeyHandler) revoke(w http.ResponseWriter, r *http.Request, ownerID string) {
+	ctx := r.Context()
+
+	cmd := commands.RevokeAPIKeyCommand{
+		ActorID:   actorID(r),
+		OwnerID:   ownerID,
+		KeyID:     mux.Vars(r)["id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.RevokeAPIKeyCommand, appDtos.APIKeyResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "API key revoked", toAPIKeyResponse(appResult))
+}
+
+func (h *APIKeyHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *APIKeyHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *APIKeyHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrReauthenticationRequired):
+		return http.StatusUnauthorized, domain.ErrReauthenticationRequired.Error()
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		return http.StatusNotFound, "API key not found"
+	case errors.Is(err, domain.ErrAPIKeyRevoked):
+		return http.StatusConflict, "API key is already revoked"
+	case errors.Is(err, domain.ErrEmptyAPIKeyName):
+		return http.StatusBadRequest, "API key name is required"
+	case errors.Is(err, domain.ErrInvalidAPIKeyExpiry):
+		return http.StatusBadRequest, "Expiry must be in the future"
+	case errors.Is(err, domain.ErrInvalidIPAllowlist):
+		return http.StatusBadRequest, "Allowed IPs must be IP addresses or CIDR ranges"
+	case errors.Is(err, domain.ErrInvalidScope):
+		return http.StatusBadRequest, "Invalid scope"
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toAPIKeyResponse(key appDtos.APIKeyResult) response.APIKeyResponse {
+	return response.APIKeyResponse{
+		ID:            key.ID,
+		UserID:        key.UserID,
+		Name:          key.Name,
+		DisplayPrefix: key.DisplayPrefix,
+		Scopes:        key.Scopes,
+		AllowedIPs:    key.AllowedIPs,
+		ExpiresAt:     key.ExpiresAt,
+		LastUsedAt:    key.LastUsedAt,
+		RevokedAt:     key.RevokedAt,
+		CreatedAt:     key.CreatedAt,
+	}
+}
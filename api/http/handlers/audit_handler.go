@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	apiDtos "authentication/api/http/dtos"
+	auditDtos "authentication/api/http/dtos/audit"
+	auditRequest "authentication/api/http/dtos/audit/request"
+	auditResponse "authentication/api/http/dtos/audit/response"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// AuditHandler serves the tenant's audit trail to administrators.
+type AuditHandler struct {
+	queryBus  *messaging.QueryBus
+	logger    logging.Logger
+	validator *validator.Validate
+}
+
+func NewAuditHandler(queryBus *messaging.QueryBus, logger logging.Logger) *AuditHandler {
+	return &AuditHandler{
+		queryBus:  queryBus,
+		logger:    logger.With(zap.String("handler", "audit")),
+		validator: utils.NewValidator(),
+	}
+}
+
+// List pages through audit entries filtered by user_id, action,
+// resource_type, resource_id, status, start_date and end_date. Dates are
+// RFC 3339.
+func (h *AuditHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	values := r.URL.Query()
+
+	startDate, endDate, ok := h.parseDateRange(w, values)
+	if !ok {
+		return
+	}
+
+	page, pageSize := utils.ParsePagination(r)
+	req := auditRequest.GetAuditLogsRequest{
+		UserID:       values.Get("user_id"),
+		Action:       values.Get("action"),
+		ResourceType: values.Get("resource_type"),
+		ResourceID:   values.Get("resource_id"),
+		Status:       values.Get("status"),
+		StartDate:    startDate,
+		EndDate:      endDate,
+		Page:         page,
+		PageSize:     pageSize,
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	appResult, err := messaging.ExecuteQuery[queries.GetAuditLogsQuery, appDtos.ListAuditLogsResult](h.queryBus, ctx, req.ToQuery())
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Audit logs retrieved", auditResponse.GetAuditLogsResponse{
+		Logs:       toAuditLogDTOs(appResult.Logs),
+		TotalCount: appResult.TotalCount,
+		Page:       appResult.Page,
+		PageSize:   appResult.PageSize,
+	})
+}
+
+// Search runs the full-text query q over audit entries. Follow next_cursor
+// with the cursor parameter to read further pages.
+func (h *AuditHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	values := r.URL.Query()
+
+	startDate, endDate, ok := h.parseDateRange(w, values)
+	if !ok {
+		return
+	}
+
+	_, pageSize := utils.ParsePagination(r)
+	req := auditRequest.SearchAuditLogsRequest{
+		Query:     values.Get("q"),
+		StartDate: startDate,
+		EndDate:   endDate,
+		Cursor:    values.Get("cursor"),
+		PageSize:  pageSize,
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	appResult, err := messaging.ExecuteQuery[queries.SearchAuditLogsQuery, appDtos.SearchAuditLogsResult](h.queryBus, ctx, req.ToQuery())
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Audit logs retrieved", auditResponse.SearchAuditLogsResponse{
+		Logs:       toAuditLogDTOs(appResult.Logs),
+		NextCursor: appResult.NextCursor,
+	})
+}
+
+func (h *AuditHandler) parseDateRange(w http.ResponseWriter, values url.Values) (*time.Time, *time.Time, bool) {
+	var dates [2]*time.Time
+	for i, name := range []string{"start_date", "end_date"} {
+		raw := values.Get(name)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			h.respondError(w, http.StatusBadRequest, name+" must be an RFC 3339 timestamp")
+			return nil, nil, false
+		}
+		dates[i] = &t
+	}
+	return dates[0], dates[1], true
+}
+
+func (h *AuditHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *AuditHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *AuditHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrInvalidAuditAction),
+		errors.Is(err, domain.ErrInvalidAuditDateRange),
+		errors.Is(err, domain.ErrAuditSearchQueryRequired),
+		errors.Is(err, domain.ErrInvalidAuditCursor):
+		return http.StatusBadRequest, err.Error()
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toAuditLogDTOs(logs []appDtos.AuditLogResult) []auditDtos.AuditLogDTO {
+	dtos := make([]auditDtos.AuditLogDTO, 0, len(logs))
+	for _, log := range logs {
+		dtos = append(dtos, auditDtos.AuditLogDTO{
+			ID:           log.ID,
+			UserID:       log.UserID,
+			Action:       log.Action,
+			ResourceType: log.ResourceType,
+			ResourceID:   log.ResourceID,
+			IPAddress:    log.IPAddress,
+			UserAgent:    log.UserAgent,
+			Status:       log.Status,
+			ErrorMessage: log.ErrorMessage,
+			Metadata:     log.Metadata,
+			Timestamp:    log.Timestamp,
+		})
+	}
+	return dtos
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	authResponse "authentication/api/http/dtos/auth/response"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// ChallengeHandler hands out the challenges login and registration ask for
+// after repeated failed logins.
+type ChallengeHandler struct {
+	queryBus *messaging.QueryBus
+	logger   logging.Logger
+}
+
+func NewChallengeHandler(queryBus *messaging.QueryBus, logger logging.Logger) *ChallengeHandler {
+	return &ChallengeHandler{
+		queryBus: queryBus,
+		logger:   logger.With(zap.String("handler", "challenge")),
+	}
+}
+
+func (h *ChallengeHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.GetChallengeQuery, appDtos.ChallengeResult](
+		h.queryBus,
+		ctx,
+		queries.GetChallengeQuery{},
+	)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to issue challenge", zap.Error(err))
+		h.respond(w, http.StatusInternalServerError, "An unexpected error occurred", nil)
+		return
+	}
+
+	resp := authResponse.ChallengeResponse{
+		Provider:   appResult.Provider,
+		Token:      appResult.Token,
+		Difficulty: appResult.Difficulty,
+		SiteKey:    appResult.SiteKey,
+	}
+	if !appResult.ExpiresAt.IsZero() {
+		resp.ExpiresAt = &appResult.ExpiresAt
+	}
+
+	// Every request must get a fresh proof-of-work token.
+	w.Header().Set("Cache-Control", "no-store")
+	h.respond(w, http.StatusOK, "Challenge issued", resp)
+}
+
+func (h *ChallengeHandler) respond(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	apiDtos "authentication/api/http/dtos"
+	"authentication/internal/application/contracts/services"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const defaultMailboxLimit = 20
+
+// DevMailboxHandler exposes captured emails so local development and
+// end-to-end tests can read OTPs and links. It must never be routed in
+// production.
+type DevMailboxHandler struct {
+	mailbox services.Mailbox
+	logger  logging.Logger
+}
+
+func NewDevMailboxHandler(mailbox services.Mailbox, logger logging.Logger) *DevMailboxHandler {
+	return &DevMailboxHandler{
+		mailbox: mailbox,
+		logger:  logger.With(zap.String("handler", "dev_mailbox")),
+	}
+}
+
+// List returns captured messages, newest first, optionally filtered by
+// ?to= and capped by ?limit=.
+func (h *DevMailboxHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := defaultMailboxLimit
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			h.respond(w, http.StatusBadRequest, "limit must be a positive integer", nil)
+			return
+		}
+		limit = parsed
+	}
+
+	messages, err := h.mailbox.List(ctx, r.URL.Query().Get("to"), limit)
+	if err != nil {
+		h.logger.Error(ctx, "Failed to read mailbox", zap.Error(err))
+		h.respond(w, http.StatusInternalServerError, "Failed to read mailbox", nil)
+		return
+	}
+
+	h.respond(w, http.StatusOK, "Mailbox retrieved", messages)
+}
+
+// Clear deletes all captured messages.
+func (h *DevMailboxHandler) Clear(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := h.mailbox.Clear(ctx); err != nil {
+		h.logger.Error(ctx, "Failed to clear mailbox", zap.Error(err))
+		h.respond(w, http.StatusInternalServerError, "Failed to clear mailbox", nil)
+		return
+	}
+
+	h.respond(w, http.StatusOK, "Mailbox cleared", nil)
+}
+
+func (h *DevMailboxHandler) respond(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	"authentication/api/http/dtos/auth/request"
+	"authentication/api/http/dtos/auth/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// DeviceHandler serves the user-facing side of the device authorization
+// grant. It runs behind the auth middleware so the approving user is known.
+type DeviceHandler struct {
+	commandBus *messaging.CommandBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewDeviceHandler(commandBus *messaging.CommandBus, logger logging.Logger) *DeviceHandler {
+	return &DeviceHandler{
+		commandBus: commandBus,
+		logger:     logger.With(zap.String("handler", "device")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *DeviceHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.VerifyDeviceCodeRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.VerifyDeviceCodeCommand, appDtos.DeviceVerificationResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	message := "Device denied"
+	if appResult.Approved {
+		message = "Device approved. You can return to your device."
+	}
+
+	h.respondSuccess(w, http.StatusOK, message, response.DeviceVerificationResponse{
+		ClientID:   appResult.ClientID,
+		ClientName: appResult.ClientName,
+		Scopes:     appResult.Scopes,
+		Approved:   appResult.Approved,
+	})
+}
+
+func (h *DeviceHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *DeviceHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *DeviceHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrInvalidUserCode), errors.Is(err, domain.ErrInvalidDeviceCode):
+		return http.StatusNotFound, "Invalid or expired code"
+	case errors.Is(err, domain.ErrDeviceCodeExpired):
+		return http.StatusGone, "The code has expired"
+	case errors.Is(err, domain.ErrOAuthClientNotFound):
+		return http.StatusNotFound, "OAuth client not found"
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
@@ -190,6 +190,8 @@ func (h *LoginHandler) buildOAuthLoginResponse(result *appDtos.LoginResult) resp
 // mapErrorToHTTP converts domain errors to HTTP status codes and messages
 func (h *LoginHandler) mapErrorToHTTP(err error) (int, string) {
 	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
 	case errors.Is(err, domain.ErrInvalidCredentials):
 		return http.StatusUnauthorized, "Invalid email or password"
 	case errors.Is(err, domain.ErrUserNotFound):
@@ -202,6 +204,26 @@ func (h *LoginHandler) mapErrorToHTTP(err error) (int, string) {
 		return http.StatusForbidden, "Email not verified by OAuth provider"
 	case errors.Is(err, domain.ErrInvalidEmail):
 		return http.StatusBadRequest, "Invalid email format"
+	case errors.Is(err, domain.ErrLDAPUnavailable):
+		return http.StatusServiceUnavailable, "Directory service is unavailable, please try again later"
+	case errors.Is(err, domain.ErrLDAPAccountConflict):
+		return http.StatusConflict, "An account with this email already exists; sign in with its password"
+	case errors.Is(err, domain.ErrLDAPProvisioningDisabled):
+		return http.StatusForbidden, "No account exists for this user"
+	case errors.Is(err, domain.ErrLDAPMissingEmail):
+		return http.StatusUnprocessableEntity, "Directory entry has no email address"
+	case errors.Is(err, domain.ErrInactiveUser):
+		return http.StatusForbidden, "User account is inactive"
+	case errors.Is(err, domain.ErrChallengeRequired):
+		return http.StatusPreconditionRequired, "Please solve the challenge from /api/v1/auth/challenge and try again"
+	case errors.Is(err, domain.ErrChallengeFailed):
+		return http.StatusForbidden, "Challenge response is invalid or expired"
+	case errors.Is(err, domain.ErrLoginBlocked):
+		return http.StatusForbidden, "This sign-in looks suspicious and was blocked"
+	case errors.Is(err, domain.ErrInvalidOTP):
+		return http.StatusUnauthorized, "Invalid or expired verification code"
+	case errors.Is(err, domain.ErrOTPRateLimited):
+		return http.StatusTooManyRequests, "Too many verification codes requested, please try again later"
 	default:
 		// Log unexpected errors
 		h.logger.Error(context.Background(), "Unexpected error during login", zap.Error(err))
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	adminRequest "authentication/api/http/dtos/admin/request"
+	adminResponse "authentication/api/http/dtos/admin/response"
+	"authentication/api/http/middleware"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type OAuthClientHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewOAuthClientHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *OAuthClientHandler {
+	return &OAuthClientHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "oauth_client")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *OAuthClientHandler) RegisterClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.RegisterOAuthClientRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.RegisterOAuthClientCommand, appDtos.OAuthClientSecretResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	message := "OAuth client registered. Store the client secret now; it will not be shown again."
+	if appResult.Public {
+		message = "OAuth client registered"
+	}
+	h.respondSuccess(w, http.StatusCreated, message, toOAuthClientSecretResponse(appResult))
+}
+
+func (h *OAuthClientHandler) ListClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, pageSize := utils.ParsePagination(r)
+
+	appResult, err := messaging.ExecuteQuery[queries.ListOAuthClientsQuery, appDtos.ListOAuthClientsResult](
+		h.queryBus,
+		ctx,
+		queries.ListOAuthClientsQuery{Page: page, PageSize: pageSize},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	clients := make([]adminResponse.OAuthClientResponse, 0, len(appResult.Clients))
+	for _, client := range appResult.Clients {
+		clients = append(clients, toOAuthClientResponse(client))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "OAuth clients retrieved", adminResponse.ListOAuthClientsResponse{
+		Clients:    clients,
+		TotalCount: appResult.TotalCount,
+		Page:       appResult.Page,
+		PageSize:   appResult.PageSize,
+	})
+}
+
+func (h *OAuthClientHandler) UpdateClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.UpdateOAuthClientRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["client_id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.UpdateOAuthClientCommand, appDtos.OAuthClientResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "OAuth client updated", toOAuthClientResponse(appResult))
+}
+
+func (h *OAuthClientHandler) DeactivateClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.DeactivateOAuthClientCommand{
+		ActorID:   actorID(r),
+		ClientID:  mux.Vars(r)["client_id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.DeactivateOAuthClientCommand, appDtos.OAuthClientResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "OAuth client deactivated", toOAuthClientResponse(appResult))
+}
+
+func (h *OAuthClientHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.RotateOAuthClientSecretCommand{
+		ActorID:   actorID(r),
+		ClientID:  mux.Vars(r)["client_id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.RotateOAuthClientSecretCommand, appDtos.OAuthClientSecretResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Client secret rotated. Store the new secret now; it will not be shown again.", toOAuthClientSecretResponse(appResult))
+}
+
+func (h *OAuthClientHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *OAuthClientHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *OAuthClientHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrOAuthClientNotFound):
+		return http.StatusNotFound, "OAuth client not found"
+	case errors.Is(err, domain.ErrOAuthClientInactive):
+		return http.StatusConflict, "OAuth client is inactive"
+	case errors.Is(err, domain.ErrOAuthClientPublic):
+		return http.StatusConflict, "Public OAuth clients have no secret"
+	case errors.Is(err, domain.ErrInvalidRedirectURI):
+		return http.StatusBadRequest, domain.ErrInvalidRedirectURI.Error()
+	case errors.Is(err, domain.ErrInvalidScope):
+		return http.StatusBadRequest, "Invalid scope"
+	case errors.Is(err, domain.ErrEmptyClientName):
+		return http.StatusBadRequest, "Client name is required"
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func actorID(r *http.Request) string {
+	if claims, ok := middleware.ClaimsFromContext(r.Context()); ok {
+		return claims.UserID
+	}
+	return ""
+}
+
+func toOAuthClientResponse(client appDtos.OAuthClientResult) adminResponse.OAuthClientResponse {
+	return adminResponse.OAuthClientResponse{
+		ID:           client.ID,
+		ClientID:     client.ClientID,
+		Name:         client.Name,
+		RedirectURIs: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		Public:       client.Public,
+		IsActive:     client.IsActive,
+	}
+}
+
+func toOAuthClientSecretResponse(result appDtos.OAuthClientSecretResult) adminResponse.OAuthClientSecretResponse {
+	return adminResponse.OAuthClientSecretResponse{
+		OAuthClientResponse: toOAuthClientResponse(result.OAuthClientResult),
+		ClientSecret:        result.ClientSecret,
+	}
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"authentication/api/http/dtos/auth/request"
+	"authentication/api/http/dtos/auth/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"go.uber.org/zap"
+)
+
+// OAuthTokenHandler serves the OAuth token, device authorization (RFC 8628),
+// introspection (RFC 7662) and revocation (RFC 7009) endpoints. They speak
+// form-encoded requests and plain OAuth JSON responses rather than the
+// ApiResponse envelope.
+type OAuthTokenHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewOAuthTokenHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *OAuthTokenHandler {
+	return &OAuthTokenHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "oauth_token")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *OAuthTokenHandler) Introspect(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "Malformed form body")
+		return
+	}
+
+	req := request.IntrospectTokenRequest{
+		Token:         r.PostForm.Get("token"),
+		TokenTypeHint: r.PostForm.Get("token_type_hint"),
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "The token parameter is required")
+		return
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+
+	appResult, err := messaging.ExecuteQuery[queries.IntrospectTokenQuery, appDtos.TokenIntrospectionResult](
+		h.queryBus,
+		ctx,
+		req.ToQuery(clientID, clientSecret),
+	)
+	if err != nil {
+		h.handleError(ctx, w, err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, response.IntrospectTokenResponse{
+		Active:    appResult.Active,
+		Scope:     appResult.Scope,
+		Username:  appResult.Username,
+		TokenType: appResult.TokenType,
+		Exp:       appResult.ExpiresAt,
+		Iat:       appResult.IssuedAt,
+		Sub:       appResult.Subject,
+		Sid:       appResult.SessionID,
+		Jti:       appResult.TokenID,
+	})
+}
+
+func (h *OAuthTokenHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "Malformed form body")
+		return
+	}
+
+	req := request.RevokeTokenRequest{
+		Token:         r.PostForm.Get("token"),
+		TokenTypeHint: r.PostForm.Get("token_type_hint"),
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "The token parameter is required")
+		return
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+	cmd := req.ToCommand(clientID, clientSecret, utils.GetClientIP(r), r.UserAgent())
+
+	_, err := messaging.Execute[commands.RevokeTokenCommand, appDtos.RevokeTokenResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		h.handleError(ctx, w, err)
+		return
+	}
+
+	// RFC 7009 section 2.2: respond 200 whether or not the token was valid.
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *OAuthTokenHandler) DeviceAuthorization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "Malformed form body")
+		return
+	}
+
+	req := request.DeviceAuthorizationRequest{
+		Scope: r.PostForm.Get("scope"),
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "Invalid scope parameter")
+		return
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+	cmd := req.ToCommand(clientID, clientSecret, utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.RequestDeviceCodeCommand, appDtos.DeviceAuthorizationResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		h.handleError(ctx, w, err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, response.DeviceAuthorizationResponse{
+		DeviceCode:              appResult.DeviceCode,
+		UserCode:                appResult.UserCode,
+		VerificationURI:         appResult.VerificationURI,
+		VerificationURIComplete: appResult.VerificationURIComplete,
+		ExpiresIn:               appResult.ExpiresIn,
+		Interval:                appResult.Interval,
+	})
+}
+
+func (h *OAuthTokenHandler) Token(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "Malformed form body")
+		return
+	}
+
+	req := request.OAuthTokenRequest{
+		GrantType:  r.PostForm.Get("grant_type"),
+		DeviceCode: r.PostForm.Get("device_code"),
+		Scope:      r.PostForm.Get("scope"),
+
+		ClientAssertionType: r.PostForm.Get("client_assertion_type"),
+		ClientAssertion:     r.PostForm.Get("client_assertion"),
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_request", "Missing or invalid token request parameters")
+		return
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+	ip, ua := utils.GetClientIP(r), r.UserAgent()
+
+	var appResult appDtos.OAuthTokenResult
+	var err error
+	switch req.GrantType {
+	case request.GrantTypeDeviceCode:
+		appResult, err = messaging.Execute[commands.PollDeviceTokenCommand, appDtos.OAuthTokenResult](
+			h.commandBus,
+			ctx,
+			req.ToDeviceCommand(clientID, clientSecret, ip, ua),
+		)
+	case request.GrantTypeClientCredentials:
+		appResult, err = messaging.Execute[commands.ClientCredentialsTokenCommand, appDtos.OAuthTokenResult](
+			h.commandBus,
+			ctx,
+			req.ToClientCredentialsCommand(clientID, clientSecret, ip, ua),
+		)
+	default:
+		h.respondOAuthError(w, http.StatusBadRequest, "unsupported_grant_type", "Grant type is not supported")
+		return
+	}
+	if err != nil {
+		h.handleError(ctx, w, err)
+		return
+	}
+
+	h.respondJSON(w, http.StatusOK, response.OAuthTokenResponse{
+		AccessToken:  appResult.AccessToken,
+		TokenType:    appResult.TokenType,
+		ExpiresIn:    appResult.ExpiresIn,
+		RefreshToken: appResult.RefreshToken,
+		Scope:        appResult.Scope,
+	})
+}
+
+func (h *OAuthTokenHandler) handleError(ctx context.Context, w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidClient), errors.Is(err, domain.ErrOAuthClientInactive):
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		h.respondOAuthError(w, http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+	case errors.Is(err, domain.ErrInvalidScope):
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_scope", "Requested scope is not allowed for this client")
+	case errors.Is(err, domain.ErrAuthorizationPending):
+		h.respondOAuthError(w, http.StatusBadRequest, "authorization_pending", "The user has not yet approved the device")
+	case errors.Is(err, domain.ErrSlowDown):
+		h.respondOAuthError(w, http.StatusBadRequest, "slow_down", "Polling too frequently, increase the interval by 5 seconds")
+	case errors.Is(err, domain.ErrDeviceCodeExpired):
+		h.respondOAuthError(w, http.StatusBadRequest, "expired_token", "The device code has expired")
+	case errors.Is(err, domain.ErrDeviceAccessDenied):
+		h.respondOAuthError(w, http.StatusBadRequest, "access_denied", "The user denied the authorization request")
+	case errors.Is(err, domain.ErrInvalidDeviceCode), errors.Is(err, domain.ErrInactiveUser):
+		h.respondOAuthError(w, http.StatusBadRequest, "invalid_grant", "The device code is invalid")
+	case errors.Is(err, domain.ErrPermissionDenied):
+		h.respondOAuthError(w, http.StatusForbidden, "access_denied", "The request is not permitted by policy")
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		h.respondOAuthError(w, http.StatusInternalServerError, "server_error", "An unexpected error occurred")
+	}
+}
+
+func (h *OAuthTokenHandler) respondJSON(w http.ResponseWriter, statusCode int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(data)
+}
+
+func (h *OAuthTokenHandler) respondOAuthError(w http.ResponseWriter, statusCode int, code, description string) {
+	h.respondJSON(w, statusCode, response.OAuthErrorResponse{
+		Error:            code,
+		ErrorDescription: description,
+	})
+}
+
+// clientCredentials reads client_secret_basic credentials, falling back to
+// client_secret_post form fields. Basic credentials are form-urlencoded per
+// RFC 6749 section 2.3.1.
+func clientCredentials(r *http.Request) (string, string) {
+	if id, secret, ok := r.BasicAuth(); ok {
+		if decoded, err := url.QueryUnescape(id); err == nil {
+			id = decoded
+		}
+		if decoded, err := url.QueryUnescape(secret); err == nil {
+			secret = decoded
+		}
+		return id, secret
+	}
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+}
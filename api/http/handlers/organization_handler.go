@@ -0,0 +1,344 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	orgRequest "authentication/api/http/dtos/organization/request"
+	orgResponse "authentication/api/http/dtos/organization/response"
+	"authentication/api/http/middleware"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type OrganizationHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewOrganizationHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *OrganizationHandler {
+	return &OrganizationHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "organization")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *OrganizationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req orgRequest.CreateOrganizationRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateOrganizationCommand, appDtos.OrganizationResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusCreated, "Organization created", toOrganizationResponse(appResult))
+}
+
+func (h *OrganizationHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.ListOrganizationsQuery, appDtos.ListOrganizationsResult](
+		h.queryBus,
+		ctx,
+		queries.ListOrganizationsQuery{ActorID: actorID(r)},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	orgs := make([]orgResponse.OrganizationResponse, 0, len(appResult.Organizations))
+	for _, org := range appResult.Organizations {
+		orgs = append(orgs, toOrganizationResponse(org))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Organizations retrieved", orgResponse.ListOrganizationsResponse{Organizations: orgs})
+}
+
+func (h *OrganizationHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.ListOrganizationMembersQuery, appDtos.ListOrganizationMembersResult](
+		h.queryBus,
+		ctx,
+		queries.ListOrganizationMembersQuery{ActorID: actorID(r), OrganizationID: mux.Vars(r)["id"]},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	resp := orgResponse.ListMembersResponse{
+		Members:     make([]orgResponse.MemberResponse, 0, len(appResult.Members)),
+		Invitations: make([]orgResponse.InvitationResponse, 0, len(appResult.Invitations)),
+	}
+	for _, member := range appResult.Members {
+		resp.Members = append(resp.Members, toMemberResponse(member))
+	}
+	for _, invitation := range appResult.Invitations {
+		resp.Invitations = append(resp.Invitations, toInvitationResponse(invitation))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Members retrieved", resp)
+}
+
+func (h *OrganizationHandler) Invite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req orgRequest.InviteMemberRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.InviteOrganizationMemberCommand, appDtos.InvitationResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusCreated, "Invitation sent", toInvitationResponse(appResult))
+}
+
+func (h *OrganizationHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req orgRequest.AcceptInvitationRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.AcceptInvitationCommand, appDtos.OrganizationResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Invitation accepted", toOrganizationResponse(appResult))
+}
+
+func (h *OrganizationHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	cmd := commands.RemoveOrganizationMemberCommand{
+		ActorID:        actorID(r),
+		OrganizationID: vars["id"],
+		UserID:         vars["userId"],
+		IPAddress:      utils.GetClientIP(r),
+		UserAgent:      r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.RemoveOrganizationMemberCommand, appDtos.OrganizationMemberResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Member removed", toMemberResponse(appResult))
+}
+
+func (h *OrganizationHandler) TransferOwnership(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req orgRequest.TransferOwnershipRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.TransferOrganizationOwnershipCommand, appDtos.OrganizationResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Ownership transferred", toOrganizationResponse(appResult))
+}
+
+// Switch issues an access token scoped to the organization in the path.
+func (h *OrganizationHandler) Switch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.SwitchOrganizationCommand{
+		ActorID:        actorID(r),
+		OrganizationID: mux.Vars(r)["id"],
+	}
+	if claims, ok := middleware.ClaimsFromContext(ctx); ok {
+		cmd.SessionID = claims.SessionID
+		cmd.AuthTime = claims.AuthTime
+		cmd.AuthMethods = claims.AuthMethods
+		cmd.ImpersonatorID = claims.ActorID
+		cmd.ExpiresAt = claims.ExpiresAt
+	}
+
+	appResult, err := messaging.Execute[commands.SwitchOrganizationCommand, appDtos.OrganizationTokenResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Organization token issued", orgResponse.OrganizationTokenResponse{
+		AccessToken:    appResult.AccessToken,
+		TokenType:      appResult.TokenType,
+		ExpiresAt:      appResult.ExpiresAt,
+		OrganizationID: appResult.OrganizationID,
+		Role:           appResult.Role,
+	})
+}
+
+func (h *OrganizationHandler) decode(w http.ResponseWriter, r *http.Request, dest interface{}) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dest); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return false
+	}
+	return true
+}
+
+func (h *OrganizationHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *OrganizationHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *OrganizationHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrOrganizationNotFound):
+		return http.StatusNotFound, "Organization not found"
+	case errors.Is(err, domain.ErrInvitationNotFound):
+		return http.StatusNotFound, "Invitation not found"
+	case errors.Is(err, domain.ErrUserNotFound):
+		return http.StatusNotFound, "User not found"
+	case errors.Is(err, domain.ErrNotOrganizationMember):
+		return http.StatusNotFound, domain.ErrNotOrganizationMember.Error()
+	case errors.Is(err, domain.ErrAlreadyOrganizationMember),
+		errors.Is(err, domain.ErrAlreadyOrganizationOwner):
+		return http.StatusConflict, err.Error()
+	case errors.Is(err, domain.ErrInvitationAlreadyUsed),
+		errors.Is(err, domain.ErrInvitationExpired):
+		return http.StatusGone, err.Error()
+	case errors.Is(err, domain.ErrInvitationEmailMismatch),
+		errors.Is(err, domain.ErrCannotRemoveOwner),
+		errors.Is(err, domain.ErrInactiveUser):
+		return http.StatusForbidden, err.Error()
+	case errors.Is(err, domain.ErrEmptyOrganizationName),
+		errors.Is(err, domain.ErrInvalidOrgRole),
+		errors.Is(err, domain.ErrInvalidInvitationRole),
+		errors.Is(err, domain.ErrInvalidInvitationToken),
+		errors.Is(err, domain.ErrInvalidEmailFormat):
+		return http.StatusBadRequest, err.Error()
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toOrganizationResponse(org appDtos.OrganizationResult) orgResponse.OrganizationResponse {
+	return orgResponse.OrganizationResponse{
+		ID:        org.ID,
+		Name:      org.Name,
+		OwnerID:   org.OwnerID,
+		Role:      org.Role,
+		CreatedAt: org.CreatedAt,
+	}
+}
+
+func toMemberResponse(member appDtos.OrganizationMemberResult) orgResponse.MemberResponse {
+	return orgResponse.MemberResponse{
+		UserID:    member.UserID,
+		Role:      member.Role,
+		InvitedBy: member.InvitedBy,
+		JoinedAt:  member.JoinedAt,
+	}
+}
+
+func toInvitationResponse(invitation appDtos.InvitationResult) orgResponse.InvitationResponse {
+	return orgResponse.InvitationResponse{
+		ID:             invitation.ID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+		Role:           invitation.Role,
+		InvitedBy:      invitation.InvitedBy,
+		ExpiresAt:      invitation.ExpiresAt,
+		CreatedAt:      invitation.CreatedAt,
+	}
+}
@@ -0,0 +1,517 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	profileRequest "authentication/api/http/dtos/profile/request"
+	profileResponse "authentication/api/http/dtos/profile/response"
+	"authentication/api/http/middleware"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+// ProfileHandler serves the signed-in user's own profile under /me.
+type ProfileHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewProfileHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *ProfileHandler {
+	return &ProfileHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "profile")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *ProfileHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.GetUserQuery, appDtos.UserResult](
+		h.queryBus,
+		ctx,
+		queries.GetUserQuery{UserID: actorID(r)},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Profile retrieved", toProfileResponse(appResult))
+}
+
+func (h *ProfileHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req profileRequest.UpdateProfileRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.UpdateUserCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Profile updated", toProfileResponse(appResult))
+}
+
+func (h *ProfileHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req profileRequest.ChangeEmailRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.RequestEmailChangeCommand, appDtos.EmailChangeResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusAccepted, "Confirmation sent to the new email address", profileResponse.EmailChangeResponse{
+		NewEmail:  appResult.NewEmail,
+		ExpiresAt: appResult.ExpiresAt,
+	})
+}
+
+func (h *ProfileHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req profileRequest.ConfirmEmailChangeRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.ConfirmEmailChangeCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Email address changed", toProfileResponse(appResult))
+}
+
+// ReportSignIn handles the "this wasn't me" link from a new sign-in email.
+// It runs without a session; the signed token identifies the user.
+func (h *ProfileHandler) ReportSignIn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req profileRequest.ReportSignInRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.ReportSignInCommand, appDtos.SignInReportResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	message := "Sessions signed out"
+	if appResult.PasswordResetRequired {
+		message = "Sessions signed out; check your email to reset your password"
+	}
+	h.respondSuccess(w, http.StatusOK, message, profileResponse.SignInReportResponse{
+		SessionID:             appResult.SessionID,
+		Scope:                 appResult.Scope,
+		PasswordResetRequired: appResult.PasswordResetRequired,
+	})
+}
+
+// ListTrustedDevices returns the caller's devices that currently skip the
+// login code step.
+func (h *ProfileHandler) ListTrustedDevices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.ListTrustedDevicesQuery, appDtos.ListTrustedDevicesResult](
+		h.queryBus,
+		ctx,
+		queries.ListTrustedDevicesQuery{UserID: actorID(r)},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	devices := make([]profileResponse.TrustedDeviceResponse, 0, len(appResult.Devices))
+	for _, device := range appResult.Devices {
+		devices = append(devices, toTrustedDeviceResponse(device))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Trusted devices retrieved", devices)
+}
+
+// RevokeTrustedDevice makes one of the caller's devices ask for the login
+// code again.
+func (h *ProfileHandler) RevokeTrustedDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.RevokeTrustedDeviceCommand{
+		ActorID:         actorID(r),
+		UserID:          actorID(r),
+		TrustedDeviceID: mux.Vars(r)["id"],
+		IPAddress:       utils.GetClientIP(r),
+		UserAgent:       r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.RevokeTrustedDeviceCommand, appDtos.TrustedDeviceResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Trusted device revoked", toTrustedDeviceResponse(appResult))
+}
+
+func (h *ProfileHandler) SendPhoneVerification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.SendPhoneVerificationCommand{
+		UserID:    actorID(r),
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.SendPhoneVerificationCommand, appDtos.PhoneVerificationResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusAccepted, "Verification code sent", profileResponse.PhoneVerificationResponse{
+		Phone: appResult.Phone,
+	})
+}
+
+func (h *ProfileHandler) VerifyPhone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req profileRequest.VerifyPhoneRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.VerifyPhoneCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Phone number verified", toProfileResponse(appResult))
+}
+
+// Reauthenticate confirms the signed-in user again and returns an access
+// token for the same session with a fresh auth_time, as required before
+// sensitive changes. Without a password or code it emails a code first.
+func (h *ProfileHandler) Reauthenticate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req profileRequest.ReauthenticateRequest
+	if r.ContentLength != 0 && !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+	if claims, ok := middleware.ClaimsFromContext(ctx); ok {
+		cmd.SessionID = claims.SessionID
+		cmd.AuthMethods = claims.AuthMethods
+		cmd.OrganizationID = claims.OrgID
+		cmd.OrganizationRole = claims.OrgRole
+	}
+
+	appResult, err := messaging.Execute[commands.ReauthenticateCommand, appDtos.ReauthenticationResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	if appResult.OTPSent {
+		h.respondSuccess(w, http.StatusAccepted, "Verification code sent", profileResponse.ReauthenticationResponse{
+			OTPSent: true,
+		})
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Reauthenticated", profileResponse.ReauthenticationResponse{
+		AccessToken: appResult.AccessToken,
+		TokenType:   appResult.TokenType,
+		ExpiresAt:   &appResult.ExpiresAt,
+		AuthTime:    &appResult.AuthTime,
+		AMR:         appResult.AuthMethods,
+		ACR:         appResult.AuthLevel,
+	})
+}
+
+// DeleteAccount soft deletes the signed-in user's account. The body may be
+// omitted by accounts that have no password.
+func (h *ProfileHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req profileRequest.DeleteAccountRequest
+	if r.ContentLength != 0 && !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.DeleteAccountCommand, appDtos.AccountDeletionResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusAccepted, "Account scheduled for deletion", profileResponse.AccountDeletionResponse{
+		PurgeAfter: appResult.PurgeAfter,
+	})
+}
+
+// ExportData serves the user's data archive as a JSON file download.
+func (h *ProfileHandler) ExportData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.ExportUserDataCommand{
+		UserID:    actorID(r),
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.ExportUserDataCommand, appDtos.UserDataExport](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="user-data-`+appResult.Profile.ID+`.json"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(toUserDataExportResponse(appResult)); err != nil {
+		h.logger.Error(ctx, "Failed to write data export", zap.Error(err))
+	}
+}
+
+func (h *ProfileHandler) decode(w http.ResponseWriter, r *http.Request, dest interface{}) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dest); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return false
+	}
+	return true
+}
+
+func (h *ProfileHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *ProfileHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *ProfileHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrUserNotFound):
+		return http.StatusNotFound, "User not found"
+	case errors.Is(err, domain.ErrInactiveUser):
+		return http.StatusForbidden, domain.ErrInactiveUser.Error()
+	case errors.Is(err, domain.ErrTrustedDeviceNotFound):
+		return http.StatusNotFound, domain.ErrTrustedDeviceNotFound.Error()
+	case errors.Is(err, domain.ErrTrustedDeviceRevoked):
+		return http.StatusConflict, domain.ErrTrustedDeviceRevoked.Error()
+	case errors.Is(err, domain.ErrReauthenticationRequired):
+		return http.StatusUnauthorized, domain.ErrReauthenticationRequired.Error()
+	case errors.Is(err, domain.ErrInvalidSession):
+		return http.StatusUnauthorized, domain.ErrInvalidSession.Error()
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		return http.StatusUnauthorized, "Invalid password"
+	case errors.Is(err, domain.ErrLoginBlocked):
+		return http.StatusForbidden, "This sign-in looks suspicious and was blocked"
+	case errors.Is(err, domain.ErrUserVersionConflict),
+		errors.Is(err, domain.ErrEmailChangeSuperseded):
+		return http.StatusConflict, err.Error()
+	case errors.Is(err, domain.ErrUsernameAlreadyTaken),
+		errors.Is(err, domain.ErrEmailAlreadyInUse):
+		return http.StatusConflict, err.Error()
+	case errors.Is(err, domain.ErrIdentityManagedExternally):
+		return http.StatusForbidden, domain.ErrIdentityManagedExternally.Error()
+	case errors.Is(err, domain.ErrPhoneAlreadyVerified):
+		return http.StatusConflict, domain.ErrPhoneAlreadyVerified.Error()
+	case errors.Is(err, domain.ErrOTPRateLimited),
+		errors.Is(err, domain.ErrSMSRateLimited):
+		return http.StatusTooManyRequests, err.Error()
+	case errors.Is(err, domain.ErrSMSUnavailable):
+		return http.StatusServiceUnavailable, domain.ErrSMSUnavailable.Error()
+	case errors.Is(err, domain.ErrEmailChangeExpired),
+		errors.Is(err, domain.ErrSignInAlertExpired),
+		errors.Is(err, domain.ErrSignInAlertUsed):
+		return http.StatusGone, err.Error()
+	case errors.Is(err, domain.ErrTenantMismatch):
+		return http.StatusForbidden, domain.ErrTenantMismatch.Error()
+	case errors.Is(err, domain.ErrInvalidEmailChangeToken),
+		errors.Is(err, domain.ErrInvalidSignInAlertToken),
+		errors.Is(err, domain.ErrEmailUnchanged),
+		errors.Is(err, domain.ErrInvalidEmailFormat),
+		errors.Is(err, domain.ErrEmptyEmail),
+		errors.Is(err, domain.ErrInvalidUsernameFormat),
+		errors.Is(err, domain.ErrUsernameTooShort),
+		errors.Is(err, domain.ErrUsernameTooLong),
+		errors.Is(err, domain.ErrInvalidPhoneFormat),
+		errors.Is(err, domain.ErrPhoneNotSet),
+		errors.Is(err, domain.ErrSMSCountryNotAllowed),
+		errors.Is(err, domain.ErrInvalidOTP):
+		return http.StatusBadRequest, err.Error()
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toProfileResponse(user appDtos.UserResult) profileResponse.ProfileResponse {
+	return profileResponse.ProfileResponse{
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Phone:         user.Phone,
+		PhoneVerified: user.PhoneVerified,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Role:          user.Role,
+		Provider:      user.Provider,
+		IsVerified:    user.IsVerified,
+		LastLoginAt:   user.LastLoginAt,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+		Version:       user.Version,
+	}
+}
+
+func toTrustedDeviceResponse(device appDtos.TrustedDeviceResult) profileResponse.TrustedDeviceResponse {
+	return profileResponse.TrustedDeviceResponse{
+		ID:         device.ID,
+		DeviceID:   device.DeviceID,
+		Name:       device.Name,
+		IPAddress:  device.IPAddress,
+		CreatedAt:  device.CreatedAt,
+		LastUsedAt: device.LastUsedAt,
+		ExpiresAt:  device.ExpiresAt,
+		RevokedAt:  device.RevokedAt,
+	}
+}
+
+func toUserDataExportResponse(export appDtos.UserDataExport) profileResponse.UserDataExportResponse {
+	resp := profileResponse.UserDataExportResponse{
+		GeneratedAt:      export.GeneratedAt,
+		Profile:          toProfileResponse(export.Profile),
+		Sessions:         make([]profileResponse.ExportedSession, 0, len(export.Sessions)),
+		LinkedIdentities: make([]profileResponse.ExportedLinkedIdentity, 0, len(export.LinkedIdentities)),
+		AuditHistory:     make([]profileResponse.ExportedAuditEntry, 0, len(export.AuditHistory)),
+	}
+	for _, s := range export.Sessions {
+		resp.Sessions = append(resp.Sessions, profileResponse.ExportedSession(s))
+	}
+	for _, l := range export.LinkedIdentities {
+		resp.LinkedIdentities = append(resp.LinkedIdentities, profileResponse.ExportedLinkedIdentity(l))
+	}
+	for _, a := range export.AuditHistory {
+		resp.AuditHistory = append(resp.AuditHistory, profileResponse.ExportedAuditEntry(a))
+	}
+	return resp
+}
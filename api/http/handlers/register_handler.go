@@ -172,6 +172,8 @@ func (h *AuthHandler) respondError(w http.ResponseWriter, statusCode int, messag
 
 func (h *AuthHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
 	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
 	case errors.Is(err, domain.ErrEmailAlreadyInUse):
 		return http.StatusConflict, "Email is already registered"
 	case errors.Is(err, domain.ErrInvalidEmail):
@@ -184,6 +186,10 @@ func (h *AuthHandler) mapErrorToHTTP(ctx context.Context, err error) (int, strin
 		return http.StatusBadRequest, "Invalid role"
 	case errors.Is(err, domain.ErrUserNotFound):
 		return http.StatusNotFound, "User not found"
+	case errors.Is(err, domain.ErrChallengeRequired):
+		return http.StatusPreconditionRequired, "Please solve the challenge from /api/v1/auth/challenge and try again"
+	case errors.Is(err, domain.ErrChallengeFailed):
+		return http.StatusForbidden, "Challenge response is invalid or expired"
 	default:
 		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
 		return http.StatusInternalServerError, "An unexpected error occurred"
@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	adminRequest "authentication/api/http/dtos/admin/request"
+	adminResponse "authentication/api/http/dtos/admin/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type RoleHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewRoleHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *RoleHandler {
+	return &RoleHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "role")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *RoleHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.CreateRoleRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateRoleCommand, appDtos.RoleResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusCreated, "Role created", toRoleResponse(appResult))
+}
+
+func (h *RoleHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.ListRolesQuery, appDtos.ListRolesResult](
+		h.queryBus,
+		ctx,
+		queries.ListRolesQuery{ActorID: actorID(r)},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	roles := make([]adminResponse.RoleResponse, 0, len(appResult.Roles))
+	for _, role := range appResult.Roles {
+		roles = append(roles, toRoleResponse(role))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Roles retrieved", adminResponse.ListRolesResponse{Roles: roles})
+}
+
+func (h *RoleHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.UpdateRoleRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["name"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.UpdateRoleCommand, appDtos.RoleResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Role updated", toRoleResponse(appResult))
+}
+
+func (h *RoleHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.DeleteRoleCommand{
+		ActorID:   actorID(r),
+		Name:      mux.Vars(r)["name"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.DeleteRoleCommand, appDtos.RoleResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Role deleted", toRoleResponse(appResult))
+}
+
+func (h *RoleHandler) UserPermissions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.GetUserPermissionsQuery, appDtos.UserPermissionsResult](
+		h.queryBus,
+		ctx,
+		queries.GetUserPermissionsQuery{ActorID: actorID(r), UserID: mux.Vars(r)["id"]},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Permissions retrieved", toUserPermissionsResponse(appResult))
+}
+
+func (h *RoleHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.AssignRoleRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.AssignRoleCommand, appDtos.UserPermissionsResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Role assigned", toUserPermissionsResponse(appResult))
+}
+
+func (h *RoleHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	cmd := commands.UnassignRoleCommand{
+		ActorID:   actorID(r),
+		UserID:    vars["id"],
+		Role:      vars["role"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.UnassignRoleCommand, appDtos.UserPermissionsResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Role unassigned", toUserPermissionsResponse(appResult))
+}
+
+func (h *RoleHandler) decode(w http.ResponseWriter, r *http.Request, dest interface{}) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dest); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return false
+	}
+	return true
+}
+
+func (h *RoleHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *RoleHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *RoleHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrRoleNotFound):
+		return http.StatusNotFound, "Role not found"
+	case errors.Is(err, domain.ErrUserNotFound):
+		return http.StatusNotFound, "User not found"
+	case errors.Is(err, domain.ErrRoleNotAssigned):
+		return http.StatusNotFound, domain.ErrRoleNotAssigned.Error()
+	case errors.Is(err, domain.ErrRoleAlreadyExists):
+		return http.StatusConflict, "Role already exists"
+	case errors.Is(err, domain.ErrRoleInUse):
+		return http.StatusConflict, domain.ErrRoleInUse.Error()
+	case errors.Is(err, domain.ErrSystemRoleImmutable):
+		return http.StatusForbidden, domain.ErrSystemRoleImmutable.Error()
+	case errors.Is(err, domain.ErrRoleEscalation):
+		return http.StatusForbidden, domain.ErrRoleEscalation.Error()
+	case errors.Is(err, domain.ErrSelfAdministration):
+		return http.StatusConflict, domain.ErrSelfAdministration.Error()
+	case errors.Is(err, domain.ErrInvalidRoleName),
+		errors.Is(err, domain.ErrInvalidPermission),
+		errors.Is(err, domain.ErrRoleHierarchyCycle):
+		return http.StatusBadRequest, err.Error()
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toRoleResponse(role appDtos.RoleResult) adminResponse.RoleResponse {
+	return adminResponse.RoleResponse{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		Parents:     role.Parents,
+		IsSystem:    role.IsSystem,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+func toUserPermissionsResponse(result appDtos.UserPermissionsResult) adminResponse.UserPermissionsResponse {
+	return adminResponse.UserPermissionsResponse{
+		UserID:      result.UserID,
+		Roles:       result.Roles,
+		Permissions: result.Permissions,
+	}
+}
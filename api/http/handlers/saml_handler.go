@@ -0,0 +1,311 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	adminRequest "authentication/api/http/dtos/admin/request"
+	adminResponse "authentication/api/http/dtos/admin/response"
+	authResponse "authentication/api/http/dtos/auth/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type SAMLHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewSAMLHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *SAMLHandler {
+	return &SAMLHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "saml")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *SAMLHandler) CreateConnection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.CreateSAMLConnectionRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateSAMLConnectionCommand, appDtos.SAMLConnectionResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusCreated, "SAML connection created", toSAMLConnectionResponse(appResult))
+}
+
+func (h *SAMLHandler) ListConnections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.ListSAMLConnectionsQuery, appDtos.ListSAMLConnectionsResult](
+		h.queryBus,
+		ctx,
+		queries.ListSAMLConnectionsQuery{},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	connections := make([]adminResponse.SAMLConnectionResponse, 0, len(appResult.Connections))
+	for _, conn := range appResult.Connections {
+		connections = append(connections, toSAMLConnectionResponse(conn))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "SAML connections retrieved", adminResponse.ListSAMLConnectionsResponse{
+		Connections: connections,
+	})
+}
+
+func (h *SAMLHandler) UpdateConnection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.UpdateSAMLConnectionRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.UpdateSAMLConnectionCommand, appDtos.SAMLConnectionResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "SAML connection updated", toSAMLConnectionResponse(appResult))
+}
+
+// Metadata serves the SP metadata document an IdP administrator imports.
+func (h *SAMLHandler) Metadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.GetSAMLMetadataQuery, appDtos.SAMLMetadataResult](
+		h.queryBus,
+		ctx,
+		queries.GetSAMLMetadataQuery{ConnectionID: mux.Vars(r)["id"]},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(appResult.XML)
+}
+
+// Login redirects the browser to the identity provider with a signed
+// AuthnRequest.
+func (h *SAMLHandler) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.Execute[commands.StartSAMLLoginCommand, appDtos.SAMLLoginRequestResult](
+		h.commandBus,
+		ctx,
+		commands.StartSAMLLoginCommand{ConnectionID: mux.Vars(r)["id"]},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, appResult.RedirectURL, http.StatusFound)
+}
+
+// AssertionConsumer receives the HTTP-POST binding response from the
+// identity provider and signs the user in.
+func (h *SAMLHandler) AssertionConsumer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Malformed form body")
+		return
+	}
+
+	cmd := commands.CompleteSAMLLoginCommand{
+		ConnectionID: mux.Vars(r)["id"],
+		SAMLResponse: r.PostForm.Get("SAMLResponse"),
+		RelayState:   r.PostForm.Get("RelayState"),
+		IPAddress:    utils.GetClientIP(r),
+		UserAgent:    r.UserAgent(),
+	}
+	if cmd.SAMLResponse == "" || cmd.RelayState == "" {
+		h.respondError(w, http.StatusBadRequest, "SAMLResponse and RelayState are required")
+		return
+	}
+
+	appResult, err := messaging.Execute[commands.CompleteSAMLLoginCommand, appDtos.SAMLLoginResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	h.respondSuccess(w, http.StatusOK, "Login successful", authResponse.SAMLLoginResponse{
+		User: authResponse.UserInfo{
+			UserID:     appResult.UserID,
+			Email:      appResult.Email,
+			FirstName:  appResult.FirstName,
+			LastName:   appResult.LastName,
+			Role:       appResult.Role,
+			IsVerified: true, // the identity provider vouches for the email
+		},
+		IsNewUser:      appResult.IsNewUser,
+		OrganizationID: appResult.OrganizationID,
+		AccessToken:    appResult.AccessToken,
+		RefreshToken:   appResult.RefreshToken,
+		TokenType:      "Bearer",
+		ExpiresIn:      appResult.ExpiresIn,
+	})
+}
+
+func (h *SAMLHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *SAMLHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *SAMLHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrSAMLConnectionNotFound):
+		return http.StatusNotFound, "SAML connection not found"
+	case errors.Is(err, domain.ErrSAMLConnectionInactive):
+		return http.StatusConflict, "SAML connection is inactive"
+	case errors.Is(err, domain.ErrOrganizationNotFound):
+		return http.StatusNotFound, "Organization not found"
+	case errors.Is(err, domain.ErrEmptySAMLConnectionName):
+		return http.StatusBadRequest, "Connection name is required"
+	case errors.Is(err, domain.ErrInvalidSAMLMetadata):
+		return http.StatusBadRequest, "Invalid identity provider metadata"
+	case errors.Is(err, domain.ErrInvalidSAMLAttributeMapping):
+		return http.StatusBadRequest, domain.ErrInvalidSAMLAttributeMapping.Error()
+	case errors.Is(err, domain.ErrInvalidSAMLDomain):
+		return http.StatusBadRequest, domain.ErrInvalidSAMLDomain.Error()
+	case errors.Is(err, domain.ErrInvalidRole):
+		return http.StatusBadRequest, "Invalid role"
+	case errors.Is(err, domain.ErrSAMLRequestNotFound):
+		return http.StatusBadRequest, "Login request expired or unknown, please start again"
+	case errors.Is(err, domain.ErrSAMLMissingEmail):
+		return http.StatusUnprocessableEntity, "Identity provider did not release an email address"
+	case errors.Is(err, domain.ErrSAMLProvisioningDisabled):
+		return http.StatusForbidden, "No account exists for this user"
+	case errors.Is(err, domain.ErrSAMLAccountNotLinked):
+		return http.StatusForbidden, "This account cannot sign in through this identity provider"
+	case errors.Is(err, domain.ErrInactiveUser):
+		return http.StatusForbidden, "User account is inactive"
+	case errors.Is(err, domain.ErrInvalidSAMLResponse),
+		errors.Is(err, domain.ErrSAMLSignatureInvalid),
+		errors.Is(err, domain.ErrSAMLAssertionExpired),
+		errors.Is(err, domain.ErrSAMLAudienceMismatch),
+		errors.Is(err, domain.ErrSAMLAssertionReplayed),
+		errors.Is(err, domain.ErrTenantMismatch):
+		// One message for every rejected assertion so the response does
+		// not tell an attacker which check failed.
+		h.logger.Warn(ctx, "SAML response rejected", zap.Error(err))
+		return http.StatusUnauthorized, "SAML response rejected"
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toSAMLConnectionResponse(conn appDtos.SAMLConnectionResult) adminResponse.SAMLConnectionResponse {
+	return adminResponse.SAMLConnectionResponse{
+		ID:               conn.ID,
+		OrganizationID:   conn.OrganizationID,
+		Name:             conn.Name,
+		IdPEntityID:      conn.IdPEntityID,
+		SSOURL:           conn.SSOURL,
+		AttributeMapping: conn.AttributeMapping,
+		Domains:          conn.Domains,
+		JITProvisioning:  conn.JITProvisioning,
+		DefaultRole:      conn.DefaultRole,
+		IsActive:         conn.IsActive,
+		CreatedAt:        conn.CreatedAt,
+		UpdatedAt:        conn.UpdatedAt,
+	}
+}
@@ -0,0 +1,487 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"authentication/api/http/dtos/scim/request"
+	"authentication/api/http/dtos/scim/response"
+	"authentication/api/http/middleware"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+const (
+	scimUsersPath  = "/scim/v2/Users/"
+	scimGroupsPath = "/scim/v2/Groups/"
+)
+
+// SCIMHandler serves the SCIM 2.0 Users and Groups endpoints. Responses use
+// the SCIM message format rather than the ApiResponse envelope.
+type SCIMHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewSCIMHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *SCIMHandler {
+	return &SCIMHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "scim")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *SCIMHandler) ServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, http.StatusOK, response.ServiceProviderConfig{
+		Schemas:        []string{response.SchemaServiceProviderConfig},
+		Patch:          response.Supported{Supported: true},
+		Bulk:           response.Supported{Supported: false},
+		Filter:         response.FilterSupport{Supported: true, MaxResults: utils.MaxPageSize},
+		ChangePassword: response.Supported{Supported: false},
+		Sort:           response.Supported{Supported: false},
+		ETag:           response.Supported{Supported: false},
+		AuthenticationSchemes: []response.AuthenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication with a SCIM token issued by a tenant administrator",
+		}},
+	})
+}
+
+func (h *SCIMHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query, err := request.NewListRequest(r.URL.Query()).ToUsersQuery()
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	appResult, err := messaging.ExecuteQuery[queries.ListSCIMUsersQuery, appDtos.ListSCIMUsersResult](
+		h.queryBus,
+		ctx,
+		query,
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	resources := make([]interface{}, 0, len(appResult.Users))
+	for _, user := range appResult.Users {
+		resources = append(resources, toSCIMUser(user))
+	}
+
+	h.respond(w, http.StatusOK, response.ListResponse{
+		Schemas:      []string{response.SchemaListResponse},
+		TotalResults: appResult.TotalResults,
+		StartIndex:   appResult.StartIndex,
+		ItemsPerPage: appResult.ItemsPerPage,
+		Resources:    resources,
+	})
+}
+
+func (h *SCIMHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.GetSCIMUserQuery, appDtos.SCIMUserResult](
+		h.queryBus,
+		ctx,
+		queries.GetSCIMUserQuery{UserID: mux.Vars(r)["id"]},
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	h.respond(w, http.StatusOK, toSCIMUser(appResult))
+}
+
+func (h *SCIMHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.UserRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	cmd := req.ToCreateCommand(scimTokenID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateSCIMUserCommand, appDtos.SCIMUserResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", scimUsersPath+appResult.ID)
+	h.respond(w, http.StatusCreated, toSCIMUser(appResult))
+}
+
+func (h *SCIMHandler) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.UserRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	cmd := req.ToReplaceCommand(scimTokenID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+	h.updateUser(ctx, w, cmd)
+}
+
+func (h *SCIMHandler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.PatchRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	cmd, err := req.ToUserCommand(scimTokenID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+	h.updateUser(ctx, w, cmd)
+}
+
+func (h *SCIMHandler) updateUser(ctx context.Context, w http.ResponseWriter, cmd commands.UpdateSCIMUserCommand) {
+	appResult, err := messaging.Execute[commands.UpdateSCIMUserCommand, appDtos.SCIMUserResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	h.respond(w, http.StatusOK, toSCIMUser(appResult))
+}
+
+// DeleteUser deactivates the account; SCIM deletes never erase user data.
+func (h *SCIMHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.DeleteSCIMUserCommand{
+		TokenID:   scimTokenID(r),
+		UserID:    mux.Vars(r)["id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	if _, err := messaging.Execute[commands.DeleteSCIMUserCommand, appDtos.SCIMUserResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	); err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SCIMHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query, err := request.NewListRequest(r.URL.Query()).ToGroupsQuery()
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	appResult, err := messaging.ExecuteQuery[queries.ListSCIMGroupsQuery, appDtos.ListSCIMGroupsResult](
+		h.queryBus,
+		ctx,
+		query,
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	resources := make([]interface{}, 0, len(appResult.Groups))
+	for _, group := range appResult.Groups {
+		resources = append(resources, toSCIMGroup(group))
+	}
+
+	h.respond(w, http.StatusOK, response.ListResponse{
+		Schemas:      []string{response.SchemaListResponse},
+		TotalResults: appResult.TotalResults,
+		StartIndex:   appResult.StartIndex,
+		ItemsPerPage: appResult.ItemsPerPage,
+		Resources:    resources,
+	})
+}
+
+func (h *SCIMHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.GetSCIMGroupQuery, appDtos.SCIMGroupResult](
+		h.queryBus,
+		ctx,
+		queries.GetSCIMGroupQuery{GroupID: mux.Vars(r)["id"]},
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	h.respond(w, http.StatusOK, toSCIMGroup(appResult))
+}
+
+func (h *SCIMHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.GroupRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	cmd := req.ToCreateCommand(scimTokenID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateSCIMGroupCommand, appDtos.SCIMGroupResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", scimGroupsPath+appResult.ID)
+	h.respond(w, http.StatusCreated, toSCIMGroup(appResult))
+}
+
+func (h *SCIMHandler) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.GroupRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	cmd := req.ToReplaceCommand(scimTokenID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+	h.updateGroup(ctx, w, cmd)
+}
+
+func (h *SCIMHandler) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req request.PatchRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalidSyntax", err.Error())
+		return
+	}
+
+	cmd, err := req.ToGroupCommand(scimTokenID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+	h.updateGroup(ctx, w, cmd)
+}
+
+func (h *SCIMHandler) updateGroup(ctx context.Context, w http.ResponseWriter, cmd commands.UpdateSCIMGroupCommand) {
+	appResult, err := messaging.Execute[commands.UpdateSCIMGroupCommand, appDtos.SCIMGroupResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	h.respond(w, http.StatusOK, toSCIMGroup(appResult))
+}
+
+func (h *SCIMHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.DeleteSCIMGroupCommand{
+		TokenID:   scimTokenID(r),
+		GroupID:   mux.Vars(r)["id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	if _, err := messaging.Execute[commands.DeleteSCIMGroupCommand, appDtos.SCIMGroupResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	); err != nil {
+		h.respondMappedError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decode accepts unknown attributes, since identity providers send schema
+// extensions this service does not store.
+func (h *SCIMHandler) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		h.respondError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request payload")
+		return false
+	}
+	return true
+}
+
+func (h *SCIMHandler) respond(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", response.ContentType)
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func (h *SCIMHandler) respondError(w http.ResponseWriter, statusCode int, scimType, detail string) {
+	h.respond(w, statusCode, response.Error{
+		Schemas:  []string{response.SchemaError},
+		Status:   strconv.Itoa(statusCode),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func (h *SCIMHandler) respondMappedError(ctx context.Context, w http.ResponseWriter, err error) {
+	statusCode, scimType, detail := h.mapErrorToHTTP(ctx, err)
+	h.respondError(w, statusCode, scimType, detail)
+}
+
+func (h *SCIMHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "", "Insufficient permissions"
+	case errors.Is(err, domain.ErrUserNotFound):
+		return http.StatusNotFound, "", "User not found"
+	case errors.Is(err, domain.ErrRoleNotFound):
+		return http.StatusNotFound, "", "Group not found"
+	case errors.Is(err, domain.ErrEmailAlreadyInUse),
+		errors.Is(err, domain.ErrUsernameAlreadyTaken),
+		errors.Is(err, domain.ErrRoleAlreadyExists):
+		return http.StatusConflict, "uniqueness", err.Error()
+	case errors.Is(err, domain.ErrRoleInUse):
+		return http.StatusConflict, "", "Group is still inherited by other roles"
+	case errors.Is(err, domain.ErrInvalidSCIMFilter):
+		return http.StatusBadRequest, "invalidFilter", err.Error()
+	case errors.Is(err, domain.ErrInvalidSCIMPatch):
+		return http.StatusBadRequest, "invalidSyntax", err.Error()
+	case errors.Is(err, domain.ErrSCIMGroupImmutable):
+		return http.StatusBadRequest, "mutability", err.Error()
+	case errors.Is(err, domain.ErrSCIMUserNameRequired),
+		errors.Is(err, domain.ErrEmptyUsername),
+		errors.Is(err, domain.ErrUsernameTooShort),
+		errors.Is(err, domain.ErrUsernameTooLong),
+		errors.Is(err, domain.ErrInvalidUsernameFormat),
+		errors.Is(err, domain.ErrEmptyEmail),
+		errors.Is(err, domain.ErrInvalidEmailFormat),
+		errors.Is(err, domain.ErrInvalidRoleName):
+		return http.StatusBadRequest, "invalidValue", err.Error()
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "", "An unexpected error occurred"
+	}
+}
+
+// scimTokenID is the id of the SCIM token that authenticated the request,
+// recorded as the source of provisioning changes.
+func scimTokenID(r *http.Request) string {
+	if claims, ok := middleware.ClaimsFromContext(r.Context()); ok {
+		return claims.TokenID
+	}
+	return ""
+}
+
+func toSCIMUser(user appDtos.SCIMUserResult) response.User {
+	result := response.User{
+		Schemas:  []string{response.SchemaUser},
+		ID:       user.ID,
+		UserName: user.UserName,
+		Name: response.Name{
+			GivenName:  user.GivenName,
+			FamilyName: user.FamilyName,
+		},
+		Active: user.Active,
+		Meta: response.Meta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+			Location:     scimUsersPath + user.ID,
+		},
+	}
+	if user.Email != "" {
+		result.Emails = []response.MultiValue{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	if user.Phone != "" {
+		result.PhoneNumbers = []response.MultiValue{{Value: user.Phone, Type: "mobile", Primary: true}}
+	}
+	return result
+}
+
+func toSCIMGroup(group appDtos.SCIMGroupResult) response.Group {
+	members := make([]response.Member, 0, len(group.Members))
+	for _, id := range group.Members {
+		members = append(members, response.Member{Value: id, Ref: scimUsersPath + id})
+	}
+
+	return response.Group{
+		Schemas:     []string{response.SchemaGroup},
+		ID:          group.ID,
+		DisplayName: group.DisplayName,
+		Members:     members,
+		Meta: response.Meta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+			Location:     scimGroupsPath + group.ID,
+		},
+	}
+}
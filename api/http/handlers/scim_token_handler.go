@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	adminRequest "authentication/api/http/dtos/admin/request"
+	adminResponse "authentication/api/http/dtos/admin/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type SCIMTokenHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewSCIMTokenHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *SCIMTokenHandler {
+	return &SCIMTokenHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "scim_token")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *SCIMTokenHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.CreateSCIMTokenRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateSCIMTokenCommand, appDtos.SCIMTokenSecretResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	h.respondSuccess(w, http.StatusCreated, "SCIM token created", adminResponse.SCIMTokenSecretResponse{
+		SCIMTokenResponse: toSCIMTokenResponse(appResult.SCIMTokenResult),
+		Token:             appResult.Token,
+	})
+}
+
+func (h *SCIMTokenHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.ListSCIMTokensQuery, appDtos.ListSCIMTokensResult](
+		h.queryBus,
+		ctx,
+		queries.ListSCIMTokensQuery{},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	tokens := make([]adminResponse.SCIMTokenResponse, 0, len(appResult.Tokens))
+	for _, token := range appResult.Tokens {
+		tokens = append(tokens, toSCIMTokenResponse(token))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "SCIM tokens retrieved", adminResponse.ListSCIMTokensResponse{
+		Tokens: tokens,
+	})
+}
+
+func (h *SCIMTokenHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.RevokeSCIMTokenCommand{
+		ActorID:   actorID(r),
+		TokenID:   mux.Vars(r)["id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.RevokeSCIMTokenCommand, appDtos.SCIMTokenResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "SCIM token revoked", toSCIMTokenResponse(appResult))
+}
+
+func (h *SCIMTokenHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *SCIMTokenHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *SCIMTokenHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrSCIMTokenNotFound):
+		return http.StatusNotFound, "SCIM token not found"
+	case errors.Is(err, domain.ErrSCIMTokenRevoked):
+		return http.StatusConflict, "SCIM token is already revoked"
+	case errors.Is(err, domain.ErrEmptySCIMTokenName):
+		return http.StatusBadRequest, "SCIM token name is required"
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toSCIMTokenResponse(token appDtos.SCIMTokenResult) adminResponse.SCIMTokenResponse {
+	return adminResponse.SCIMTokenResponse{
+		ID:            token.ID,
+		Name:          token.Name,
+		DisplayPrefix: token.DisplayPrefix,
+		CreatedBy:     token.CreatedBy,
+		LastUsedAt:    token.LastUsedAt,
+		RevokedAt:     token.RevokedAt,
+		CreatedAt:     token.CreatedAt,
+	}
+}
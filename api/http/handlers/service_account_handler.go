@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	apiDtos "authentication/api/http/dtos"
+	adminRequest "authentication/api/http/dtos/admin/request"
+	adminResponse "authentication/api/http/dtos/admin/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type ServiceAccountHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewServiceAccountHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *ServiceAccountHandler {
+	return &ServiceAccountHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "service_account")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *ServiceAccountHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.CreateServiceAccountRequest
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&req); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.CreateServiceAccountCommand, appDtos.ServiceAccountSecretResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusCreated, "Service account created. Store the client secret now; it will not be shown again.", toServiceAccountSecretResponse(appResult))
+}
+
+func (h *ServiceAccountHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, pageSize := utils.ParsePagination(r)
+
+	appResult, err := messaging.ExecuteQuery[queries.ListServiceAccountsQuery, appDtos.ListServiceAccountsResult](
+		h.queryBus,
+		ctx,
+		queries.ListServiceAccountsQuery{Page: page, PageSize: pageSize},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	accounts := make([]adminResponse.ServiceAccountResponse, 0, len(appResult.ServiceAccounts))
+	for _, account := range appResult.ServiceAccounts {
+		accounts = append(accounts, toServiceAccountResponse(account))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Service accounts retrieved", adminResponse.ListServiceAccountsResponse{
+		ServiceAccounts: accounts,
+		TotalCount:      appResult.TotalCount,
+		Page:            appResult.Page,
+		PageSize:        appResult.PageSize,
+	})
+}
+
+func (h *ServiceAccountHandler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.DeactivateServiceAccountCommand{
+		ActorID:          actorID(r),
+		ServiceAccountID: mux.Vars(r)["id"],
+		IPAddress:        utils.GetClientIP(r),
+		UserAgent:        r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.DeactivateServiceAccountCommand, appDtos.ServiceAccountResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Service account deactivated", toServiceAccountResponse(appResult))
+}
+
+func (h *ServiceAccountHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.RotateServiceAccountSecretCommand{
+		ActorID:          actorID(r),
+		ServiceAccountID: mux.Vars(r)["id"],
+		IPAddress:        utils.GetClientIP(r),
+		UserAgent:        r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.RotateServiceAccountSecretCommand, appDtos.ServiceAccountSecretResult](
+		h.commandBus,
+		ctx,
+		cmd,
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Client secret rotated. Store the new secret now; it will not be shown again.", toServiceAccountSecretResponse(appResult))
+}
+
+func (h *ServiceAccountHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *ServiceAccountHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *ServiceAccountHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrServiceAccountNotFound):
+		return http.StatusNotFound, "Service account not found"
+	case errors.Is(err, domain.ErrInvalidClient):
+		return http.StatusConflict, "Service account is inactive"
+	case errors.Is(err, domain.ErrEmptyServiceAccountName):
+		return http.StatusBadRequest, "Service account name is required"
+	case errors.Is(err, domain.ErrInvalidRole):
+		return http.StatusBadRequest, "Invalid role"
+	case errors.Is(err, domain.ErrRoleEscalation):
+		return http.StatusForbidden, domain.ErrRoleEscalation.Error()
+	case errors.Is(err, domain.ErrInvalidPublicKey):
+		return http.StatusBadRequest, domain.ErrInvalidPublicKey.Error()
+	case errors.Is(err, domain.ErrInvalidScope):
+		return http.StatusBadRequest, "Invalid scope"
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toServiceAccountResponse(account appDtos.ServiceAccountResult) adminResponse.ServiceAccountResponse {
+	return adminResponse.ServiceAccountResponse{
+		ID:                account.ID,
+		Name:              account.Name,
+		ClientID:          account.ClientID,
+		Role:              account.Role,
+		Scopes:            account.Scopes,
+		UsesPrivateKeyJWT: account.UsesPrivateKeyJWT,
+		IsActive:          account.IsActive,
+		CreatedAt:         account.CreatedAt,
+	}
+}
+
+func toServiceAccountSecretResponse(result appDtos.ServiceAccountSecretResult) adminResponse.ServiceAccountSecretResponse {
+	return adminResponse.ServiceAccountSecretResponse{
+		ServiceAccountResponse: toServiceAccountResponse(result.ServiceAccountResult),
+		ClientSecret:           result.ClientSecret,
+	}
+}
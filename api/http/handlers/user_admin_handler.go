@@ -0,0 +1,366 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	apiDtos "authentication/api/http/dtos"
+	adminDtos "authentication/api/http/dtos/admin"
+	adminRequest "authentication/api/http/dtos/admin/request"
+	adminResponse "authentication/api/http/dtos/admin/response"
+	"authentication/internal/application/commands"
+	appDtos "authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type UserAdminHandler struct {
+	commandBus *messaging.CommandBus
+	queryBus   *messaging.QueryBus
+	logger     logging.Logger
+	validator  *validator.Validate
+}
+
+func NewUserAdminHandler(
+	commandBus *messaging.CommandBus,
+	queryBus *messaging.QueryBus,
+	logger logging.Logger,
+) *UserAdminHandler {
+	return &UserAdminHandler{
+		commandBus: commandBus,
+		queryBus:   queryBus,
+		logger:     logger.With(zap.String("handler", "user_admin")),
+		validator:  utils.NewValidator(),
+	}
+}
+
+func (h *UserAdminHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	values := r.URL.Query()
+
+	page, pageSize := utils.ParsePagination(r)
+	req := adminRequest.ListUsersRequest{
+		Page:      page,
+		PageSize:  pageSize,
+		Role:      values.Get("role"),
+		Search:    values.Get("search"),
+		SortBy:    values.Get("sort_by"),
+		SortOrder: values.Get("sort_order"),
+	}
+	if raw := values.Get("is_active"); raw != "" {
+		isActive, err := strconv.ParseBool(raw)
+		if err != nil {
+			h.respondError(w, http.StatusBadRequest, "is_active must be true or false")
+			return
+		}
+		req.IsActive = &isActive
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	appResult, err := messaging.ExecuteQuery[queries.ListUsersQuery, appDtos.ListUsersResult](h.queryBus, ctx, req.ToQuery())
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	users := make([]adminDtos.UserDTO, 0, len(appResult.Users))
+	for _, user := range appResult.Users {
+		users = append(users, toUserDTO(user))
+	}
+
+	h.respondSuccess(w, http.StatusOK, "Users retrieved", adminResponse.ListUsersResponse{
+		Users:      users,
+		TotalCount: appResult.TotalCount,
+		Page:       appResult.Page,
+		PageSize:   appResult.PageSize,
+	})
+}
+
+func (h *UserAdminHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	appResult, err := messaging.ExecuteQuery[queries.GetUserQuery, appDtos.UserResult](
+		h.queryBus,
+		ctx,
+		queries.GetUserQuery{UserID: mux.Vars(r)["id"]},
+	)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "User retrieved", toUserDTO(appResult))
+}
+
+func (h *UserAdminHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.UpdateUserRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.UpdateUserCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "User updated", toUserDTO(appResult))
+}
+
+func (h *UserAdminHandler) ChangeRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.ChangeUserRoleRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.ChangeUserRoleCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "User role changed", toUserDTO(appResult))
+}
+
+func (h *UserAdminHandler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.DeactivateUserCommand{
+		ActorID:   actorID(r),
+		UserID:    mux.Vars(r)["id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.DeactivateUserCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "User deactivated", toUserDTO(appResult))
+}
+
+func (h *UserAdminHandler) Activate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.ActivateUserCommand{
+		ActorID:   actorID(r),
+		UserID:    mux.Vars(r)["id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.ActivateUserCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "User activated", toUserDTO(appResult))
+}
+
+func (h *UserAdminHandler) RevokeSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmd := commands.RevokeUserSessionsCommand{
+		ActorID:   actorID(r),
+		UserID:    mux.Vars(r)["id"],
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.RevokeUserSessionsCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "User sessions revoked", toUserDTO(appResult))
+}
+
+// Delete soft deletes the user; ?hard=true removes the record for good.
+// Impersonate starts a short-lived session acting as the user. The
+// command checks users:impersonate itself.
+func (h *UserAdminHandler) Impersonate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req adminRequest.StartImpersonationRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	if err := req.Validate(h.validator); err != nil {
+		h.respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cmd := req.ToCommand(actorID(r), mux.Vars(r)["id"], utils.GetClientIP(r), r.UserAgent())
+
+	appResult, err := messaging.Execute[commands.StartImpersonationCommand, appDtos.ImpersonationResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	h.respondSuccess(w, http.StatusCreated, "Impersonation session started", adminResponse.ImpersonationResponse{
+		UserID:      appResult.UserID,
+		Email:       appResult.Email,
+		Username:    appResult.Username,
+		ActorID:     appResult.ActorID,
+		SessionID:   appResult.SessionID,
+		AccessToken: appResult.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresAt:   appResult.ExpiresAt,
+		ExpiresIn:   appResult.ExpiresIn,
+	})
+}
+
+func (h *UserAdminHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	hard := false
+	if raw := r.URL.Query().Get("hard"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			h.respondError(w, http.StatusBadRequest, "hard must be true or false")
+			return
+		}
+		hard = parsed
+	}
+
+	cmd := commands.DeleteUserCommand{
+		ActorID:   actorID(r),
+		UserID:    mux.Vars(r)["id"],
+		Hard:      hard,
+		IPAddress: utils.GetClientIP(r),
+		UserAgent: r.UserAgent(),
+	}
+
+	appResult, err := messaging.Execute[commands.DeleteUserCommand, appDtos.UserResult](h.commandBus, ctx, cmd)
+	if err != nil {
+		statusCode, message := h.mapErrorToHTTP(ctx, err)
+		h.respondError(w, statusCode, message)
+		return
+	}
+
+	h.respondSuccess(w, http.StatusOK, "User deleted", adminResponse.DeleteUserResponse{ID: appResult.ID, Hard: hard})
+}
+
+func (h *UserAdminHandler) decode(w http.ResponseWriter, r *http.Request, dest interface{}) bool {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dest); err != nil {
+		h.respondError(w, http.StatusBadRequest, "Invalid request payload")
+		return false
+	}
+	return true
+}
+
+func (h *UserAdminHandler) respondSuccess(w http.ResponseWriter, statusCode int, message string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    data,
+	})
+}
+
+func (h *UserAdminHandler) respondError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
+
+func (h *UserAdminHandler) mapErrorToHTTP(ctx context.Context, err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return http.StatusForbidden, "Insufficient permissions"
+	case errors.Is(err, domain.ErrUserNotFound):
+		return http.StatusNotFound, "User not found"
+	case errors.Is(err, domain.ErrRoleNotFound):
+		return http.StatusNotFound, "Role not found"
+	case errors.Is(err, domain.ErrSelfAdministration):
+		return http.StatusConflict, domain.ErrSelfAdministration.Error()
+	case errors.Is(err, domain.ErrCannotImpersonateSelf),
+		errors.Is(err, domain.ErrCannotImpersonatePrivileged),
+		errors.Is(err, domain.ErrInactiveUser):
+		return http.StatusConflict, err.Error()
+	case errors.Is(err, domain.ErrUserVersionConflict):
+		return http.StatusConflict, domain.ErrUserVersionConflict.Error()
+	case errors.Is(err, domain.ErrInvalidRoleName),
+		errors.Is(err, domain.ErrImpersonationReasonRequired),
+		errors.Is(err, domain.ErrInvalidPhoneFormat),
+		errors.Is(err, domain.ErrEmptyPhoneNumber):
+		return http.StatusBadRequest, err.Error()
+	default:
+		h.logger.Error(ctx, "Unexpected error", zap.Error(err))
+		return http.StatusInternalServerError, "An unexpected error occurred"
+	}
+}
+
+func toUserDTO(user appDtos.UserResult) adminDtos.UserDTO {
+	return adminDtos.UserDTO{
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Phone:         user.Phone,
+		PhoneVerified: user.PhoneVerified,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Role:          user.Role,
+		Provider:      user.Provider,
+		IsActive:      user.IsActive,
+		IsVerified:    user.IsVerified,
+		LastLoginAt:   user.LastLoginAt,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+	}
+}
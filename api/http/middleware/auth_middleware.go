@@ -1 +1,227 @@
-package middleware
\ No newline at end of file
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	apiDtos "authentication/api/http/dtos"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type contextKey string
+
+const claimsContextKey contextKey = "auth_claims"
+
+type AuthMiddleware struct {
+	tokenService   services.TokenService
+	authorizer     services.Authorizer
+	tenantResolver services.TenantResolver
+	logger         logging.Logger
+}
+
+func NewAuthMiddleware(
+	tokenService services.TokenService,
+	authorizer services.Authorizer,
+	tenantResolver services.TenantResolver,
+	logger logging.Logger,
+) *AuthMiddleware {
+	return &AuthMiddleware{
+		tokenService:   tokenService,
+		authorizer:     authorizer,
+		tenantResolver: tenantResolver,
+		logger:         logger.With(zap.String("middleware", "auth")),
+	}
+}
+
+// Authenticate verifies the bearer access token or personal access token and
+// stores its claims on the request context.
+func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := utils.WithClientIP(r.Context(), utils.GetClientIP(r))
+
+		token := bearerToken(r)
+		if token == "" {
+			respondUnauthorized(w, "Missing bearer token")
+			return
+		}
+
+		claims, err := m.tokenService.VerifyAccess(ctx, token)
+		if err != nil {
+			m.logger.Warn(ctx, "Access token rejected", zap.Error(err))
+			respondUnauthorized(w, "Invalid or expired token")
+			return
+		}
+
+		ctx, err = m.bindTokenTenant(ctx, claims)
+		if err != nil {
+			m.logger.Warn(ctx, "Token tenant rejected",
+				zap.String("token_tenant", claims.TenantID),
+				zap.Error(err),
+			)
+			respondUnauthorized(w, "Token is not valid for this tenant")
+			return
+		}
+
+		ctx = services.WithSubject(ctx, services.Subject{
+			UserID:      claims.UserID,
+			Role:        claims.Role,
+			ClientID:    claims.ClientID,
+			TokenType:   claims.TokenType,
+			Scopes:      claims.Scopes,
+			OrgID:       claims.OrgID,
+			OrgRole:     claims.OrgRole,
+			ActorID:     claims.ActorID,
+			AuthTime:    claims.AuthTime,
+			AuthMethods: claims.AuthMethods,
+			AuthLevel:   claims.AuthLevel,
+		})
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, claimsContextKey, claims)))
+	})
+}
+
+// bindTokenTenant reconciles the token's tenant claim with the tenant resolved
+// from the request. A token may pick its tenant when the request only fell
+// back to the default, but never override a host or header naming another.
+func (m *AuthMiddleware) bindTokenTenant(ctx context.Context, claims *services.TokenClaims) (context.Context, error) {
+	current, _ := utils.TenantIDFromContext(ctx)
+	if claims.TenantID == "" || claims.TenantID == current {
+		return ctx, nil
+	}
+
+	if tenantSourceFromContext(ctx) != services.TenantSourceDefault {
+		return ctx, domain.ErrTenantMismatch
+	}
+
+	tenant, err := m.tenantResolver.ResolveByID(ctx, claims.TenantID)
+	if err != nil {
+		return ctx, err
+	}
+	return utils.WithTenantID(ctx, tenant.ID), nil
+}
+
+// RequireRole must run after Authenticate.
+func (m *AuthMiddleware) RequireRole(role valueobjects.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := ClaimsFromContext(r.Context())
+			if !ok {
+				respondUnauthorized(w, "Authentication required")
+				return
+			}
+
+			if !valueobjects.Role(strings.ToUpper(claims.Role)).HasPermission(role) {
+				respondJSON(w, http.StatusForbidden, "Insufficient permissions")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequirePermission checks the caller against the authorizer. Service
+// account tokens are checked against the role they carry, since they have no
+// user record. Personal access and service tokens must also have been
+// granted the permission as a scope. It must run after Authenticate.
+func (m *AuthMiddleware) RequirePermission(permission valueobjects.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			claims, ok := ClaimsFromContext(ctx)
+			if !ok {
+				respondUnauthorized(w, "Authentication required")
+				return
+			}
+
+			scoped := claims.TokenType == string(valueobjects.TokenTypePersonalAccess) ||
+				claims.TokenType == string(valueobjects.TokenTypeService)
+			if scoped && !valueobjects.ScopesGrant(claims.Scopes, permission) {
+				respondJSON(w, http.StatusForbidden, "Token scopes do not allow this operation")
+				return
+			}
+
+			// Impersonation sessions never use the permissions of the user they
+			// act as; see middleware.PermissionMiddleware on the command bus.
+			if claims.ActorID != "" {
+				respondJSON(w, http.StatusForbidden, "Not allowed while impersonating a user")
+				return
+			}
+
+			var err error
+			if claims.TokenType == string(valueobjects.TokenTypeService) {
+				role := valueobjects.Role(strings.ToUpper(claims.Role))
+				err = m.authorizer.AuthorizeRoles(ctx, []valueobjects.Role{role}, permission)
+			} else {
+				err = m.authorizer.Authorize(ctx, claims.UserID, permission)
+			}
+
+			if err != nil {
+				if !errors.Is(err, domain.ErrPermissionDenied) {
+					m.logger.Error(ctx, "Permission check failed", zap.Error(err))
+				}
+				respondJSON(w, http.StatusForbidden, "Insufficient permissions")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireUser rejects service account tokens on endpoints that act on behalf
+// of a person. It must run after Authenticate.
+func (m *AuthMiddleware) RequireUser(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := ClaimsFromContext(r.Context())
+		if !ok {
+			respondUnauthorized(w, "Authentication required")
+			return
+		}
+
+		if claims.TokenType == string(valueobjects.TokenTypeService) {
+			respondJSON(w, http.StatusForbidden, "Service tokens are not permitted on this endpoint")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func ClaimsFromContext(ctx context.Context) (*services.TokenClaims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(*services.TokenClaims)
+	return claims, ok && claims != nil
+}
+
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(header[7:])
+}
+
+func respondUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	respondJSON(w, http.StatusUnauthorized, message)
+}
+
+func respondJSON(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(apiDtos.ApiResponse[interface{}]{
+		Code:    statusCode,
+		Message: message,
+		Data:    nil,
+	})
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+
+	"authentication/shared/utils"
+)
+
+type ClientIPMiddleware struct {
+	resolver *utils.ClientIPResolver
+}
+
+func NewClientIPMiddleware(resolver *utils.ClientIPResolver) *ClientIPMiddleware {
+	return &ClientIPMiddleware{resolver: resolver}
+}
+
+// Resolve stores the caller's address on the request context, where
+// utils.GetClientIP and ClientIPFromContext read it. It must wrap the root
+// router so rate limits, allowlists and audit entries all see the same
+// address.
+func (m *ClientIPMiddleware) Resolve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := utils.WithClientIP(r.Context(), m.resolver.Resolve(r))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	scimResponse "authentication/api/http/dtos/scim/response"
+	"authentication/internal/application/contracts/services"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+// SCIMMiddleware authenticates SCIM clients. It accepts only SCIM tokens,
+// and the access tokens AuthMiddleware accepts are rejected here.
+type SCIMMiddleware struct {
+	verifier services.SCIMTokenVerifier
+	logger   logging.Logger
+}
+
+func NewSCIMMiddleware(verifier services.SCIMTokenVerifier, logger logging.Logger) *SCIMMiddleware {
+	return &SCIMMiddleware{
+		verifier: verifier,
+		logger:   logger.With(zap.String("middleware", "scim")),
+	}
+}
+
+// Authenticate must run after the tenant middleware, since tokens are looked
+// up in the tenant the request resolved to.
+func (m *SCIMMiddleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := utils.WithClientIP(r.Context(), utils.GetClientIP(r))
+
+		token := bearerToken(r)
+		if token == "" {
+			respondSCIMUnauthorized(w, "Missing bearer token")
+			return
+		}
+
+		claims, err := m.verifier.Verify(ctx, token)
+		if err != nil {
+			m.logger.Warn(ctx, "SCIM token rejected", zap.Error(err))
+			respondSCIMUnauthorized(w, "Invalid or revoked token")
+			return
+		}
+
+		ctx = services.WithSubject(ctx, services.Subject{
+			ClientID:  claims.ClientID,
+			TokenType: claims.TokenType,
+		})
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, claimsContextKey, claims)))
+	})
+}
+
+func respondSCIMUnauthorized(w http.ResponseWriter, detail string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+	w.Header().Set("Content-Type", scimResponse.ContentType)
+	w.WriteHeader(http.StatusUnauthorized)
+	json.NewEncoder(w).Encode(scimResponse.Error{
+		Schemas: []string{scimResponse.SchemaError},
+		Status:  strconv.Itoa(http.StatusUnauthorized),
+		Detail:  detail,
+	})
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+const tenantSourceContextKey contextKey = "tenant_source"
+
+type TenantMiddleware struct {
+	resolver services.TenantResolver
+	header   string
+	logger   logging.Logger
+}
+
+func NewTenantMiddleware(
+	resolver services.TenantResolver,
+	cfg config.TenancyConfig,
+	logger logging.Logger,
+) *TenantMiddleware {
+	return &TenantMiddleware{
+		resolver: resolver,
+		header:   cfg.Header,
+		logger:   logger.With(zap.String("middleware", "tenant")),
+	}
+}
+
+// Resolve binds the request to a tenant before any handler or repository
+// runs. It must wrap the root router; Authenticate relies on it.
+func (m *TenantMiddleware) Resolve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		var header string
+		if m.header != "" {
+			header = r.Header.Get(m.header)
+		}
+
+		tenant, err := m.resolver.Resolve(ctx, r.Host, header)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrTenantNotFound), errors.Is(err, domain.ErrTenantRequired):
+				respondJSON(w, http.StatusNotFound, "Unknown tenant")
+			case errors.Is(err, domain.ErrTenantInactive):
+				respondJSON(w, http.StatusForbidden, "Tenant is inactive")
+			default:
+				m.logger.Error(ctx, "Tenant resolution failed", zap.String("host", r.Host), zap.Error(err))
+				respondJSON(w, http.StatusInternalServerError, "Internal server error")
+			}
+			return
+		}
+
+		ctx = utils.WithTenantID(ctx, tenant.ID)
+		ctx = context.WithValue(ctx, tenantSourceContextKey, tenant.Source)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func tenantSourceFromContext(ctx context.Context) services.TenantSource {
+	source, _ := ctx.Value(tenantSourceContextKey).(services.TenantSource)
+	return source
+}
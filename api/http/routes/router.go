@@ -4,12 +4,28 @@ import (
 	"net/http"
 
 	"authentication/api/http/handlers"
+	"authentication/api/http/middleware"
 	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	appMessaging "authentication/internal/application/messaging"
+	"authentication/internal/domain/valueobjects"
 	"authentication/shared/logging"
 
 	"github.com/gorilla/mux"
 )
 
+// SetupClientIP must be called first so every later middleware, including
+// tenant resolution, sees the caller's real address.
+func SetupClientIP(router *mux.Router, clientIPMiddleware *middleware.ClientIPMiddleware) {
+	router.Use(clientIPMiddleware.Resolve)
+}
+
+// SetupTenantResolution must be called before the route Setup functions so
+// every route, including the public ones, runs inside a resolved tenant.
+func SetupTenantResolution(router *mux.Router, tenantMiddleware *middleware.TenantMiddleware) {
+	router.Use(tenantMiddleware.Resolve)
+}
+
 func SetupAuthRoutes(
 	router *mux.Router,
 	commandBus messaging.CommandBus,
@@ -36,4 +52,273 @@ func SetupAuthRoutes(
 	// authRouter.HandleFunc("/verify-email", authHandler.VerifyEmail).Methods(http.MethodPost)
 	// authRouter.HandleFunc("/forgot-password", authHandler.ForgotPassword).Methods(http.MethodPost)
 	// authRouter.HandleFunc("/reset-password", authHandler.ResetPassword).Methods(http.MethodPost)
-}
\ No newline at end of file
+}
+
+// SetupChallengeRoutes serves the challenges login and registration
+// require after repeated failed logins. It is public: clients fetch a
+// challenge before they can sign in.
+func SetupChallengeRoutes(
+	router *mux.Router,
+	queryBus *appMessaging.QueryBus,
+	logger logging.Logger,
+) {
+	challengeHandler := handlers.NewChallengeHandler(queryBus, logger)
+
+	router.HandleFunc("/api/v1/auth/challenge", challengeHandler.Get).Methods(http.MethodGet)
+}
+
+func SetupAdminRoutes(
+	router *mux.Router,
+	commandBus *appMessaging.CommandBus,
+	queryBus *appMessaging.QueryBus,
+	authMiddleware *middleware.AuthMiddleware,
+	logger logging.Logger,
+) {
+	oauthClientHandler := handlers.NewOAuthClientHandler(commandBus, queryBus, logger)
+	apiKeyHandler := handlers.NewAPIKeyHandler(commandBus, queryBus, logger)
+	serviceAccountHandler := handlers.NewServiceAccountHandler(commandBus, queryBus, logger)
+	roleHandler := handlers.NewRoleHandler(commandBus, queryBus, logger)
+	samlHandler := handlers.NewSAMLHandler(commandBus, queryBus, logger)
+	scimTokenHandler := handlers.NewSCIMTokenHandler(commandBus, queryBus, logger)
+	userAdminHandler := handlers.NewUserAdminHandler(commandBus, queryBus, logger)
+	auditHandler := handlers.NewAuditHandler(queryBus, logger)
+
+	// Admin subrouter. Each area below requires its own permission, so
+	// custom roles can be granted parts of the admin API.
+	adminRouter := router.PathPrefix("/api/v1/admin").Subrouter()
+	adminRouter.Use(authMiddleware.Authenticate, authMiddleware.RequireUser)
+
+	// OAuth client registry
+	oauthClientRouter := adminRouter.PathPrefix("/oauth/clients").Subrouter()
+	oauthClientRouter.Use(authMiddleware.RequirePermission(valueobjects.PermissionOAuthClientsManage))
+	oauthClientRouter.HandleFunc("", oauthClientHandler.RegisterClient).Methods(http.MethodPost)
+	oauthClientRouter.HandleFunc("", oauthClientHandler.ListClients).Methods(http.MethodGet)
+	oauthClientRouter.HandleFunc("/{client_id}", oauthClientHandler.UpdateClient).Methods(http.MethodPatch)
+	oauthClientRouter.HandleFunc("/{client_id}", oauthClientHandler.DeactivateClient).Methods(http.MethodDelete)
+	oauthClientRouter.HandleFunc("/{client_id}/rotate-secret", oauthClientHandler.RotateSecret).Methods(http.MethodPost)
+
+	// API keys across all users
+	apiKeyAdminRouter := adminRouter.PathPrefix("/api-keys").Subrouter()
+	apiKeyAdminRouter.Use(authMiddleware.RequirePermission(valueobjects.PermissionAPIKeysManage))
+	apiKeyAdminRouter.HandleFunc("", apiKeyHandler.AdminList).Methods(http.MethodGet)
+	apiKeyAdminRouter.HandleFunc("/{id}", apiKeyHandler.AdminRevoke).Methods(http.MethodDelete)
+
+	// Service accounts
+	serviceAccountRouter := adminRouter.PathPrefix("/service-accounts").Subrouter()
+	serviceAccountRouter.Use(authMiddleware.RequirePermission(valueobjects.PermissionServiceAccountsManage))
+	serviceAccountRouter.HandleFunc("", serviceAccountHandler.Create).Methods(http.MethodPost)
+	serviceAccountRouter.HandleFunc("", serviceAccountHandler.List).Methods(http.MethodGet)
+	serviceAccountRouter.HandleFunc("/{id}", serviceAccountHandler.Deactivate).Methods(http.MethodDelete)
+	serviceAccountRouter.HandleFunc("/{id}/rotate-secret", serviceAccountHandler.RotateSecret).Methods(http.MethodPost)
+
+	// SAML identity provider connections
+	samlConnectionRouter := adminRouter.PathPrefix("/sso/saml/connections").Subrouter()
+	samlConnectionRouter.Use(authMiddleware.RequirePermission(valueobjects.PermissionSSOManage))
+	samlConnectionRouter.HandleFunc("", samlHandler.CreateConnection).Methods(http.MethodPost)
+	samlConnectionRouter.HandleFunc("", samlHandler.ListConnections).Methods(http.MethodGet)
+	samlConnectionRouter.HandleFunc("/{id}", samlHandler.UpdateConnection).Methods(http.MethodPatch)
+
+	// SCIM provisioning tokens
+	scimTokenRouter := adminRouter.PathPrefix("/scim/tokens").Subrouter()
+	scimTokenRouter.Use(authMiddleware.RequirePermission(valueobjects.PermissionSCIMManage))
+	scimTokenRouter.HandleFunc("", scimTokenHandler.Create).Methods(http.MethodPost)
+	scimTokenRouter.HandleFunc("", scimTokenHandler.List).Methods(http.MethodGet)
+	scimTokenRouter.HandleFunc("/{id}", scimTokenHandler.Revoke).Methods(http.MethodDelete)
+
+	// Roles and role assignments. The handlers check roles:read and
+	// roles:manage themselves.
+	adminRouter.HandleFunc("/roles", roleHandler.Create).Methods(http.MethodPost)
+	adminRouter.HandleFunc("/roles", roleHandler.List).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/roles/{name}", roleHandler.Update).Methods(http.MethodPut)
+	adminRouter.HandleFunc("/roles/{name}", roleHandler.Delete).Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/users/{id}/permissions", roleHandler.UserPermissions).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/users/{id}/roles", roleHandler.Assign).Methods(http.MethodPost)
+	adminRouter.HandleFunc("/users/{id}/roles/{role}", roleHandler.Unassign).Methods(http.MethodDelete)
+
+	// User management. Reads need users:read; the commands declare
+	// users:write, users:delete, users:impersonate and roles:manage, which
+	// the command bus enforces.
+	requireUsersRead := authMiddleware.RequirePermission(valueobjects.PermissionUsersRead)
+	adminRouter.Handle("/users", requireUsersRead(http.HandlerFunc(userAdminHandler.List))).Methods(http.MethodGet)
+	adminRouter.Handle("/users/{id}", requireUsersRead(http.HandlerFunc(userAdminHandler.Get))).Methods(http.MethodGet)
+	adminRouter.HandleFunc("/users/{id}", userAdminHandler.Update).Methods(http.MethodPatch)
+	adminRouter.HandleFunc("/users/{id}", userAdminHandler.Delete).Methods(http.MethodDelete)
+	adminRouter.HandleFunc("/users/{id}/role", userAdminHandler.ChangeRole).Methods(http.MethodPut)
+	adminRouter.HandleFunc("/users/{id}/deactivate", userAdminHandler.Deactivate).Methods(http.MethodPost)
+	adminRouter.HandleFunc("/users/{id}/activate", userAdminHandler.Activate).Methods(http.MethodPost)
+	adminRouter.HandleFunc("/users/{id}/revoke-sessions", userAdminHandler.RevokeSessions).Methods(http.MethodPost)
+	adminRouter.HandleFunc("/users/{id}/impersonate", userAdminHandler.Impersonate).Methods(http.MethodPost)
+
+	// Audit trail. Full-text search is granted separately from filtered
+	// reads since it reaches into metadata.
+	requireAuditRead := authMiddleware.RequirePermission(valueobjects.PermissionAuditRead)
+	requireAuditSearch := authMiddleware.RequirePermission(valueobjects.PermissionAuditSearch)
+	adminRouter.Handle("/audit-logs", requireAuditRead(http.HandlerFunc(auditHandler.List))).Methods(http.MethodGet)
+	adminRouter.Handle("/audit-logs/search", requireAuditSearch(http.HandlerFunc(auditHandler.Search))).Methods(http.MethodGet)
+}
+
+func SetupAPIKeyRoutes(
+	router *mux.Router,
+	commandBus *appMessaging.CommandBus,
+	queryBus *appMessaging.QueryBus,
+	authMiddleware *middleware.AuthMiddleware,
+	logger logging.Logger,
+) {
+	apiKeyHandler := handlers.NewAPIKeyHandler(commandBus, queryBus, logger)
+
+	// Personal access tokens, scoped to the signed-in user
+	apiKeyRouter := router.PathPrefix("/api/v1/api-keys").Subrouter()
+	apiKeyRouter.Use(authMiddleware.Authenticate, authMiddleware.RequireUser)
+
+	apiKeyRouter.HandleFunc("", apiKeyHandler.Create).Methods(http.MethodPost)
+	apiKeyRouter.HandleFunc("", apiKeyHandler.List).Methods(http.MethodGet)
+	apiKeyRouter.HandleFunc("/{id}", apiKeyHandler.Revoke).Methods(http.MethodDelete)
+}
+
+func SetupOAuthRoutes(
+	router *mux.Router,
+	commandBus *appMessaging.CommandBus,
+	queryBus *appMessaging.QueryBus,
+	authMiddleware *middleware.AuthMiddleware,
+	logger logging.Logger,
+) {
+	oauthTokenHandler := handlers.NewOAuthTokenHandler(commandBus, queryBus, logger)
+	deviceHandler := handlers.NewDeviceHandler(commandBus, logger)
+
+	// OAuth subrouter, endpoints authenticate the calling client themselves
+	oauthRouter := router.PathPrefix("/oauth").Subrouter()
+
+	oauthRouter.HandleFunc("/token", oauthTokenHandler.Token).Methods(http.MethodPost)
+	oauthRouter.HandleFunc("/device_authorization", oauthTokenHandler.DeviceAuthorization).Methods(http.MethodPost)
+	oauthRouter.HandleFunc("/introspect", oauthTokenHandler.Introspect).Methods(http.MethodPost)
+	oauthRouter.HandleFunc("/revoke", oauthTokenHandler.Revoke).Methods(http.MethodPost)
+
+	// Device verification runs inside the signed-in user's session
+	deviceRouter := router.PathPrefix("/api/v1/auth/device").Subrouter()
+	deviceRouter.Use(authMiddleware.Authenticate, authMiddleware.RequireUser)
+	deviceRouter.HandleFunc("/verify", deviceHandler.Verify).Methods(http.MethodPost)
+}
+
+func SetupOrganizationRoutes(
+	router *mux.Router,
+	commandBus *appMessaging.CommandBus,
+	queryBus *appMessaging.QueryBus,
+	authMiddleware *middleware.AuthMiddleware,
+	logger logging.Logger,
+) {
+	orgHandler := handlers.NewOrganizationHandler(commandBus, queryBus, logger)
+
+	// Organization endpoints, membership is checked by the handlers
+	orgRouter := router.PathPrefix("/api/v1/organizations").Subrouter()
+	orgRouter.Use(authMiddleware.Authenticate, authMiddleware.RequireUser)
+
+	orgRouter.HandleFunc("", orgHandler.Create).Methods(http.MethodPost)
+	orgRouter.HandleFunc("", orgHandler.List).Methods(http.MethodGet)
+	orgRouter.HandleFunc("/{id}/members", orgHandler.ListMembers).Methods(http.MethodGet)
+	orgRouter.HandleFunc("/{id}/members/{userId}", orgHandler.RemoveMember).Methods(http.MethodDelete)
+	orgRouter.HandleFunc("/{id}/invitations", orgHandler.Invite).Methods(http.MethodPost)
+	orgRouter.HandleFunc("/{id}/transfer-ownership", orgHandler.TransferOwnership).Methods(http.MethodPost)
+	orgRouter.HandleFunc("/{id}/token", orgHandler.Switch).Methods(http.MethodPost)
+
+	// Invitations are accepted by the invited user after signing in
+	invitationRouter := router.PathPrefix("/api/v1/invitations").Subrouter()
+	invitationRouter.Use(authMiddleware.Authenticate, authMiddleware.RequireUser)
+	invitationRouter.HandleFunc("/accept", orgHandler.AcceptInvitation).Methods(http.MethodPost)
+}
+
+func SetupProfileRoutes(
+	router *mux.Router,
+	commandBus *appMessaging.CommandBus,
+	queryBus *appMessaging.QueryBus,
+	authMiddleware *middleware.AuthMiddleware,
+	logger logging.Logger,
+) {
+	profileHandler := handlers.NewProfileHandler(commandBus, queryBus, logger)
+
+	// The signed-in user's own profile
+	meRouter := router.PathPrefix("/api/v1/me").Subrouter()
+	meRouter.Use(authMiddleware.Authenticate, authMiddleware.RequireUser)
+
+	meRouter.HandleFunc("", profileHandler.Get).Methods(http.MethodGet)
+	meRouter.HandleFunc("", profileHandler.Update).Methods(http.MethodPatch)
+	meRouter.HandleFunc("", profileHandler.DeleteAccount).Methods(http.MethodDelete)
+	meRouter.HandleFunc("/reauthenticate", profileHandler.Reauthenticate).Methods(http.MethodPost)
+	meRouter.HandleFunc("/export", profileHandler.ExportData).Methods(http.MethodGet)
+	meRouter.HandleFunc("/email", profileHandler.RequestEmailChange).Methods(http.MethodPost)
+	meRouter.HandleFunc("/email/confirm", profileHandler.ConfirmEmailChange).Methods(http.MethodPost)
+	meRouter.HandleFunc("/phone/verification", profileHandler.SendPhoneVerification).Methods(http.MethodPost)
+	meRouter.HandleFunc("/phone/verify", profileHandler.VerifyPhone).Methods(http.MethodPost)
+	meRouter.HandleFunc("/trusted-devices", profileHandler.ListTrustedDevices).Methods(http.MethodGet)
+	meRouter.HandleFunc("/trusted-devices/{id}", profileHandler.RevokeTrustedDevice).Methods(http.MethodDelete)
+
+	// Opened from a new sign-in email, possibly after the account was taken
+	// over, so no session is required
+	router.HandleFunc("/api/v1/sign-in-alerts/report", profileHandler.ReportSignIn).Methods(http.MethodPost)
+}
+
+func SetupSAMLRoutes(
+	router *mux.Router,
+	commandBus *appMessaging.CommandBus,
+	queryBus *appMessaging.QueryBus,
+	logger logging.Logger,
+) {
+	samlHandler := handlers.NewSAMLHandler(commandBus, queryBus, logger)
+
+	// SAML service provider endpoints, called by browsers and identity
+	// providers before any session exists
+	samlRouter := router.PathPrefix("/api/v1/saml/{id}").Subrouter()
+
+	samlRouter.HandleFunc("/metadata", samlHandler.Metadata).Methods(http.MethodGet)
+	samlRouter.HandleFunc("/login", samlHandler.Login).Methods(http.MethodGet)
+	samlRouter.HandleFunc("/acs", samlHandler.AssertionConsumer).Methods(http.MethodPost)
+}
+
+func SetupSCIMRoutes(
+	router *mux.Router,
+	commandBus *appMessaging.CommandBus,
+	queryBus *appMessaging.QueryBus,
+	scimMiddleware *middleware.SCIMMiddleware,
+	logger logging.Logger,
+) {
+	scimHandler := handlers.NewSCIMHandler(commandBus, queryBus, logger)
+
+	// SCIM 2.0 service provider, authenticated with SCIM tokens only
+	scimRouter := router.PathPrefix("/scim/v2").Subrouter()
+	scimRouter.Use(scimMiddleware.Authenticate)
+
+	scimRouter.HandleFunc("/ServiceProviderConfig", scimHandler.ServiceProviderConfig).Methods(http.MethodGet)
+
+	scimRouter.HandleFunc("/Users", scimHandler.ListUsers).Methods(http.MethodGet)
+	scimRouter.HandleFunc("/Users", scimHandler.CreateUser).Methods(http.MethodPost)
+	scimRouter.HandleFunc("/Users/{id}", scimHandler.GetUser).Methods(http.MethodGet)
+	scimRouter.HandleFunc("/Users/{id}", scimHandler.ReplaceUser).Methods(http.MethodPut)
+	scimRouter.HandleFunc("/Users/{id}", scimHandler.PatchUser).Methods(http.MethodPatch)
+	scimRouter.HandleFunc("/Users/{id}", scimHandler.DeleteUser).Methods(http.MethodDelete)
+
+	scimRouter.HandleFunc("/Groups", scimHandler.ListGroups).Methods(http.MethodGet)
+	scimRouter.HandleFunc("/Groups", scimHandler.CreateGroup).Methods(http.MethodPost)
+	scimRouter.HandleFunc("/Groups/{id}", scimHandler.GetGroup).Methods(http.MethodGet)
+	scimRouter.HandleFunc("/Groups/{id}", scimHandler.ReplaceGroup).Methods(http.MethodPut)
+	scimRouter.HandleFunc("/Groups/{id}", scimHandler.PatchGroup).Methods(http.MethodPatch)
+	scimRouter.HandleFunc("/Groups/{id}", scimHandler.DeleteGroup).Methods(http.MethodDelete)
+}
+
+// SetupDevMailboxRoutes exposes captured emails for local testing. It does
+// nothing unless the mailbox email provider is configured, which the config
+// validator rejects in production.
+func SetupDevMailboxRoutes(
+	router *mux.Router,
+	mailbox services.Mailbox,
+	logger logging.Logger,
+) {
+	if mailbox == nil {
+		return
+	}
+
+	mailboxHandler := handlers.NewDevMailboxHandler(mailbox, logger)
+
+	devRouter := router.PathPrefix("/dev/mailbox").Subrouter()
+
+	devRouter.HandleFunc("", mailboxHandler.List).Methods(http.MethodGet)
+	devRouter.HandleFunc("", mailboxHandler.Clear).Methods(http.MethodDelete)
+}
@@ -0,0 +1,20 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// AcceptInvitationCommand joins the actor to the organization named by the
+// signed invitation token.
+type AcceptInvitationCommand struct {
+	ActorID   string
+	Token     string
+	IPAddress string
+	UserAgent string
+}
+
+func (c AcceptInvitationCommand) CommandName() string {
+	return "AcceptInvitationCommand"
+}
+
+func (c AcceptInvitationCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.ActorID}
+}
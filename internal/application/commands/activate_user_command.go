@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type ActivateUserCommand struct {
+	ActorID   string
+	UserID    string
+	IPAddress string
+	UserAgent string
+}
+
+func (c ActivateUserCommand) CommandName() string {
+	return "ActivateUserCommand"
+}
+
+func (c ActivateUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionUsersWrite}
+}
@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type AssignRoleCommand struct {
+	ActorID   string
+	UserID    string
+	Role      string
+	IPAddress string
+	UserAgent string
+}
+
+func (c AssignRoleCommand) CommandName() string {
+	return "AssignRoleCommand"
+}
+
+func (c AssignRoleCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionRolesManage}
+}
@@ -1,5 +1,7 @@
 package commands
 
+import "authentication/internal/application/contracts/messaging"
+
 type ChangePasswordCommand struct {
     UserID      string
     OldPassword string
@@ -10,4 +12,8 @@ type ChangePasswordCommand struct {
 
 func(c ChangePasswordCommand) CommandName() string {
 	return "ChangePasswordCommand"
-}
\ No newline at end of file
+}
+
+func (c ChangePasswordCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.UserID, Sensitive: true}
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// ChangeUserRoleCommand replaces a user's primary role. Additional roles
+// are managed with AssignRoleCommand.
+type ChangeUserRoleCommand struct {
+	ActorID   string
+	UserID    string
+	Role      string
+	IPAddress string
+	UserAgent string
+}
+
+func (c ChangeUserRoleCommand) CommandName() string {
+	return "ChangeUserRoleCommand"
+}
+
+func (c ChangeUserRoleCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionRolesManage}
+}
@@ -0,0 +1,17 @@
+package commands
+
+// ClientCredentialsTokenCommand authenticates a service account with either
+// ClientSecret or a private_key_jwt ClientAssertion.
+type ClientCredentialsTokenCommand struct {
+	ClientID            string
+	ClientSecret        string
+	ClientAssertionType string
+	ClientAssertion     string
+	Scope               string
+	IPAddress           string
+	UserAgent           string
+}
+
+func (c ClientCredentialsTokenCommand) CommandName() string {
+	return "ClientCredentialsTokenCommand"
+}
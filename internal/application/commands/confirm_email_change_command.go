@@ -0,0 +1,20 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// ConfirmEmailChangeCommand applies the email change carried by the signed
+// token. The token must have been issued to the actor.
+type ConfirmEmailChangeCommand struct {
+	ActorID   string
+	Token     string
+	IPAddress string
+	UserAgent string
+}
+
+func (c ConfirmEmailChangeCommand) CommandName() string {
+	return "ConfirmEmailChangeCommand"
+}
+
+func (c ConfirmEmailChangeCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.ActorID, Sensitive: true}
+}
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"time"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type CreateAPIKeyCommand struct {
+	UserID     string
+	Name       string
+	Scopes     []string
+	AllowedIPs []string
+	ExpiresAt  *time.Time
+	IPAddress  string
+	UserAgent  string
+}
+
+func (c CreateAPIKeyCommand) CommandName() string {
+	return "CreateAPIKeyCommand"
+}
+
+func (c CreateAPIKeyCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		Permission:   valueobjects.PermissionAPIKeysManage,
+		OwnerID:      c.UserID,
+		Sensitive:    true,
+		MaxAuthAge:   messaging.RecentAuthMaxAge,
+		MinAuthLevel: valueobjects.AuthLevelMultiFactor,
+	}
+}
@@ -0,0 +1,19 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// CreateOrganizationCommand creates an organization owned by the actor.
+type CreateOrganizationCommand struct {
+	ActorID   string
+	Name      string
+	IPAddress string
+	UserAgent string
+}
+
+func (c CreateOrganizationCommand) CommandName() string {
+	return "CreateOrganizationCommand"
+}
+
+func (c CreateOrganizationCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.ActorID}
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type CreateRoleCommand struct {
+	ActorID     string
+	Name        string
+	Description string
+	Permissions []string
+	Parents     []string
+	IPAddress   string
+	UserAgent   string
+}
+
+func (c CreateRoleCommand) CommandName() string {
+	return "CreateRoleCommand"
+}
+
+func (c CreateRoleCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionRolesManage}
+}
@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// CreateSAMLConnectionCommand registers an identity provider from its
+// metadata document. OrganizationID is optional; when set the connection
+// only signs users into that organization. Domains are the email domains
+// whose existing accounts the connection may sign in.
+type CreateSAMLConnectionCommand struct {
+	ActorID          string
+	OrganizationID   string
+	Name             string
+	MetadataXML      string
+	AttributeMapping map[string]string
+	Domains          []string
+	JITProvisioning  bool
+	DefaultRole      string
+	IPAddress        string
+	UserAgent        string
+}
+
+func (c CreateSAMLConnectionCommand) CommandName() string {
+	return "CreateSAMLConnectionCommand"
+}
+
+func (c CreateSAMLConnectionCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSSOManage}
+}
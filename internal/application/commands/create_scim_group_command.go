@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// CreateSCIMGroupCommand creates a custom role named after the group and
+// assigns it to the members. Permissions are granted to the role through
+// the admin API afterwards.
+type CreateSCIMGroupCommand struct {
+	TokenID     string
+	DisplayName string
+	Members     []string
+	IPAddress   string
+	UserAgent   string
+}
+
+func (c CreateSCIMGroupCommand) CommandName() string {
+	return "CreateSCIMGroupCommand"
+}
+
+func (c CreateSCIMGroupCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMProvision}
+}
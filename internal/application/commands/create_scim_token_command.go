@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// CreateSCIMTokenCommand issues a bearer token an identity provider uses to
+// provision users and groups into the current tenant.
+type CreateSCIMTokenCommand struct {
+	ActorID   string
+	Name      string
+	IPAddress string
+	UserAgent string
+}
+
+func (c CreateSCIMTokenCommand) CommandName() string {
+	return "CreateSCIMTokenCommand"
+}
+
+func (c CreateSCIMTokenCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMManage}
+}
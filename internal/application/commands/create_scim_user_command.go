@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// CreateSCIMUserCommand provisions an account pushed by a SCIM client.
+// UserName doubles as the email when it is an email address. TokenID is the
+// SCIM token the request was made with.
+type CreateSCIMUserCommand struct {
+	TokenID    string
+	UserName   string
+	Email      string
+	GivenName  string
+	FamilyName string
+	Phone      string
+	Active     bool
+	IPAddress  string
+	UserAgent  string
+}
+
+func (c CreateSCIMUserCommand) CommandName() string {
+	return "CreateSCIMUserCommand"
+}
+
+func (c CreateSCIMUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMProvision}
+}
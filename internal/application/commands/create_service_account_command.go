@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type CreateServiceAccountCommand struct {
+	ActorID      string
+	Name         string
+	Role         string
+	Scopes       []string
+	PublicKeyPEM string
+	IPAddress    string
+	UserAgent    string
+}
+
+func (c CreateServiceAccountCommand) CommandName() string {
+	return "CreateServiceAccountCommand"
+}
+
+func (c CreateServiceAccountCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionServiceAccountsManage}
+}
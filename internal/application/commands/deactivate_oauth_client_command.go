@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type DeactivateOAuthClientCommand struct {
+	ActorID   string
+	ClientID  string
+	IPAddress string
+	UserAgent string
+}
+
+func (c DeactivateOAuthClientCommand) CommandName() string {
+	return "DeactivateOAuthClientCommand"
+}
+
+func (c DeactivateOAuthClientCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionOAuthClientsManage}
+}
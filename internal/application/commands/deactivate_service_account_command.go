@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type DeactivateServiceAccountCommand struct {
+	ActorID          string
+	ServiceAccountID string
+	IPAddress        string
+	UserAgent        string
+}
+
+func (c DeactivateServiceAccountCommand) CommandName() string {
+	return "DeactivateServiceAccountCommand"
+}
+
+func (c DeactivateServiceAccountCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionServiceAccountsManage}
+}
@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// DeactivateUserCommand blocks sign-in and revokes every session. The
+// account and its data are kept.
+type DeactivateUserCommand struct {
+	ActorID   string
+	UserID    string
+	IPAddress string
+	UserAgent string
+}
+
+func (c DeactivateUserCommand) CommandName() string {
+	return "DeactivateUserCommand"
+}
+
+func (c DeactivateUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionUsersWrite}
+}
@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// DeleteAccountCommand is a user deleting their own account. The account is
+// soft deleted at once and purged after the configured grace period.
+// Password is required when the account has one.
+type DeleteAccountCommand struct {
+	UserID    string
+	Password  string
+	IPAddress string
+	UserAgent string
+}
+
+func (c DeleteAccountCommand) CommandName() string {
+	return "DeleteAccountCommand"
+}
+
+func (c DeleteAccountCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		OwnerID:      c.UserID,
+		Sensitive:    true,
+		MaxAuthAge:   messaging.RecentAuthMaxAge,
+		MinAuthLevel: valueobjects.AuthLevelMultiFactor,
+	}
+}
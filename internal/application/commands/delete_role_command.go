@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type DeleteRoleCommand struct {
+	ActorID   string
+	Name      string
+	IPAddress string
+	UserAgent string
+}
+
+func (c DeleteRoleCommand) CommandName() string {
+	return "DeleteRoleCommand"
+}
+
+func (c DeleteRoleCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionRolesManage}
+}
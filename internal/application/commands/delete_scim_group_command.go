@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// DeleteSCIMGroupCommand unassigns the role from every member and deletes it.
+type DeleteSCIMGroupCommand struct {
+	TokenID   string
+	GroupID   string
+	IPAddress string
+	UserAgent string
+}
+
+func (c DeleteSCIMGroupCommand) CommandName() string {
+	return "DeleteSCIMGroupCommand"
+}
+
+func (c DeleteSCIMGroupCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMProvision}
+}
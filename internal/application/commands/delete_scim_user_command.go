@@ -0,0 +1,23 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// DeleteSCIMUserCommand deactivates the account and revokes its sessions.
+// The user row is kept so audit history stays attached to it.
+type DeleteSCIMUserCommand struct {
+	TokenID   string
+	UserID    string
+	IPAddress string
+	UserAgent string
+}
+
+func (c DeleteSCIMUserCommand) CommandName() string {
+	return "DeleteSCIMUserCommand"
+}
+
+func (c DeleteSCIMUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMProvision}
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// DeleteUserCommand soft deletes a user, hiding the account while keeping
+// the row. Hard removes the row permanently.
+type DeleteUserCommand struct {
+	ActorID   string
+	UserID    string
+	Hard      bool
+	IPAddress string
+	UserAgent string
+}
+
+func (c DeleteUserCommand) CommandName() string {
+	return "DeleteUserCommand"
+}
+
+func (c DeleteUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionUsersDelete}
+}
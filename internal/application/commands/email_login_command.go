@@ -6,4 +6,11 @@ type LoginEmailUserCommand struct {
 	IPAddress string
 	UserAgent string
 	DeviceID  string
+	// ChallengeResponse answers the challenge required after repeated
+	// failed logins; see services.ChallengeGate.
+	ChallengeResponse string
+	// TrustedDeviceToken was issued to DeviceID and UserAgent by an earlier
+	// login that asked to trust the device; see
+	// VerifyLoginOTPCommand.TrustDevice.
+	TrustedDeviceToken string
 }
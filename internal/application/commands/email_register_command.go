@@ -10,5 +10,7 @@ type RegisterEmailUserCommand struct {
 	IPAddress string
 	UserAgent string
 	DeviceID  string
+	// ChallengeResponse answers the challenge required after repeated
+	// failed logins; see services.ChallengeGate.
+	ChallengeResponse string
 }
-
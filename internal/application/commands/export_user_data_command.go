@@ -0,0 +1,20 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// ExportUserDataCommand assembles everything stored about a user into one
+// archive for a data subject access request. It is a command rather than a
+// query because every export is audited.
+type ExportUserDataCommand struct {
+	UserID    string
+	IPAddress string
+	UserAgent string
+}
+
+func (c ExportUserDataCommand) CommandName() string {
+	return "ExportUserDataCommand"
+}
+
+func (c ExportUserDataCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.UserID, Sensitive: true}
+}
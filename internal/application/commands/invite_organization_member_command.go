@@ -0,0 +1,20 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+type InviteOrganizationMemberCommand struct {
+	ActorID        string
+	OrganizationID string
+	Email          string
+	Role           string
+	IPAddress      string
+	UserAgent      string
+}
+
+func (c InviteOrganizationMemberCommand) CommandName() string {
+	return "InviteOrganizationMemberCommand"
+}
+
+func (c InviteOrganizationMemberCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.ActorID}
+}
@@ -1,5 +1,10 @@
 package commands
 
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
 type LogoutUserCommand struct {
     UserID       string
     SessionID    string
@@ -10,4 +15,11 @@ type LogoutUserCommand struct {
 
 func (c LogoutUserCommand) CommandName() string {
 	return "LogoutUserCommand"
-}
\ No newline at end of file
+}
+
+func (c LogoutUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		Permission: valueobjects.PermissionUsersWrite,
+		OwnerID:    c.UserID,
+	}
+}
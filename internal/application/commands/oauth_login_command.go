@@ -8,4 +8,6 @@ type LoginOAuthUserCommand struct {
 	IPAddress     string
 	UserAgent     string
 	DeviceID      string
+	// TrustedDeviceToken lets a trusted device skip the login code step.
+	TrustedDeviceToken string
 }
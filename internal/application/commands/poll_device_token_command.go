@@ -0,0 +1,13 @@
+package commands
+
+type PollDeviceTokenCommand struct {
+	ClientID     string
+	ClientSecret string
+	DeviceCode   string
+	IPAddress    string
+	UserAgent    string
+}
+
+func (c PollDeviceTokenCommand) CommandName() string {
+	return "PollDeviceTokenCommand"
+}
@@ -0,0 +1,12 @@
+package commands
+
+// PurgeDeletedAccountsCommand permanently removes accounts whose grace
+// period has ended. It is issued by the background purge job, not over
+// HTTP, and so declares no authorization rule.
+type PurgeDeletedAccountsCommand struct {
+	BatchSize int
+}
+
+func (c PurgeDeletedAccountsCommand) CommandName() string {
+	return "PurgeDeletedAccountsCommand"
+}
@@ -0,0 +1,29 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// ReauthenticateCommand has a signed-in user prove their presence again,
+// with their password or a code emailed to them, and reissues the access
+// token of SessionID with auth_time reset and the method added to amr.
+// Sent with neither Password nor OTPCode it only emails the code.
+// AuthMethods, OrganizationID and OrganizationRole come from the caller's
+// token and carry over into the new one.
+type ReauthenticateCommand struct {
+	UserID           string
+	SessionID        string
+	Password         string
+	OTPCode          string
+	AuthMethods      []string
+	OrganizationID   string
+	OrganizationRole string
+	IPAddress        string
+	UserAgent        string
+}
+
+func (c ReauthenticateCommand) CommandName() string {
+	return "ReauthenticateCommand"
+}
+
+func (c ReauthenticateCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.UserID, Sensitive: true}
+}
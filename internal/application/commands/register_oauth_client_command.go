@@ -0,0 +1,26 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// RegisterOAuthClientCommand registers a client. Public clients get no
+// secret and may only use the device authorization grant.
+type RegisterOAuthClientCommand struct {
+	ActorID      string
+	Name         string
+	RedirectURIs []string
+	Scopes       []string
+	Public       bool
+	IPAddress    string
+	UserAgent    string
+}
+
+func (c RegisterOAuthClientCommand) CommandName() string {
+	return "RegisterOAuthClientCommand"
+}
+
+func (c RegisterOAuthClientCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionOAuthClientsManage}
+}
@@ -0,0 +1,21 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// RemoveOrganizationMemberCommand removes UserID from the organization. A
+// member may always remove themselves.
+type RemoveOrganizationMemberCommand struct {
+	ActorID        string
+	OrganizationID string
+	UserID         string
+	IPAddress      string
+	UserAgent      string
+}
+
+func (c RemoveOrganizationMemberCommand) CommandName() string {
+	return "RemoveOrganizationMemberCommand"
+}
+
+func (c RemoveOrganizationMemberCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.ActorID, Sensitive: true}
+}
@@ -0,0 +1,15 @@
+package commands
+
+// ReportSignInCommand is the "this wasn't me" answer to a new sign-in
+// email. The signed token is the only credential, since the user may no
+// longer be able to sign in, so the command declares no authorization
+// rule.
+type ReportSignInCommand struct {
+	Token     string
+	IPAddress string
+	UserAgent string
+}
+
+func (c ReportSignInCommand) CommandName() string {
+	return "ReportSignInCommand"
+}
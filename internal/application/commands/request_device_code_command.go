@@ -0,0 +1,13 @@
+package commands
+
+type RequestDeviceCodeCommand struct {
+	ClientID     string
+	ClientSecret string
+	Scope        string
+	IPAddress    string
+	UserAgent    string
+}
+
+func (c RequestDeviceCodeCommand) CommandName() string {
+	return "RequestDeviceCodeCommand"
+}
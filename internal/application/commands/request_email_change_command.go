@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// RequestEmailChangeCommand sends a confirmation link to NewEmail. The
+// address is only switched once the link is confirmed. Password is required
+// when the account has one.
+type RequestEmailChangeCommand struct {
+	UserID    string
+	NewEmail  string
+	Password  string
+	IPAddress string
+	UserAgent string
+}
+
+func (c RequestEmailChangeCommand) CommandName() string {
+	return "RequestEmailChangeCommand"
+}
+
+func (c RequestEmailChangeCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		OwnerID:      c.UserID,
+		Sensitive:    true,
+		MaxAuthAge:   messaging.RecentAuthMaxAge,
+		MinAuthLevel: valueobjects.AuthLevelMultiFactor,
+	}
+}
@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// RevokeAPIKeyCommand revokes a key. OwnerID restricts the lookup to the
+// caller's own keys; admins leave it empty.
+type RevokeAPIKeyCommand struct {
+	ActorID   string
+	OwnerID   string
+	KeyID     string
+	IPAddress string
+	UserAgent string
+}
+
+func (c RevokeAPIKeyCommand) CommandName() string {
+	return "RevokeAPIKeyCommand"
+}
+
+func (c RevokeAPIKeyCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		Permission: valueobjects.PermissionAPIKeysManage,
+		OwnerID:    c.OwnerID,
+	}
+}
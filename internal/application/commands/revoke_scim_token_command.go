@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type RevokeSCIMTokenCommand struct {
+	ActorID   string
+	TokenID   string
+	IPAddress string
+	UserAgent string
+}
+
+func (c RevokeSCIMTokenCommand) CommandName() string {
+	return "RevokeSCIMTokenCommand"
+}
+
+func (c RevokeSCIMTokenCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMManage}
+}
@@ -1,12 +1,15 @@
 package commands
 
 type RevokeTokenCommand struct {
-    UserID    string
-    Token     string
-    IPAddress string
-    UserAgent string
+    UserID        string
+    Token         string
+    TokenTypeHint string
+    ClientID      string
+    ClientSecret  string
+    IPAddress     string
+    UserAgent     string
 }
 
 func (c RevokeTokenCommand) CommandName() string {
 	return "RevokeTokenCommand"
-}
\ No newline at end of file
+}
@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// RevokeTrustedDeviceCommand stops a device of UserID from skipping the
+// login code step. Users revoke their own devices; admins anyone's.
+type RevokeTrustedDeviceCommand struct {
+	ActorID         string
+	UserID          string
+	TrustedDeviceID string
+	IPAddress       string
+	UserAgent       string
+}
+
+func (c RevokeTrustedDeviceCommand) CommandName() string {
+	return "RevokeTrustedDeviceCommand"
+}
+
+func (c RevokeTrustedDeviceCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		Permission: valueobjects.PermissionUsersWrite,
+		OwnerID:    c.UserID,
+	}
+}
@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// RevokeUserSessionsCommand signs a user out everywhere.
+type RevokeUserSessionsCommand struct {
+	ActorID   string
+	UserID    string
+	IPAddress string
+	UserAgent string
+}
+
+func (c RevokeUserSessionsCommand) CommandName() string {
+	return "RevokeUserSessionsCommand"
+}
+
+func (c RevokeUserSessionsCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionUsersWrite}
+}
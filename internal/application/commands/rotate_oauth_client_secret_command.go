@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type RotateOAuthClientSecretCommand struct {
+	ActorID   string
+	ClientID  string
+	IPAddress string
+	UserAgent string
+}
+
+func (c RotateOAuthClientSecretCommand) CommandName() string {
+	return "RotateOAuthClientSecretCommand"
+}
+
+func (c RotateOAuthClientSecretCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionOAuthClientsManage}
+}
@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type RotateServiceAccountSecretCommand struct {
+	ActorID          string
+	ServiceAccountID string
+	IPAddress        string
+	UserAgent        string
+}
+
+func (c RotateServiceAccountSecretCommand) CommandName() string {
+	return "RotateServiceAccountSecretCommand"
+}
+
+func (c RotateServiceAccountSecretCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionServiceAccountsManage}
+}
@@ -0,0 +1,26 @@
+package commands
+
+// StartSAMLLoginCommand builds the AuthnRequest that sends the browser to
+// the connection's identity provider.
+type StartSAMLLoginCommand struct {
+	ConnectionID string
+}
+
+func (c StartSAMLLoginCommand) CommandName() string {
+	return "StartSAMLLoginCommand"
+}
+
+// CompleteSAMLLoginCommand carries the form fields the identity provider
+// posts to the assertion consumer service.
+type CompleteSAMLLoginCommand struct {
+	ConnectionID string
+	SAMLResponse string
+	RelayState   string
+	IPAddress    string
+	UserAgent    string
+	DeviceID     string
+}
+
+func (c CompleteSAMLLoginCommand) CommandName() string {
+	return "CompleteSAMLLoginCommand"
+}
@@ -0,0 +1,19 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// SendPhoneVerificationCommand texts a one-time code to the user's phone
+// number.
+type SendPhoneVerificationCommand struct {
+	UserID    string
+	IPAddress string
+	UserAgent string
+}
+
+func (c SendPhoneVerificationCommand) CommandName() string {
+	return "SendPhoneVerificationCommand"
+}
+
+func (c SendPhoneVerificationCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.UserID, Sensitive: true}
+}
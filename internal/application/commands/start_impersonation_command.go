@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// StartImpersonationCommand issues ActorID a short-lived session acting as
+// TargetUserID. Reason is kept in the audit log. An impersonation session
+// cannot start another one.
+type StartImpersonationCommand struct {
+	ActorID      string
+	TargetUserID string
+	Reason       string
+	IPAddress    string
+	UserAgent    string
+}
+
+func (c StartImpersonationCommand) CommandName() string {
+	return "StartImpersonationCommand"
+}
+
+func (c StartImpersonationCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		Permission: valueobjects.PermissionUsersImpersonate,
+		Sensitive:  true,
+	}
+}
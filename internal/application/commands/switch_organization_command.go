@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"time"
+
+	"authentication/internal/application/contracts/messaging"
+)
+
+// SwitchOrganizationCommand issues an access token whose org_id and org_role
+// claims name the given organization. SessionID, AuthTime and AuthMethods
+// come from the caller's token and carry over unchanged, so switching does
+// not count as authenticating again. ImpersonatorID and ExpiresAt are the
+// act claim and expiry of the caller's token; the new token keeps the
+// former and expires no later than the latter. Impersonation sessions may
+// not switch at all.
+type SwitchOrganizationCommand struct {
+	ActorID        string
+	OrganizationID string
+	SessionID      string
+	AuthTime       time.Time
+	AuthMethods    []string
+	ImpersonatorID string
+	ExpiresAt      time.Time
+}
+
+func (c SwitchOrganizationCommand) CommandName() string {
+	return "SwitchOrganizationCommand"
+}
+
+func (c SwitchOrganizationCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.ActorID, Sensitive: true}
+}
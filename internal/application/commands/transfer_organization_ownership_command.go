@@ -0,0 +1,19 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+type TransferOrganizationOwnershipCommand struct {
+	ActorID        string
+	OrganizationID string
+	NewOwnerID     string
+	IPAddress      string
+	UserAgent      string
+}
+
+func (c TransferOrganizationOwnershipCommand) CommandName() string {
+	return "TransferOrganizationOwnershipCommand"
+}
+
+func (c TransferOrganizationOwnershipCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.ActorID, Sensitive: true}
+}
@@ -0,0 +1,22 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type UnassignRoleCommand struct {
+	ActorID   string
+	UserID    string
+	Role      string
+	IPAddress string
+	UserAgent string
+}
+
+func (c UnassignRoleCommand) CommandName() string {
+	return "UnassignRoleCommand"
+}
+
+func (c UnassignRoleCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionRolesManage}
+}
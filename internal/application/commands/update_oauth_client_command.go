@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// UpdateOAuthClientCommand replaces the redirect URIs and/or scopes of a client.
+// A nil slice leaves the corresponding field untouched.
+type UpdateOAuthClientCommand struct {
+	ActorID      string
+	ClientID     string
+	RedirectURIs []string
+	Scopes       []string
+	IPAddress    string
+	UserAgent    string
+}
+
+func (c UpdateOAuthClientCommand) CommandName() string {
+	return "UpdateOAuthClientCommand"
+}
+
+func (c UpdateOAuthClientCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionOAuthClientsManage}
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+type UpdateRoleCommand struct {
+	ActorID     string
+	Name        string
+	Description string
+	Permissions []string
+	Parents     []string
+	IPAddress   string
+	UserAgent   string
+}
+
+func (c UpdateRoleCommand) CommandName() string {
+	return "UpdateRoleCommand"
+}
+
+func (c UpdateRoleCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionRolesManage}
+}
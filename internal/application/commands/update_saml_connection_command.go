@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// UpdateSAMLConnectionCommand changes only the fields that are set. A new
+// MetadataXML replaces the IdP endpoint and certificates. A non-nil Domains
+// replaces the connection's domains, an empty one clearing them.
+type UpdateSAMLConnectionCommand struct {
+	ActorID          string
+	ConnectionID     string
+	MetadataXML      *string
+	AttributeMapping map[string]string
+	Domains          []string
+	JITProvisioning  *bool
+	DefaultRole      *string
+	IsActive         *bool
+	IPAddress        string
+	UserAgent        string
+}
+
+func (c UpdateSAMLConnectionCommand) CommandName() string {
+	return "UpdateSAMLConnectionCommand"
+}
+
+func (c UpdateSAMLConnectionCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSSOManage}
+}
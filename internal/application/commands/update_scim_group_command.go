@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// UpdateSCIMGroupCommand changes group membership. With ReplaceMembers the
+// membership becomes exactly Members, as for a PUT; otherwise AddMembers and
+// RemoveMembers are applied. DisplayName, when set, must still name the
+// same role since roles cannot be renamed.
+type UpdateSCIMGroupCommand struct {
+	TokenID        string
+	GroupID        string
+	DisplayName    *string
+	ReplaceMembers bool
+	Members        []string
+	AddMembers     []string
+	RemoveMembers  []string
+	IPAddress      string
+	UserAgent      string
+}
+
+func (c UpdateSCIMGroupCommand) CommandName() string {
+	return "UpdateSCIMGroupCommand"
+}
+
+func (c UpdateSCIMGroupCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMProvision}
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// UpdateSCIMUserCommand changes only the attributes that are set. A PUT sets
+// all of them, a PATCH only the ones its operations touch. Setting Active to
+// false deactivates the account and revokes its sessions.
+type UpdateSCIMUserCommand struct {
+	TokenID    string
+	UserID     string
+	UserName   *string
+	Email      *string
+	GivenName  *string
+	FamilyName *string
+	Phone      *string
+	Active     *bool
+	IPAddress  string
+	UserAgent  string
+}
+
+func (c UpdateSCIMUserCommand) CommandName() string {
+	return "UpdateSCIMUserCommand"
+}
+
+func (c UpdateSCIMUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMProvision}
+}
@@ -1,14 +1,36 @@
 package commands
 
+import (
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/valueobjects"
+)
+
+// UpdateUserCommand changes a user's profile. Empty fields are left
+// unchanged. ActorID is the user making the change, who may be the user
+// themselves or an administrator. A non-zero Version makes the update fail
+// with ErrUserVersionConflict unless the user is still at that version.
+// Changing the username or phone number alters how the account signs in and
+// is recovered, so those changes are not allowed while impersonating.
 type UpdateUserCommand struct {
+    ActorID   string
     UserID    string
+    Username  string
     FirstName string
     LastName  string
     Phone     string
+    Version   int
     IPAddress string
     UserAgent string
 }
 
 func (c UpdateUserCommand) CommandName() string {
 	return "UpdateUserCommand"
-}
\ No newline at end of file
+}
+
+func (c UpdateUserCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{
+		Permission: valueobjects.PermissionUsersWrite,
+		OwnerID:    c.UserID,
+		Sensitive:  c.Username != "" || c.Phone != "",
+	}
+}
@@ -0,0 +1,22 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// VerifyDeviceCodeCommand approves or denies a pending device authorization.
+// Approval hands the device a full session of its own, so it is never
+// allowed while impersonating.
+type VerifyDeviceCodeCommand struct {
+	UserID    string
+	UserCode  string
+	Approve   bool
+	IPAddress string
+	UserAgent string
+}
+
+func (c VerifyDeviceCodeCommand) CommandName() string {
+	return "VerifyDeviceCodeCommand"
+}
+
+func (c VerifyDeviceCodeCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.UserID, Sensitive: true}
+}
@@ -6,4 +6,11 @@ type VerifyLoginOTPCommand struct {
 	IPAddress string
 	UserAgent string
 	DeviceID  string
-}
\ No newline at end of file
+	// TrustDevice asks to skip the code step on DeviceID from now on; the
+	// result then carries the token the device must present.
+	TrustDevice bool
+}
+
+func (c VerifyLoginOTPCommand) CommandName() string {
+	return "VerifyLoginOTPCommand"
+}
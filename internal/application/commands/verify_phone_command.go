@@ -0,0 +1,20 @@
+package commands
+
+import "authentication/internal/application/contracts/messaging"
+
+// VerifyPhoneCommand marks the user's phone number as verified when Code
+// matches the one last texted to it.
+type VerifyPhoneCommand struct {
+	UserID    string
+	Code      string
+	IPAddress string
+	UserAgent string
+}
+
+func (c VerifyPhoneCommand) CommandName() string {
+	return "VerifyPhoneCommand"
+}
+
+func (c VerifyPhoneCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.UserID, Sensitive: true}
+}
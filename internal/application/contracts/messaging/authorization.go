@@ -0,0 +1,42 @@
+package messaging
+
+import (
+	"time"
+
+	"authentication/internal/domain/valueobjects"
+)
+
+// AuthorizationRule declares who may execute a command. When OwnerID is set
+// and matches the calling user, the command is allowed without Permission;
+// otherwise the caller needs Permission. A rule with neither field only
+// requires an authenticated caller. Personal access tokens are held to
+// their scopes even on their owner's resources and cannot run commands
+// without a Permission; service tokens need Permission both in their scopes
+// and in their role. Sensitive commands change how an
+// account is secured or recovered, remove it, hand out access beyond the
+// current session, or give away what it owns, and are refused to
+// impersonation sessions whatever else the rule allows. Impersonation
+// sessions are also refused anything that needs Permission outside the
+// user's own resources, so they never borrow the user's role. MaxAuthAge and
+// MinAuthLevel ask for step-up authentication: the caller's token must show
+// a sign-in within MaxAuthAge that reached MinAuthLevel, or the command is
+// refused until the user reauthenticates. Service tokens do not sign in and
+// are exempt.
+type AuthorizationRule struct {
+	Permission   valueobjects.Permission
+	OwnerID      string
+	Sensitive    bool
+	MaxAuthAge   time.Duration
+	MinAuthLevel valueobjects.AuthLevel
+}
+
+// RecentAuthMaxAge is the step-up window for commands that change how an
+// account is reached or remove it.
+const RecentAuthMaxAge = 15 * time.Minute
+
+// AuthorizedCommand is implemented by commands that require an
+// authenticated caller. Commands that do not implement it, such as login
+// and registration, are open to anonymous callers.
+type AuthorizedCommand interface {
+	AuthorizationRule() AuthorizationRule
+}
@@ -2,12 +2,19 @@ package persistence
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrCacheMiss is returned by Get when the key does not exist.
+var ErrCacheMiss = errors.New("cache miss")
+
 type Cache interface {
 	Get(ctx context.Context, key string, dest interface{}) error
 	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
 	Exists(ctx context.Context, key string) (bool, error)
+	// SetIfAbsent stores value only when key does not exist yet, in one
+	// atomic step, and reports whether it did.
+	SetIfAbsent(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error)
 }
@@ -14,9 +14,12 @@ type OutboxMessage struct {
 }
 
 
-// OutboxRepository defines operations for persisting and fetching outbox messages
+// OutboxRepository defines operations for persisting and fetching outbox messages.
+// MarkFailed records a failed dispatch; the message stays pending and is
+// fetched again after a backoff until it runs out of attempts.
 type OutboxRepository interface {
 	Save(ctx context.Context, msg *OutboxMessage) error
 	MarkAsSent(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, id string, reason string) error
 	FetchPending(ctx context.Context, limit int) ([]*OutboxMessage, error)
 }
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"authentication/internal/domain/valueobjects"
+)
+
+// Authorizer is the single place permission checks go through, for both the
+// HTTP layer and command handlers.
+type Authorizer interface {
+	// Authorize returns domain.ErrPermissionDenied unless userID holds
+	// permission through its primary role or any assigned role.
+	Authorize(ctx context.Context, userID string, permission valueobjects.Permission) error
+	// AuthorizeRoles checks roles directly. It is used for principals that are
+	// not users, such as service accounts.
+	AuthorizeRoles(ctx context.Context, roles []valueobjects.Role, permission valueobjects.Permission) error
+	EffectivePermissions(ctx context.Context, userID string) ([]valueobjects.Permission, error)
+	// InvalidateUser drops the cached permissions of one user, after their
+	// role assignments change.
+	InvalidateUser(ctx context.Context, userID string) error
+	// InvalidateAll drops every cached permission set, after a role
+	// definition changes.
+	InvalidateAll(ctx context.Context) error
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// Challenge tells a client what to solve before retrying a guarded
+// request. Proof-of-work challenges carry Token and Difficulty; CAPTCHA
+// challenges carry the SiteKey the widget needs.
+type Challenge struct {
+	Provider   string    `json:"provider"`
+	Token      string    `json:"token,omitempty"`
+	Difficulty int       `json:"difficulty,omitempty"`
+	SiteKey    string    `json:"site_key,omitempty"`
+	ExpiresAt  time.Time `json:"expires_at,omitempty"`
+}
+
+// ChallengeProvider issues and verifies challenges. Verify returns
+// ErrChallengeFailed for a wrong, expired or reused response.
+type ChallengeProvider interface {
+	Name() string
+	Issue(ctx context.Context) (Challenge, error)
+	Verify(ctx context.Context, response, remoteIP string) error
+}
+
+// ChallengeGate decides when a login or registration must carry a solved
+// challenge: once failed logins for the email or the IP pass the configured
+// thresholds.
+type ChallengeGate interface {
+	// Check returns nil when no challenge is needed or response solves one,
+	// ErrChallengeRequired when it is missing and ErrChallengeFailed when
+	// it is wrong.
+	Check(ctx context.Context, email, remoteIP, response string) error
+	Issue(ctx context.Context) (Challenge, error)
+}
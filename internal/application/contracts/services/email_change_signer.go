@@ -0,0 +1,21 @@
+package services
+
+import "time"
+
+// EmailChange is what an email change token vouches for: the user asked to
+// move from CurrentEmail to NewEmail.
+type EmailChange struct {
+	UserID       string
+	CurrentEmail string
+	NewEmail     string
+}
+
+// EmailChangeSigner produces the tamper-proof token sent to the new address
+// when a user changes their email. Nothing is stored; the token itself
+// carries the pending change.
+type EmailChangeSigner interface {
+	Sign(change EmailChange, expiresAt time.Time) (string, error)
+	// Verify returns the change, or ErrEmailChangeExpired /
+	// ErrInvalidEmailChangeToken.
+	Verify(token string) (EmailChange, error)
+}
@@ -9,9 +9,12 @@ type EmailService interface {
 	SendWelcomeEmail(ctx context.Context, email, username string) error
 }
 
+// SendEmailInput describes one templated email. Locale selects a template
+// variant such as "de" or "pt-BR"; empty means the configured default.
 type SendEmailInput struct {
 	To       string
 	Subject  string
 	Template string
+	Locale   string
 	Data     map[string]interface{}
 }
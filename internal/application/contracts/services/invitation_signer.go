@@ -0,0 +1,13 @@
+package services
+
+import "time"
+
+// InvitationSigner produces the tamper-proof token embedded in invitation
+// links. The token names the invitation and its expiry; the stored
+// invitation still decides whether it can be accepted.
+type InvitationSigner interface {
+	Sign(invitationID string, expiresAt time.Time) (string, error)
+	// Verify returns the invitation id, or ErrInvitationExpired /
+	// ErrInvalidInvitationToken.
+	Verify(token string) (string, error)
+}
@@ -0,0 +1,28 @@
+package services
+
+import "context"
+
+// LDAPIdentity is a directory entry whose password was verified. Role is
+// the role its groups map to, or the configured default.
+type LDAPIdentity struct {
+	DN        string
+	Email     string
+	Username  string
+	FirstName string
+	LastName  string
+	Groups    []string
+	Role      string
+}
+
+// LDAPAuthenticator verifies credentials by binding to an LDAP or Active
+// Directory server as the user.
+type LDAPAuthenticator interface {
+	// Authenticate returns domain.ErrInvalidCredentials when no single entry
+	// matches login or the password is rejected, and
+	// domain.ErrLDAPUnavailable when the directory cannot be reached.
+	Authenticate(ctx context.Context, login, password string) (*LDAPIdentity, error)
+
+	// JITProvisioning reports whether directory users without a local
+	// account may be created on their first login.
+	JITProvisioning() bool
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// CapturedEmail is a message held by a local mailbox instead of being sent.
+type CapturedEmail struct {
+	ID      string    `json:"id"`
+	From    string    `json:"from"`
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Text    string    `json:"text,omitempty"`
+	HTML    string    `json:"html,omitempty"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// Mailbox exposes captured emails for development and end-to-end tests.
+// It is only available when the mailbox email provider is configured.
+type Mailbox interface {
+	List(ctx context.Context, to string, limit int) ([]CapturedEmail, error)
+	Clear(ctx context.Context) error
+}
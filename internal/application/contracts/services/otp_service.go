@@ -14,6 +14,9 @@ type OTPService interface {
 	
 	// SendEmail sends OTP via email
 	SendEmail(ctx context.Context, email string, otpCode string, purpose string) error
+
+	// SendSMS sends OTP via text message to an E.164 phone number
+	SendSMS(ctx context.Context, phone string, otpCode string, purpose string) error
 	
 	// IsRateLimited checks if user has exceeded OTP request limits
 	IsRateLimited(ctx context.Context, email string) (bool, error)
@@ -24,6 +27,8 @@ const (
 	OTPPurposeLogin            = "login"
 	OTPPurposeEmailVerification = "email_verification"
 	OTPPurposePasswordReset    = "password_reset"
+	OTPPurposePhoneVerification = "phone_verification"
+	OTPPurposeReauthentication = "reauthentication"
 )
 
 // OTPConfig holds OTP configuration
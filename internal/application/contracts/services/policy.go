@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// Subject is the authenticated caller as seen by the policy engine. It is
+// empty for anonymous requests such as login and registration. ActorID is
+// set when the caller is impersonating UserID and names who is really
+// acting.
+type Subject struct {
+	UserID    string
+	Role      string
+	ClientID  string
+	TokenType string
+	Scopes    []string
+	OrgID     string
+	OrgRole   string
+	ActorID   string
+	// AuthTime, AuthMethods and AuthLevel describe the sign-in behind the
+	// token and are checked by step-up requirements.
+	AuthTime    time.Time
+	AuthMethods []string
+	AuthLevel   string
+}
+
+// Resource identifies what a command or query acts on. Attributes hold
+// additional facts, such as the owner of the resource or the role of a
+// target user, that policies may test.
+type Resource struct {
+	Type       string
+	ID         string
+	Attributes map[string]string
+}
+
+type Environment struct {
+	IPAddress string
+	Time      time.Time
+}
+
+type AccessRequest struct {
+	Subject     Subject
+	Action      string
+	Resource    Resource
+	Environment Environment
+}
+
+type PolicyDecision struct {
+	Allowed  bool
+	PolicyID string
+	Reason   string
+}
+
+type PolicyEvaluator interface {
+	Evaluate(ctx context.Context, request AccessRequest) (PolicyDecision, error)
+}
+
+// ResourceAttributeProvider adds attributes that are not on the command
+// itself, for example by loading the target user.
+type ResourceAttributeProvider interface {
+	ResourceAttributes(ctx context.Context, resource Resource) (map[string]string, error)
+}
+
+// PolicyResourceDescriber is implemented by commands and queries that want
+// to name their resource explicitly instead of having it derived from their
+// fields.
+type PolicyResourceDescriber interface {
+	PolicyResource() Resource
+}
+
+type subjectKey struct{}
+
+func WithSubject(ctx context.Context, subject Subject) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+func SubjectFromContext(ctx context.Context) (Subject, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(Subject)
+	return subject, ok
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiter counts hits in fixed windows.
+type RateLimiter interface {
+	// Allow records a hit against key and reports whether the key is still
+	// within limit hits for the current window.
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
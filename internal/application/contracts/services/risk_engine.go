@@ -0,0 +1,44 @@
+package services
+
+import "context"
+
+// RiskDecision is the outcome of a login risk assessment.
+type RiskDecision string
+
+const (
+	RiskDecisionAllow  RiskDecision = "allow"
+	RiskDecisionStepUp RiskDecision = "step_up"
+	RiskDecisionBlock  RiskDecision = "block"
+)
+
+// LoginAttempt describes a sign-in being assessed. UserID is empty when the
+// account is unknown, e.g. when recording a failure for a mistyped email.
+type LoginAttempt struct {
+	UserID    string
+	Email     string
+	IPAddress string
+	UserAgent string
+	DeviceID  string
+	Method    string
+}
+
+// RiskSignal is one observation that contributed to a score.
+type RiskSignal struct {
+	Name   string `json:"name"`
+	Score  int    `json:"score"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type RiskAssessment struct {
+	Score    int
+	Decision RiskDecision
+	Signals  []RiskSignal
+}
+
+// RiskEngine scores login attempts from device, network, location and
+// failure history. RecordSuccess and RecordFailure feed that history.
+type RiskEngine interface {
+	Evaluate(ctx context.Context, attempt LoginAttempt) (RiskAssessment, error)
+	RecordSuccess(ctx context.Context, attempt LoginAttempt) error
+	RecordFailure(ctx context.Context, attempt LoginAttempt) error
+}
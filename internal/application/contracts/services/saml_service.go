@@ -0,0 +1,47 @@
+package services
+
+import "time"
+
+// SAMLIdentityProvider is the part of the IdP metadata the service provider
+// needs. Certificates are PEM encoded.
+type SAMLIdentityProvider struct {
+	EntityID     string
+	SSOURL       string
+	Certificates []string
+}
+
+// SAMLAuthnRequest is an AuthnRequest ready to be sent with the
+// HTTP-Redirect binding. ID must come back as InResponseTo.
+type SAMLAuthnRequest struct {
+	ID          string
+	RedirectURL string
+}
+
+// SAMLAssertion holds the contents of a verified assertion.
+type SAMLAssertion struct {
+	ID           string
+	NameID       string
+	SessionIndex string
+	Attributes   map[string][]string
+	NotOnOrAfter time.Time
+}
+
+// SAMLServiceProvider implements the SP side of SAML 2.0 web browser SSO.
+// Each connection is its own service provider with its own entity id and
+// assertion consumer service URL.
+type SAMLServiceProvider interface {
+	// ParseMetadata reads an IdP EntityDescriptor. It fails with
+	// ErrInvalidSAMLMetadata when no redirect SSO endpoint or signing
+	// certificate is present.
+	ParseMetadata(metadata []byte) (*SAMLIdentityProvider, error)
+
+	// Metadata returns the SP EntityDescriptor for the connection.
+	Metadata(connectionID string) ([]byte, error)
+
+	NewAuthnRequest(connectionID string, idp SAMLIdentityProvider, relayState string) (*SAMLAuthnRequest, error)
+
+	// ValidateResponse decodes a base64 SAMLResponse posted to the ACS and
+	// checks its signature, issuer, audience, recipient, validity window and
+	// that it answers requestID.
+	ValidateResponse(connectionID string, idp SAMLIdentityProvider, samlResponse, requestID string) (*SAMLAssertion, error)
+}
@@ -0,0 +1,11 @@
+package services
+
+import "context"
+
+// SCIMTokenVerifier authenticates the bearer tokens SCIM clients present.
+// It is separate from TokenService so that a SCIM token is never accepted
+// on the regular API. Verified tokens have TokenType SCIM and carry the
+// token id in TokenID and ClientID.
+type SCIMTokenVerifier interface {
+	Verify(ctx context.Context, token string) (*TokenClaims, error)
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"authentication/internal/domain/valueobjects"
+)
+
+// ClientAssertionTypeJWTBearer is the RFC 7523 client_assertion_type used by
+// private_key_jwt client authentication.
+const ClientAssertionTypeJWTBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+type ServiceTokenIssuer interface {
+	GenerateServiceToken(tenantID, clientID, role string, scopes []string) (*valueobjects.Token, error)
+}
+
+// OrganizationClaims selects the organization an access token acts in. The
+// zero value issues a token without organization context.
+type OrganizationClaims struct {
+	ID   string
+	Role string
+}
+
+// AuthenticationContext records how and when the user behind an access
+// token signed in, carried as the auth_time, amr and acr claims. Tokens
+// reissued for the same session pass it along unchanged; reauthenticating
+// resets Time and adds the method used. ActorID and NotAfter carry the act
+// claim and expiry of the token being reissued, so the new token neither
+// drops the impersonating administrator nor outlives the original.
+type AuthenticationContext struct {
+	SessionID string
+	Time      time.Time
+	Methods   []valueobjects.AuthMethod
+	ActorID   string
+	NotAfter  time.Time
+}
+
+// Level is the assurance level Methods reach together.
+func (a AuthenticationContext) Level() valueobjects.AuthLevel {
+	return valueobjects.AuthLevelFor(a.Methods)
+}
+
+type AccessTokenIssuer interface {
+	GenerateAccessToken(
+		tenantID, userID, email, username, role string,
+		org OrganizationClaims,
+		auth AuthenticationContext,
+	) (*valueobjects.Token, error)
+}
+
+// ImpersonationClaims describe an access token issued to ActorID to act as
+// UserID. The token names ActorID in its act claim and cannot be refreshed.
+type ImpersonationClaims struct {
+	TenantID  string
+	UserID    string
+	Email     string
+	Username  string
+	Role      string
+	ActorID   string
+	SessionID string
+}
+
+type ImpersonationTokenIssuer interface {
+	GenerateImpersonationToken(claims ImpersonationClaims, ttl time.Duration) (*valueobjects.Token, error)
+}
+
+type ClientAssertionVerifier interface {
+	ValidatePublicKey(publicKeyPEM string) error
+	// Verify checks the assertion signature against publicKeyPEM, that iss and
+	// sub equal clientID, that aud contains audience, and that the jti has not
+	// been seen before.
+	Verify(ctx context.Context, assertion, clientID, publicKeyPEM, audience string) error
+}
@@ -0,0 +1,32 @@
+package services
+
+import "time"
+
+// Scopes of a "this wasn't me" link.
+const (
+	SignInAlertScopeSession = "session"
+	SignInAlertScopeAll     = "all"
+)
+
+// SignInAlert is what a sign-in alert token vouches for: the owner of
+// UserID may revoke SessionID, or every session when Scope is "all". ID
+// and ExpiresAt are set by Verify; ID is unique to the token so that the
+// link can be honoured only once.
+type SignInAlert struct {
+	ID        string
+	UserID    string
+	TenantID  string
+	SessionID string
+	Scope     string
+	ExpiresAt time.Time
+}
+
+// SignInAlertSigner produces the tamper-proof tokens behind the links in a
+// new sign-in email. Nothing is stored when signing; the token carries the
+// session.
+type SignInAlertSigner interface {
+	Sign(alert SignInAlert, expiresAt time.Time) (string, error)
+	// Verify returns the alert, or ErrSignInAlertExpired /
+	// ErrInvalidSignInAlertToken.
+	Verify(token string) (SignInAlert, error)
+}
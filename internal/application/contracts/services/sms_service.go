@@ -0,0 +1,16 @@
+package services
+
+import "context"
+
+// SMSMessage is a single text message. To is an E.164 phone number.
+type SMSMessage struct {
+	To   string
+	Body string
+}
+
+// SMSService delivers text messages. Implementations return
+// ErrSMSRateLimited or ErrSMSCountryNotAllowed when a message is refused,
+// and ErrSMSUnavailable when the provider cannot be reached.
+type SMSService interface {
+	Send(ctx context.Context, message SMSMessage) error
+}
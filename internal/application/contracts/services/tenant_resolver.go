@@ -0,0 +1,27 @@
+package services
+
+import "context"
+
+// TenantSource records which part of the request identified the tenant.
+type TenantSource string
+
+const (
+	TenantSourceHeader  TenantSource = "header"
+	TenantSourceHost    TenantSource = "host"
+	TenantSourceDefault TenantSource = "default"
+)
+
+type ResolvedTenant struct {
+	ID     string
+	Slug   string
+	Source TenantSource
+}
+
+// TenantResolver maps an incoming request to an active tenant. Header takes
+// precedence over host; when neither identifies a tenant the configured
+// default is returned with TenantSourceDefault, so callers holding a token
+// with an explicit tenant claim can still override it.
+type TenantResolver interface {
+	Resolve(ctx context.Context, host, header string) (*ResolvedTenant, error)
+	ResolveByID(ctx context.Context, id string) (*ResolvedTenant, error)
+}
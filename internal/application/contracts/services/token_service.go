@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"time"
+
+	"authentication/internal/domain/valueobjects"
 )
 
 type TokenPair struct {
@@ -14,20 +16,43 @@ type TokenPair struct {
 	SessionID    string    `json:"session_id"`
 }
 
+// TokenClaims describes a verified credential. Service account tokens carry
+// ClientID instead of a user and have TokenType SERVICE. Impersonation
+// tokens carry the impersonating administrator in ActorID (the act claim).
+// AuthTime, AuthMethods and AuthLevel come from the auth_time, amr and acr
+// claims and tell how recently and how strongly the user authenticated.
 type TokenClaims struct {
-	UserID    string
-	TokenID   string
-	SessionID string
-	Role      string
-	Email     string
-	IssuedAt  time.Time
-	ExpiresAt time.Time
+	UserID      string
+	TenantID    string
+	OrgID       string
+	OrgRole     string
+	TokenID     string
+	SessionID   string
+	Role        string
+	Email       string
+	Scopes      []string
+	ClientID    string
+	TokenType   string
+	ActorID     string
+	AuthTime    time.Time
+	AuthMethods []string
+	AuthLevel   string
+	IssuedAt    time.Time
+	ExpiresAt   time.Time
 }
 
+// SessionMetadata describes a new session. AuthMethods lists how the user
+// authenticated and becomes the amr claim of the issued tokens. Scopes,
+// when set, become the scope claim of every access token of the session,
+// including those issued on refresh. ClientID names the OAuth client the
+// session was issued to and becomes the client_id claim.
 type SessionMetadata struct {
-	IPAddress string
-	UserAgent string
-	DeviceID  string
+	IPAddress   string
+	UserAgent   string
+	DeviceID    string
+	AuthMethods []valueobjects.AuthMethod
+	Scopes      []string
+	ClientID    string
 }
 
 type TokenService interface {
@@ -0,0 +1,24 @@
+package services
+
+import "time"
+
+// TrustedDeviceGrant is what a trusted device token vouches for: the device
+// with DeviceID may skip the login code step for UserID while the record
+// GrantID is neither revoked nor expired. UserAgentHash fingerprints the
+// user agent the grant was issued to.
+type TrustedDeviceGrant struct {
+	GrantID       string
+	UserID        string
+	TenantID      string
+	DeviceID      string
+	UserAgentHash string
+}
+
+// TrustedDeviceSigner produces the token a device keeps after "trust this
+// device". The token is bound to the device id and user agent it was issued
+// for and is only honoured together with the stored record it names.
+type TrustedDeviceSigner interface {
+	Sign(grant TrustedDeviceGrant, expiresAt time.Time) (string, error)
+	// Verify returns the grant, or ErrInvalidTrustedDeviceToken.
+	Verify(token string) (TrustedDeviceGrant, error)
+}
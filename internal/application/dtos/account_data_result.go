@@ -0,0 +1,51 @@
+package dtos
+
+import "time"
+
+// AccountDeletionResult tells the user when their account will be purged.
+type AccountDeletionResult struct {
+	UserID     string
+	PurgeAfter time.Time
+}
+
+// PurgeResult counts the accounts removed by one purge run.
+type PurgeResult struct {
+	Purged int
+	Failed int
+}
+
+// UserDataExport is the archive returned for a data subject access request.
+type UserDataExport struct {
+	GeneratedAt      time.Time
+	Profile          UserResult
+	Sessions         []SessionExport
+	LinkedIdentities []LinkedIdentityExport
+	AuditHistory     []AuditEntryExport
+}
+
+type SessionExport struct {
+	ID        string
+	IPAddress string
+	UserAgent string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	Revoked   bool
+}
+
+// LinkedIdentityExport names an external identity tied to the account. Token
+// values are never exported.
+type LinkedIdentityExport struct {
+	Provider  string
+	Scope     string
+	CreatedAt *time.Time
+}
+
+type AuditEntryExport struct {
+	Action       string
+	ResourceType string
+	ResourceID   string
+	Status       string
+	IPAddress    string
+	UserAgent    string
+	Timestamp    time.Time
+}
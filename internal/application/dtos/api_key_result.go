@@ -0,0 +1,29 @@
+package dtos
+
+import "time"
+
+type APIKeyResult struct {
+	ID            string
+	UserID        string
+	Name          string
+	DisplayPrefix string
+	Scopes        []string
+	AllowedIPs    []string
+	ExpiresAt     *time.Time
+	LastUsedAt    *time.Time
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+}
+
+// APIKeySecretResult carries the plain key. It is only produced on creation.
+type APIKeySecretResult struct {
+	APIKeyResult
+	Key string
+}
+
+type ListAPIKeysResult struct {
+	Keys       []APIKeyResult
+	TotalCount int64
+	Page       int
+	PageSize   int
+}
@@ -0,0 +1,31 @@
+package dtos
+
+import "time"
+
+type AuditLogResult struct {
+	ID           string
+	UserID       string
+	Action       string
+	ResourceType string
+	ResourceID   string
+	IPAddress    string
+	UserAgent    string
+	Status       string
+	ErrorMessage string
+	Metadata     map[string]interface{}
+	Timestamp    time.Time
+}
+
+type ListAuditLogsResult struct {
+	Logs       []AuditLogResult
+	TotalCount int64
+	Page       int
+	PageSize   int
+}
+
+// SearchAuditLogsResult is one page of search hits. NextCursor is empty on
+// the last page.
+type SearchAuditLogsResult struct {
+	Logs       []AuditLogResult
+	NextCursor string
+}
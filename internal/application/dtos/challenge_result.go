@@ -0,0 +1,13 @@
+package dtos
+
+import "time"
+
+// ChallengeResult is a challenge to solve. Provider "none" means challenges
+// are disabled.
+type ChallengeResult struct {
+	Provider   string
+	Token      string
+	Difficulty int
+	SiteKey    string
+	ExpiresAt  time.Time
+}
@@ -0,0 +1,27 @@
+package dtos
+
+type DeviceAuthorizationResult struct {
+	DeviceCode              string
+	UserCode                string
+	VerificationURI         string
+	VerificationURIComplete string
+	ExpiresIn               int64
+	Interval                int64
+}
+
+type DeviceVerificationResult struct {
+	ClientID   string
+	ClientName string
+	Scopes     []string
+	Approved   bool
+}
+
+// OAuthTokenResult is the token endpoint response shared by grant types.
+type OAuthTokenResult struct {
+	AccessToken  string
+	RefreshToken string
+	TokenType    string
+	ExpiresIn    int64
+	Scope        string
+	SessionID    string
+}
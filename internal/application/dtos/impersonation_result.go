@@ -0,0 +1,16 @@
+package dtos
+
+import "time"
+
+// ImpersonationResult carries the access token of an impersonation session.
+// There is no refresh token; a new session must be started once it expires.
+type ImpersonationResult struct {
+	UserID      string
+	Email       string
+	Username    string
+	ActorID     string
+	SessionID   string
+	AccessToken string
+	ExpiresAt   time.Time
+	ExpiresIn   int64
+}
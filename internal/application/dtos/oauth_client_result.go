@@ -0,0 +1,25 @@
+package dtos
+
+type OAuthClientResult struct {
+	ID           string
+	ClientID     string
+	Name         string
+	RedirectURIs []string
+	Scopes       []string
+	Public       bool
+	IsActive     bool
+}
+
+// OAuthClientSecretResult carries the plain client secret. It is only
+// produced on registration and rotation; the secret is not recoverable later.
+type OAuthClientSecretResult struct {
+	OAuthClientResult
+	ClientSecret string
+}
+
+type ListOAuthClientsResult struct {
+	Clients    []OAuthClientResult
+	TotalCount int64
+	Page       int
+	PageSize   int
+}
@@ -0,0 +1,49 @@
+package dtos
+
+import "time"
+
+// OrganizationResult describes an organization from the caller's point of
+// view; Role is the caller's own role in it.
+type OrganizationResult struct {
+	ID        string
+	Name      string
+	OwnerID   string
+	Role      string
+	CreatedAt time.Time
+}
+
+type ListOrganizationsResult struct {
+	Organizations []OrganizationResult
+}
+
+type OrganizationMemberResult struct {
+	UserID    string
+	Role      string
+	InvitedBy string
+	JoinedAt  time.Time
+}
+
+type InvitationResult struct {
+	ID             string
+	OrganizationID string
+	Email          string
+	Role           string
+	InvitedBy      string
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+}
+
+// ListOrganizationMembersResult only includes pending invitations for
+// callers who may manage members.
+type ListOrganizationMembersResult struct {
+	Members     []OrganizationMemberResult
+	Invitations []InvitationResult
+}
+
+type OrganizationTokenResult struct {
+	AccessToken    string
+	TokenType      string
+	ExpiresAt      time.Time
+	OrganizationID string
+	Role           string
+}
@@ -0,0 +1,15 @@
+package dtos
+
+import "time"
+
+// ReauthenticationResult is either the upgraded access token or, when the
+// command only asked for a code, OTPSent with no token.
+type ReauthenticationResult struct {
+	OTPSent     bool
+	AccessToken string
+	TokenType   string
+	ExpiresAt   time.Time
+	AuthTime    time.Time
+	AuthMethods []string
+	AuthLevel   string
+}
@@ -0,0 +1,25 @@
+package dtos
+
+import "time"
+
+type RoleResult struct {
+	Name        string
+	Description string
+	Permissions []string
+	Parents     []string
+	IsSystem    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ListRolesResult struct {
+	Roles []RoleResult
+}
+
+// UserPermissionsResult lists the roles a user holds, primary role first,
+// and the permissions those roles resolve to.
+type UserPermissionsResult struct {
+	UserID      string
+	Roles       []string
+	Permissions []string
+}
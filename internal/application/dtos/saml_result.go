@@ -0,0 +1,46 @@
+package dtos
+
+import "time"
+
+type SAMLConnectionResult struct {
+	ID               string
+	OrganizationID   string
+	Name             string
+	IdPEntityID      string
+	SSOURL           string
+	AttributeMapping map[string]string
+	Domains          []string
+	JITProvisioning  bool
+	DefaultRole      string
+	IsActive         bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type ListSAMLConnectionsResult struct {
+	Connections []SAMLConnectionResult
+}
+
+type SAMLMetadataResult struct {
+	XML []byte
+}
+
+type SAMLLoginRequestResult struct {
+	RedirectURL string
+}
+
+type SAMLLoginResult struct {
+	UserID         string
+	Email          string
+	FirstName      string
+	LastName       string
+	Role           string
+	IsNewUser      bool
+	ConnectionID   string
+	OrganizationID string
+	AccessToken    string
+	RefreshToken   string
+	ExpiresAt      int64
+	ExpiresIn      int64
+	SessionID      string
+}
@@ -0,0 +1,58 @@
+package dtos
+
+import "time"
+
+type SCIMUserResult struct {
+	ID         string
+	UserName   string
+	Email      string
+	GivenName  string
+	FamilyName string
+	Phone      string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ListSCIMUsersResult struct {
+	Users        []SCIMUserResult
+	TotalResults int64
+	StartIndex   int
+	ItemsPerPage int
+}
+
+type SCIMGroupResult struct {
+	ID          string
+	DisplayName string
+	Members     []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ListSCIMGroupsResult struct {
+	Groups       []SCIMGroupResult
+	TotalResults int64
+	StartIndex   int
+	ItemsPerPage int
+}
+
+type SCIMTokenResult struct {
+	ID            string
+	Name          string
+	DisplayPrefix string
+	CreatedBy     string
+	LastUsedAt    *time.Time
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+}
+
+// SCIMTokenSecretResult carries the plain token, which is only available
+// when it is created.
+type SCIMTokenSecretResult struct {
+	SCIMTokenResult
+	Token string
+}
+
+type ListSCIMTokensResult struct {
+	Tokens []SCIMTokenResult
+}
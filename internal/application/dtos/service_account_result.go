@@ -0,0 +1,28 @@
+package dtos
+
+import "time"
+
+type ServiceAccountResult struct {
+	ID                string
+	Name              string
+	ClientID          string
+	Role              string
+	Scopes            []string
+	UsesPrivateKeyJWT bool
+	IsActive          bool
+	CreatedAt         time.Time
+}
+
+// ServiceAccountSecretResult carries the plain client secret. It is only
+// produced on creation and rotation.
+type ServiceAccountSecretResult struct {
+	ServiceAccountResult
+	ClientSecret string
+}
+
+type ListServiceAccountsResult struct {
+	ServiceAccounts []ServiceAccountResult
+	TotalCount      int64
+	Page            int
+	PageSize        int
+}
@@ -0,0 +1,9 @@
+package dtos
+
+// SignInReportResult describes what a "this wasn't me" report changed.
+type SignInReportResult struct {
+	UserID                string
+	SessionID             string
+	Scope                 string
+	PasswordResetRequired bool
+}
@@ -0,0 +1,19 @@
+package dtos
+
+// TokenIntrospectionResult mirrors the RFC 7662 response. Only Active is
+// set for tokens that are expired, revoked or otherwise unknown.
+type TokenIntrospectionResult struct {
+	Active    bool
+	Scope     string
+	Username  string
+	TokenType string
+	Subject   string
+	SessionID string
+	TokenID   string
+	ExpiresAt int64
+	IssuedAt  int64
+}
+
+type RevokeTokenResult struct {
+	Revoked bool
+}
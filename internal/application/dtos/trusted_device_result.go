@@ -0,0 +1,18 @@
+package dtos
+
+import "time"
+
+type TrustedDeviceResult struct {
+	ID         string
+	DeviceID   string
+	Name       string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+type ListTrustedDevicesResult struct {
+	Devices []TrustedDeviceResult
+}
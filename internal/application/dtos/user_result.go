@@ -0,0 +1,41 @@
+package dtos
+
+import "time"
+
+type UserResult struct {
+	ID            string
+	Username      string
+	Email         string
+	Phone         string
+	PhoneVerified bool
+	FirstName     string
+	LastName      string
+	Role          string
+	Provider      string
+	IsActive      bool
+	IsVerified    bool
+	LastLoginAt   *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	Version       int
+}
+
+type ListUsersResult struct {
+	Users      []UserResult
+	TotalCount int64
+	Page       int
+	PageSize   int
+}
+
+// PhoneVerificationResult names the number a verification code was sent to.
+type PhoneVerificationResult struct {
+	UserID string
+	Phone  string
+}
+
+// EmailChangeResult describes a pending email change awaiting confirmation.
+type EmailChangeResult struct {
+	UserID    string
+	NewEmail  string
+	ExpiresAt time.Time
+}
@@ -12,4 +12,7 @@ type VerifyLoginOTPResult struct {
 	ExpiresAt    string
 	ExpiresIn    int64
 	SessionID    string
-}
\ No newline at end of file
+	// TrustedDeviceToken is set when the device was trusted by this login.
+	TrustedDeviceToken     string
+	TrustedDeviceExpiresAt string
+}
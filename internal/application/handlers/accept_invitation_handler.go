@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type AcceptInvitationHandler struct {
+	orgRepo        repositories.OrganizationRepository
+	invitationRepo repositories.InvitationRepository
+	userRepo       repositories.UserRepository
+	auditRepo      repositories.AuditRepository
+	uow            persistence.UnitOfWork
+	signer         services.InvitationSigner
+	logger         logging.Logger
+}
+
+func NewAcceptInvitationHandler(
+	orgRepo repositories.OrganizationRepository,
+	invitationRepo repositories.InvitationRepository,
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	signer services.InvitationSigner,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.AcceptInvitationCommand, dtos.OrganizationResult] {
+	return &AcceptInvitationHandler{
+		orgRepo:        orgRepo,
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		auditRepo:      auditRepo,
+		uow:            uow,
+		signer:         signer,
+		logger:         logger.With(zap.String("handler", "accept_invitation")),
+	}
+}
+
+func (h *AcceptInvitationHandler) Handle(
+	ctx context.Context,
+	cmd commands.AcceptInvitationCommand,
+) (dtos.OrganizationResult, error) {
+	invitationID, err := h.signer.Verify(cmd.Token)
+	if err != nil {
+		return dtos.OrganizationResult{}, err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, cmd.ActorID)
+	if err != nil {
+		return dtos.OrganizationResult{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return dtos.OrganizationResult{}, domain.ErrUserNotFound
+	}
+
+	var (
+		org        *aggregates.Organization
+		invitation *aggregates.Invitation
+	)
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		invitation, err = h.invitationRepo.FindByID(ctx, invitationID)
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return domain.ErrInvitationNotFound
+			}
+			return fmt.Errorf("failed to find invitation: %w", err)
+		}
+
+		if err := invitation.Accept(user.ID(), user.User.Email); err != nil {
+			return err
+		}
+
+		org, err = h.orgRepo.FindByID(ctx, invitation.OrganizationID)
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return domain.ErrOrganizationNotFound
+			}
+			return fmt.Errorf("failed to find organization: %w", err)
+		}
+
+		_, err = h.orgRepo.FindMember(ctx, org.ID(), user.ID())
+		if err == nil {
+			return domain.ErrAlreadyOrganizationMember
+		}
+		if !repositories.IsNotFoundError(err) {
+			return fmt.Errorf("failed to find organization member: %w", err)
+		}
+
+		member := entities.NewOrganizationMember(org.ID(), user.ID(), invitation.Role, invitation.InvitedBy)
+		if err := h.orgRepo.AddMember(ctx, member); err != nil {
+			return fmt.Errorf("failed to add organization member: %w", err)
+		}
+
+		if err := h.invitationRepo.Update(ctx, invitation); err != nil {
+			return fmt.Errorf("failed to update invitation: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OrganizationResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOrgInvitationAccepted,
+		"organization",
+		org.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"invitation_id": invitation.ID(),
+			"role":          invitation.Role.String(),
+			"invited_by":    invitation.InvitedBy,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record invitation audit log",
+			zap.Error(err),
+			zap.String("invitation_id", invitation.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "Organization invitation accepted",
+		zap.String("organization_id", org.ID()),
+		zap.String("user_id", cmd.ActorID),
+	)
+
+	return toOrganizationResult(org, invitation.Role), nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ActivateUserHandler struct {
+	userRepo  repositories.UserRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewActivateUserHandler(
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.ActivateUserCommand, dtos.UserResult] {
+	return &ActivateUserHandler{
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "activate_user")),
+	}
+}
+
+func (h *ActivateUserHandler) Handle(ctx context.Context, cmd commands.ActivateUserCommand) (dtos.UserResult, error) {
+	var (
+		result    dtos.UserResult
+		activated bool
+	)
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if !user.User.IsActive {
+			user.Activate()
+			if err := h.userRepo.Update(ctx, user); err != nil {
+				return fmt.Errorf("failed to activate user: %w", err)
+			}
+			activated = true
+		}
+
+		result = toUserResult(user)
+		return nil
+	})
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	if activated {
+		recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, valueobjects.AuditActionUserActivated,
+			result.ID, cmd.IPAddress, cmd.UserAgent, nil)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type AssignRoleHandler struct {
+	userRepo   repositories.UserRepository
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewAssignRoleHandler(
+	userRepo repositories.UserRepository,
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.AssignRoleCommand, dtos.UserPermissionsResult] {
+	return &AssignRoleHandler{
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "assign_role")),
+	}
+}
+
+func (h *AssignRoleHandler) Handle(ctx context.Context, cmd commands.AssignRoleCommand) (dtos.UserPermissionsResult, error) {
+	if err := h.authorizer.Authorize(ctx, cmd.ActorID, valueobjects.PermissionRolesManage); err != nil {
+		return dtos.UserPermissionsResult{}, err
+	}
+	if cmd.ActorID == cmd.UserID {
+		return dtos.UserPermissionsResult{}, domain.ErrSelfAdministration
+	}
+
+	var role *aggregates.Role
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := h.userRepo.FindByID(ctx, cmd.UserID)
+		if err != nil && !repositories.IsNotFoundError(err) {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if user == nil {
+			return domain.ErrUserNotFound
+		}
+
+		role, err = findRole(ctx, h.roleRepo, cmd.Role)
+		if err != nil {
+			return err
+		}
+
+		if err := checkRoleGrantable(ctx, h.authorizer, h.roleRepo, cmd.ActorID, role); err != nil {
+			return err
+		}
+
+		return h.roleRepo.AssignToUser(ctx, cmd.UserID, role.Name, cmd.ActorID)
+	})
+	if err != nil {
+		return dtos.UserPermissionsResult{}, err
+	}
+
+	if err := h.authorizer.InvalidateUser(ctx, cmd.UserID); err != nil {
+		h.logger.Error(ctx, "Failed to invalidate cached permissions",
+			zap.Error(err),
+			zap.String("user_id", cmd.UserID),
+		)
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionRoleAssigned,
+		"user",
+		cmd.UserID,
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"role": role.Name.String()},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record role audit log",
+			zap.Error(err),
+			zap.String("user_id", cmd.UserID),
+		)
+	}
+
+	return userPermissions(ctx, h.userRepo, h.roleRepo, h.authorizer, cmd.UserID)
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ChangeUserRoleHandler struct {
+	userRepo   repositories.UserRepository
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewChangeUserRoleHandler(
+	userRepo repositories.UserRepository,
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.ChangeUserRoleCommand, dtos.UserResult] {
+	return &ChangeUserRoleHandler{
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "change_user_role")),
+	}
+}
+
+func (h *ChangeUserRoleHandler) Handle(ctx context.Context, cmd commands.ChangeUserRoleCommand) (dtos.UserResult, error) {
+	// An administrator demoting themselves could leave the tenant without one.
+	if cmd.ActorID == cmd.UserID {
+		return dtos.UserResult{}, domain.ErrSelfAdministration
+	}
+
+	var (
+		result   dtos.UserResult
+		previous valueobjects.Role
+	)
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		role, err := findRole(ctx, h.roleRepo, cmd.Role)
+		if err != nil {
+			return err
+		}
+
+		previous = user.User.Role
+		if previous != role.Name {
+			user.ChangeRole(role.Name)
+			if err := h.userRepo.Update(ctx, user); err != nil {
+				return fmt.Errorf("failed to update user role: %w", err)
+			}
+		}
+
+		result = toUserResult(user)
+		return nil
+	})
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	if previous.String() == result.Role {
+		return result, nil
+	}
+
+	if err := h.authorizer.InvalidateUser(ctx, cmd.UserID); err != nil {
+		h.logger.Error(ctx, "Failed to invalidate cached permissions",
+			zap.Error(err),
+			zap.String("user_id", cmd.UserID),
+		)
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, valueobjects.AuditActionUserRoleChanged,
+		result.ID, cmd.IPAddress, cmd.UserAgent, map[string]interface{}{
+			"previous_role": previous.String(),
+			"role":          result.Role,
+		})
+
+	return result, nil
+}
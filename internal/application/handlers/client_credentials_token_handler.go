@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ClientCredentialsTokenHandler struct {
+	accountRepo       repositories.ServiceAccountRepository
+	auditRepo         repositories.AuditRepository
+	tokenIssuer       services.ServiceTokenIssuer
+	assertionVerifier services.ClientAssertionVerifier
+	secretService     domainServices.SecretService
+	tokenEndpoint     string
+	logger            logging.Logger
+}
+
+// NewClientCredentialsTokenHandler takes the public token endpoint URL, which
+// private_key_jwt assertions must name as their audience.
+func NewClientCredentialsTokenHandler(
+	accountRepo repositories.ServiceAccountRepository,
+	auditRepo repositories.AuditRepository,
+	tokenIssuer services.ServiceTokenIssuer,
+	assertionVerifier services.ClientAssertionVerifier,
+	secretService domainServices.SecretService,
+	tokenEndpoint string,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.ClientCredentialsTokenCommand, dtos.OAuthTokenResult] {
+	return &ClientCredentialsTokenHandler{
+		accountRepo:       accountRepo,
+		auditRepo:         auditRepo,
+		tokenIssuer:       tokenIssuer,
+		assertionVerifier: assertionVerifier,
+		secretService:     secretService,
+		tokenEndpoint:     tokenEndpoint,
+		logger:            logger.With(zap.String("handler", "client_credentials_token")),
+	}
+}
+
+func (h *ClientCredentialsTokenHandler) Handle(
+	ctx context.Context,
+	cmd commands.ClientCredentialsTokenCommand,
+) (dtos.OAuthTokenResult, error) {
+	account, err := h.authenticate(ctx, cmd)
+	if err != nil {
+		h.logger.Warn(ctx, "Service account authentication failed",
+			zap.String("client_id", cmd.ClientID),
+			zap.String("ip_address", cmd.IPAddress),
+			zap.Error(err),
+		)
+		return dtos.OAuthTokenResult{}, err
+	}
+
+	scopes, err := resolveScopes(account.Scopes, cmd.Scope)
+	if err != nil {
+		return dtos.OAuthTokenResult{}, err
+	}
+
+	token, err := h.tokenIssuer.GenerateServiceToken(account.TenantID, account.ClientID, account.Role.String(), scopes)
+	if err != nil {
+		return dtos.OAuthTokenResult{}, fmt.Errorf("failed to generate service token: %w", err)
+	}
+
+	authMethod := "client_secret"
+	if cmd.ClientAssertion != "" {
+		authMethod = "private_key_jwt"
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		"",
+		valueobjects.AuditActionServiceTokenIssued,
+		"service_account",
+		account.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"client_id":   account.ClientID,
+			"scopes":      scopes,
+			"auth_method": authMethod,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record service token audit log",
+			zap.Error(err),
+			zap.String("client_id", account.ClientID),
+		)
+	}
+
+	return dtos.OAuthTokenResult{
+		AccessToken: token.Value(),
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(token.RemainingValidity().Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+// authenticate accepts private_key_jwt for accounts with a registered public
+// key and client_secret otherwise. Every failure collapses to ErrInvalidClient
+// so callers cannot probe which part was wrong.
+func (h *ClientCredentialsTokenHandler) authenticate(
+	ctx context.Context,
+	cmd commands.ClientCredentialsTokenCommand,
+) (*aggregates.ServiceAccount, error) {
+	if cmd.ClientID == "" {
+		return nil, domain.ErrInvalidClient
+	}
+
+	account, err := h.accountRepo.FindByClientID(ctx, cmd.ClientID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrInvalidClient
+		}
+		return nil, fmt.Errorf("failed to find service account: %w", err)
+	}
+
+	if !account.IsActive {
+		return nil, domain.ErrInvalidClient
+	}
+
+	if cmd.ClientAssertion != "" {
+		if cmd.ClientAssertionType != services.ClientAssertionTypeJWTBearer || !account.UsesPrivateKeyJWT() {
+			return nil, domain.ErrInvalidClient
+		}
+		if err := h.assertionVerifier.Verify(ctx, cmd.ClientAssertion, account.ClientID, account.PublicKeyPEM, h.tokenEndpoint); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidClient, err)
+		}
+		return account, nil
+	}
+
+	if account.UsesPrivateKeyJWT() {
+		return nil, domain.ErrInvalidClient
+	}
+	if cmd.ClientSecret == "" || !h.secretService.Verify(cmd.ClientSecret, account.SecretHash) {
+		return nil, domain.ErrInvalidClient
+	}
+	return account, nil
+}
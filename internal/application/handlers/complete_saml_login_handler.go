@@ -0,0 +1,356 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type CompleteSAMLLoginHandler struct {
+	connRepo     repositories.SAMLConnectionRepository
+	userRepo     repositories.UserRepository
+	sessionRepo  repositories.SessionRepository
+	orgRepo      repositories.OrganizationRepository
+	auditRepo    repositories.AuditRepository
+	uow          persistence.UnitOfWork
+	cache        persistence.Cache
+	sp           services.SAMLServiceProvider
+	tokenService services.TokenService
+	logger       logging.Logger
+}
+
+func NewCompleteSAMLLoginHandler(
+	connRepo repositories.SAMLConnectionRepository,
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	orgRepo repositories.OrganizationRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	cache persistence.Cache,
+	sp services.SAMLServiceProvider,
+	tokenService services.TokenService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CompleteSAMLLoginCommand, dtos.SAMLLoginResult] {
+	return &CompleteSAMLLoginHandler{
+		connRepo:     connRepo,
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		orgRepo:      orgRepo,
+		auditRepo:    auditRepo,
+		uow:          uow,
+		cache:        cache,
+		sp:           sp,
+		tokenService: tokenService,
+		logger:       logger.With(zap.String("handler", "complete_saml_login")),
+	}
+}
+
+func (h *CompleteSAMLLoginHandler) Handle(
+	ctx context.Context,
+	cmd commands.CompleteSAMLLoginCommand,
+) (dtos.SAMLLoginResult, error) {
+	pending, err := takeSAMLRequest(ctx, h.cache, cmd.RelayState)
+	if err != nil {
+		return dtos.SAMLLoginResult{}, err
+	}
+	if pending.ConnectionID != cmd.ConnectionID {
+		return dtos.SAMLLoginResult{}, domain.ErrSAMLRequestNotFound
+	}
+
+	// The IdP posts back without the tenant header, so the tenant recorded
+	// when the request was issued takes over unless the host named another.
+	if tenantID, ok := utils.TenantIDFromContext(ctx); ok && tenantID != pending.TenantID {
+		return dtos.SAMLLoginResult{}, domain.ErrTenantMismatch
+	}
+	if pending.TenantID != "" {
+		ctx = utils.WithTenantID(ctx, pending.TenantID)
+	}
+
+	conn, err := findSAMLConnection(ctx, h.connRepo, cmd.ConnectionID)
+	if err != nil {
+		return dtos.SAMLLoginResult{}, err
+	}
+	if !conn.IsActive {
+		return dtos.SAMLLoginResult{}, domain.ErrSAMLConnectionInactive
+	}
+
+	assertion, err := h.sp.ValidateResponse(conn.ID(), samlIdentityProvider(conn), cmd.SAMLResponse, pending.RequestID)
+	if err != nil {
+		h.logger.Warn(ctx, "Rejected saml response",
+			zap.String("connection_id", conn.ID()),
+			zap.Error(err),
+		)
+		return dtos.SAMLLoginResult{}, err
+	}
+
+	if err := markSAMLAssertionUsed(ctx, h.cache, conn.ID(), assertion.ID, assertion.NotOnOrAfter); err != nil {
+		return dtos.SAMLLoginResult{}, err
+	}
+
+	fields := conn.MapAttributes(assertion.NameID, assertion.Attributes)
+	if fields[aggregates.SAMLFieldEmail] == "" {
+		return dtos.SAMLLoginResult{}, domain.ErrSAMLMissingEmail
+	}
+	email, err := valueobjects.NewEmail(fields[aggregates.SAMLFieldEmail])
+	if err != nil {
+		return dtos.SAMLLoginResult{}, err
+	}
+
+	var (
+		user      *aggregates.UserAggregate
+		session   *entities.Session
+		tokenPair *services.TokenPair
+		isNewUser bool
+	)
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		var (
+			err    error
+			linked bool
+		)
+		user, linked, err = h.resolveUser(ctx, conn, assertion.NameID, email)
+		if err != nil {
+			return err
+		}
+
+		if user == nil {
+			if !conn.JITProvisioning {
+				return domain.ErrSAMLProvisioningDisabled
+			}
+			user = aggregates.NewOAuthUserAggregate(
+				email,
+				fields[aggregates.SAMLFieldFirstName],
+				fields[aggregates.SAMLFieldLastName],
+				aggregates.SAMLUserProvider,
+				conn.DefaultRole,
+			)
+			isNewUser = true
+		} else if user.User.IsOAuthUser() && user.User.Provider() == aggregates.SAMLUserProvider {
+			// The IdP owns the profile of users it provisioned.
+			if first := fields[aggregates.SAMLFieldFirstName]; first != "" {
+				user.User.FirstName = first
+			}
+			if last := fields[aggregates.SAMLFieldLastName]; last != "" {
+				user.User.LastName = last
+			}
+		}
+		h.applyOptionalFields(ctx, user, fields)
+
+		if !user.User.IsActive {
+			return domain.ErrInactiveUser
+		}
+
+		if isNewUser {
+			if err := h.userRepo.Create(ctx, user); err != nil {
+				return fmt.Errorf("failed to create user: %w", err)
+			}
+		}
+		if !linked && assertion.NameID != "" {
+			identity := entities.NewSAMLIdentity(conn.ID(), assertion.NameID, user.ID())
+			if err := h.connRepo.LinkIdentity(ctx, identity); err != nil {
+				return fmt.Errorf("failed to link saml identity: %w", err)
+			}
+		}
+
+		if conn.OrganizationID != "" {
+			if err := h.ensureMembership(ctx, conn.OrganizationID, user.ID()); err != nil {
+				return err
+			}
+		}
+
+		tokenPair, err = h.tokenService.Generate(
+			ctx,
+			user.ID(),
+			user.User.Role.String(),
+			user.User.Email.String(),
+			services.SessionMetadata{
+				IPAddress:   cmd.IPAddress,
+				UserAgent:   cmd.UserAgent,
+				DeviceID:    cmd.DeviceID,
+				AuthMethods: []valueobjects.AuthMethod{valueobjects.AuthMethodOAuth},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate tokens: %w", err)
+		}
+
+		session = user.Login(
+			cmd.IPAddress,
+			cmd.UserAgent,
+			tokenPair.RefreshToken,
+			tokenPair.AccessToken,
+			tokenPair.ExpiresAt,
+		)
+		if err := h.sessionRepo.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.SAMLLoginResult{}, err
+	}
+
+	h.recordAudit(ctx, valueobjects.AuditActionSAMLLogin, user, conn, cmd)
+	if isNewUser {
+		h.recordAudit(ctx, valueobjects.AuditActionSAMLUserProvisioned, user, conn, cmd)
+	}
+
+	h.logger.Info(ctx, "SAML user logged in",
+		zap.String("user_id", user.ID()),
+		zap.String("connection_id", conn.ID()),
+		zap.Bool("provisioned", isNewUser),
+	)
+
+	return dtos.SAMLLoginResult{
+		UserID:         user.ID(),
+		Email:          user.User.Email.String(),
+		FirstName:      user.User.FirstName,
+		LastName:       user.User.LastName,
+		Role:           user.User.Role.String(),
+		IsNewUser:      isNewUser,
+		ConnectionID:   conn.ID(),
+		OrganizationID: conn.OrganizationID,
+		AccessToken:    tokenPair.AccessToken,
+		RefreshToken:   tokenPair.RefreshToken,
+		ExpiresAt:      tokenPair.ExpiresAt.Unix(),
+		ExpiresIn:      tokenPair.ExpiresIn,
+		SessionID:      session.ID,
+	}, nil
+}
+
+// resolveUser finds the account the assertion signs in as, reporting whether
+// it is already linked to the asserted subject. A nil user means none exists
+// yet. Anyone able to configure an identity provider can assert any email,
+// so an existing account found by email is only accepted when it is already
+// bound to this connection or its domain is one the connection covers.
+func (h *CompleteSAMLLoginHandler) resolveUser(
+	ctx context.Context,
+	conn *aggregates.SAMLConnection,
+	subject string,
+	email valueobjects.Email,
+) (*aggregates.UserAggregate, bool, error) {
+	if subject != "" {
+		identity, err := h.connRepo.FindIdentity(ctx, conn.ID(), subject)
+		if err != nil && !repositories.IsNotFoundError(err) {
+			return nil, false, fmt.Errorf("failed to find saml identity: %w", err)
+		}
+		if identity != nil {
+			user, err := h.userRepo.FindByID(ctx, identity.UserID)
+			if err != nil && !repositories.IsNotFoundError(err) {
+				return nil, false, fmt.Errorf("failed to find user: %w", err)
+			}
+			if user != nil {
+				return user, true, nil
+			}
+		}
+	}
+
+	user, err := h.userRepo.FindByEmail(ctx, email)
+	if err != nil && !repositories.IsNotFoundError(err) && err != domain.ErrUserNotFound {
+		return nil, false, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, false, nil
+	}
+
+	if !conn.CoversEmail(email) {
+		bound, err := h.connRepo.HasIdentity(ctx, conn.ID(), user.ID())
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to check saml identity: %w", err)
+		}
+		if !bound {
+			h.logger.Warn(ctx, "Refused to link saml login to an existing account",
+				zap.String("connection_id", conn.ID()),
+				zap.String("user_id", user.ID()),
+			)
+			return nil, false, domain.ErrSAMLAccountNotLinked
+		}
+	}
+	return user, false, nil
+}
+
+// applyOptionalFields fills username and phone from the assertion when the
+// user has none yet. Values that fail validation are skipped rather than
+// failing the login.
+func (h *CompleteSAMLLoginHandler) applyOptionalFields(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	fields map[string]string,
+) {
+	if value := fields[aggregates.SAMLFieldUsername]; value != "" && user.User.Username.String() == "" {
+		if username, err := valueobjects.NewUsername(value); err == nil {
+			user.User.Username = username
+		} else {
+			h.logger.Warn(ctx, "Ignoring invalid username from saml assertion", zap.Error(err))
+		}
+	}
+	if value := fields[aggregates.SAMLFieldPhone]; value != "" && user.User.Phone.String() == "" {
+		if phone, err := valueobjects.NewPhoneNumber(value); err == nil {
+			user.User.Phone = phone
+		} else {
+			h.logger.Warn(ctx, "Ignoring invalid phone number from saml assertion", zap.Error(err))
+		}
+	}
+}
+
+func (h *CompleteSAMLLoginHandler) ensureMembership(ctx context.Context, organizationID, userID string) error {
+	_, err := h.orgRepo.FindMember(ctx, organizationID, userID)
+	if err == nil {
+		return nil
+	}
+	if !repositories.IsNotFoundError(err) {
+		return fmt.Errorf("failed to find organization member: %w", err)
+	}
+
+	member := entities.NewOrganizationMember(organizationID, userID, valueobjects.OrgRoleMember, "")
+	if err := h.orgRepo.AddMember(ctx, member); err != nil {
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+	return nil
+}
+
+func (h *CompleteSAMLLoginHandler) recordAudit(
+	ctx context.Context,
+	action valueobjects.AuditAction,
+	user *aggregates.UserAggregate,
+	conn *aggregates.SAMLConnection,
+	cmd commands.CompleteSAMLLoginCommand,
+) {
+	auditLog := aggregates.NewAuditLog(
+		user.ID(),
+		action,
+		"user",
+		user.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"connection_id":   conn.ID(),
+			"idp_entity_id":   conn.IdPEntityID,
+			"organization_id": conn.OrganizationID,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record saml login audit log",
+			zap.Error(err),
+			zap.String("user_id", user.ID()),
+		)
+	}
+}
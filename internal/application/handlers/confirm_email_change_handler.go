@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const emailChangedTemplate = "email_changed"
+
+type ConfirmEmailChangeHandler struct {
+	userRepo     repositories.UserRepository
+	auditRepo    repositories.AuditRepository
+	uow          persistence.UnitOfWork
+	signer       services.EmailChangeSigner
+	emailService services.EmailService
+	logger       logging.Logger
+}
+
+func NewConfirmEmailChangeHandler(
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	signer services.EmailChangeSigner,
+	emailService services.EmailService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.ConfirmEmailChangeCommand, dtos.UserResult] {
+	return &ConfirmEmailChangeHandler{
+		userRepo:     userRepo,
+		auditRepo:    auditRepo,
+		uow:          uow,
+		signer:       signer,
+		emailService: emailService,
+		logger:       logger.With(zap.String("handler", "confirm_email_change")),
+	}
+}
+
+func (h *ConfirmEmailChangeHandler) Handle(
+	ctx context.Context,
+	cmd commands.ConfirmEmailChangeCommand,
+) (dtos.UserResult, error) {
+	change, err := h.signer.Verify(cmd.Token)
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+	if change.UserID != cmd.ActorID {
+		return dtos.UserResult{}, domain.ErrInvalidEmailChangeToken
+	}
+
+	newEmail, err := valueobjects.NewEmail(change.NewEmail)
+	if err != nil {
+		return dtos.UserResult{}, domain.ErrInvalidEmailChangeToken
+	}
+
+	var result dtos.UserResult
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, change.UserID)
+		if err != nil {
+			return err
+		}
+
+		// The token names the address it replaces, so once any change is
+		// confirmed every other outstanding link stops working.
+		if user.User.Email.String() != change.CurrentEmail {
+			return domain.ErrEmailChangeSuperseded
+		}
+		if identityManagedExternally(user) {
+			return domain.ErrIdentityManagedExternally
+		}
+		if err := ensureEmailAvailable(ctx, h.userRepo, user.ID(), newEmail); err != nil {
+			return err
+		}
+
+		user.ChangeIdentity(user.User.Username, newEmail)
+		user.VerifyEmail()
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to change email: %w", err)
+		}
+
+		result = toUserResult(user)
+		return nil
+	})
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, valueobjects.AuditActionEmailChanged,
+		result.ID, cmd.IPAddress, cmd.UserAgent, map[string]interface{}{
+			"previous_email": change.CurrentEmail,
+			"email":          result.Email,
+		})
+
+	// Tell the old address, so an unexpected change is noticed.
+	err = h.emailService.SendEmail(ctx, services.SendEmailInput{
+		To:       change.CurrentEmail,
+		Subject:  "Your email address was changed",
+		Template: emailChangedTemplate,
+		Data: map[string]interface{}{
+			"username":  result.Username,
+			"new_email": result.Email,
+		},
+	})
+	if err != nil {
+		h.logger.Error(ctx, "Failed to notify previous email address",
+			zap.Error(err),
+			zap.String("user_id", result.ID),
+		)
+	}
+
+	return result, nil
+}
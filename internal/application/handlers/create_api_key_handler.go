@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// apiKeyDisplayLength is how much of the key, prefix included, is kept in
+// the clear so users can tell their keys apart.
+const apiKeyDisplayLength = 12
+
+type CreateAPIKeyHandler struct {
+	keyRepo       repositories.APIKeyRepository
+	auditRepo     repositories.AuditRepository
+	uow           persistence.UnitOfWork
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewCreateAPIKeyHandler(
+	keyRepo repositories.APIKeyRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateAPIKeyCommand, dtos.APIKeySecretResult] {
+	return &CreateAPIKeyHandler{
+		keyRepo:       keyRepo,
+		auditRepo:     auditRepo,
+		uow:           uow,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "create_api_key")),
+	}
+}
+
+func (h *CreateAPIKeyHandler) Handle(
+	ctx context.Context,
+	cmd commands.CreateAPIKeyCommand,
+) (dtos.APIKeySecretResult, error) {
+	scopes, err := valueobjects.NewScopes(cmd.Scopes)
+	if err != nil {
+		return dtos.APIKeySecretResult{}, err
+	}
+
+	secret, err := h.secretService.Generate(domainServices.DefaultSecretLength)
+	if err != nil {
+		return dtos.APIKeySecretResult{}, fmt.Errorf("failed to generate api key: %w", err)
+	}
+	plainKey := aggregates.APIKeyPrefix + secret
+
+	key, err := aggregates.NewAPIKey(
+		cmd.UserID,
+		cmd.Name,
+		h.secretService.Hash(plainKey),
+		plainKey[:apiKeyDisplayLength],
+		valueobjects.ScopeStrings(scopes),
+		cmd.AllowedIPs,
+		cmd.ExpiresAt,
+	)
+	if err != nil {
+		return dtos.APIKeySecretResult{}, err
+	}
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		return h.keyRepo.Create(ctx, key)
+	})
+	if err != nil {
+		return dtos.APIKeySecretResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.UserID,
+		valueobjects.AuditActionAPIKeyCreated,
+		"api_key",
+		key.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"name":        key.Name,
+			"scopes":      key.Scopes,
+			"allowed_ips": key.AllowedIPs,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record api key audit log",
+			zap.Error(err),
+			zap.String("key_id", key.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "API key created",
+		zap.String("key_id", key.ID()),
+		zap.String("user_id", cmd.UserID),
+	)
+
+	return dtos.APIKeySecretResult{
+		APIKeyResult: toAPIKeyResult(key),
+		Key:          plainKey,
+	}, nil
+}
+
+func toAPIKeyResult(key *aggregates.APIKey) dtos.APIKeyResult {
+	return dtos.APIKeyResult{
+		ID:            key.ID(),
+		UserID:        key.UserID,
+		Name:          key.Name,
+		DisplayPrefix: key.DisplayPrefix,
+		Scopes:        key.Scopes,
+		AllowedIPs:    key.AllowedIPs,
+		ExpiresAt:     key.ExpiresAt,
+		LastUsedAt:    key.LastUsedAt,
+		RevokedAt:     key.RevokedAt,
+		CreatedAt:     key.CreatedAt,
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type CreateOrganizationHandler struct {
+	orgRepo   repositories.OrganizationRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewCreateOrganizationHandler(
+	orgRepo repositories.OrganizationRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateOrganizationCommand, dtos.OrganizationResult] {
+	return &CreateOrganizationHandler{
+		orgRepo:   orgRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "create_organization")),
+	}
+}
+
+func (h *CreateOrganizationHandler) Handle(
+	ctx context.Context,
+	cmd commands.CreateOrganizationCommand,
+) (dtos.OrganizationResult, error) {
+	org, err := aggregates.NewOrganization(cmd.Name, cmd.ActorID)
+	if err != nil {
+		return dtos.OrganizationResult{}, err
+	}
+	owner := entities.NewOrganizationMember(org.ID(), cmd.ActorID, valueobjects.OrgRoleOwner, "")
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		if err := h.orgRepo.Create(ctx, org); err != nil {
+			return err
+		}
+		if err := h.orgRepo.AddMember(ctx, owner); err != nil {
+			return fmt.Errorf("failed to add organization owner: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OrganizationResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOrganizationCreated,
+		"organization",
+		org.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"name": org.Name},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record organization audit log",
+			zap.Error(err),
+			zap.String("organization_id", org.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "Organization created",
+		zap.String("organization_id", org.ID()),
+		zap.String("owner_id", cmd.ActorID),
+	)
+
+	return toOrganizationResult(org, owner.Role), nil
+}
+
+// findMembership loads an organization together with userID's membership in
+// it. Organizations the user does not belong to are reported as not found so
+// their ids cannot be probed.
+func findMembership(
+	ctx context.Context,
+	orgRepo repositories.OrganizationRepository,
+	organizationID, userID string,
+) (*aggregates.Organization, *entities.OrganizationMember, error) {
+	org, err := orgRepo.FindByID(ctx, organizationID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, nil, domain.ErrOrganizationNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to find organization: %w", err)
+	}
+
+	member, err := orgRepo.FindMember(ctx, organizationID, userID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, nil, domain.ErrOrganizationNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to find organization member: %w", err)
+	}
+
+	return org, member, nil
+}
+
+func toOrganizationResult(org *aggregates.Organization, role valueobjects.OrgRole) dtos.OrganizationResult {
+	return dtos.OrganizationResult{
+		ID:        org.ID(),
+		Name:      org.Name,
+		OwnerID:   org.OwnerID,
+		Role:      role.String(),
+		CreatedAt: org.CreatedAt,
+	}
+}
+
+func toOrganizationMemberResult(member *entities.OrganizationMember) dtos.OrganizationMemberResult {
+	return dtos.OrganizationMemberResult{
+		UserID:    member.UserID,
+		Role:      member.Role.String(),
+		InvitedBy: member.InvitedBy,
+		JoinedAt:  member.JoinedAt,
+	}
+}
+
+func toInvitationResult(invitation *aggregates.Invitation) dtos.InvitationResult {
+	return dtos.InvitationResult{
+		ID:             invitation.ID(),
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email.String(),
+		Role:           invitation.Role.String(),
+		InvitedBy:      invitation.InvitedBy,
+		ExpiresAt:      invitation.ExpiresAt,
+		CreatedAt:      invitation.CreatedAt,
+	}
+}
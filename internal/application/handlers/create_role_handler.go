@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type CreateRoleHandler struct {
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewCreateRoleHandler(
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateRoleCommand, dtos.RoleResult] {
+	return &CreateRoleHandler{
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "create_role")),
+	}
+}
+
+func (h *CreateRoleHandler) Handle(ctx context.Context, cmd commands.CreateRoleCommand) (dtos.RoleResult, error) {
+	if err := h.authorizer.Authorize(ctx, cmd.ActorID, valueobjects.PermissionRolesManage); err != nil {
+		return dtos.RoleResult{}, err
+	}
+
+	role, err := aggregates.NewRole(cmd.Name, cmd.Description, cmd.Permissions, cmd.Parents)
+	if err != nil {
+		return dtos.RoleResult{}, err
+	}
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		if _, err := h.roleRepo.FindByName(ctx, role.Name); err == nil {
+			return domain.ErrRoleAlreadyExists
+		} else if !repositories.IsNotFoundError(err) {
+			return fmt.Errorf("failed to check role name: %w", err)
+		}
+
+		if err := aggregates.CheckRoleHierarchy(role, roleLookup(ctx, h.roleRepo)); err != nil {
+			return err
+		}
+
+		if err := checkRoleGrantable(ctx, h.authorizer, h.roleRepo, cmd.ActorID, role); err != nil {
+			return err
+		}
+
+		if err := h.roleRepo.Create(ctx, role); err != nil {
+			return fmt.Errorf("failed to create role: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.RoleResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionRoleCreated,
+		"role",
+		role.Name.String(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"permissions": valueobjects.SortedPermissionStrings(role.Permissions)},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record role audit log",
+			zap.Error(err),
+			zap.String("role", role.Name.String()),
+		)
+	}
+
+	return toRoleResult(role), nil
+}
+
+// roleLookup adapts the repository to aggregates.RoleLookup, translating a
+// missing row into domain.ErrRoleNotFound.
+func roleLookup(ctx context.Context, roleRepo repositories.RoleRepository) aggregates.RoleLookup {
+	return func(name valueobjects.Role) (*aggregates.Role, error) {
+		return findRole(ctx, roleRepo, name.String())
+	}
+}
+
+// checkRoleGrantable returns domain.ErrRoleEscalation unless actorID already
+// holds every permission role grants, directly or through its parents, so
+// that roles:manage cannot hand out more than its holder has.
+func checkRoleGrantable(
+	ctx context.Context,
+	authorizer services.Authorizer,
+	roleRepo repositories.RoleRepository,
+	actorID string,
+	role *aggregates.Role,
+) error {
+	inherited, err := aggregates.ResolvePermissions(role.Parents, roleLookup(ctx, roleRepo))
+	if err != nil {
+		return fmt.Errorf("failed to resolve role permissions: %w", err)
+	}
+
+	held, err := authorizer.EffectivePermissions(ctx, actorID)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range append(append([]valueobjects.Permission(nil), role.Permissions...), inherited...) {
+		if !valueobjects.PermissionsGrant(held, p) {
+			return domain.ErrRoleEscalation
+		}
+	}
+	return nil
+}
+
+func findRole(ctx context.Context, roleRepo repositories.RoleRepository, name string) (*aggregates.Role, error) {
+	roleName, err := valueobjects.NewRoleName(name)
+	if err != nil {
+		return nil, domain.ErrRoleNotFound
+	}
+
+	role, err := roleRepo.FindByName(ctx, roleName)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("failed to find role: %w", err)
+	}
+	return role, nil
+}
+
+func toRoleResult(role *aggregates.Role) dtos.RoleResult {
+	parents := make([]string, len(role.Parents))
+	for i, p := range role.Parents {
+		parents[i] = p.String()
+	}
+
+	return dtos.RoleResult{
+		Name:        role.Name.String(),
+		Description: role.Description,
+		Permissions: valueobjects.SortedPermissionStrings(role.Permissions),
+		Parents:     parents,
+		IsSystem:    role.IsSystem,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
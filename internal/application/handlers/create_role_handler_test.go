@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/valueobjects"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckRoleGrantable(t *testing.T) {
+	auditor, err := aggregates.NewRole("auditor", "", []string{"audit:read", "audit:search"}, nil)
+	if err != nil {
+		t.Fatalf("NewRole() error = %v", err)
+	}
+	roleRepo := &fakeRoleRepository{roles: map[valueobjects.Role]*aggregates.Role{auditor.Name: auditor}}
+	authorizer := &fakeAuthorizer{permissions: map[string][]valueobjects.Permission{
+		"role-manager": {valueobjects.PermissionRolesManage, valueobjects.PermissionAuditRead, "users:*"},
+		"admin":        {valueobjects.PermissionAll},
+	}}
+
+	tests := []struct {
+		name        string
+		actorID     string
+		permissions []string
+		parents     []string
+		wantErr     error
+	}{
+		{
+			name:        "subset of the caller's permissions",
+			actorID:     "role-manager",
+			permissions: []string{"users:read", "users:delete", "audit:read"},
+		},
+		{
+			name:        "covered by the caller's wildcard",
+			actorID:     "role-manager",
+			permissions: []string{"users:*"},
+		},
+		{
+			name:        "permission the caller lacks",
+			actorID:     "role-manager",
+			permissions: []string{"sso:manage"},
+			wantErr:     domain.ErrRoleEscalation,
+		},
+		{
+			name:        "roles:manage does not grant everything",
+			actorID:     "role-manager",
+			permissions: []string{"*"},
+			wantErr:     domain.ErrRoleEscalation,
+		},
+		{
+			name:    "permission inherited from a parent",
+			actorID: "role-manager",
+			parents: []string{"auditor"},
+			wantErr: domain.ErrRoleEscalation,
+		},
+		{
+			name:    "inheriting a system role",
+			actorID: "role-manager",
+			parents: []string{"ADMIN"},
+			wantErr: domain.ErrRoleEscalation,
+		},
+		{
+			name:    "admin may grant anything",
+			actorID: "admin",
+			parents: []string{"ADMIN", "auditor"},
+		},
+		{
+			name:        "caller without permissions",
+			actorID:     "nobody",
+			permissions: []string{"profile:read"},
+			wantErr:     domain.ErrRoleEscalation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := aggregates.NewRole("custom", "", tt.permissions, tt.parents)
+			if err != nil {
+				t.Fatalf("NewRole() error = %v", err)
+			}
+
+			err = checkRoleGrantable(context.Background(), authorizer, roleRepo, tt.actorID, role)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkRoleGrantable() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type CreateSAMLConnectionHandler struct {
+	connRepo  repositories.SAMLConnectionRepository
+	orgRepo   repositories.OrganizationRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	sp        services.SAMLServiceProvider
+	logger    logging.Logger
+}
+
+func NewCreateSAMLConnectionHandler(
+	connRepo repositories.SAMLConnectionRepository,
+	orgRepo repositories.OrganizationRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	sp services.SAMLServiceProvider,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateSAMLConnectionCommand, dtos.SAMLConnectionResult] {
+	return &CreateSAMLConnectionHandler{
+		connRepo:  connRepo,
+		orgRepo:   orgRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		sp:        sp,
+		logger:    logger.With(zap.String("handler", "create_saml_connection")),
+	}
+}
+
+func (h *CreateSAMLConnectionHandler) Handle(
+	ctx context.Context,
+	cmd commands.CreateSAMLConnectionCommand,
+) (dtos.SAMLConnectionResult, error) {
+	idp, err := h.sp.ParseMetadata([]byte(cmd.MetadataXML))
+	if err != nil {
+		return dtos.SAMLConnectionResult{}, err
+	}
+
+	role := valueobjects.RoleUser
+	if cmd.DefaultRole != "" {
+		role = valueobjects.Role(strings.ToUpper(cmd.DefaultRole))
+	}
+
+	conn, err := aggregates.NewSAMLConnection(
+		cmd.OrganizationID,
+		cmd.Name,
+		idp.EntityID,
+		idp.SSOURL,
+		idp.Certificates,
+		cmd.AttributeMapping,
+		cmd.Domains,
+		cmd.JITProvisioning,
+		role,
+	)
+	if err != nil {
+		return dtos.SAMLConnectionResult{}, err
+	}
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		if conn.OrganizationID != "" {
+			if _, err := h.orgRepo.FindByID(ctx, conn.OrganizationID); err != nil {
+				if repositories.IsNotFoundError(err) {
+					return domain.ErrOrganizationNotFound
+				}
+				return fmt.Errorf("failed to find organization: %w", err)
+			}
+		}
+
+		if err := h.connRepo.Create(ctx, conn); err != nil {
+			return fmt.Errorf("failed to create saml connection: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.SAMLConnectionResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionSAMLConnectionCreated,
+		"saml_connection",
+		conn.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"organization_id":  conn.OrganizationID,
+			"idp_entity_id":    conn.IdPEntityID,
+			"domains":          conn.Domains,
+			"jit_provisioning": conn.JITProvisioning,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record saml connection audit log",
+			zap.Error(err),
+			zap.String("connection_id", conn.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "SAML connection created",
+		zap.String("connection_id", conn.ID()),
+		zap.String("idp_entity_id", conn.IdPEntityID),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toSAMLConnectionResult(conn), nil
+}
+
+func findSAMLConnection(
+	ctx context.Context,
+	connRepo repositories.SAMLConnectionRepository,
+	id string,
+) (*aggregates.SAMLConnection, error) {
+	conn, err := connRepo.FindByID(ctx, id)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrSAMLConnectionNotFound
+		}
+		return nil, fmt.Errorf("failed to find saml connection: %w", err)
+	}
+	return conn, nil
+}
+
+func samlIdentityProvider(conn *aggregates.SAMLConnection) services.SAMLIdentityProvider {
+	return services.SAMLIdentityProvider{
+		EntityID:     conn.IdPEntityID,
+		SSOURL:       conn.SSOURL,
+		Certificates: conn.Certificates,
+	}
+}
+
+func toSAMLConnectionResult(conn *aggregates.SAMLConnection) dtos.SAMLConnectionResult {
+	return dtos.SAMLConnectionResult{
+		ID:               conn.ID(),
+		OrganizationID:   conn.OrganizationID,
+		Name:             conn.Name,
+		IdPEntityID:      conn.IdPEntityID,
+		SSOURL:           conn.SSOURL,
+		AttributeMapping: conn.AttributeMapping,
+		Domains:          conn.Domains,
+		JITProvisioning:  conn.JITProvisioning,
+		DefaultRole:      conn.DefaultRole.String(),
+		IsActive:         conn.IsActive,
+		CreatedAt:        conn.CreatedAt,
+		UpdatedAt:        conn.UpdatedAt,
+	}
+}
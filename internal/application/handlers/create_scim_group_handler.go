@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+var scimGroupNameSeparators = regexp.MustCompile(`[^A-Z0-9]+`)
+
+type CreateSCIMGroupHandler struct {
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewCreateSCIMGroupHandler(
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateSCIMGroupCommand, dtos.SCIMGroupResult] {
+	return &CreateSCIMGroupHandler{
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "create_scim_group")),
+	}
+}
+
+func (h *CreateSCIMGroupHandler) Handle(
+	ctx context.Context,
+	cmd commands.CreateSCIMGroupCommand,
+) (dtos.SCIMGroupResult, error) {
+	role, err := aggregates.NewSCIMGroupRole(
+		scimGroupRoleName(cmd.DisplayName),
+		fmt.Sprintf("Provisioned by SCIM from group %q", strings.TrimSpace(cmd.DisplayName)),
+	)
+	if err != nil {
+		return dtos.SCIMGroupResult{}, err
+	}
+
+	var result dtos.SCIMGroupResult
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		if _, err := h.roleRepo.FindByName(ctx, role.Name); err == nil {
+			return domain.ErrRoleAlreadyExists
+		} else if !repositories.IsNotFoundError(err) {
+			return fmt.Errorf("failed to check role name: %w", err)
+		}
+
+		if err := h.roleRepo.Create(ctx, role); err != nil {
+			return fmt.Errorf("failed to create role: %w", err)
+		}
+
+		if err := changeSCIMGroupMembers(ctx, h.roleRepo, role, cmd.Members, nil); err != nil {
+			return err
+		}
+
+		var err error
+		result, err = toSCIMGroupResult(ctx, h.roleRepo, role)
+		return err
+	})
+	if err != nil {
+		return dtos.SCIMGroupResult{}, err
+	}
+
+	invalidateSCIMMembers(ctx, h.authorizer, h.logger, cmd.Members)
+
+	recordSCIMAudit(ctx, h.auditRepo, h.logger, valueobjects.AuditActionSCIMGroupCreated,
+		"role", role.Name.String(), cmd.TokenID, cmd.IPAddress, cmd.UserAgent)
+
+	h.logger.Info(ctx, "SCIM group created",
+		zap.String("role", role.Name.String()),
+		zap.Int("members", len(result.Members)),
+		zap.String("scim_token_id", cmd.TokenID),
+	)
+
+	return result, nil
+}
+
+// scimGroupRoleName turns a group display name into the role it maps to,
+// e.g. "Engineering Team" into ENGINEERING_TEAM. Names that still are not
+// valid role names are rejected by valueobjects.NewRoleName.
+func scimGroupRoleName(displayName string) string {
+	name := scimGroupNameSeparators.ReplaceAllString(strings.ToUpper(strings.TrimSpace(displayName)), "_")
+	return strings.Trim(name, "_")
+}
+
+// findSCIMGroup loads the role behind a SCIM group. Built-in roles and
+// custom roles created through the admin API are not exposed to SCIM
+// clients, so a directory cannot hand them out.
+func findSCIMGroup(ctx context.Context, roleRepo repositories.RoleRepository, groupID string) (*aggregates.Role, error) {
+	role, err := findRole(ctx, roleRepo, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if !role.IsSCIMGroup() {
+		return nil, domain.ErrRoleNotFound
+	}
+	return role, nil
+}
+
+// changeSCIMGroupMembers assigns the role to add and unassigns it from
+// remove. Ids of users outside the tenant are skipped by the repository, and
+// removing a user who is not a member is not an error.
+func changeSCIMGroupMembers(
+	ctx context.Context,
+	roleRepo repositories.RoleRepository,
+	role *aggregates.Role,
+	add, remove []string,
+) error {
+	for _, userID := range add {
+		if err := roleRepo.AssignToUser(ctx, userID, role.Name, ""); err != nil {
+			return err
+		}
+	}
+	for _, userID := range remove {
+		if err := roleRepo.UnassignFromUser(ctx, userID, role.Name); err != nil && !repositories.IsNotFoundError(err) {
+			return fmt.Errorf("failed to unassign role: %w", err)
+		}
+	}
+	return nil
+}
+
+// invalidateSCIMMembers drops the cached permissions of users whose group
+// membership changed.
+func invalidateSCIMMembers(ctx context.Context, authorizer services.Authorizer, logger logging.Logger, userIDs []string) {
+	for _, userID := range userIDs {
+		if err := authorizer.InvalidateUser(ctx, userID); err != nil {
+			logger.Error(ctx, "Failed to invalidate cached permissions",
+				zap.Error(err),
+				zap.String("user_id", userID),
+			)
+		}
+	}
+}
+
+func toSCIMGroupResult(ctx context.Context, roleRepo repositories.RoleRepository, role *aggregates.Role) (dtos.SCIMGroupResult, error) {
+	members, err := roleRepo.FindRoleMembers(ctx, role.Name)
+	if err != nil {
+		return dtos.SCIMGroupResult{}, err
+	}
+
+	return dtos.SCIMGroupResult{
+		ID:          role.Name.String(),
+		DisplayName: role.Name.String(),
+		Members:     members,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}, nil
+}
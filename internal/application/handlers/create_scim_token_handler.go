@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// scimTokenDisplayLength is how much of the token, prefix included, is kept
+// in the clear so admins can tell their tokens apart.
+const scimTokenDisplayLength = 13
+
+type CreateSCIMTokenHandler struct {
+	tokenRepo     repositories.SCIMTokenRepository
+	auditRepo     repositories.AuditRepository
+	uow           persistence.UnitOfWork
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewCreateSCIMTokenHandler(
+	tokenRepo repositories.SCIMTokenRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateSCIMTokenCommand, dtos.SCIMTokenSecretResult] {
+	return &CreateSCIMTokenHandler{
+		tokenRepo:     tokenRepo,
+		auditRepo:     auditRepo,
+		uow:           uow,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "create_scim_token")),
+	}
+}
+
+func (h *CreateSCIMTokenHandler) Handle(
+	ctx context.Context,
+	cmd commands.CreateSCIMTokenCommand,
+) (dtos.SCIMTokenSecretResult, error) {
+	secret, err := h.secretService.Generate(domainServices.DefaultSecretLength)
+	if err != nil {
+		return dtos.SCIMTokenSecretResult{}, fmt.Errorf("failed to generate scim token: %w", err)
+	}
+	plainToken := aggregates.SCIMTokenPrefix + secret
+
+	token, err := aggregates.NewSCIMToken(
+		cmd.Name,
+		h.secretService.Hash(plainToken),
+		plainToken[:scimTokenDisplayLength],
+		cmd.ActorID,
+	)
+	if err != nil {
+		return dtos.SCIMTokenSecretResult{}, err
+	}
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		return h.tokenRepo.Create(ctx, token)
+	})
+	if err != nil {
+		return dtos.SCIMTokenSecretResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionSCIMTokenCreated,
+		"scim_token",
+		token.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"name": token.Name},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record scim token audit log",
+			zap.Error(err),
+			zap.String("token_id", token.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "SCIM token created",
+		zap.String("token_id", token.ID()),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return dtos.SCIMTokenSecretResult{
+		SCIMTokenResult: toSCIMTokenResult(token),
+		Token:           plainToken,
+	}, nil
+}
+
+func toSCIMTokenResult(token *aggregates.SCIMToken) dtos.SCIMTokenResult {
+	return dtos.SCIMTokenResult{
+		ID:            token.ID(),
+		Name:          token.Name,
+		DisplayPrefix: token.DisplayPrefix,
+		CreatedBy:     token.CreatedBy,
+		LastUsedAt:    token.LastUsedAt,
+		RevokedAt:     token.RevokedAt,
+		CreatedAt:     token.CreatedAt,
+	}
+}
@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type CreateSCIMUserHandler struct {
+	userRepo  repositories.UserRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewCreateSCIMUserHandler(
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateSCIMUserCommand, dtos.SCIMUserResult] {
+	return &CreateSCIMUserHandler{
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "create_scim_user")),
+	}
+}
+
+func (h *CreateSCIMUserHandler) Handle(
+	ctx context.Context,
+	cmd commands.CreateSCIMUserCommand,
+) (dtos.SCIMUserResult, error) {
+	username, email, err := scimIdentity(cmd.UserName, cmd.Email)
+	if err != nil {
+		return dtos.SCIMUserResult{}, err
+	}
+
+	// The identity provider authenticates these users, so they are created
+	// without a password like any other federated account.
+	user := aggregates.NewOAuthUserAggregate(
+		email,
+		strings.TrimSpace(cmd.GivenName),
+		strings.TrimSpace(cmd.FamilyName),
+		aggregates.SCIMUserProvider,
+		valueobjects.RoleUser,
+	)
+	user.User.Username = username
+	if phone, ok := scimPhone(ctx, h.logger, cmd.Phone); ok {
+		user.User.Phone = phone
+	}
+	if !cmd.Active {
+		user.Deactivate()
+	}
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		if err := checkSCIMIdentityAvailable(ctx, h.userRepo, "", username, email); err != nil {
+			return err
+		}
+		if err := h.userRepo.Create(ctx, user); err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.SCIMUserResult{}, err
+	}
+
+	recordSCIMAudit(ctx, h.auditRepo, h.logger, valueobjects.AuditActionSCIMUserProvisioned,
+		"user", user.ID(), cmd.TokenID, cmd.IPAddress, cmd.UserAgent)
+
+	h.logger.Info(ctx, "SCIM user provisioned",
+		zap.String("user_id", user.ID()),
+		zap.String("scim_token_id", cmd.TokenID),
+	)
+
+	return toSCIMUserResult(user), nil
+}
+
+// scimIdentity resolves the username and email a SCIM user signs in with.
+// Most identity providers send the email as userName; in that case it wins
+// over the emails attribute. Any other userName becomes the username and
+// the email must then come from emails.
+func scimIdentity(userName, email string) (valueobjects.Username, valueobjects.Email, error) {
+	userName = strings.TrimSpace(userName)
+	email = strings.TrimSpace(email)
+
+	username := valueobjects.EmptyUsername()
+	if strings.Contains(userName, "@") {
+		email = userName
+	} else if userName != "" {
+		var err error
+		username, err = valueobjects.NewUsername(userName)
+		if err != nil {
+			return valueobjects.Username{}, valueobjects.Email{}, err
+		}
+	}
+
+	if email == "" {
+		return valueobjects.Username{}, valueobjects.Email{}, domain.ErrSCIMUserNameRequired
+	}
+	parsedEmail, err := valueobjects.NewEmail(email)
+	if err != nil {
+		return valueobjects.Username{}, valueobjects.Email{}, err
+	}
+	return username, parsedEmail, nil
+}
+
+// scimUserName is the userName a SCIM client sees: the username when the
+// account has one, otherwise the email.
+func scimUserName(user *aggregates.UserAggregate) string {
+	if !user.User.Username.IsEmpty() {
+		return user.User.Username.String()
+	}
+	return user.User.Email.String()
+}
+
+// scimPhone parses a phone number pushed by the identity provider. Numbers
+// that fail validation are dropped rather than failing provisioning.
+func scimPhone(ctx context.Context, logger logging.Logger, value string) (valueobjects.PhoneNumber, bool) {
+	value = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "").Replace(value)
+	if value == "" {
+		return valueobjects.PhoneNumber{}, false
+	}
+	phone, err := valueobjects.NewPhoneNumber(value)
+	if err != nil {
+		logger.Warn(ctx, "Ignoring invalid phone number from scim client", zap.Error(err))
+		return valueobjects.PhoneNumber{}, false
+	}
+	return phone, true
+}
+
+// checkSCIMIdentityAvailable rejects a username or email held by any account
+// other than userID.
+func checkSCIMIdentityAvailable(
+	ctx context.Context,
+	userRepo repositories.UserRepository,
+	userID string,
+	username valueobjects.Username,
+	email valueobjects.Email,
+) error {
+	existing, err := userRepo.FindByEmail(ctx, email)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return fmt.Errorf("failed to find user by email: %w", err)
+	}
+	if existing != nil && existing.ID() != userID {
+		return domain.ErrEmailAlreadyInUse
+	}
+
+	if username.IsEmpty() {
+		return nil
+	}
+	existing, err = userRepo.FindByUsername(ctx, username)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return fmt.Errorf("failed to find user by username: %w", err)
+	}
+	if existing != nil && existing.ID() != userID {
+		return domain.ErrUsernameAlreadyTaken
+	}
+	return nil
+}
+
+func findSCIMUser(ctx context.Context, userRepo repositories.UserRepository, userID string) (*aggregates.UserAggregate, error) {
+	user, err := userRepo.FindByID(ctx, userID)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// recordSCIMAudit logs a change made by a SCIM client. There is no acting
+// user, so the token is recorded in the metadata instead.
+func recordSCIMAudit(
+	ctx context.Context,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+	action valueobjects.AuditAction,
+	resourceType, resourceID, tokenID, ipAddress, userAgent string,
+) {
+	auditLog := aggregates.NewAuditLog(
+		"",
+		action,
+		resourceType,
+		resourceID,
+		ipAddress,
+		userAgent,
+		"SUCCESS",
+		map[string]interface{}{"scim_token_id": tokenID},
+	)
+	if err := auditRepo.Create(ctx, auditLog); err != nil {
+		logger.Error(ctx, "Failed to record scim audit log",
+			zap.Error(err),
+			zap.String("resource_id", resourceID),
+		)
+	}
+}
+
+func toSCIMUserResult(user *aggregates.UserAggregate) dtos.SCIMUserResult {
+	return dtos.SCIMUserResult{
+		ID:         user.ID(),
+		UserName:   scimUserName(user),
+		Email:      user.User.Email.String(),
+		GivenName:  user.User.FirstName,
+		FamilyName: user.User.LastName,
+		Phone:      user.User.Phone.String(),
+		Active:     user.User.IsActive,
+		CreatedAt:  user.User.CreatedAt,
+		UpdatedAt:  user.User.UpdatedAt,
+	}
+}
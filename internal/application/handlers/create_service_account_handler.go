@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type CreateServiceAccountHandler struct {
+	accountRepo       repositories.ServiceAccountRepository
+	roleRepo          repositories.RoleRepository
+	auditRepo         repositories.AuditRepository
+	authorizer        services.Authorizer
+	uow               persistence.UnitOfWork
+	secretService     domainServices.SecretService
+	assertionVerifier services.ClientAssertionVerifier
+	logger            logging.Logger
+}
+
+func NewCreateServiceAccountHandler(
+	accountRepo repositories.ServiceAccountRepository,
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	secretService domainServices.SecretService,
+	assertionVerifier services.ClientAssertionVerifier,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.CreateServiceAccountCommand, dtos.ServiceAccountSecretResult] {
+	return &CreateServiceAccountHandler{
+		accountRepo:       accountRepo,
+		roleRepo:          roleRepo,
+		auditRepo:         auditRepo,
+		authorizer:        authorizer,
+		uow:               uow,
+		secretService:     secretService,
+		assertionVerifier: assertionVerifier,
+		logger:            logger.With(zap.String("handler", "create_service_account")),
+	}
+}
+
+func (h *CreateServiceAccountHandler) Handle(
+	ctx context.Context,
+	cmd commands.CreateServiceAccountCommand,
+) (dtos.ServiceAccountSecretResult, error) {
+	scopes, err := valueobjects.NewScopes(cmd.Scopes)
+	if err != nil {
+		return dtos.ServiceAccountSecretResult{}, err
+	}
+
+	publicKeyPEM := strings.TrimSpace(cmd.PublicKeyPEM)
+	if publicKeyPEM != "" {
+		if err := h.assertionVerifier.ValidatePublicKey(publicKeyPEM); err != nil {
+			return dtos.ServiceAccountSecretResult{}, err
+		}
+	}
+
+	clientIDSuffix, err := h.secretService.Generate(oauthClientIDLength)
+	if err != nil {
+		return dtos.ServiceAccountSecretResult{}, fmt.Errorf("failed to generate client id: %w", err)
+	}
+
+	secret, err := h.secretService.Generate(domainServices.DefaultSecretLength)
+	if err != nil {
+		return dtos.ServiceAccountSecretResult{}, fmt.Errorf("failed to generate client secret: %w", err)
+	}
+
+	account, err := aggregates.NewServiceAccount(
+		cmd.Name,
+		aggregates.ServiceAccountClientIDPrefix+clientIDSuffix,
+		h.secretService.Hash(secret),
+		publicKeyPEM,
+		valueobjects.Role(strings.ToUpper(cmd.Role)),
+		valueobjects.ScopeStrings(scopes),
+	)
+	if err != nil {
+		return dtos.ServiceAccountSecretResult{}, err
+	}
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		// A service account acts with its role, so the caller may not give it
+		// more than they hold themselves.
+		role, err := findRole(ctx, h.roleRepo, account.Role.String())
+		if err != nil {
+			if errors.Is(err, domain.ErrRoleNotFound) {
+				return domain.ErrInvalidRole
+			}
+			return err
+		}
+		if err := checkRoleGrantable(ctx, h.authorizer, h.roleRepo, cmd.ActorID, role); err != nil {
+			return err
+		}
+
+		return h.accountRepo.Create(ctx, account)
+	})
+	if err != nil {
+		return dtos.ServiceAccountSecretResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionServiceAccountCreated,
+		"service_account",
+		account.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"client_id":       account.ClientID,
+			"role":            account.Role.String(),
+			"scopes":          account.Scopes,
+			"private_key_jwt": account.UsesPrivateKeyJWT(),
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record service account audit log",
+			zap.Error(err),
+			zap.String("client_id", account.ClientID),
+		)
+	}
+
+	h.logger.Info(ctx, "Service account created",
+		zap.String("id", account.ID()),
+		zap.String("client_id", account.ClientID),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return dtos.ServiceAccountSecretResult{
+		ServiceAccountResult: toServiceAccountResult(account),
+		ClientSecret:         secret,
+	}, nil
+}
+
+func findServiceAccount(
+	ctx context.Context,
+	accountRepo repositories.ServiceAccountRepository,
+	id string,
+) (*aggregates.ServiceAccount, error) {
+	account, err := accountRepo.FindByID(ctx, id)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrServiceAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to find service account: %w", err)
+	}
+	return account, nil
+}
+
+func toServiceAccountResult(account *aggregates.ServiceAccount) dtos.ServiceAccountResult {
+	return dtos.ServiceAccountResult{
+		ID:                account.ID(),
+		Name:              account.Name,
+		ClientID:          account.ClientID,
+		Role:              account.Role.String(),
+		Scopes:            account.Scopes,
+		UsesPrivateKeyJWT: account.UsesPrivateKeyJWT(),
+		IsActive:          account.IsActive,
+		CreatedAt:         account.CreatedAt,
+	}
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeactivateOAuthClientHandler struct {
+	clientRepo repositories.OAuthClientRepository
+	auditRepo  repositories.AuditRepository
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewDeactivateOAuthClientHandler(
+	clientRepo repositories.OAuthClientRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeactivateOAuthClientCommand, dtos.OAuthClientResult] {
+	return &DeactivateOAuthClientHandler{
+		clientRepo: clientRepo,
+		auditRepo:  auditRepo,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "deactivate_oauth_client")),
+	}
+}
+
+func (h *DeactivateOAuthClientHandler) Handle(
+	ctx context.Context,
+	cmd commands.DeactivateOAuthClientCommand,
+) (dtos.OAuthClientResult, error) {
+	var client *aggregates.OAuthClient
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		client, err = findOAuthClient(ctx, h.clientRepo, cmd.ClientID)
+		if err != nil {
+			return err
+		}
+
+		if !client.IsActive {
+			return domain.ErrOAuthClientInactive
+		}
+
+		client.Deactivate()
+		if err := h.clientRepo.Update(ctx, client); err != nil {
+			return fmt.Errorf("failed to deactivate oauth client: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OAuthClientResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOAuthClientDeactivated,
+		"oauth_client",
+		client.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"client_id": client.ClientID},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record oauth client audit log",
+			zap.Error(err),
+			zap.String("client_id", client.ClientID),
+		)
+	}
+
+	h.logger.Info(ctx, "OAuth client deactivated",
+		zap.String("client_id", client.ClientID),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toOAuthClientResult(client), nil
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeactivateServiceAccountHandler struct {
+	accountRepo repositories.ServiceAccountRepository
+	auditRepo   repositories.AuditRepository
+	uow         persistence.UnitOfWork
+	logger      logging.Logger
+}
+
+func NewDeactivateServiceAccountHandler(
+	accountRepo repositories.ServiceAccountRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeactivateServiceAccountCommand, dtos.ServiceAccountResult] {
+	return &DeactivateServiceAccountHandler{
+		accountRepo: accountRepo,
+		auditRepo:   auditRepo,
+		uow:         uow,
+		logger:      logger.With(zap.String("handler", "deactivate_service_account")),
+	}
+}
+
+// Handle deactivates the account. Tokens already issued stay valid until
+// they expire, which service token lifetimes keep short.
+func (h *DeactivateServiceAccountHandler) Handle(
+	ctx context.Context,
+	cmd commands.DeactivateServiceAccountCommand,
+) (dtos.ServiceAccountResult, error) {
+	var account *aggregates.ServiceAccount
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		account, err = findServiceAccount(ctx, h.accountRepo, cmd.ServiceAccountID)
+		if err != nil {
+			return err
+		}
+
+		account.Deactivate()
+		if err := h.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to deactivate service account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.ServiceAccountResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionServiceAccountDeactivated,
+		"service_account",
+		account.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"client_id": account.ClientID},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record service account audit log",
+			zap.Error(err),
+			zap.String("client_id", account.ClientID),
+		)
+	}
+
+	return toServiceAccountResult(account), nil
+}
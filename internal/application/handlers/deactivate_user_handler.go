@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeactivateUserHandler struct {
+	userRepo    repositories.UserRepository
+	sessionRepo repositories.SessionRepository
+	auditRepo   repositories.AuditRepository
+	uow         persistence.UnitOfWork
+	logger      logging.Logger
+}
+
+func NewDeactivateUserHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeactivateUserCommand, dtos.UserResult] {
+	return &DeactivateUserHandler{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		uow:         uow,
+		logger:      logger.With(zap.String("handler", "deactivate_user")),
+	}
+}
+
+func (h *DeactivateUserHandler) Handle(ctx context.Context, cmd commands.DeactivateUserCommand) (dtos.UserResult, error) {
+	if cmd.ActorID == cmd.UserID {
+		return dtos.UserResult{}, domain.ErrSelfAdministration
+	}
+
+	var result dtos.UserResult
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if user.User.IsActive {
+			user.Deactivate()
+			if err := h.userRepo.Update(ctx, user); err != nil {
+				return fmt.Errorf("failed to deactivate user: %w", err)
+			}
+		}
+
+		if err := h.sessionRepo.RevokeByUserID(ctx, user.ID()); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		result = toUserResult(user)
+		return nil
+	})
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, valueobjects.AuditActionUserDeactivated,
+		result.ID, cmd.IPAddress, cmd.UserAgent, nil)
+
+	h.logger.Info(ctx, "User deactivated",
+		zap.String("user_id", result.ID),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return result, nil
+}
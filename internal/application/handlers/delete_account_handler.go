@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeleteAccountHandler struct {
+	userRepo       repositories.UserRepository
+	sessionRepo    repositories.SessionRepository
+	auditRepo      repositories.AuditRepository
+	passwordHasher *domainServices.PasswordHashingService
+	uow            persistence.UnitOfWork
+	gracePeriod    time.Duration
+	logger         logging.Logger
+}
+
+func NewDeleteAccountHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	passwordHasher *domainServices.PasswordHashingService,
+	uow persistence.UnitOfWork,
+	gracePeriod time.Duration,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeleteAccountCommand, dtos.AccountDeletionResult] {
+	return &DeleteAccountHandler{
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		auditRepo:      auditRepo,
+		passwordHasher: passwordHasher,
+		uow:            uow,
+		gracePeriod:    gracePeriod,
+		logger:         logger.With(zap.String("handler", "delete_account")),
+	}
+}
+
+// Handle soft deletes the account and signs it out everywhere. Personal data
+// stays in place until PurgeDeletedAccountsHandler removes it after the
+// grace period, so support can still restore an account deleted by mistake.
+func (h *DeleteAccountHandler) Handle(
+	ctx context.Context,
+	cmd commands.DeleteAccountCommand,
+) (dtos.AccountDeletionResult, error) {
+	user, err := findUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return dtos.AccountDeletionResult{}, err
+	}
+	// Directory accounts would be provisioned again on the next sync.
+	if identityManagedExternally(user) {
+		return dtos.AccountDeletionResult{}, domain.ErrIdentityManagedExternally
+	}
+	if !user.User.Password.IsEmpty() && !h.passwordHasher.Verify(cmd.Password, user.User.Password) {
+		return dtos.AccountDeletionResult{}, domain.ErrInvalidCredentials
+	}
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		if err := h.sessionRepo.RevokeByUserID(ctx, user.ID()); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+		if err := h.userRepo.Delete(ctx, user.ID()); err != nil {
+			return fmt.Errorf("failed to delete account: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.AccountDeletionResult{}, err
+	}
+
+	result := dtos.AccountDeletionResult{
+		UserID:     user.ID(),
+		PurgeAfter: time.Now().UTC().Add(h.gracePeriod),
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, user.ID(), valueobjects.AuditActionAccountDeletionRequested,
+		user.ID(), cmd.IPAddress, cmd.UserAgent, map[string]interface{}{"purge_after": result.PurgeAfter})
+
+	h.logger.Info(ctx, "Account deleted by owner",
+		zap.String("user_id", user.ID()),
+		zap.Time("purge_after", result.PurgeAfter),
+	)
+
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeleteRoleHandler struct {
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewDeleteRoleHandler(
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeleteRoleCommand, dtos.RoleResult] {
+	return &DeleteRoleHandler{
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "delete_role")),
+	}
+}
+
+// Handle deletes a custom role. Roles that are still assigned to users or
+// inherited by other roles must be detached first.
+func (h *DeleteRoleHandler) Handle(ctx context.Context, cmd commands.DeleteRoleCommand) (dtos.RoleResult, error) {
+	if err := h.authorizer.Authorize(ctx, cmd.ActorID, valueobjects.PermissionRolesManage); err != nil {
+		return dtos.RoleResult{}, err
+	}
+
+	var role *aggregates.Role
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		role, err = findRole(ctx, h.roleRepo, cmd.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := role.CanDelete(); err != nil {
+			return err
+		}
+
+		dependents, err := h.roleRepo.CountDependents(ctx, role.Name)
+		if err != nil {
+			return err
+		}
+		if dependents > 0 {
+			return domain.ErrRoleInUse
+		}
+
+		if err := h.roleRepo.Delete(ctx, role.Name); err != nil {
+			return fmt.Errorf("failed to delete role: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.RoleResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionRoleDeleted,
+		"role",
+		role.Name.String(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		nil,
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record role audit log",
+			zap.Error(err),
+			zap.String("role", role.Name.String()),
+		)
+	}
+
+	return toRoleResult(role), nil
+}
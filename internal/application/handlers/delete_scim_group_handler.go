@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeleteSCIMGroupHandler struct {
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewDeleteSCIMGroupHandler(
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeleteSCIMGroupCommand, dtos.SCIMGroupResult] {
+	return &DeleteSCIMGroupHandler{
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "delete_scim_group")),
+	}
+}
+
+// Handle removes every member from the group and then deletes its role. A
+// role that other roles still inherit from is kept, as in the admin API.
+func (h *DeleteSCIMGroupHandler) Handle(
+	ctx context.Context,
+	cmd commands.DeleteSCIMGroupCommand,
+) (dtos.SCIMGroupResult, error) {
+	var (
+		role    *aggregates.Role
+		members []string
+	)
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		role, err = findSCIMGroup(ctx, h.roleRepo, cmd.GroupID)
+		if err != nil {
+			return err
+		}
+
+		members, err = h.roleRepo.FindRoleMembers(ctx, role.Name)
+		if err != nil {
+			return err
+		}
+		if err := changeSCIMGroupMembers(ctx, h.roleRepo, role, nil, members); err != nil {
+			return err
+		}
+
+		dependents, err := h.roleRepo.CountDependents(ctx, role.Name)
+		if err != nil {
+			return err
+		}
+		if dependents > 0 {
+			return domain.ErrRoleInUse
+		}
+
+		if err := h.roleRepo.Delete(ctx, role.Name); err != nil {
+			return fmt.Errorf("failed to delete role: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.SCIMGroupResult{}, err
+	}
+
+	invalidateSCIMMembers(ctx, h.authorizer, h.logger, members)
+
+	recordSCIMAudit(ctx, h.auditRepo, h.logger, valueobjects.AuditActionSCIMGroupDeleted,
+		"role", role.Name.String(), cmd.TokenID, cmd.IPAddress, cmd.UserAgent)
+
+	h.logger.Info(ctx, "SCIM group deleted",
+		zap.String("role", role.Name.String()),
+		zap.String("scim_token_id", cmd.TokenID),
+	)
+
+	return dtos.SCIMGroupResult{
+		ID:          role.Name.String(),
+		DisplayName: role.Name.String(),
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeleteSCIMUserHandler struct {
+	userRepo    repositories.UserRepository
+	sessionRepo repositories.SessionRepository
+	auditRepo   repositories.AuditRepository
+	uow         persistence.UnitOfWork
+	logger      logging.Logger
+}
+
+func NewDeleteSCIMUserHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeleteSCIMUserCommand, dtos.SCIMUserResult] {
+	return &DeleteSCIMUserHandler{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		uow:         uow,
+		logger:      logger.With(zap.String("handler", "delete_scim_user")),
+	}
+}
+
+// Handle deactivates rather than deletes, so the account can be restored by
+// a later PUT or PATCH with active=true and its audit trail is kept.
+func (h *DeleteSCIMUserHandler) Handle(
+	ctx context.Context,
+	cmd commands.DeleteSCIMUserCommand,
+) (dtos.SCIMUserResult, error) {
+	var result dtos.SCIMUserResult
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findSCIMUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if user.User.IsActive {
+			user.Deactivate()
+			if err := h.userRepo.Update(ctx, user); err != nil {
+				return fmt.Errorf("failed to deactivate user: %w", err)
+			}
+		}
+
+		// Revoke even when the account was already inactive, in case a
+		// session outlived an earlier deactivation.
+		if err := h.sessionRepo.RevokeByUserID(ctx, user.ID()); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		result = toSCIMUserResult(user)
+		return nil
+	})
+	if err != nil {
+		return dtos.SCIMUserResult{}, err
+	}
+
+	recordSCIMAudit(ctx, h.auditRepo, h.logger, valueobjects.AuditActionSCIMUserDeactivated,
+		"user", result.ID, cmd.TokenID, cmd.IPAddress, cmd.UserAgent)
+
+	h.logger.Info(ctx, "SCIM user deactivated",
+		zap.String("user_id", result.ID),
+		zap.String("scim_token_id", cmd.TokenID),
+	)
+
+	return result, nil
+}
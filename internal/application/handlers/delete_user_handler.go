@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type DeleteUserHandler struct {
+	userRepo    repositories.UserRepository
+	sessionRepo repositories.SessionRepository
+	auditRepo   repositories.AuditRepository
+	uow         persistence.UnitOfWork
+	logger      logging.Logger
+}
+
+func NewDeleteUserHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.DeleteUserCommand, dtos.UserResult] {
+	return &DeleteUserHandler{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		uow:         uow,
+		logger:      logger.With(zap.String("handler", "delete_user")),
+	}
+}
+
+// Handle soft deletes by default. A hard delete also removes the user's
+// sessions; audit entries naming the user id are kept.
+func (h *DeleteUserHandler) Handle(ctx context.Context, cmd commands.DeleteUserCommand) (dtos.UserResult, error) {
+	if cmd.ActorID == cmd.UserID {
+		return dtos.UserResult{}, domain.ErrSelfAdministration
+	}
+
+	var result dtos.UserResult
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		result = toUserResult(user)
+
+		if cmd.Hard {
+			if err := h.sessionRepo.DeleteByUserID(ctx, user.ID()); err != nil {
+				return fmt.Errorf("failed to delete sessions: %w", err)
+			}
+			if err := h.userRepo.HardDelete(ctx, user.ID()); err != nil {
+				return fmt.Errorf("failed to hard delete user: %w", err)
+			}
+			return nil
+		}
+
+		if err := h.sessionRepo.RevokeByUserID(ctx, user.ID()); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+		if err := h.userRepo.Delete(ctx, user.ID()); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	action := valueobjects.AuditActionUserDeleted
+	if cmd.Hard {
+		action = valueobjects.AuditActionUserPurged
+	}
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, action,
+		result.ID, cmd.IPAddress, cmd.UserAgent, map[string]interface{}{"hard": cmd.Hard})
+
+	h.logger.Info(ctx, "User deleted",
+		zap.String("user_id", result.ID),
+		zap.String("actor_id", cmd.ActorID),
+		zap.Bool("hard", cmd.Hard),
+	)
+
+	return result, nil
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain"
+	"authentication/internal/domain/entities"
+)
+
+const (
+	deviceCodeKeyPrefix         = "device_code:"
+	deviceCodeRedeemedKeyPrefix = "device_code_redeemed:"
+	userCodeKeyPrefix           = "device_user_code:"
+
+	// RFC 8628 section 6.1: consonants only, no ambiguous characters.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// normalizeUserCode strips the separator and case so "bcdf-ghjk" and
+// "BCDFGHJK" resolve to the same code.
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, code)
+}
+
+func formatUserCode(code string) string {
+	if len(code) != userCodeLength {
+		return code
+	}
+	return code[:4] + "-" + code[4:]
+}
+
+func saveDeviceAuthorization(
+	ctx context.Context,
+	cache persistence.Cache,
+	deviceCodeHash string,
+	auth *entities.DeviceAuthorization,
+) error {
+	ttl := auth.ExpiresIn()
+	if ttl <= 0 {
+		return domain.ErrDeviceCodeExpired
+	}
+
+	if err := cache.Set(ctx, deviceCodeKeyPrefix+deviceCodeHash, auth, ttl); err != nil {
+		return fmt.Errorf("failed to store device authorization: %w", err)
+	}
+	if err := cache.Set(ctx, userCodeKeyPrefix+auth.UserCode, deviceCodeHash, ttl); err != nil {
+		return fmt.Errorf("failed to store device user code: %w", err)
+	}
+	return nil
+}
+
+func loadDeviceAuthorization(
+	ctx context.Context,
+	cache persistence.Cache,
+	deviceCodeHash string,
+) (*entities.DeviceAuthorization, error) {
+	var auth entities.DeviceAuthorization
+	if err := cache.Get(ctx, deviceCodeKeyPrefix+deviceCodeHash, &auth); err != nil {
+		if errors.Is(err, persistence.ErrCacheMiss) {
+			return nil, domain.ErrInvalidDeviceCode
+		}
+		return nil, fmt.Errorf("failed to load device authorization: %w", err)
+	}
+	return &auth, nil
+}
+
+func findDeviceCodeHashByUserCode(
+	ctx context.Context,
+	cache persistence.Cache,
+	userCode string,
+) (string, error) {
+	var deviceCodeHash string
+	if err := cache.Get(ctx, userCodeKeyPrefix+normalizeUserCode(userCode), &deviceCodeHash); err != nil {
+		if errors.Is(err, persistence.ErrCacheMiss) {
+			return "", domain.ErrInvalidUserCode
+		}
+		return "", fmt.Errorf("failed to load device user code: %w", err)
+	}
+	return deviceCodeHash, nil
+}
+
+// claimDeviceAuthorization marks an approved device code as redeemed. Of
+// several polls racing on the same code only the first gets true; the
+// others must not issue tokens.
+func claimDeviceAuthorization(
+	ctx context.Context,
+	cache persistence.Cache,
+	deviceCodeHash string,
+	auth *entities.DeviceAuthorization,
+) (bool, error) {
+	ttl := auth.ExpiresIn()
+	if ttl <= 0 {
+		return false, domain.ErrDeviceCodeExpired
+	}
+
+	claimed, err := cache.SetIfAbsent(ctx, deviceCodeRedeemedKeyPrefix+deviceCodeHash, true, ttl)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim device code: %w", err)
+	}
+	return claimed, nil
+}
+
+func deleteDeviceAuthorization(
+	ctx context.Context,
+	cache persistence.Cache,
+	deviceCodeHash string,
+	auth *entities.DeviceAuthorization,
+) error {
+	if err := cache.Delete(ctx, deviceCodeKeyPrefix+deviceCodeHash); err != nil {
+		return fmt.Errorf("failed to delete device authorization: %w", err)
+	}
+	if err := cache.Delete(ctx, userCodeKeyPrefix+auth.UserCode); err != nil {
+		return fmt.Errorf("failed to delete device user code: %w", err)
+	}
+	return nil
+}
@@ -2,8 +2,8 @@ package handlers
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"time"
 
 	"authentication/internal/application/commands"
 	"authentication/internal/application/contracts/messaging"
@@ -12,14 +12,13 @@ import (
 	"authentication/internal/application/dtos"
 	"authentication/internal/domain"
 	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/events"
 	"authentication/internal/domain/repositories"
 	domainServices "authentication/internal/domain/services"
 	"authentication/internal/domain/valueobjects"
 	"authentication/shared/logging"
 
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
-	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -31,7 +30,14 @@ type LoginEmailHandler struct {
 	passwordHasher *domainServices.PasswordHashingService
 	tokenService   services.TokenService
 	otpService     services.OTPService
-	logger         logging.Logger
+	// ldap is nil when directory sign-in is disabled; see ldap.New.
+	ldap         services.LDAPAuthenticator
+	risk         services.RiskEngine
+	challenge    services.ChallengeGate
+	deviceRepo   repositories.TrustedDeviceRepository
+	deviceSigner services.TrustedDeviceSigner
+	auditRepo    repositories.AuditRepository
+	logger       logging.Logger
 }
 
 func NewLoginEmailHandler(
@@ -42,6 +48,12 @@ func NewLoginEmailHandler(
 	passwordHasher *domainServices.PasswordHashingService,
 	tokenService services.TokenService,
 	otpService services.OTPService,
+	ldap services.LDAPAuthenticator,
+	risk services.RiskEngine,
+	challenge services.ChallengeGate,
+	deviceRepo repositories.TrustedDeviceRepository,
+	deviceSigner services.TrustedDeviceSigner,
+	auditRepo repositories.AuditRepository,
 	logger logging.Logger,
 ) messaging.CommandHandler[commands.LoginEmailUserCommand, dtos.LoginEmailUserResult] {
 	return &LoginEmailHandler{
@@ -52,6 +64,12 @@ func NewLoginEmailHandler(
 		passwordHasher: passwordHasher,
 		tokenService:   tokenService,
 		otpService:     otpService,
+		ldap:           ldap,
+		risk:           risk,
+		challenge:      challenge,
+		deviceRepo:     deviceRepo,
+		deviceSigner:   deviceSigner,
+		auditRepo:      auditRepo,
 		logger:         logger.With(zap.String("handler", "login_email")),
 	}
 }
@@ -60,8 +78,8 @@ func (h *LoginEmailHandler) Handle(
 	ctx context.Context,
 	cmd commands.LoginEmailUserCommand,
 ) (dtos.LoginEmailUserResult, error) {
-	defer func(){
-		if r := recover(); r != nil{
+	defer func() {
+		if r := recover(); r != nil {
 			h.logger.Error(ctx, "Panic during email user login",
 				zap.Any("panic", r),
 				zap.String("email", cmd.Email),
@@ -71,48 +89,60 @@ func (h *LoginEmailHandler) Handle(
 		}
 	}()
 
+	if err := checkChallenge(ctx, h.challenge, cmd.Email, cmd.IPAddress, cmd.ChallengeResponse); err != nil {
+		return dtos.LoginEmailUserResult{}, err
+	}
+
 	emailVO, err := valueobjects.NewEmail(cmd.Email)
 	if err != nil {
 		_ = h.publishFailedLoginEvent(ctx, cmd, err)
 		return dtos.LoginEmailUserResult{}, err
 	}
 
+	// An unknown email is not an error yet: the directory may know it.
 	user, err := h.userRepo.FindByEmail(ctx, emailVO)
-	if err != nil {
-		if err == domain.ErrUserNotFound {
-			_ = h.publishFailedLoginEvent(ctx, cmd, err)
-			return dtos.LoginEmailUserResult{}, domain.ErrInvalidCredentials
-		}
-
+	if err != nil && err != domain.ErrUserNotFound && !repositories.IsNotFoundError(err) {
 		return dtos.LoginEmailUserResult{}, fmt.Errorf("error fetching user by email: %w", err)
 	}
 
-	if !user.User.IsActive{
-		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrUserInactive)
-		return dtos.LoginEmailUserResult{}, domain.ErrUserInactive
+	// With a directory configured, unknown users and users it provisioned
+	// authenticate against it; everyone else keeps their local password.
+	if h.ldap != nil && (user == nil || user.User.Provider() == aggregates.LDAPUserProvider) {
+		return h.handleLDAPLogin(ctx, user, cmd)
+	}
+	if user == nil {
+		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrUserNotFound)
+		h.recordLoginFailure(ctx, cmd)
+		return dtos.LoginEmailUserResult{}, domain.ErrInvalidCredentials
 	}
 
-	if user.User.IsOAuthUser(){
+	if !user.User.IsActive {
+		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrInactiveUser)
+		return dtos.LoginEmailUserResult{}, domain.ErrInactiveUser
+	}
+
+	if user.User.IsOAuthUser() {
 		return h.handleOAuthUserLogin(ctx, user, cmd)
 	}
 
-	if !h.passwordHasher.Verify(cmd.Password, user.User.Password){
+	if !h.passwordHasher.Verify(cmd.Password, user.User.Password) {
 		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrInvalidCredentials)
+		h.recordLoginFailure(ctx, cmd)
 		return dtos.LoginEmailUserResult{}, domain.ErrInvalidCredentials
 	}
 
-	if !user.User.IsVerified{
-		return dtos.LoginEmailUserResult{}, domain.ErrEmailNotVerified()
+	if !user.User.IsVerified {
+		return dtos.LoginEmailUserResult{}, domain.ErrEmailNotVerified
 	}
 
-	return h.generateTokensAndLogin(ctx, user, cmd)
+	return h.completeLogin(ctx, user, cmd)
 }
 
 func (h *LoginEmailHandler) handleOAuthUserLogin(
 	ctx context.Context,
 	user *aggregates.UserAggregate,
 	cmd commands.LoginEmailUserCommand,
-) (dtos.LoginEmailUserResult, error){
+) (dtos.LoginEmailUserResult, error) {
 
 	isLimited, err := h.otpService.IsRateLimited(ctx, cmd.Email)
 	if err != nil {
@@ -124,7 +154,7 @@ func (h *LoginEmailHandler) handleOAuthUserLogin(
 	}
 
 	otpCode, err := h.otpService.Generate(ctx, cmd.Email, services.OTPPurposeLogin)
-	if err != nil{
+	if err != nil {
 		return dtos.LoginEmailUserResult{}, fmt.Errorf("failed to generate login OTP: %w", err)
 	}
 
@@ -137,80 +167,68 @@ func (h *LoginEmailHandler) handleOAuthUserLogin(
 	}
 
 	return dtos.LoginEmailUserResult{
-		Email: cmd.Email,
+		Email:       cmd.Email,
 		RequiresOTP: true,
-		OTPSent: true,
-		Message: fmt.Sprintf("This account uses %s for sign-in. We've sent a verification code to your email.", user.User.Provider())
+		OTPSent:     true,
+		Message:     fmt.Sprintf("This account uses %s for sign-in. We've sent a verification code to your email.", user.User.Provider()),
 	}, nil
 
 }
 
-
 func (h *LoginEmailHandler) generateTokensAndLogin(
-	ctx contect.Context,
+	ctx context.Context,
 	user *aggregates.UserAggregate,
 	cmd commands.LoginEmailUserCommand,
-)(dtos.LoginEmailUserResult, error){
-	var session *aggregates.Session
-	var tokenPair services.TokenPair
+) (dtos.LoginEmailUserResult, error) {
+	var (
+		session   *entities.Session
+		tokenPair *services.TokenPair
+	)
 
 	err := h.uow.Execute(ctx, func(ctx context.Context) error {
-		metadata := services.SessionMetadata{
-			IPAddress: cmd.IPAddress,
-			UserAgent: cmd.UserAgent,
-			DeviceID: cmd.DeviceID,
-		}
-	
-
 		var err error
 		tokenPair, err = h.tokenService.Generate(
-			ctx, 
+			ctx,
 			user.ID(),
 			user.User.Role.String(),
-			user.User.Email.String()
-			metadata,
+			user.User.Email.String(),
+			services.SessionMetadata{
+				IPAddress:   cmd.IPAddress,
+				UserAgent:   cmd.UserAgent,
+				DeviceID:    cmd.DeviceID,
+				AuthMethods: []valueobjects.AuthMethod{valueobjects.AuthMethodPassword},
+			},
 		)
-
-		if err != nil{
+		if err != nil {
 			return fmt.Errorf("failed to generate tokens: %w", err)
 		}
 
-		sessionEntity := user.Login(
+		session = user.Login(
 			cmd.IPAddress,
 			cmd.UserAgent,
-			cmd.DeviceID,
 			tokenPair.RefreshToken,
 			tokenPair.AccessToken,
 			tokenPair.ExpiresAt,
 		)
-
-		if err := h.sessionRepo.Create(ctx, sessionEntity); err != nil{
+		if err := h.sessionRepo.Create(ctx, session); err != nil {
 			return fmt.Errorf("failed to create session: %w", err)
 		}
 
-		if err := h.userRepo.Update(ctx, user); err != nil{
+		// Saving the user also queues the user.logged_in event.
+		if err := h.userRepo.Update(ctx, user); err != nil {
 			return fmt.Errorf("failed to update user last login: %w", err)
 		}
-
-		session = sessionEntity
 		return nil
 	})
-
 	if err != nil {
 		_ = h.publishFailedLoginEvent(ctx, cmd, err)
 		return dtos.LoginEmailUserResult{}, err
-	}	
-
-	if err := h.publishSuccessLoginEvent(ctx, user, cmd); err != nil{
-		h.logger.Error(ctx, "Failed to publish login success event",)
-			zap.Error(err),
-			zap.String("user_id", user.ID()),
 	}
 
 	h.logger.Info(ctx, "Email user logged in successfully",
 		zap.String("user_id", user.ID()),
 		zap.String("email", cmd.Email),
-		zap.String("session_id", session.ID()),
+		zap.String("session_id", session.ID),
 	)
 
 	return dtos.LoginEmailUserResult{
@@ -220,76 +238,21 @@ func (h *LoginEmailHandler) generateTokensAndLogin(
 		FirstName:    user.User.FirstName,
 		LastName:     user.User.LastName,
 		Role:         user.User.Role.String(),
-		RequiresOTP: false,
+		RequiresOTP:  false,
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
-		ExpiresAt:    tokenPair.ExpiresAt.Unix(),
-		ExpiresIn:    int64(tokenPair.ExpiresAt.Sub(services.Now()).Seconds()),
-		SessionID:    session.ID(),
+		ExpiresAt:    tokenPair.ExpiresAt.Format(time.RFC3339),
+		ExpiresIn:    tokenPair.ExpiresIn,
+		SessionID:    session.ID,
 	}, nil
 }
 
-func (h *LoginEmailHandler) publishSuccessLoginEvent(
-	ctx context.Context,
-	user *aggregates.UserAggregate,
-	cmd commands.LoginEmailUserCommand,
-) error{
-	event := domain.NewUserLoginEvent(
-		user.ID(),
-		user.User.Email.String(),
-		cmd.IPAddress,
-		cmd.UserAgent,
-		cmd.DeviceID,
-		"SUCCESS",
-		"email"
-	)
-
-	outboxMsg := &persistence.OutboxMessage{
-		ID:          event.EventID().String(),
-		EventType:   event.EventName(),
-		AggregateID: event.AggregateID(),
-		Payload:     event.Payload(),
-		Metadata:    event.Metadata(),
-		OccurredAt:  event.OccurredAt().Unix(),
-	}
-	
-	if err := h.outbox.Save(ctx, outboxMsg); err != nil {
-		return fmt.Errorf("failed to save outbox event: %w", err)
-	}
-
-	return nil
-}
-
 func (h *LoginEmailHandler) publishFailedLoginEvent(
-	ctx context.Context, 
+	ctx context.Context,
 	cmd commands.LoginEmailUserCommand,
 	cause error,
-) error{
-	event := domain.NewUserLoginEvent(
-		"nil",
-		cmd.Email,
-		cmd.IPAddress,
-		cmd.UserAgent,
-		cmd.DeviceID,
-		"FAILURE",
-		"email",
-	)
-
-	meta := event.Metadata()
-	meta["error"] = cause.Error()
-
-	outboxMsg := &persistence.OutboxMessage{
-		ID:          event.EventID().String(),
-		EventType:   event.EventName(),
-		AggregateID: event.AggregateID(),
-		Payload:     event.Payload(),
-		Metadata:    event.Metadata(),
-		OccurredAt:  event.OccurredAt().Unix(),
-	}
-
-	if err := h.outbox.Save(ctx, outboxMsg); err != nil {
-		return fmt.Errorf("failed to save outbox event: %w", err)
-	}
-
-	return nil
-}
\ No newline at end of file
+) error {
+	return saveLoginFailedEvent(ctx, h.outbox, events.NewUserLoginFailedEvent(
+		cmd.Email, "email", cause.Error(), cmd.IPAddress, cmd.UserAgent, cmd.DeviceID,
+	))
+}
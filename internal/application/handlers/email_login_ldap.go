@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+
+	"go.uber.org/zap"
+)
+
+// handleLDAPLogin verifies the password against the directory, provisions
+// or refreshes the local account and signs the user in. current is the
+// account matching the typed email, if any.
+func (h *LoginEmailHandler) handleLDAPLogin(
+	ctx context.Context,
+	current *aggregates.UserAggregate,
+	cmd commands.LoginEmailUserCommand,
+) (dtos.LoginEmailUserResult, error) {
+	identity, err := h.ldap.Authenticate(ctx, cmd.Email, cmd.Password)
+	if err != nil {
+		_ = h.publishFailedLoginEvent(ctx, cmd, err)
+		if errors.Is(err, domain.ErrInvalidCredentials) {
+			h.recordLoginFailure(ctx, cmd)
+		}
+		if errors.Is(err, domain.ErrInvalidCredentials) || errors.Is(err, domain.ErrLDAPUnavailable) {
+			return dtos.LoginEmailUserResult{}, err
+		}
+		return dtos.LoginEmailUserResult{}, fmt.Errorf("failed to authenticate against ldap: %w", err)
+	}
+
+	user, err := h.syncLDAPUser(ctx, current, identity)
+	if err != nil {
+		_ = h.publishFailedLoginEvent(ctx, cmd, err)
+		return dtos.LoginEmailUserResult{}, err
+	}
+
+	if !user.User.IsActive {
+		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrInactiveUser)
+		return dtos.LoginEmailUserResult{}, domain.ErrInactiveUser
+	}
+
+	return h.completeLogin(ctx, user, cmd)
+}
+
+// syncLDAPUser creates the local account on first login and afterwards
+// refreshes name and role from the directory, which owns them. The account
+// is keyed by the directory's email, which need not be what the user typed
+// when the filter also matches e.g. userPrincipalName.
+func (h *LoginEmailHandler) syncLDAPUser(
+	ctx context.Context,
+	current *aggregates.UserAggregate,
+	identity *services.LDAPIdentity,
+) (*aggregates.UserAggregate, error) {
+	if identity.Email == "" {
+		return nil, domain.ErrLDAPMissingEmail
+	}
+	email, err := valueobjects.NewEmail(identity.Email)
+	if err != nil {
+		return nil, err
+	}
+	role, err := valueobjects.NewRoleName(identity.Role)
+	if err != nil {
+		return nil, fmt.Errorf("invalid role %q mapped from ldap groups: %w", identity.Role, err)
+	}
+
+	var (
+		user      *aggregates.UserAggregate
+		isNewUser bool
+	)
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		existing, err := h.userRepo.FindByEmail(ctx, email)
+		if err != nil && !repositories.IsNotFoundError(err) && err != domain.ErrUserNotFound {
+			return fmt.Errorf("failed to find user: %w", err)
+		}
+		if existing == nil && current != nil && current.User.Provider() == aggregates.LDAPUserProvider {
+			// The address changed in the directory; move the account along.
+			current.ChangeIdentity(current.User.Username, email)
+			existing = current
+		}
+
+		switch {
+		case existing == nil:
+			if !h.ldap.JITProvisioning() {
+				return domain.ErrLDAPProvisioningDisabled
+			}
+			user = aggregates.NewOAuthUserAggregate(
+				email,
+				identity.FirstName,
+				identity.LastName,
+				aggregates.LDAPUserProvider,
+				role,
+			)
+			h.applyLDAPUsername(ctx, user, identity.Username)
+			if err := h.userRepo.Create(ctx, user); err != nil {
+				return fmt.Errorf("failed to create user: %w", err)
+			}
+			isNewUser = true
+		case existing.User.Provider() != aggregates.LDAPUserProvider:
+			// Never let the directory take over an account that signs in
+			// another way.
+			return domain.ErrLDAPAccountConflict
+		default:
+			user = existing
+			if identity.FirstName != "" {
+				user.User.FirstName = identity.FirstName
+			}
+			if identity.LastName != "" {
+				user.User.LastName = identity.LastName
+			}
+			user.User.Role = role
+			if err := h.userRepo.Update(ctx, user); err != nil {
+				return fmt.Errorf("failed to update user: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if isNewUser {
+		h.logger.Info(ctx, "Provisioned user from ldap",
+			zap.String("user_id", user.ID()),
+			zap.String("dn", identity.DN),
+			zap.String("role", role.String()),
+		)
+	}
+	return user, nil
+}
+
+// applyLDAPUsername takes the directory username for a new account when it
+// is valid and free. Otherwise the account is created without one rather
+// than failing the login.
+func (h *LoginEmailHandler) applyLDAPUsername(ctx context.Context, user *aggregates.UserAggregate, value string) {
+	if value == "" {
+		return
+	}
+	username, err := valueobjects.NewUsername(value)
+	if err != nil {
+		h.logger.Warn(ctx, "Ignoring invalid username from ldap", zap.Error(err))
+		return
+	}
+	taken, err := h.userRepo.ExistsByUsername(ctx, username)
+	if err != nil || taken {
+		return
+	}
+	user.User.Username = username
+}
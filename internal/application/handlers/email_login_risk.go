@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+
+	"go.uber.org/zap"
+)
+
+// completeLogin runs the risk engine once the password (or directory bind)
+// has been accepted, then signs the user in, asks for a one-time code, or
+// refuses the login. A trusted device is not asked for the code, but is
+// still refused when the login is blocked.
+func (h *LoginEmailHandler) completeLogin(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	cmd commands.LoginEmailUserCommand,
+) (dtos.LoginEmailUserResult, error) {
+	attempt := h.loginAttempt(user, cmd)
+
+	assessment := assessLogin(ctx, h.risk, h.auditRepo, h.logger, attempt)
+	switch assessment.Decision {
+	case services.RiskDecisionBlock:
+		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrLoginBlocked)
+		return dtos.LoginEmailUserResult{}, domain.ErrLoginBlocked
+	case services.RiskDecisionStepUp:
+		if !isTrustedDevice(ctx, h.deviceRepo, h.deviceSigner, h.logger,
+			user.ID(), cmd.DeviceID, cmd.UserAgent, cmd.TrustedDeviceToken) {
+			return h.requireStepUp(ctx, user, cmd)
+		}
+	}
+
+	result, err := h.generateTokensAndLogin(ctx, user, cmd)
+	if err != nil {
+		return result, err
+	}
+	recordLoginOutcome(ctx, h.risk, h.logger, attempt, true)
+	return result, nil
+}
+
+// requireStepUp emails a login code; VerifyLoginOTPCommand completes the
+// sign-in.
+func (h *LoginEmailHandler) requireStepUp(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	cmd commands.LoginEmailUserCommand,
+) (dtos.LoginEmailUserResult, error) {
+	email := user.User.Email.String()
+
+	isLimited, err := h.otpService.IsRateLimited(ctx, email)
+	if err != nil {
+		return dtos.LoginEmailUserResult{}, fmt.Errorf("failed to check OTP rate limit: %w", err)
+	}
+	if isLimited {
+		return dtos.LoginEmailUserResult{}, domain.ErrOTPRateLimited
+	}
+
+	otpCode, err := h.otpService.Generate(ctx, email, services.OTPPurposeLogin)
+	if err != nil {
+		return dtos.LoginEmailUserResult{}, fmt.Errorf("failed to generate login OTP: %w", err)
+	}
+	if err := h.otpService.SendEmail(ctx, email, otpCode, services.OTPPurposeLogin); err != nil {
+		h.logger.Error(ctx, "Failed to send step-up OTP email",
+			zap.Error(err),
+			zap.String("user_id", user.ID()),
+		)
+		return dtos.LoginEmailUserResult{}, fmt.Errorf("failed to send OTP: %w", err)
+	}
+
+	return dtos.LoginEmailUserResult{
+		Email:       email,
+		RequiresOTP: true,
+		OTPSent:     true,
+		Message:     "We need to confirm it's you. We've sent a verification code to your email.",
+	}, nil
+}
+
+// recordLoginFailure counts a rejected password towards the risk score of
+// later attempts on the same email and from the same address.
+func (h *LoginEmailHandler) recordLoginFailure(ctx context.Context, cmd commands.LoginEmailUserCommand) {
+	recordLoginOutcome(ctx, h.risk, h.logger, services.LoginAttempt{
+		Email:     cmd.Email,
+		IPAddress: cmd.IPAddress,
+		UserAgent: cmd.UserAgent,
+		DeviceID:  cmd.DeviceID,
+		Method:    "email",
+	}, false)
+}
+
+func (h *LoginEmailHandler) loginAttempt(
+	user *aggregates.UserAggregate,
+	cmd commands.LoginEmailUserCommand,
+) services.LoginAttempt {
+	method := "email"
+	if user.User.Provider() == aggregates.LDAPUserProvider {
+		method = "ldap"
+	}
+	return services.LoginAttempt{
+		UserID:    user.ID(),
+		Email:     user.User.Email.String(),
+		IPAddress: cmd.IPAddress,
+		UserAgent: cmd.UserAgent,
+		DeviceID:  cmd.DeviceID,
+		Method:    method,
+	}
+}
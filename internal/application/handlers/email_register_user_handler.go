@@ -4,6 +4,7 @@ import (
 	"authentication/internal/application/commands"
 	"authentication/internal/application/contracts/messaging"
 	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
 	"authentication/internal/application/dtos"
 	"authentication/internal/domain"
 	"authentication/internal/domain/aggregates"
@@ -23,6 +24,7 @@ type RegisterEmailHandler struct {
 	outbox         persistence.OutboxRepository
 	uow            persistence.UnitOfWork
 	passwordHasher *domainServices.PasswordHashingService
+	challenge      services.ChallengeGate
 	logger         logging.Logger
 }
 
@@ -31,6 +33,7 @@ func NewRegisterEmailHandler(
 	outbox persistence.OutboxRepository,
 	uow persistence.UnitOfWork,
 	passwordHasher *domainServices.PasswordHashingService,
+	challenge services.ChallengeGate,
 	logger logging.Logger,
 ) messaging.CommandHandler[commands.RegisterEmailUserCommand, dtos.RegisterEmailUserResult] {
 	return &RegisterEmailHandler{
@@ -38,6 +41,7 @@ func NewRegisterEmailHandler(
 		outbox:         outbox,
 		uow:            uow,
 		passwordHasher: passwordHasher,
+		challenge:      challenge,
 		logger:         logger.With(zap.String("handler", "register_email")),
 	}
 }
@@ -58,6 +62,12 @@ func (r *RegisterEmailHandler) Handle(
 		}
 	}()
 
+	// Registration is not counted as a failed login, but an address that is
+	// guessing passwords must not mint accounts freely either.
+	if err := checkChallenge(ctx, r.challenge, cmd.Email, cmd.IPAddress, cmd.ChallengeResponse); err != nil {
+		return dtos.RegisterEmailUserResult{}, err
+	}
+
 	var user *aggregates.UserAggregate
 	err := r.uow.Execute(ctx, func(ctx context.Context) error {
 		var err error
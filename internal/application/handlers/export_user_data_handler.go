@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// exportAuditLimit caps the audit history in one export.
+const exportAuditLimit = 1000
+
+type ExportUserDataHandler struct {
+	userRepo       repositories.UserRepository
+	sessionRepo    repositories.SessionRepository
+	oauthTokenRepo repositories.OAuthTokenRepository
+	auditRepo      repositories.AuditRepository
+	logger         logging.Logger
+}
+
+func NewExportUserDataHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	oauthTokenRepo repositories.OAuthTokenRepository,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.ExportUserDataCommand, dtos.UserDataExport] {
+	return &ExportUserDataHandler{
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		oauthTokenRepo: oauthTokenRepo,
+		auditRepo:      auditRepo,
+		logger:         logger.With(zap.String("handler", "export_user_data")),
+	}
+}
+
+// Handle collects the profile, sessions, linked identities and audit history
+// of the user. Secrets (password hash, token values) are never included.
+func (h *ExportUserDataHandler) Handle(
+	ctx context.Context,
+	cmd commands.ExportUserDataCommand,
+) (dtos.UserDataExport, error) {
+	user, err := findUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return dtos.UserDataExport{}, err
+	}
+
+	sessions, err := h.sessionRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return dtos.UserDataExport{}, fmt.Errorf("failed to load sessions: %w", err)
+	}
+	tokens, err := h.oauthTokenRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return dtos.UserDataExport{}, fmt.Errorf("failed to load linked identities: %w", err)
+	}
+	auditLogs, err := h.auditRepo.FindByUserID(ctx, user.ID(), exportAuditLimit)
+	if err != nil {
+		return dtos.UserDataExport{}, fmt.Errorf("failed to load audit history: %w", err)
+	}
+
+	export := dtos.UserDataExport{
+		GeneratedAt:      time.Now().UTC(),
+		Profile:          toUserResult(user),
+		Sessions:         make([]dtos.SessionExport, 0, len(sessions)),
+		LinkedIdentities: make([]dtos.LinkedIdentityExport, 0, len(tokens)+1),
+		AuditHistory:     make([]dtos.AuditEntryExport, 0, len(auditLogs)),
+	}
+
+	for _, s := range sessions {
+		export.Sessions = append(export.Sessions, dtos.SessionExport{
+			ID:        s.ID,
+			IPAddress: s.IPAddress,
+			UserAgent: s.UserAgent,
+			CreatedAt: s.CreatedAt,
+			ExpiresAt: s.ExpiresAt,
+			Revoked:   s.IsRevoked,
+		})
+	}
+
+	linked := make(map[string]bool)
+	for _, t := range tokens {
+		createdAt := t.CreatedAt
+		export.LinkedIdentities = append(export.LinkedIdentities, dtos.LinkedIdentityExport{
+			Provider:  t.Provider,
+			Scope:     t.Scope,
+			CreatedAt: &createdAt,
+		})
+		linked[t.Provider] = true
+	}
+	if provider := user.User.Provider(); provider != "" && !linked[provider] {
+		export.LinkedIdentities = append(export.LinkedIdentities, dtos.LinkedIdentityExport{Provider: provider})
+	}
+
+	for _, l := range auditLogs {
+		export.AuditHistory = append(export.AuditHistory, dtos.AuditEntryExport{
+			Action:       l.Action.String(),
+			ResourceType: l.ResourceType,
+			ResourceID:   l.ResourceID,
+			Status:       l.Status,
+			IPAddress:    l.IPAddress,
+			UserAgent:    l.UserAgent,
+			Timestamp:    l.Timestamp,
+		})
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, user.ID(), valueobjects.AuditActionUserDataExported,
+		user.ID(), cmd.IPAddress, cmd.UserAgent, nil)
+
+	h.logger.Info(ctx, "User data exported",
+		zap.String("user_id", user.ID()),
+		zap.Int("sessions", len(export.Sessions)),
+		zap.Int("audit_entries", len(export.AuditHistory)),
+	)
+
+	return export, nil
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Test doubles shared by the handler tests. Each embeds the interface it
+// stands in for, so calling a method a test did not expect panics.
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(context.Context, string, ...zap.Field) {}
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Warn(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+func (nopLogger) Fatal(context.Context, string, ...zap.Field) {}
+func (l nopLogger) With(...zap.Field) logging.Logger          { return l }
+
+type fakeOAuthClientRepository struct {
+	repositories.OAuthClientRepository
+	clients map[string]*aggregates.OAuthClient
+}
+
+func (r *fakeOAuthClientRepository) FindByClientID(_ context.Context, clientID string) (*aggregates.OAuthClient, error) {
+	client, ok := r.clients[clientID]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	return client, nil
+}
+
+type fakeAuditRepository struct {
+	repositories.AuditRepository
+	logs []*aggregates.AuditLog
+}
+
+func (r *fakeAuditRepository) Create(_ context.Context, log *aggregates.AuditLog) error {
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+type fakeTokenService struct {
+	services.TokenService
+	access  map[string]*services.TokenClaims
+	revoked []string
+
+	mu        sync.Mutex
+	generated []services.SessionMetadata
+}
+
+func (s *fakeTokenService) VerifyAccess(_ context.Context, token string) (*services.TokenClaims, error) {
+	claims, ok := s.access[token]
+	if !ok {
+		return nil, domain.ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func (s *fakeTokenService) VerifyRefresh(context.Context, string) (*services.TokenClaims, error) {
+	return nil, domain.ErrInvalidToken
+}
+
+func (s *fakeTokenService) RevokeSession(_ context.Context, sessionID string) error {
+	s.revoked = append(s.revoked, sessionID)
+	return nil
+}
+
+// fakeRoleRepository serves custom roles by name and falls back to the
+// built-in definitions, as the real repository does.
+type fakeRoleRepository struct {
+	repositories.RoleRepository
+	roles map[valueobjects.Role]*aggregates.Role
+}
+
+func (r *fakeRoleRepository) FindByName(_ context.Context, name valueobjects.Role) (*aggregates.Role, error) {
+	if role, ok := r.roles[name]; ok {
+		return role, nil
+	}
+	if role, ok := aggregates.SystemRole(name); ok {
+		return role, nil
+	}
+	return nil, repositories.ErrNotFound
+}
+
+type fakeAuthorizer struct {
+	services.Authorizer
+	permissions map[string][]valueobjects.Permission
+}
+
+func (a *fakeAuthorizer) EffectivePermissions(_ context.Context, userID string) ([]valueobjects.Permission, error) {
+	return a.permissions[userID], nil
+}
+
+func (s *fakeTokenService) Generate(
+	_ context.Context,
+	userID, _, _ string,
+	metadata services.SessionMetadata,
+) (*services.TokenPair, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generated = append(s.generated, metadata)
+	return &services.TokenPair{
+		AccessToken:  "access-" + userID,
+		RefreshToken: "refresh-" + userID,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		SessionID:    "session-" + userID,
+	}, nil
+}
+
+// memoryCache round-trips values through JSON, as the Redis cache does.
+type memoryCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{entries: map[string][]byte{}}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string, dest interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.entries[key]
+	if !ok {
+		return persistence.ErrCacheMiss
+	}
+	return json.Unmarshal(data, dest)
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value interface{}, _ time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = data
+	return nil
+}
+
+func (c *memoryCache) Delete(_ context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+	return nil
+}
+
+func (c *memoryCache) Exists(_ context.Context, key string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.entries[key]
+	return ok, nil
+}
+
+func (c *memoryCache) SetIfAbsent(_ context.Context, key string, value interface{}, _ time.Duration) (bool, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; ok {
+		return false, nil
+	}
+	c.entries[key] = data
+	return true, nil
+}
+
+// fakeUnitOfWork runs the function without a transaction.
+type fakeUnitOfWork struct {
+	persistence.UnitOfWork
+}
+
+func (fakeUnitOfWork) Execute(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeUserRepository struct {
+	repositories.UserRepository
+	mu    sync.Mutex
+	users map[string]*aggregates.UserAggregate
+}
+
+func (r *fakeUserRepository) FindByID(_ context.Context, id string) (*aggregates.UserAggregate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	user, ok := r.users[id]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepository) Update(context.Context, *aggregates.UserAggregate) error {
+	return nil
+}
+
+type fakeSessionRepository struct {
+	repositories.SessionRepository
+	mu       sync.Mutex
+	sessions []*entities.Session
+}
+
+func (r *fakeSessionRepository) Create(_ context.Context, session *entities.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sessions = append(r.sessions, session)
+	return nil
+}
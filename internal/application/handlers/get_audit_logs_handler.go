@@ -1 +1,102 @@
-package handlers
\ No newline at end of file
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type GetAuditLogsHandler struct {
+	auditRepo repositories.AuditRepository
+	logger    logging.Logger
+}
+
+func NewGetAuditLogsHandler(
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.GetAuditLogsQuery, dtos.ListAuditLogsResult] {
+	return &GetAuditLogsHandler{
+		auditRepo: auditRepo,
+		logger:    logger.With(zap.String("handler", "get_audit_logs")),
+	}
+}
+
+func (h *GetAuditLogsHandler) Handle(
+	ctx context.Context,
+	query queries.GetAuditLogsQuery,
+) (dtos.ListAuditLogsResult, error) {
+	if err := checkAuditDateRange(query.StartDate, query.EndDate); err != nil {
+		return dtos.ListAuditLogsResult{}, err
+	}
+
+	page, pageSize := utils.NormalizePagination(query.Page, query.PageSize)
+
+	filter := repositories.AuditLogFilter{
+		UserID:       query.UserID,
+		ResourceType: query.ResourceType,
+		ResourceID:   query.ResourceID,
+		Status:       query.Status,
+		StartDate:    query.StartDate,
+		EndDate:      query.EndDate,
+		Page:         page,
+		PageSize:     pageSize,
+	}
+	if query.Action != nil {
+		action := valueobjects.AuditAction(*query.Action)
+		if !action.IsValid() {
+			return dtos.ListAuditLogsResult{}, domain.ErrInvalidAuditAction
+		}
+		filter.Action = &action
+	}
+
+	logs, total, err := h.auditRepo.List(ctx, filter)
+	if err != nil {
+		return dtos.ListAuditLogsResult{}, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+
+	return dtos.ListAuditLogsResult{
+		Logs:       toAuditLogResults(logs),
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
+
+func checkAuditDateRange(start, end *time.Time) error {
+	if start != nil && end != nil && !start.Before(*end) {
+		return domain.ErrInvalidAuditDateRange
+	}
+	return nil
+}
+
+func toAuditLogResults(logs []*aggregates.AuditLog) []dtos.AuditLogResult {
+	results := make([]dtos.AuditLogResult, 0, len(logs))
+	for _, log := range logs {
+		results = append(results, dtos.AuditLogResult{
+			ID:           log.ID(),
+			UserID:       log.UserID,
+			Action:       log.Action.String(),
+			ResourceType: log.ResourceType,
+			ResourceID:   log.ResourceID,
+			IPAddress:    log.IPAddress,
+			UserAgent:    log.UserAgent,
+			Status:       log.Status,
+			ErrorMessage: log.ErrorMessage,
+			Metadata:     log.Metadata,
+			Timestamp:    log.Timestamp,
+		})
+	}
+	return results
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+)
+
+type GetChallengeHandler struct {
+	gate services.ChallengeGate
+}
+
+// NewGetChallengeHandler serves challenges from gate; a nil gate means
+// challenges are disabled.
+func NewGetChallengeHandler(
+	gate services.ChallengeGate,
+) messaging.QueryHandler[queries.GetChallengeQuery, dtos.ChallengeResult] {
+	return &GetChallengeHandler{gate: gate}
+}
+
+func (h *GetChallengeHandler) Handle(
+	ctx context.Context,
+	query queries.GetChallengeQuery,
+) (dtos.ChallengeResult, error) {
+	if h.gate == nil {
+		return dtos.ChallengeResult{Provider: "none"}, nil
+	}
+
+	challenge, err := h.gate.Issue(ctx)
+	if err != nil {
+		return dtos.ChallengeResult{}, fmt.Errorf("failed to issue challenge: %w", err)
+	}
+	return dtos.ChallengeResult{
+		Provider:   challenge.Provider,
+		Token:      challenge.Token,
+		Difficulty: challenge.Difficulty,
+		SiteKey:    challenge.SiteKey,
+		ExpiresAt:  challenge.ExpiresAt,
+	}, nil
+}
+
+// checkChallenge runs gate for a login or registration; a nil gate means
+// challenges are disabled.
+func checkChallenge(ctx context.Context, gate services.ChallengeGate, email, ipAddress, response string) error {
+	if gate == nil {
+		return nil
+	}
+	return gate.Check(ctx, email, ipAddress, response)
+}
@@ -1 +1,100 @@
-package handlers
\ No newline at end of file
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type GetUserHandler struct {
+	userRepo repositories.UserRepository
+	logger   logging.Logger
+}
+
+func NewGetUserHandler(
+	userRepo repositories.UserRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.GetUserQuery, dtos.UserResult] {
+	return &GetUserHandler{
+		userRepo: userRepo,
+		logger:   logger.With(zap.String("handler", "get_user")),
+	}
+}
+
+func (h *GetUserHandler) Handle(ctx context.Context, query queries.GetUserQuery) (dtos.UserResult, error) {
+	user, err := findUser(ctx, h.userRepo, query.UserID)
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+	return toUserResult(user), nil
+}
+
+func findUser(ctx context.Context, userRepo repositories.UserRepository, userID string) (*aggregates.UserAggregate, error) {
+	user, err := userRepo.FindByID(ctx, userID)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return user, nil
+}
+
+// recordUserAudit logs an administrative change to a user account.
+func recordUserAudit(
+	ctx context.Context,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+	actorID string,
+	action valueobjects.AuditAction,
+	userID, ipAddress, userAgent string,
+	metadata map[string]interface{},
+) {
+	auditLog := aggregates.NewAuditLog(
+		actorID,
+		action,
+		"user",
+		userID,
+		ipAddress,
+		userAgent,
+		"SUCCESS",
+		metadata,
+	)
+	if err := auditRepo.Create(ctx, auditLog); err != nil {
+		logger.Error(ctx, "Failed to record user audit log",
+			zap.Error(err),
+			zap.String("action", action.String()),
+			zap.String("user_id", userID),
+		)
+	}
+}
+
+func toUserResult(user *aggregates.UserAggregate) dtos.UserResult {
+	return dtos.UserResult{
+		ID:            user.ID(),
+		Username:      user.User.Username.String(),
+		Email:         user.User.Email.String(),
+		Phone:         user.User.Phone.String(),
+		PhoneVerified: user.User.PhoneVerified,
+		FirstName:     user.User.FirstName,
+		LastName:      user.User.LastName,
+		Role:          user.User.Role.String(),
+		Provider:      user.User.Provider(),
+		IsActive:      user.User.IsActive,
+		IsVerified:    user.User.IsVerified,
+		LastLoginAt:   user.User.LastLoginAt,
+		CreatedAt:     user.User.CreatedAt,
+		UpdatedAt:     user.User.UpdatedAt,
+		Version:       user.Version(),
+	}
+}
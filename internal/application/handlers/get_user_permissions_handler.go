@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type GetUserPermissionsHandler struct {
+	userRepo   repositories.UserRepository
+	roleRepo   repositories.RoleRepository
+	authorizer services.Authorizer
+	logger     logging.Logger
+}
+
+func NewGetUserPermissionsHandler(
+	userRepo repositories.UserRepository,
+	roleRepo repositories.RoleRepository,
+	authorizer services.Authorizer,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.GetUserPermissionsQuery, dtos.UserPermissionsResult] {
+	return &GetUserPermissionsHandler{
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		authorizer: authorizer,
+		logger:     logger.With(zap.String("handler", "get_user_permissions")),
+	}
+}
+
+func (h *GetUserPermissionsHandler) Handle(
+	ctx context.Context,
+	query queries.GetUserPermissionsQuery,
+) (dtos.UserPermissionsResult, error) {
+	if query.ActorID != query.UserID {
+		if err := h.authorizer.Authorize(ctx, query.ActorID, valueobjects.PermissionRolesRead); err != nil {
+			return dtos.UserPermissionsResult{}, err
+		}
+	}
+
+	return userPermissions(ctx, h.userRepo, h.roleRepo, h.authorizer, query.UserID)
+}
+
+func userPermissions(
+	ctx context.Context,
+	userRepo repositories.UserRepository,
+	roleRepo repositories.RoleRepository,
+	authorizer services.Authorizer,
+	userID string,
+) (dtos.UserPermissionsResult, error) {
+	user, err := userRepo.FindByID(ctx, userID)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return dtos.UserPermissionsResult{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return dtos.UserPermissionsResult{}, domain.ErrUserNotFound
+	}
+
+	assigned, err := roleRepo.FindUserRoles(ctx, userID)
+	if err != nil {
+		return dtos.UserPermissionsResult{}, err
+	}
+
+	roles := []string{user.User.Role.String()}
+	for _, r := range assigned {
+		if r != user.User.Role {
+			roles = append(roles, r.String())
+		}
+	}
+
+	permissions, err := authorizer.EffectivePermissions(ctx, userID)
+	if err != nil {
+		return dtos.UserPermissionsResult{}, err
+	}
+
+	return dtos.UserPermissionsResult{
+		UserID:      userID,
+		Roles:       roles,
+		Permissions: valueobjects.SortedPermissionStrings(permissions),
+	}, nil
+}
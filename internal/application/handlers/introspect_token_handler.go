@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type IntrospectTokenHandler struct {
+	clientRepo    repositories.OAuthClientRepository
+	tokenService  services.TokenService
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewIntrospectTokenHandler(
+	clientRepo repositories.OAuthClientRepository,
+	tokenService services.TokenService,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.IntrospectTokenQuery, dtos.TokenIntrospectionResult] {
+	return &IntrospectTokenHandler{
+		clientRepo:    clientRepo,
+		tokenService:  tokenService,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "introspect_token")),
+	}
+}
+
+func (h *IntrospectTokenHandler) Handle(
+	ctx context.Context,
+	query queries.IntrospectTokenQuery,
+) (dtos.TokenIntrospectionResult, error) {
+	client, err := authenticateOAuthClient(ctx, h.clientRepo, h.secretService, query.ClientID, query.ClientSecret)
+	if err != nil {
+		return dtos.TokenIntrospectionResult{}, err
+	}
+
+	inactive := dtos.TokenIntrospectionResult{Active: false}
+
+	claims, tokenType, err := verifyTokenWithHint(ctx, h.tokenService, query.Token, query.TokenTypeHint)
+	if err != nil {
+		h.logger.Debug(ctx, "Introspected token is not active",
+			zap.String("client_id", client.ClientID),
+			zap.Error(err),
+		)
+		return inactive, nil
+	}
+
+	if claims.SessionID != "" {
+		valid, err := h.tokenService.IsSessionValid(ctx, claims.SessionID)
+		if err != nil {
+			return dtos.TokenIntrospectionResult{}, fmt.Errorf("failed to check session: %w", err)
+		}
+		if !valid {
+			return inactive, nil
+		}
+	}
+
+	result := dtos.TokenIntrospectionResult{
+		Active:    true,
+		Scope:     strings.Join(claims.Scopes, " "),
+		Username:  claims.Email,
+		TokenType: tokenType,
+		Subject:   claims.UserID,
+		SessionID: claims.SessionID,
+		TokenID:   claims.TokenID,
+		IssuedAt:  claims.IssuedAt.Unix(),
+	}
+	// API keys may have no expiry
+	if !claims.ExpiresAt.IsZero() {
+		result.ExpiresAt = claims.ExpiresAt.Unix()
+	}
+	return result, nil
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const invitationEmailTemplate = "organization_invitation"
+
+type InviteOrganizationMemberHandler struct {
+	orgRepo        repositories.OrganizationRepository
+	invitationRepo repositories.InvitationRepository
+	userRepo       repositories.UserRepository
+	auditRepo      repositories.AuditRepository
+	uow            persistence.UnitOfWork
+	signer         services.InvitationSigner
+	emailService   services.EmailService
+	invitationURL  string
+	invitationTTL  time.Duration
+	logger         logging.Logger
+}
+
+func NewInviteOrganizationMemberHandler(
+	orgRepo repositories.OrganizationRepository,
+	invitationRepo repositories.InvitationRepository,
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	signer services.InvitationSigner,
+	emailService services.EmailService,
+	invitationURL string,
+	invitationTTL time.Duration,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.InviteOrganizationMemberCommand, dtos.InvitationResult] {
+	return &InviteOrganizationMemberHandler{
+		orgRepo:        orgRepo,
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		auditRepo:      auditRepo,
+		uow:            uow,
+		signer:         signer,
+		emailService:   emailService,
+		invitationURL:  invitationURL,
+		invitationTTL:  invitationTTL,
+		logger:         logger.With(zap.String("handler", "invite_organization_member")),
+	}
+}
+
+func (h *InviteOrganizationMemberHandler) Handle(
+	ctx context.Context,
+	cmd commands.InviteOrganizationMemberCommand,
+) (dtos.InvitationResult, error) {
+	email, err := valueobjects.NewEmail(cmd.Email)
+	if err != nil {
+		return dtos.InvitationResult{}, err
+	}
+
+	role, err := valueobjects.NewOrgRole(cmd.Role)
+	if err != nil {
+		return dtos.InvitationResult{}, err
+	}
+
+	org, actor, err := findMembership(ctx, h.orgRepo, cmd.OrganizationID, cmd.ActorID)
+	if err != nil {
+		return dtos.InvitationResult{}, err
+	}
+
+	// Owners may invite admins and members; admins only members.
+	if !actor.Role.CanManageMembers() || !actor.Role.Outranks(role) {
+		return dtos.InvitationResult{}, domain.ErrPermissionDenied
+	}
+
+	if err := h.ensureNotMember(ctx, org.ID(), email); err != nil {
+		return dtos.InvitationResult{}, err
+	}
+
+	invitation, err := aggregates.NewInvitation(org.ID(), email, role, cmd.ActorID, h.invitationTTL)
+	if err != nil {
+		return dtos.InvitationResult{}, err
+	}
+
+	token, err := h.signer.Sign(invitation.ID(), invitation.ExpiresAt)
+	if err != nil {
+		return dtos.InvitationResult{}, fmt.Errorf("failed to sign invitation: %w", err)
+	}
+
+	// The email is sent inside the transaction so an invitation that could
+	// not be delivered is not left behind.
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		if err := h.invitationRepo.Create(ctx, invitation); err != nil {
+			return err
+		}
+
+		err := h.emailService.SendEmail(ctx, services.SendEmailInput{
+			To:       email.String(),
+			Subject:  fmt.Sprintf("You have been invited to join %s", org.Name),
+			Template: invitationEmailTemplate,
+			Data: map[string]interface{}{
+				"organization": org.Name,
+				"role":         role.String(),
+				"link":         h.invitationLink(token),
+				"expires_at":   invitation.ExpiresAt,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send invitation email: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.InvitationResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOrgMemberInvited,
+		"organization",
+		org.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"invitation_id": invitation.ID(),
+			"email":         email.String(),
+			"role":          role.String(),
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record invitation audit log",
+			zap.Error(err),
+			zap.String("invitation_id", invitation.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "Organization invitation sent",
+		zap.String("organization_id", org.ID()),
+		zap.String("invitation_id", invitation.ID()),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toInvitationResult(invitation), nil
+}
+
+func (h *InviteOrganizationMemberHandler) ensureNotMember(ctx context.Context, organizationID string, email valueobjects.Email) error {
+	user, err := h.userRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil
+	}
+
+	_, err = h.orgRepo.FindMember(ctx, organizationID, user.ID())
+	if err == nil {
+		return domain.ErrAlreadyOrganizationMember
+	}
+	if !repositories.IsNotFoundError(err) {
+		return fmt.Errorf("failed to find organization member: %w", err)
+	}
+	return nil
+}
+
+func (h *InviteOrganizationMemberHandler) invitationLink(token string) string {
+	separator := "?"
+	if strings.Contains(h.invitationURL, "?") {
+		separator = "&"
+	}
+	return h.invitationURL + separator + "token=" + url.QueryEscape(token)
+}
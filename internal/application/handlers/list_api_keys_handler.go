@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type ListAPIKeysHandler struct {
+	keyRepo repositories.APIKeyRepository
+	logger  logging.Logger
+}
+
+func NewListAPIKeysHandler(
+	keyRepo repositories.APIKeyRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListAPIKeysQuery, dtos.ListAPIKeysResult] {
+	return &ListAPIKeysHandler{
+		keyRepo: keyRepo,
+		logger:  logger.With(zap.String("handler", "list_api_keys")),
+	}
+}
+
+func (h *ListAPIKeysHandler) Handle(
+	ctx context.Context,
+	query queries.ListAPIKeysQuery,
+) (dtos.ListAPIKeysResult, error) {
+	page, pageSize := utils.NormalizePagination(query.Page, query.PageSize)
+
+	var keys []*aggregates.APIKey
+	var total int64
+	var err error
+	if query.UserID != "" {
+		keys, total, err = h.keyRepo.ListByUser(ctx, query.UserID, page, pageSize)
+	} else {
+		keys, total, err = h.keyRepo.List(ctx, page, pageSize)
+	}
+	if err != nil {
+		return dtos.ListAPIKeysResult{}, fmt.Errorf("failed to list api keys: %w", err)
+	}
+
+	results := make([]dtos.APIKeyResult, 0, len(keys))
+	for _, key := range keys {
+		results = append(results, toAPIKeyResult(key))
+	}
+
+	return dtos.ListAPIKeysResult{
+		Keys:       results,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type ListOAuthClientsHandler struct {
+	clientRepo repositories.OAuthClientRepository
+	logger     logging.Logger
+}
+
+func NewListOAuthClientsHandler(
+	clientRepo repositories.OAuthClientRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListOAuthClientsQuery, dtos.ListOAuthClientsResult] {
+	return &ListOAuthClientsHandler{
+		clientRepo: clientRepo,
+		logger:     logger.With(zap.String("handler", "list_oauth_clients")),
+	}
+}
+
+func (h *ListOAuthClientsHandler) Handle(
+	ctx context.Context,
+	query queries.ListOAuthClientsQuery,
+) (dtos.ListOAuthClientsResult, error) {
+	page, pageSize := utils.NormalizePagination(query.Page, query.PageSize)
+
+	clients, total, err := h.clientRepo.List(ctx, page, pageSize)
+	if err != nil {
+		return dtos.ListOAuthClientsResult{}, fmt.Errorf("failed to list oauth clients: %w", err)
+	}
+
+	results := make([]dtos.OAuthClientResult, 0, len(clients))
+	for _, client := range clients {
+		results = append(results, toOAuthClientResult(client))
+	}
+
+	return dtos.ListOAuthClientsResult{
+		Clients:    results,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
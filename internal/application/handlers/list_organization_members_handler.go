@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ListOrganizationMembersHandler struct {
+	orgRepo        repositories.OrganizationRepository
+	invitationRepo repositories.InvitationRepository
+	logger         logging.Logger
+}
+
+func NewListOrganizationMembersHandler(
+	orgRepo repositories.OrganizationRepository,
+	invitationRepo repositories.InvitationRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListOrganizationMembersQuery, dtos.ListOrganizationMembersResult] {
+	return &ListOrganizationMembersHandler{
+		orgRepo:        orgRepo,
+		invitationRepo: invitationRepo,
+		logger:         logger.With(zap.String("handler", "list_organization_members")),
+	}
+}
+
+func (h *ListOrganizationMembersHandler) Handle(
+	ctx context.Context,
+	query queries.ListOrganizationMembersQuery,
+) (dtos.ListOrganizationMembersResult, error) {
+	org, actor, err := findMembership(ctx, h.orgRepo, query.OrganizationID, query.ActorID)
+	if err != nil {
+		return dtos.ListOrganizationMembersResult{}, err
+	}
+
+	members, err := h.orgRepo.ListMembers(ctx, org.ID())
+	if err != nil {
+		return dtos.ListOrganizationMembersResult{}, fmt.Errorf("failed to list organization members: %w", err)
+	}
+
+	result := dtos.ListOrganizationMembersResult{
+		Members:     make([]dtos.OrganizationMemberResult, 0, len(members)),
+		Invitations: []dtos.InvitationResult{},
+	}
+	for _, member := range members {
+		result.Members = append(result.Members, toOrganizationMemberResult(member))
+	}
+
+	if actor.Role.CanManageMembers() {
+		invitations, err := h.invitationRepo.ListPending(ctx, org.ID())
+		if err != nil {
+			return dtos.ListOrganizationMembersResult{}, fmt.Errorf("failed to list invitations: %w", err)
+		}
+		for _, invitation := range invitations {
+			result.Invitations = append(result.Invitations, toInvitationResult(invitation))
+		}
+	}
+
+	return result, nil
+}
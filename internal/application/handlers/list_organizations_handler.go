@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ListOrganizationsHandler struct {
+	orgRepo repositories.OrganizationRepository
+	logger  logging.Logger
+}
+
+func NewListOrganizationsHandler(
+	orgRepo repositories.OrganizationRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListOrganizationsQuery, dtos.ListOrganizationsResult] {
+	return &ListOrganizationsHandler{
+		orgRepo: orgRepo,
+		logger:  logger.With(zap.String("handler", "list_organizations")),
+	}
+}
+
+func (h *ListOrganizationsHandler) Handle(
+	ctx context.Context,
+	query queries.ListOrganizationsQuery,
+) (dtos.ListOrganizationsResult, error) {
+	orgs, err := h.orgRepo.ListByUser(ctx, query.ActorID)
+	if err != nil {
+		return dtos.ListOrganizationsResult{}, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	results := make([]dtos.OrganizationResult, 0, len(orgs))
+	for _, org := range orgs {
+		member, err := h.orgRepo.FindMember(ctx, org.ID(), query.ActorID)
+		if err != nil {
+			return dtos.ListOrganizationsResult{}, fmt.Errorf("failed to find organization member: %w", err)
+		}
+		results = append(results, toOrganizationResult(org, member.Role))
+	}
+
+	return dtos.ListOrganizationsResult{Organizations: results}, nil
+}
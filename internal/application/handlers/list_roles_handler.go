@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ListRolesHandler struct {
+	roleRepo   repositories.RoleRepository
+	authorizer services.Authorizer
+	logger     logging.Logger
+}
+
+func NewListRolesHandler(
+	roleRepo repositories.RoleRepository,
+	authorizer services.Authorizer,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListRolesQuery, dtos.ListRolesResult] {
+	return &ListRolesHandler{
+		roleRepo:   roleRepo,
+		authorizer: authorizer,
+		logger:     logger.With(zap.String("handler", "list_roles")),
+	}
+}
+
+func (h *ListRolesHandler) Handle(ctx context.Context, query queries.ListRolesQuery) (dtos.ListRolesResult, error) {
+	if err := h.authorizer.Authorize(ctx, query.ActorID, valueobjects.PermissionRolesRead); err != nil {
+		return dtos.ListRolesResult{}, err
+	}
+
+	roles, err := h.roleRepo.List(ctx)
+	if err != nil {
+		return dtos.ListRolesResult{}, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	results := make([]dtos.RoleResult, 0, len(roles))
+	for _, role := range roles {
+		results = append(results, toRoleResult(role))
+	}
+
+	return dtos.ListRolesResult{Roles: results}, nil
+}
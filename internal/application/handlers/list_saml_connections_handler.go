@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ListSAMLConnectionsHandler struct {
+	connRepo repositories.SAMLConnectionRepository
+	logger   logging.Logger
+}
+
+func NewListSAMLConnectionsHandler(
+	connRepo repositories.SAMLConnectionRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListSAMLConnectionsQuery, dtos.ListSAMLConnectionsResult] {
+	return &ListSAMLConnectionsHandler{
+		connRepo: connRepo,
+		logger:   logger.With(zap.String("handler", "list_saml_connections")),
+	}
+}
+
+func (h *ListSAMLConnectionsHandler) Handle(
+	ctx context.Context,
+	query queries.ListSAMLConnectionsQuery,
+) (dtos.ListSAMLConnectionsResult, error) {
+	conns, err := h.connRepo.List(ctx)
+	if err != nil {
+		return dtos.ListSAMLConnectionsResult{}, fmt.Errorf("failed to list saml connections: %w", err)
+	}
+
+	results := make([]dtos.SAMLConnectionResult, 0, len(conns))
+	for _, conn := range conns {
+		results = append(results, toSAMLConnectionResult(conn))
+	}
+
+	return dtos.ListSAMLConnectionsResult{Connections: results}, nil
+}
+
+type GetSAMLMetadataHandler struct {
+	connRepo repositories.SAMLConnectionRepository
+	sp       services.SAMLServiceProvider
+	logger   logging.Logger
+}
+
+func NewGetSAMLMetadataHandler(
+	connRepo repositories.SAMLConnectionRepository,
+	sp services.SAMLServiceProvider,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.GetSAMLMetadataQuery, dtos.SAMLMetadataResult] {
+	return &GetSAMLMetadataHandler{
+		connRepo: connRepo,
+		sp:       sp,
+		logger:   logger.With(zap.String("handler", "get_saml_metadata")),
+	}
+}
+
+func (h *GetSAMLMetadataHandler) Handle(
+	ctx context.Context,
+	query queries.GetSAMLMetadataQuery,
+) (dtos.SAMLMetadataResult, error) {
+	conn, err := findSAMLConnection(ctx, h.connRepo, query.ConnectionID)
+	if err != nil {
+		return dtos.SAMLMetadataResult{}, err
+	}
+
+	metadata, err := h.sp.Metadata(conn.ID())
+	if err != nil {
+		return dtos.SAMLMetadataResult{}, fmt.Errorf("failed to build saml metadata: %w", err)
+	}
+
+	return dtos.SAMLMetadataResult{XML: metadata}, nil
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type ListSCIMGroupsHandler struct {
+	roleRepo repositories.RoleRepository
+	logger   logging.Logger
+}
+
+func NewListSCIMGroupsHandler(
+	roleRepo repositories.RoleRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListSCIMGroupsQuery, dtos.ListSCIMGroupsResult] {
+	return &ListSCIMGroupsHandler{
+		roleRepo: roleRepo,
+		logger:   logger.With(zap.String("handler", "list_scim_groups")),
+	}
+}
+
+// Handle lists the tenant's custom roles as groups. Roles are few enough
+// to page through in memory.
+func (h *ListSCIMGroupsHandler) Handle(
+	ctx context.Context,
+	query queries.ListSCIMGroupsQuery,
+) (dtos.ListSCIMGroupsResult, error) {
+	roles, err := h.roleRepo.List(ctx)
+	if err != nil {
+		return dtos.ListSCIMGroupsResult{}, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	var groups []*aggregates.Role
+	for _, role := range roles {
+		if !role.IsSCIMGroup() {
+			continue
+		}
+		if query.DisplayName != "" && role.Name.String() != scimGroupRoleName(query.DisplayName) {
+			continue
+		}
+		groups = append(groups, role)
+	}
+
+	startIndex := query.StartIndex
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	_, count := utils.NormalizePagination(1, query.Count)
+	if query.Count == 0 {
+		count = 0
+	}
+
+	var page []*aggregates.Role
+	if startIndex <= len(groups) {
+		page = groups[startIndex-1:]
+		if len(page) > count {
+			page = page[:count]
+		}
+	}
+
+	results := make([]dtos.SCIMGroupResult, 0, len(page))
+	for _, role := range page {
+		result, err := toSCIMGroupResult(ctx, h.roleRepo, role)
+		if err != nil {
+			return dtos.ListSCIMGroupsResult{}, fmt.Errorf("failed to load group members: %w", err)
+		}
+		results = append(results, result)
+	}
+
+	return dtos.ListSCIMGroupsResult{
+		Groups:       results,
+		TotalResults: int64(len(groups)),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(results),
+	}, nil
+}
+
+type GetSCIMGroupHandler struct {
+	roleRepo repositories.RoleRepository
+	logger   logging.Logger
+}
+
+func NewGetSCIMGroupHandler(
+	roleRepo repositories.RoleRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.GetSCIMGroupQuery, dtos.SCIMGroupResult] {
+	return &GetSCIMGroupHandler{
+		roleRepo: roleRepo,
+		logger:   logger.With(zap.String("handler", "get_scim_group")),
+	}
+}
+
+func (h *GetSCIMGroupHandler) Handle(
+	ctx context.Context,
+	query queries.GetSCIMGroupQuery,
+) (dtos.SCIMGroupResult, error) {
+	role, err := findSCIMGroup(ctx, h.roleRepo, query.GroupID)
+	if err != nil {
+		return dtos.SCIMGroupResult{}, err
+	}
+	return toSCIMGroupResult(ctx, h.roleRepo, role)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ListSCIMTokensHandler struct {
+	tokenRepo repositories.SCIMTokenRepository
+	logger    logging.Logger
+}
+
+func NewListSCIMTokensHandler(
+	tokenRepo repositories.SCIMTokenRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListSCIMTokensQuery, dtos.ListSCIMTokensResult] {
+	return &ListSCIMTokensHandler{
+		tokenRepo: tokenRepo,
+		logger:    logger.With(zap.String("handler", "list_scim_tokens")),
+	}
+}
+
+func (h *ListSCIMTokensHandler) Handle(
+	ctx context.Context,
+	query queries.ListSCIMTokensQuery,
+) (dtos.ListSCIMTokensResult, error) {
+	tokens, err := h.tokenRepo.List(ctx)
+	if err != nil {
+		return dtos.ListSCIMTokensResult{}, fmt.Errorf("failed to list scim tokens: %w", err)
+	}
+
+	results := make([]dtos.SCIMTokenResult, 0, len(tokens))
+	for _, token := range tokens {
+		results = append(results, toSCIMTokenResult(token))
+	}
+
+	return dtos.ListSCIMTokensResult{Tokens: results}, nil
+}
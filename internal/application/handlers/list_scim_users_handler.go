@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type ListSCIMUsersHandler struct {
+	userRepo repositories.UserRepository
+	logger   logging.Logger
+}
+
+func NewListSCIMUsersHandler(
+	userRepo repositories.UserRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListSCIMUsersQuery, dtos.ListSCIMUsersResult] {
+	return &ListSCIMUsersHandler{
+		userRepo: userRepo,
+		logger:   logger.With(zap.String("handler", "list_scim_users")),
+	}
+}
+
+func (h *ListSCIMUsersHandler) Handle(
+	ctx context.Context,
+	query queries.ListSCIMUsersQuery,
+) (dtos.ListSCIMUsersResult, error) {
+	if query.UserName != "" || query.Email != "" {
+		user, err := h.findOne(ctx, query)
+		if err != nil {
+			return dtos.ListSCIMUsersResult{}, err
+		}
+
+		startIndex := query.StartIndex
+		if startIndex < 1 {
+			startIndex = 1
+		}
+
+		var total int64
+		var users []*aggregates.UserAggregate
+		if user != nil && (query.Active == nil || *query.Active == user.User.IsActive) {
+			total = 1
+			if startIndex == 1 && query.Count != 0 {
+				users = append(users, user)
+			}
+		}
+		return scimUserPage(users, total, startIndex), nil
+	}
+
+	page, pageSize, startIndex := scimPagination(query.StartIndex, query.Count)
+	users, total, err := h.userRepo.List(ctx, page, pageSize, nil, query.Active)
+	if err != nil {
+		return dtos.ListSCIMUsersResult{}, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	// count=0 asks for the total only.
+	if query.Count == 0 {
+		users = nil
+	}
+	return scimUserPage(users, total, startIndex), nil
+}
+
+// findOne serves the userName and emails filters, which match at most one
+// account. A userName that is not an email address is a username.
+func (h *ListSCIMUsersHandler) findOne(ctx context.Context, query queries.ListSCIMUsersQuery) (*aggregates.UserAggregate, error) {
+	var (
+		user *aggregates.UserAggregate
+		err  error
+	)
+	switch {
+	case query.Email != "" || strings.Contains(query.UserName, "@"):
+		value := query.Email
+		if value == "" {
+			value = query.UserName
+		}
+		email, parseErr := valueobjects.NewEmail(value)
+		if parseErr != nil {
+			return nil, nil
+		}
+		user, err = h.userRepo.FindByEmail(ctx, email)
+	default:
+		username, parseErr := valueobjects.NewUsername(query.UserName)
+		if parseErr != nil {
+			return nil, nil
+		}
+		user, err = h.userRepo.FindByUsername(ctx, username)
+	}
+
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	return user, nil
+}
+
+// scimPagination maps a 1-based SCIM startIndex and count onto the
+// repository's pages. A startIndex that is not on a page boundary is moved
+// back to the start of its page; SCIM lets the server report the index it
+// actually used.
+func scimPagination(startIndex, count int) (page, pageSize, actualStart int) {
+	if startIndex < 1 {
+		startIndex = 1
+	}
+	_, pageSize = utils.NormalizePagination(1, count)
+	page = (startIndex-1)/pageSize + 1
+	return page, pageSize, (page-1)*pageSize + 1
+}
+
+func scimUserPage(users []*aggregates.UserAggregate, total int64, startIndex int) dtos.ListSCIMUsersResult {
+	results := make([]dtos.SCIMUserResult, 0, len(users))
+	for _, user := range users {
+		results = append(results, toSCIMUserResult(user))
+	}
+
+	return dtos.ListSCIMUsersResult{
+		Users:        results,
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(results),
+	}
+}
+
+type GetSCIMUserHandler struct {
+	userRepo repositories.UserRepository
+	logger   logging.Logger
+}
+
+func NewGetSCIMUserHandler(
+	userRepo repositories.UserRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.GetSCIMUserQuery, dtos.SCIMUserResult] {
+	return &GetSCIMUserHandler{
+		userRepo: userRepo,
+		logger:   logger.With(zap.String("handler", "get_scim_user")),
+	}
+}
+
+func (h *GetSCIMUserHandler) Handle(
+	ctx context.Context,
+	query queries.GetSCIMUserQuery,
+) (dtos.SCIMUserResult, error) {
+	user, err := findSCIMUser(ctx, h.userRepo, query.UserID)
+	if err != nil {
+		return dtos.SCIMUserResult{}, err
+	}
+	return toSCIMUserResult(user), nil
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type ListServiceAccountsHandler struct {
+	accountRepo repositories.ServiceAccountRepository
+	logger      logging.Logger
+}
+
+func NewListServiceAccountsHandler(
+	accountRepo repositories.ServiceAccountRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListServiceAccountsQuery, dtos.ListServiceAccountsResult] {
+	return &ListServiceAccountsHandler{
+		accountRepo: accountRepo,
+		logger:      logger.With(zap.String("handler", "list_service_accounts")),
+	}
+}
+
+func (h *ListServiceAccountsHandler) Handle(
+	ctx context.Context,
+	query queries.ListServiceAccountsQuery,
+) (dtos.ListServiceAccountsResult, error) {
+	page, pageSize := utils.NormalizePagination(query.Page, query.PageSize)
+
+	accounts, total, err := h.accountRepo.List(ctx, page, pageSize)
+	if err != nil {
+		return dtos.ListServiceAccountsResult{}, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+
+	results := make([]dtos.ServiceAccountResult, 0, len(accounts))
+	for _, account := range accounts {
+		results = append(results, toServiceAccountResult(account))
+	}
+
+	return dtos.ListServiceAccountsResult{
+		ServiceAccounts: results,
+		TotalCount:      total,
+		Page:            page,
+		PageSize:        pageSize,
+	}, nil
+}
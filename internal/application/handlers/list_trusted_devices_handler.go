@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type ListTrustedDevicesHandler struct {
+	deviceRepo repositories.TrustedDeviceRepository
+	logger     logging.Logger
+}
+
+func NewListTrustedDevicesHandler(
+	deviceRepo repositories.TrustedDeviceRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListTrustedDevicesQuery, dtos.ListTrustedDevicesResult] {
+	return &ListTrustedDevicesHandler{
+		deviceRepo: deviceRepo,
+		logger:     logger.With(zap.String("handler", "list_trusted_devices")),
+	}
+}
+
+func (h *ListTrustedDevicesHandler) Handle(
+	ctx context.Context,
+	query queries.ListTrustedDevicesQuery,
+) (dtos.ListTrustedDevicesResult, error) {
+	devices, err := h.deviceRepo.ListActiveByUser(ctx, query.UserID)
+	if err != nil {
+		return dtos.ListTrustedDevicesResult{}, fmt.Errorf("failed to list trusted devices: %w", err)
+	}
+
+	results := make([]dtos.TrustedDeviceResult, 0, len(devices))
+	for _, device := range devices {
+		results = append(results, toTrustedDeviceResult(device))
+	}
+
+	return dtos.ListTrustedDevicesResult{Devices: results}, nil
+}
+
+func toTrustedDeviceResult(device *aggregates.TrustedDevice) dtos.TrustedDeviceResult {
+	return dtos.TrustedDeviceResult{
+		ID:         device.ID(),
+		DeviceID:   device.DeviceID,
+		Name:       device.Name,
+		IPAddress:  device.IPAddress,
+		CreatedAt:  device.CreatedAt,
+		LastUsedAt: device.LastUsedAt,
+		ExpiresAt:  device.ExpiresAt,
+		RevokedAt:  device.RevokedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type ListUsersHandler struct {
+	userRepo repositories.UserRepository
+	logger   logging.Logger
+}
+
+func NewListUsersHandler(
+	userRepo repositories.UserRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.ListUsersQuery, dtos.ListUsersResult] {
+	return &ListUsersHandler{
+		userRepo: userRepo,
+		logger:   logger.With(zap.String("handler", "list_users")),
+	}
+}
+
+func (h *ListUsersHandler) Handle(
+	ctx context.Context,
+	query queries.ListUsersQuery,
+) (dtos.ListUsersResult, error) {
+	page, pageSize := utils.NormalizePagination(query.Page, query.PageSize)
+
+	filter := repositories.UserFilter{
+		Page:       page,
+		PageSize:   pageSize,
+		IsActive:   query.IsActive,
+		Search:     query.Search,
+		SortBy:     repositories.UserSortField(query.SortBy),
+		Descending: query.Descending,
+	}
+	if query.Role != nil {
+		role, err := valueobjects.NewRoleName(*query.Role)
+		if err != nil {
+			return dtos.ListUsersResult{}, err
+		}
+		filter.Role = &role
+	}
+
+	users, total, err := h.userRepo.Search(ctx, filter)
+	if err != nil {
+		return dtos.ListUsersResult{}, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	results := make([]dtos.UserResult, 0, len(users))
+	for _, user := range users {
+		results = append(results, toUserResult(user))
+	}
+
+	return dtos.ListUsersResult{
+		Users:      results,
+		TotalCount: total,
+		Page:       page,
+		PageSize:   pageSize,
+	}, nil
+}
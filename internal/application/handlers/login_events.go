@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/events"
+)
+
+// saveLoginFailedEvent queues a user.login_failed event. Callers ignore the
+// error: a lost notification must not change the outcome of the login.
+func saveLoginFailedEvent(ctx context.Context, outbox persistence.OutboxRepository, event events.DomainEvent) error {
+	outboxMsg := &persistence.OutboxMessage{
+		ID:          event.EventID().String(),
+		EventType:   event.EventName(),
+		AggregateID: event.AggregateID(),
+		Payload:     event.Payload(),
+		Metadata:    event.Metadata(),
+		OccurredAt:  event.OccurredAt().Unix(),
+	}
+
+	if err := outbox.Save(ctx, outboxMsg); err != nil {
+		return fmt.Errorf("failed to save outbox event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// assessLogin scores a login that has passed primary authentication and
+// records the decision, with the signals behind it, in the audit log. A nil
+// engine or an engine error allows the login: risk scoring hardens sign-in
+// but must not take it down when the cache is unavailable.
+func assessLogin(
+	ctx context.Context,
+	engine services.RiskEngine,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+	attempt services.LoginAttempt,
+) services.RiskAssessment {
+	allow := services.RiskAssessment{Decision: services.RiskDecisionAllow}
+	if engine == nil {
+		return allow
+	}
+
+	assessment, err := engine.Evaluate(ctx, attempt)
+	if err != nil {
+		logger.Error(ctx, "Login risk assessment failed, allowing login",
+			zap.Error(err),
+			zap.String("user_id", attempt.UserID),
+		)
+		return allow
+	}
+
+	status := "SUCCESS"
+	if assessment.Decision == services.RiskDecisionBlock {
+		status = "FAILURE"
+	}
+	auditLog := aggregates.NewAuditLog(
+		attempt.UserID,
+		valueobjects.AuditActionLoginRiskAssessed,
+		"user",
+		attempt.UserID,
+		attempt.IPAddress,
+		attempt.UserAgent,
+		status,
+		map[string]interface{}{
+			"method":   attempt.Method,
+			"decision": string(assessment.Decision),
+			"score":    assessment.Score,
+			"signals":  assessment.Signals,
+		},
+	)
+	if err := auditRepo.Create(ctx, auditLog); err != nil {
+		logger.Error(ctx, "Failed to record login risk audit log",
+			zap.Error(err),
+			zap.String("user_id", attempt.UserID),
+		)
+	}
+
+	if assessment.Decision != services.RiskDecisionAllow {
+		logger.Warn(ctx, "Risky login",
+			zap.String("user_id", attempt.UserID),
+			zap.String("decision", string(assessment.Decision)),
+			zap.Int("score", assessment.Score),
+		)
+	}
+
+	return assessment
+}
+
+// recordLoginOutcome feeds the engine's history. Errors are only logged;
+// the login itself has already been decided.
+func recordLoginOutcome(
+	ctx context.Context,
+	engine services.RiskEngine,
+	logger logging.Logger,
+	attempt services.LoginAttempt,
+	success bool,
+) {
+	if engine == nil {
+		return
+	}
+
+	record := engine.RecordFailure
+	if success {
+		record = engine.RecordSuccess
+	}
+	if err := record(ctx, attempt); err != nil {
+		logger.Warn(ctx, "Failed to record login outcome for risk scoring",
+			zap.Error(err),
+			zap.Bool("success", success),
+		)
+	}
+}
@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+)
+
+const (
+	tokenTypeHintAccess  = "access_token"
+	tokenTypeHintRefresh = "refresh_token"
+)
+
+// authenticateOAuthClient checks client credentials presented to the token,
+// introspection and revocation endpoints. Unknown clients and wrong secrets
+// both yield ErrInvalidClient so callers cannot probe for client ids.
+func authenticateOAuthClient(
+	ctx context.Context,
+	clientRepo repositories.OAuthClientRepository,
+	secretService domainServices.SecretService,
+	clientID, clientSecret string,
+) (*aggregates.OAuthClient, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, domain.ErrInvalidClient
+	}
+
+	client, err := clientRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrInvalidClient
+		}
+		return nil, fmt.Errorf("failed to find oauth client: %w", err)
+	}
+
+	if client == nil || !secretService.Verify(clientSecret, client.ClientSecret) {
+		return nil, domain.ErrInvalidClient
+	}
+
+	if !client.IsActive {
+		return nil, domain.ErrOAuthClientInactive
+	}
+
+	return client, nil
+}
+
+// authenticateDeviceClient checks the client of a device authorization
+// request or token poll. Besides confidential clients it admits public
+// clients, which identify themselves by client_id alone since the devices
+// they run on cannot keep a secret (RFC 8628 section 3.1).
+func authenticateDeviceClient(
+	ctx context.Context,
+	clientRepo repositories.OAuthClientRepository,
+	secretService domainServices.SecretService,
+	clientID, clientSecret string,
+) (*aggregates.OAuthClient, error) {
+	if clientSecret != "" {
+		return authenticateOAuthClient(ctx, clientRepo, secretService, clientID, clientSecret)
+	}
+	if clientID == "" {
+		return nil, domain.ErrInvalidClient
+	}
+
+	client, err := clientRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrInvalidClient
+		}
+		return nil, fmt.Errorf("failed to find oauth client: %w", err)
+	}
+
+	if client == nil || !client.IsPublic() {
+		return nil, domain.ErrInvalidClient
+	}
+
+	if !client.IsActive {
+		return nil, domain.ErrOAuthClientInactive
+	}
+
+	return client, nil
+}
+
+// verifyTokenWithHint tries the hinted token type first and falls back to the
+// other one, as RFC 7009 and RFC 7662 treat the hint as advisory.
+func verifyTokenWithHint(
+	ctx context.Context,
+	tokenService services.TokenService,
+	token, hint string,
+) (*services.TokenClaims, string, error) {
+	order := []string{tokenTypeHintAccess, tokenTypeHintRefresh}
+	if hint == tokenTypeHintRefresh {
+		order = []string{tokenTypeHintRefresh, tokenTypeHintAccess}
+	}
+
+	var lastErr error
+	for _, tokenType := range order {
+		var claims *services.TokenClaims
+		var err error
+		if tokenType == tokenTypeHintAccess {
+			claims, err = tokenService.VerifyAccess(ctx, token)
+		} else {
+			claims, err = tokenService.VerifyRefresh(ctx, token)
+		}
+		if err == nil && claims != nil {
+			return claims, tokenType, nil
+		}
+		lastErr = err
+	}
+
+	if lastErr == nil {
+		lastErr = domain.ErrInvalidToken
+	}
+	return nil, "", lastErr
+}
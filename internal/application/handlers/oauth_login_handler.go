@@ -1,17 +1,22 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
 	"authentication/internal/application/contracts/persistence"
 	"authentication/internal/application/contracts/services"
 	"authentication/internal/application/dtos"
 	"authentication/internal/domain"
 	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/events"
 	"authentication/internal/domain/repositories"
 	"authentication/internal/domain/valueobjects"
 	"authentication/shared/logging"
-	"context"
-	"fmt"
 
 	"go.uber.org/zap"
 )
@@ -24,6 +29,10 @@ type LoginOAuthHandler struct {
 	oauthService services.OAuthService
 	tokenService services.TokenService
 	otpService   services.OTPService
+	risk         services.RiskEngine
+	deviceRepo   repositories.TrustedDeviceRepository
+	deviceSigner services.TrustedDeviceSigner
+	auditRepo    repositories.AuditRepository
 	logger       logging.Logger
 }
 
@@ -35,8 +44,12 @@ func NewLoginOAuthHandler(
 	oauthService services.OAuthService,
 	tokenService services.TokenService,
 	otpService services.OTPService,
+	risk services.RiskEngine,
+	deviceRepo repositories.TrustedDeviceRepository,
+	deviceSigner services.TrustedDeviceSigner,
+	auditRepo repositories.AuditRepository,
 	logger logging.Logger,
-) {
+) messaging.CommandHandler[commands.LoginOAuthUserCommand, dtos.LoginOAuthUserResult] {
 	return &LoginOAuthHandler{
 		userRepo:     userRepo,
 		sessionRepo:  sessionRepo,
@@ -45,6 +58,10 @@ func NewLoginOAuthHandler(
 		oauthService: oauthService,
 		tokenService: tokenService,
 		otpService:   otpService,
+		risk:         risk,
+		deviceRepo:   deviceRepo,
+		deviceSigner: deviceSigner,
+		auditRepo:    auditRepo,
 		logger:       logger.With(zap.String("handler", "login_oauth")),
 	}
 }
@@ -61,7 +78,7 @@ func (h *LoginOAuthHandler) Handle(
 				zap.String("email", cmd.Email),
 			)
 
-			_ = h.publishFailedUserLoginEvent(ctx, cmd, fmt.Errorf("panic: %v", r))
+			_ = h.publishFailedLoginEvent(ctx, cmd, fmt.Errorf("panic: %v", r))
 			panic(r)
 		}
 	}()
@@ -87,7 +104,7 @@ func (h *LoginOAuthHandler) Handle(
 	}
 
 	existingUser, err := h.userRepo.FindByEmail(ctx, emailVO)
-	if err != nil && err != domain.ErrUserNotFound {
+	if err != nil && err != domain.ErrUserNotFound && !repositories.IsNotFoundError(err) {
 		return dtos.LoginOAuthUserResult{}, fmt.Errorf("failed to check email existence: %w", err)
 	}
 
@@ -96,17 +113,15 @@ func (h *LoginOAuthHandler) Handle(
 	}
 
 	if !existingUser.User.IsActive {
-		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrUserAlreadyInactive)
-		return dtos.LoginOAuthUserResult{}, domain.ErrUserAlreadyInactive
+		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrInactiveUser)
+		return dtos.LoginOAuthUserResult{}, domain.ErrInactiveUser
 	}
 
-	//come back to this
-
 	if !existingUser.User.IsOAuthUser() {
 		return h.handleEmailRegisteredUserLogin(ctx, existingUser, cmd)
 	}
 
-	return h.generateTokensAndLogin(ctx, existingUser, cmd)
+	return h.completeLogin(ctx, existingUser, cmd)
 
 }
 
@@ -143,10 +158,10 @@ func (h *LoginOAuthHandler) handleEmailRegisteredUserLogin(
 	)
 
 	return dtos.LoginOAuthUserResult{
-		Email:   user.User.Email.String(),
+		Email:       user.User.Email.String(),
 		RequiresOTP: true,
-		OTPSent:	true,
-		Message: fmt.Sprintf("This account uses email/password for sign-in. We've sent a verification code to %s.", user.User.Email.String()),
+		OTPSent:     true,
+		Message:     fmt.Sprintf("This account uses email/password for sign-in. We've sent a verification code to %s.", user.User.Email.String()),
 	}, nil
 }
 
@@ -154,7 +169,78 @@ func (h *LoginOAuthHandler) generateTokensAndLogin(
 	ctx context.Context,
 	user *aggregates.UserAggregate,
 	cmd commands.LoginOAuthUserCommand,
-)(dtos.LoginOAuthUserResult, error){
-	var session *aggregates.Session 
-	var tokenPair *services.TokenPair
-}
\ No newline at end of file
+) (dtos.LoginOAuthUserResult, error) {
+	var (
+		session   *entities.Session
+		tokenPair *services.TokenPair
+	)
+
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		tokenPair, err = h.tokenService.Generate(
+			ctx,
+			user.ID(),
+			user.User.Role.String(),
+			user.User.Email.String(),
+			services.SessionMetadata{
+				IPAddress:   cmd.IPAddress,
+				UserAgent:   cmd.UserAgent,
+				DeviceID:    cmd.DeviceID,
+				AuthMethods: []valueobjects.AuthMethod{valueobjects.AuthMethodOAuth},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate tokens: %w", err)
+		}
+
+		session = user.Login(
+			cmd.IPAddress,
+			cmd.UserAgent,
+			tokenPair.RefreshToken,
+			tokenPair.AccessToken,
+			tokenPair.ExpiresAt,
+		)
+		if err := h.sessionRepo.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user last login: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		_ = h.publishFailedLoginEvent(ctx, cmd, err)
+		return dtos.LoginOAuthUserResult{}, err
+	}
+
+	h.logger.Info(ctx, "OAuth user logged in successfully",
+		zap.String("user_id", user.ID()),
+		zap.String("oauth_provider", cmd.OAuthProvider),
+		zap.String("session_id", session.ID),
+	)
+
+	return dtos.LoginOAuthUserResult{
+		UserID:        user.ID(),
+		Email:         user.User.Email.String(),
+		FirstName:     user.User.FirstName,
+		LastName:      user.User.LastName,
+		Role:          user.User.Role.String(),
+		OAuthProvider: cmd.OAuthProvider,
+		AccessToken:   tokenPair.AccessToken,
+		RefreshToken:  tokenPair.RefreshToken,
+		ExpiresAt:     tokenPair.ExpiresAt.Format(time.RFC3339),
+		ExpiresIn:     tokenPair.ExpiresIn,
+		SessionID:     session.ID,
+	}, nil
+}
+
+func (h *LoginOAuthHandler) publishFailedLoginEvent(
+	ctx context.Context,
+	cmd commands.LoginOAuthUserCommand,
+	cause error,
+) error {
+	return saveLoginFailedEvent(ctx, h.outbox, events.NewUserLoginFailedEvent(
+		cmd.Email, "oauth:"+cmd.OAuthProvider, cause.Error(), cmd.IPAddress, cmd.UserAgent, cmd.DeviceID,
+	))
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+
+	"go.uber.org/zap"
+)
+
+// completeLogin runs the risk engine once the provider tokens have been
+// verified. A provider vouches for the identity but not for the device, so
+// risky logins are stepped up with an emailed code like password logins,
+// unless the device was trusted after an earlier code.
+func (h *LoginOAuthHandler) completeLogin(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	cmd commands.LoginOAuthUserCommand,
+) (dtos.LoginOAuthUserResult, error) {
+	attempt := services.LoginAttempt{
+		UserID:    user.ID(),
+		Email:     user.User.Email.String(),
+		IPAddress: cmd.IPAddress,
+		UserAgent: cmd.UserAgent,
+		DeviceID:  cmd.DeviceID,
+		Method:    "oauth:" + cmd.OAuthProvider,
+	}
+
+	assessment := assessLogin(ctx, h.risk, h.auditRepo, h.logger, attempt)
+	switch assessment.Decision {
+	case services.RiskDecisionBlock:
+		_ = h.publishFailedLoginEvent(ctx, cmd, domain.ErrLoginBlocked)
+		return dtos.LoginOAuthUserResult{}, domain.ErrLoginBlocked
+	case services.RiskDecisionStepUp:
+		if !isTrustedDevice(ctx, h.deviceRepo, h.deviceSigner, h.logger,
+			user.ID(), cmd.DeviceID, cmd.UserAgent, cmd.TrustedDeviceToken) {
+			return h.requireStepUp(ctx, user, cmd)
+		}
+	}
+
+	result, err := h.generateTokensAndLogin(ctx, user, cmd)
+	if err != nil {
+		return result, err
+	}
+	recordLoginOutcome(ctx, h.risk, h.logger, attempt, true)
+	return result, nil
+}
+
+func (h *LoginOAuthHandler) requireStepUp(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	cmd commands.LoginOAuthUserCommand,
+) (dtos.LoginOAuthUserResult, error) {
+	email := user.User.Email.String()
+
+	isLimited, err := h.otpService.IsRateLimited(ctx, email)
+	if err != nil {
+		return dtos.LoginOAuthUserResult{}, fmt.Errorf("failed to check OTP rate limit: %w", err)
+	}
+	if isLimited {
+		return dtos.LoginOAuthUserResult{}, domain.ErrOTPRateLimited
+	}
+
+	otpCode, err := h.otpService.Generate(ctx, email, services.OTPPurposeLogin)
+	if err != nil {
+		return dtos.LoginOAuthUserResult{}, fmt.Errorf("failed to generate login OTP: %w", err)
+	}
+	if err := h.otpService.SendEmail(ctx, email, otpCode, services.OTPPurposeLogin); err != nil {
+		h.logger.Error(ctx, "Failed to send step-up OTP email",
+			zap.Error(err),
+			zap.String("user_id", user.ID()),
+		)
+		return dtos.LoginOAuthUserResult{}, fmt.Errorf("failed to send OTP: %w", err)
+	}
+
+	return dtos.LoginOAuthUserResult{
+		Email:         email,
+		OAuthProvider: cmd.OAuthProvider,
+		RequiresOTP:   true,
+		OTPSent:       true,
+		Message:       "We need to confirm it's you. We've sent a verification code to your email.",
+	}, nil
+}
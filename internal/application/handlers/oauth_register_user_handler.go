@@ -81,9 +81,10 @@ func (r *RegisterOAuthUserHandler) Handle(
 
 	// Generate tokens for OAuth users
 	metadata := services.SessionMetadata{
-		IPAddress: cmd.IPAddress,
-		UserAgent: cmd.UserAgent,
-		DeviceID:  cmd.DeviceID,
+		IPAddress:   cmd.IPAddress,
+		UserAgent:   cmd.UserAgent,
+		DeviceID:    cmd.DeviceID,
+		AuthMethods: []valueobjects.AuthMethod{valueobjects.AuthMethodOAuth},
 	}
 
 	tokenPair, err := r.tokenService.Generate(
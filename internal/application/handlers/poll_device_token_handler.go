@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type PollDeviceTokenHandler struct {
+	clientRepo    repositories.OAuthClientRepository
+	userRepo      repositories.UserRepository
+	sessionRepo   repositories.SessionRepository
+	uow           persistence.UnitOfWork
+	cache         persistence.Cache
+	tokenService  services.TokenService
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewPollDeviceTokenHandler(
+	clientRepo repositories.OAuthClientRepository,
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	uow persistence.UnitOfWork,
+	cache persistence.Cache,
+	tokenService services.TokenService,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.PollDeviceTokenCommand, dtos.OAuthTokenResult] {
+	return &PollDeviceTokenHandler{
+		clientRepo:    clientRepo,
+		userRepo:      userRepo,
+		sessionRepo:   sessionRepo,
+		uow:           uow,
+		cache:         cache,
+		tokenService:  tokenService,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "poll_device_token")),
+	}
+}
+
+// Handle answers a device's token poll. Until the user acts it returns
+// ErrAuthorizationPending, or ErrSlowDown when the device polls too often.
+func (h *PollDeviceTokenHandler) Handle(
+	ctx context.Context,
+	cmd commands.PollDeviceTokenCommand,
+) (dtos.OAuthTokenResult, error) {
+	client, err := authenticateDeviceClient(ctx, h.clientRepo, h.secretService, cmd.ClientID, cmd.ClientSecret)
+	if err != nil {
+		return dtos.OAuthTokenResult{}, err
+	}
+
+	deviceCodeHash := h.secretService.Hash(cmd.DeviceCode)
+	auth, err := loadDeviceAuthorization(ctx, h.cache, deviceCodeHash)
+	if err != nil {
+		return dtos.OAuthTokenResult{}, err
+	}
+
+	if auth.ClientID != client.ClientID {
+		return dtos.OAuthTokenResult{}, domain.ErrInvalidDeviceCode
+	}
+
+	if pollErr := auth.Poll(); pollErr != nil {
+		if errors.Is(pollErr, domain.ErrAuthorizationPending) || errors.Is(pollErr, domain.ErrSlowDown) {
+			if err := saveDeviceAuthorization(ctx, h.cache, deviceCodeHash, auth); err != nil {
+				return dtos.OAuthTokenResult{}, err
+			}
+		} else if err := deleteDeviceAuthorization(ctx, h.cache, deviceCodeHash, auth); err != nil {
+			h.logger.Warn(ctx, "Failed to delete finished device authorization", zap.Error(err))
+		}
+		return dtos.OAuthTokenResult{}, pollErr
+	}
+
+	// The device code is single use. Claim it before issuing tokens so that
+	// concurrent polls cannot each redeem it, then drop it.
+	claimed, err := claimDeviceAuthorization(ctx, h.cache, deviceCodeHash, auth)
+	if err != nil {
+		return dtos.OAuthTokenResult{}, err
+	}
+	if !claimed {
+		return dtos.OAuthTokenResult{}, domain.ErrInvalidDeviceCode
+	}
+	if err := deleteDeviceAuthorization(ctx, h.cache, deviceCodeHash, auth); err != nil {
+		return dtos.OAuthTokenResult{}, err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, auth.UserID)
+	if err != nil {
+		return dtos.OAuthTokenResult{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil || !user.User.IsActive {
+		return dtos.OAuthTokenResult{}, domain.ErrInactiveUser
+	}
+
+	var tokenPair *services.TokenPair
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		tokenPair, err = h.tokenService.Generate(
+			ctx,
+			user.ID(),
+			user.User.Role.String(),
+			user.User.Email.String(),
+			services.SessionMetadata{
+				IPAddress: cmd.IPAddress,
+				UserAgent: cmd.UserAgent,
+				DeviceID:  client.ClientID,
+				Scopes:    auth.Scopes,
+				ClientID:  client.ClientID,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate tokens: %w", err)
+		}
+
+		session := user.Login(
+			cmd.IPAddress,
+			cmd.UserAgent,
+			tokenPair.RefreshToken,
+			tokenPair.AccessToken,
+			tokenPair.ExpiresAt,
+		)
+		if err := h.sessionRepo.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user last login: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OAuthTokenResult{}, err
+	}
+
+	h.logger.Info(ctx, "Device authorization completed",
+		zap.String("client_id", client.ClientID),
+		zap.String("user_id", user.ID()),
+		zap.String("session_id", tokenPair.SessionID),
+	)
+
+	return dtos.OAuthTokenResult{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(tokenPair.ExpiresAt).Seconds()),
+		Scope:        strings.Join(auth.Scopes, " "),
+		SessionID:    tokenPair.SessionID,
+	}, nil
+}
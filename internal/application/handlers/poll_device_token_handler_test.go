@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"authentication/internal/application/commands"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// deviceFlow wires the three device flow handlers to one cache, as the
+// device, the verification page and the token endpoint share Redis.
+type deviceFlow struct {
+	t        *testing.T
+	cache    *memoryCache
+	secrets  domainServices.SecretService
+	tokens   *fakeTokenService
+	sessions *fakeSessionRepository
+	user     *aggregates.UserAggregate
+}
+
+func newDeviceFlow(t *testing.T) *deviceFlow {
+	t.Helper()
+
+	username, err := valueobjects.NewUsername("alice")
+	if err != nil {
+		t.Fatalf("NewUsername() error = %v", err)
+	}
+	email, err := valueobjects.NewEmail("alice@example.com")
+	if err != nil {
+		t.Fatalf("NewEmail() error = %v", err)
+	}
+
+	return &deviceFlow{
+		t:        t,
+		cache:    newMemoryCache(),
+		secrets:  domainServices.NewSecretService(),
+		tokens:   &fakeTokenService{},
+		sessions: &fakeSessionRepository{},
+		user: aggregates.NewEmailUserAggregate(
+			username, email, valueobjects.NewPassword("hash"), "Alice", "Liddell", valueobjects.RoleUser,
+		),
+	}
+}
+
+func (f *deviceFlow) clients() *fakeOAuthClientRepository {
+	tv := aggregates.NewOAuthClient("tv-app", "", "", "TV App", nil, []string{"openid", "profile"})
+	other := aggregates.NewOAuthClient("other-app", "", "", "Other App", nil, []string{"openid"})
+	return &fakeOAuthClientRepository{clients: map[string]*aggregates.OAuthClient{
+		tv.ClientID:    tv,
+		other.ClientID: other,
+	}}
+}
+
+func (f *deviceFlow) request() dtos.DeviceAuthorizationResult {
+	f.t.Helper()
+	handler := NewRequestDeviceCodeHandler(f.clients(), f.cache, f.secrets, "https://example.com/device", nopLogger{})
+	result, err := handler.Handle(context.Background(), commands.RequestDeviceCodeCommand{ClientID: "tv-app", Scope: "openid"})
+	if err != nil {
+		f.t.Fatalf("RequestDeviceCode() error = %v", err)
+	}
+	return result
+}
+
+func (f *deviceFlow) verify(userCode string, approve bool) error {
+	handler := NewVerifyDeviceCodeHandler(f.clients(), &fakeAuditRepository{}, f.cache, nopLogger{})
+	_, err := handler.Handle(context.Background(), commands.VerifyDeviceCodeCommand{
+		UserID:   f.user.ID(),
+		UserCode: userCode,
+		Approve:  approve,
+	})
+	return err
+}
+
+func (f *deviceFlow) poll(clientID, deviceCode string) (dtos.OAuthTokenResult, error) {
+	handler := NewPollDeviceTokenHandler(
+		f.clients(),
+		&fakeUserRepository{users: map[string]*aggregates.UserAggregate{f.user.ID(): f.user}},
+		f.sessions,
+		fakeUnitOfWork{},
+		f.cache,
+		f.tokens,
+		f.secrets,
+		nopLogger{},
+	)
+	return handler.Handle(context.Background(), commands.PollDeviceTokenCommand{ClientID: clientID, DeviceCode: deviceCode})
+}
+
+// allowPoll clears the last poll time so the next poll is not answered
+// with slow_down.
+func (f *deviceFlow) allowPoll(deviceCode string) {
+	f.t.Helper()
+	hash := f.secrets.Hash(deviceCode)
+	auth, err := loadDeviceAuthorization(context.Background(), f.cache, hash)
+	if err != nil {
+		f.t.Fatalf("loadDeviceAuthorization() error = %v", err)
+	}
+	auth.LastPolledAt = nil
+	if err := saveDeviceAuthorization(context.Background(), f.cache, hash, auth); err != nil {
+		f.t.Fatalf("saveDeviceAuthorization() error = %v", err)
+	}
+}
+
+func TestDeviceFlowApproved(t *testing.T) {
+	f := newDeviceFlow(t)
+	started := f.request()
+
+	if !strings.HasSuffix(started.VerificationURIComplete, "?user_code="+started.UserCode) {
+		t.Errorf("VerificationURIComplete = %q, want the user code", started.VerificationURIComplete)
+	}
+
+	if _, err := f.poll("tv-app", started.DeviceCode); !errors.Is(err, domain.ErrAuthorizationPending) {
+		t.Fatalf("poll() before approval error = %v, want %v", err, domain.ErrAuthorizationPending)
+	}
+	if _, err := f.poll("tv-app", started.DeviceCode); !errors.Is(err, domain.ErrSlowDown) {
+		t.Fatalf("poll() too soon error = %v, want %v", err, domain.ErrSlowDown)
+	}
+
+	// Users may type the code in lower case and without the dash.
+	typed := strings.ToLower(strings.ReplaceAll(started.UserCode, "-", ""))
+	if err := f.verify(typed, true); err != nil {
+		t.Fatalf("verify() error = %v", err)
+	}
+	if err := f.verify(started.UserCode, true); !errors.Is(err, domain.ErrInvalidUserCode) {
+		t.Fatalf("verify() twice error = %v, want %v", err, domain.ErrInvalidUserCode)
+	}
+
+	f.allowPoll(started.DeviceCode)
+	if _, err := f.poll("other-app", started.DeviceCode); !errors.Is(err, domain.ErrInvalidDeviceCode) {
+		t.Fatalf("poll() by another client error = %v, want %v", err, domain.ErrInvalidDeviceCode)
+	}
+
+	result, err := f.poll("tv-app", started.DeviceCode)
+	if err != nil {
+		t.Fatalf("poll() after approval error = %v", err)
+	}
+	if result.AccessToken == "" || result.Scope != "openid" {
+		t.Errorf("poll() = %+v, want tokens scoped to openid", result)
+	}
+	if len(f.tokens.generated) != 1 || f.tokens.generated[0].ClientID != "tv-app" {
+		t.Errorf("generated = %+v, want one token pair for tv-app", f.tokens.generated)
+	}
+	if len(f.sessions.sessions) != 1 {
+		t.Errorf("sessions = %d, want 1", len(f.sessions.sessions))
+	}
+
+	if _, err := f.poll("tv-app", started.DeviceCode); !errors.Is(err, domain.ErrInvalidDeviceCode) {
+		t.Fatalf("poll() after redemption error = %v, want %v", err, domain.ErrInvalidDeviceCode)
+	}
+	if err := f.verify(started.UserCode, true); !errors.Is(err, domain.ErrInvalidUserCode) {
+		t.Fatalf("verify() after redemption error = %v, want %v", err, domain.ErrInvalidUserCode)
+	}
+}
+
+func TestDeviceFlowDenied(t *testing.T) {
+	f := newDeviceFlow(t)
+	started := f.request()
+
+	if err := f.verify(started.UserCode, false); err != nil {
+		t.Fatalf("verify() error = %v", err)
+	}
+	if _, err := f.poll("tv-app", started.DeviceCode); !errors.Is(err, domain.ErrDeviceAccessDenied) {
+		t.Fatalf("poll() error = %v, want %v", err, domain.ErrDeviceAccessDenied)
+	}
+	if _, err := f.poll("tv-app", started.DeviceCode); !errors.Is(err, domain.ErrInvalidDeviceCode) {
+		t.Fatalf("poll() after denial error = %v, want %v", err, domain.ErrInvalidDeviceCode)
+	}
+	if len(f.tokens.generated) != 0 {
+		t.Errorf("generated = %d token pairs, want none", len(f.tokens.generated))
+	}
+}
+
+func TestDeviceFlowAlreadyClaimed(t *testing.T) {
+	f := newDeviceFlow(t)
+	started := f.request()
+	if err := f.verify(started.UserCode, true); err != nil {
+		t.Fatalf("verify() error = %v", err)
+	}
+
+	// Another poll claimed the code but has not deleted it yet.
+	hash := f.secrets.Hash(started.DeviceCode)
+	claimed, err := claimDeviceAuthorization(context.Background(), f.cache, hash, &entities.DeviceAuthorization{
+		ExpiresAt: deviceCodeExpiry(t, f.cache, hash),
+	})
+	if err != nil || !claimed {
+		t.Fatalf("claimDeviceAuthorization() = %v, %v", claimed, err)
+	}
+
+	if _, err := f.poll("tv-app", started.DeviceCode); !errors.Is(err, domain.ErrInvalidDeviceCode) {
+		t.Fatalf("poll() error = %v, want %v", err, domain.ErrInvalidDeviceCode)
+	}
+	if len(f.tokens.generated) != 0 {
+		t.Errorf("generated = %d token pairs, want none", len(f.tokens.generated))
+	}
+}
+
+func TestDeviceFlowConcurrentPolls(t *testing.T) {
+	f := newDeviceFlow(t)
+	started := f.request()
+	if err := f.verify(started.UserCode, true); err != nil {
+		t.Fatalf("verify() error = %v", err)
+	}
+
+	const polls = 8
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		succeeded int
+	)
+	for i := 0; i < polls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := f.poll("tv-app", started.DeviceCode); err == nil {
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Errorf("successful polls = %d, want 1", succeeded)
+	}
+	if len(f.tokens.generated) != 1 {
+		t.Errorf("generated = %d token pairs, want 1", len(f.tokens.generated))
+	}
+}
+
+func deviceCodeExpiry(t *testing.T, cache *memoryCache, hash string) time.Time {
+	t.Helper()
+	auth, err := loadDeviceAuthorization(context.Background(), cache, hash)
+	if err != nil {
+		t.Fatalf("loadDeviceAuthorization() error = %v", err)
+	}
+	return auth.ExpiresAt
+}
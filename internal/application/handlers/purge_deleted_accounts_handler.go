@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPurgeBatchSize = 100
+	purgeTenantPageSize   = 100
+)
+
+type PurgeDeletedAccountsHandler struct {
+	tenantRepo     repositories.TenantRepository
+	userRepo       repositories.UserRepository
+	sessionRepo    repositories.SessionRepository
+	oauthTokenRepo repositories.OAuthTokenRepository
+	apiKeyRepo     repositories.APIKeyRepository
+	deviceRepo     repositories.TrustedDeviceRepository
+	orgRepo        repositories.OrganizationRepository
+	roleRepo       repositories.RoleRepository
+	auditRepo      repositories.AuditRepository
+	uow            persistence.UnitOfWork
+	gracePeriod    time.Duration
+	logger         logging.Logger
+}
+
+func NewPurgeDeletedAccountsHandler(
+	tenantRepo repositories.TenantRepository,
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	oauthTokenRepo repositories.OAuthTokenRepository,
+	apiKeyRepo repositories.APIKeyRepository,
+	deviceRepo repositories.TrustedDeviceRepository,
+	orgRepo repositories.OrganizationRepository,
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	gracePeriod time.Duration,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.PurgeDeletedAccountsCommand, dtos.PurgeResult] {
+	return &PurgeDeletedAccountsHandler{
+		tenantRepo:     tenantRepo,
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		oauthTokenRepo: oauthTokenRepo,
+		apiKeyRepo:     apiKeyRepo,
+		deviceRepo:     deviceRepo,
+		orgRepo:        orgRepo,
+		roleRepo:       roleRepo,
+		auditRepo:      auditRepo,
+		uow:            uow,
+		gracePeriod:    gracePeriod,
+		logger:         logger.With(zap.String("handler", "purge_deleted_accounts")),
+	}
+}
+
+// Handle walks every tenant and purges accounts soft deleted longer than the
+// grace period ago: sessions, OAuth tokens, API keys, trusted devices,
+// organization memberships and role assignments are deleted, audit entries
+// are stripped of IP addresses, user agents and metadata, and the user row is
+// removed. Each account is purged in its own transaction so one failure does
+// not block the rest.
+func (h *PurgeDeletedAccountsHandler) Handle(
+	ctx context.Context,
+	cmd commands.PurgeDeletedAccountsCommand,
+) (dtos.PurgeResult, error) {
+	batchSize := cmd.BatchSize
+	if batchSize < 1 {
+		batchSize = defaultPurgeBatchSize
+	}
+	before := time.Now().UTC().Add(-h.gracePeriod)
+
+	var result dtos.PurgeResult
+	for page := 1; ; page++ {
+		tenants, total, err := h.tenantRepo.List(ctx, page, purgeTenantPageSize)
+		if err != nil {
+			return result, fmt.Errorf("failed to list tenants: %w", err)
+		}
+
+		for _, tenant := range tenants {
+			tenantCtx := utils.WithTenantID(ctx, tenant.ID())
+
+			ids, err := h.userRepo.FindDeletedBefore(tenantCtx, before, batchSize)
+			if err != nil {
+				h.logger.Error(ctx, "Failed to find deleted accounts",
+					zap.Error(err),
+					zap.String("tenant_id", tenant.ID()),
+				)
+				continue
+			}
+
+			for _, id := range ids {
+				if err := h.purge(tenantCtx, id); err != nil {
+					h.logger.Error(ctx, "Failed to purge account",
+						zap.Error(err),
+						zap.String("tenant_id", tenant.ID()),
+						zap.String("user_id", id),
+					)
+					result.Failed++
+					continue
+				}
+				result.Purged++
+			}
+		}
+
+		if int64(page*purgeTenantPageSize) >= total {
+			break
+		}
+	}
+
+	if result.Purged > 0 || result.Failed > 0 {
+		h.logger.Info(ctx, "Deleted accounts purged",
+			zap.Int("purged", result.Purged),
+			zap.Int("failed", result.Failed),
+		)
+	}
+
+	return result, nil
+}
+
+func (h *PurgeDeletedAccountsHandler) purge(ctx context.Context, userID string) error {
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		if err := h.sessionRepo.DeleteByUserID(ctx, userID); err != nil {
+			return fmt.Errorf("failed to delete sessions: %w", err)
+		}
+		if err := h.oauthTokenRepo.DeleteByUserID(ctx, userID); err != nil {
+			return fmt.Errorf("failed to delete oauth tokens: %w", err)
+		}
+		if err := h.apiKeyRepo.DeleteByUserID(ctx, userID); err != nil {
+			return fmt.Errorf("failed to delete api keys: %w", err)
+		}
+		if err := h.deviceRepo.DeleteByUserID(ctx, userID); err != nil {
+			return fmt.Errorf("failed to delete trusted devices: %w", err)
+		}
+		if err := h.orgRepo.RemoveUserMemberships(ctx, userID); err != nil {
+			return fmt.Errorf("failed to remove organization memberships: %w", err)
+		}
+		if err := h.roleRepo.UnassignAllFromUser(ctx, userID); err != nil {
+			return fmt.Errorf("failed to unassign roles: %w", err)
+		}
+		if err := h.auditRepo.AnonymizeByUserID(ctx, userID); err != nil {
+			return fmt.Errorf("failed to anonymize audit logs: %w", err)
+		}
+		if err := h.userRepo.HardDelete(ctx, userID); err != nil {
+			return fmt.Errorf("failed to purge user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// No actor: the purge is a system action, like SCIM provisioning.
+	recordUserAudit(ctx, h.auditRepo, h.logger, "", valueobjects.AuditActionUserPurged,
+		userID, "", "", map[string]interface{}{"reason": "deletion_grace_period_elapsed"})
+	return nil
+}
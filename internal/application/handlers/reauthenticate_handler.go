@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// ReauthenticateHandler upgrades the caller's session for commands that
+// require a recent or stronger sign-in. The session itself is kept; only
+// its access token is replaced. Wrong passwords count towards the same
+// risk score as failed logins, so reauthentication cannot be used to guess
+// a password without tripping the login lockout.
+type ReauthenticateHandler struct {
+	userRepo       repositories.UserRepository
+	sessionRepo    repositories.SessionRepository
+	auditRepo      repositories.AuditRepository
+	passwordHasher *domainServices.PasswordHashingService
+	otpService     services.OTPService
+	tokenIssuer    services.AccessTokenIssuer
+	risk           services.RiskEngine
+	logger         logging.Logger
+}
+
+func NewReauthenticateHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	passwordHasher *domainServices.PasswordHashingService,
+	otpService services.OTPService,
+	tokenIssuer services.AccessTokenIssuer,
+	risk services.RiskEngine,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.ReauthenticateCommand, dtos.ReauthenticationResult] {
+	return &ReauthenticateHandler{
+		userRepo:       userRepo,
+		sessionRepo:    sessionRepo,
+		auditRepo:      auditRepo,
+		passwordHasher: passwordHasher,
+		otpService:     otpService,
+		tokenIssuer:    tokenIssuer,
+		risk:           risk,
+		logger:         logger.With(zap.String("handler", "reauthenticate")),
+	}
+}
+
+func (h *ReauthenticateHandler) Handle(
+	ctx context.Context,
+	cmd commands.ReauthenticateCommand,
+) (dtos.ReauthenticationResult, error) {
+	user, err := findUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return dtos.ReauthenticationResult{}, err
+	}
+	if !user.User.IsActive {
+		return dtos.ReauthenticationResult{}, domain.ErrInactiveUser
+	}
+
+	var session *entities.Session
+	if cmd.SessionID != "" {
+		session, err = h.sessionRepo.FindByID(ctx, cmd.SessionID)
+		if err != nil && !repositories.IsNotFoundError(err) {
+			return dtos.ReauthenticationResult{}, fmt.Errorf("failed to find session: %w", err)
+		}
+		if session == nil || session.UserID != user.ID() || !session.IsValid() {
+			return dtos.ReauthenticationResult{}, domain.ErrInvalidSession
+		}
+	}
+
+	var method valueobjects.AuthMethod
+	switch {
+	case cmd.Password != "":
+		attempt := services.LoginAttempt{
+			UserID:    user.ID(),
+			Email:     user.User.Email.String(),
+			IPAddress: cmd.IPAddress,
+			UserAgent: cmd.UserAgent,
+			Method:    "reauthenticate",
+		}
+		if assessLogin(ctx, h.risk, h.auditRepo, h.logger, attempt).Decision == services.RiskDecisionBlock {
+			return dtos.ReauthenticationResult{}, domain.ErrLoginBlocked
+		}
+		if user.User.Password.IsEmpty() || !h.passwordHasher.Verify(cmd.Password, user.User.Password) {
+			recordLoginOutcome(ctx, h.risk, h.logger, attempt, false)
+			h.logger.Warn(ctx, "Reauthentication failed",
+				zap.String("user_id", user.ID()),
+				zap.String("method", valueobjects.AuthMethodPassword.String()),
+			)
+			return dtos.ReauthenticationResult{}, domain.ErrInvalidCredentials
+		}
+		recordLoginOutcome(ctx, h.risk, h.logger, attempt, true)
+		method = valueobjects.AuthMethodPassword
+	case cmd.OTPCode != "":
+		valid, err := h.otpService.Verify(ctx, user.User.Email.String(), cmd.OTPCode, services.OTPPurposeReauthentication)
+		if err != nil {
+			return dtos.ReauthenticationResult{}, fmt.Errorf("failed to verify otp: %w", err)
+		}
+		if !valid {
+			h.logger.Warn(ctx, "Reauthentication failed",
+				zap.String("user_id", user.ID()),
+				zap.String("method", valueobjects.AuthMethodOTP.String()),
+			)
+			return dtos.ReauthenticationResult{}, domain.ErrInvalidOTP
+		}
+		method = valueobjects.AuthMethodOTP
+	default:
+		return h.sendCode(ctx, user)
+	}
+
+	auth := services.AuthenticationContext{
+		SessionID: cmd.SessionID,
+		Time:      time.Now().UTC(),
+		Methods:   valueobjects.MergeAuthMethods(valueobjects.ParseAuthMethods(cmd.AuthMethods), method),
+	}
+	token, err := h.tokenIssuer.GenerateAccessToken(
+		user.User.TenantID,
+		user.ID(),
+		user.User.Email.String(),
+		user.User.Username.String(),
+		user.User.Role.String(),
+		services.OrganizationClaims{ID: cmd.OrganizationID, Role: cmd.OrganizationRole},
+		auth,
+	)
+	if err != nil {
+		return dtos.ReauthenticationResult{}, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	if session != nil {
+		session.UpdateTokens(session.RefreshToken, token.Value(), session.ExpiresAt)
+		if err := h.sessionRepo.Update(ctx, session); err != nil {
+			return dtos.ReauthenticationResult{}, fmt.Errorf("failed to update session: %w", err)
+		}
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, user.ID(), valueobjects.AuditActionUserReauthenticated,
+		user.ID(), cmd.IPAddress, cmd.UserAgent, map[string]interface{}{
+			"method":     method.String(),
+			"session_id": cmd.SessionID,
+			"acr":        auth.Level().String(),
+		})
+
+	h.logger.Info(ctx, "User reauthenticated",
+		zap.String("user_id", user.ID()),
+		zap.String("method", method.String()),
+		zap.String("acr", auth.Level().String()),
+	)
+
+	return dtos.ReauthenticationResult{
+		AccessToken: token.Value(),
+		TokenType:   "Bearer",
+		ExpiresAt:   token.ExpiresAt(),
+		AuthTime:    auth.Time,
+		AuthMethods: valueobjects.AuthMethodStrings(auth.Methods),
+		AuthLevel:   auth.Level().String(),
+	}, nil
+}
+
+// sendCode emails the code the user answers with OTPCode.
+func (h *ReauthenticateHandler) sendCode(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+) (dtos.ReauthenticationResult, error) {
+	email := user.User.Email.String()
+
+	isLimited, err := h.otpService.IsRateLimited(ctx, email)
+	if err != nil {
+		return dtos.ReauthenticationResult{}, fmt.Errorf("failed to check OTP rate limit: %w", err)
+	}
+	if isLimited {
+		return dtos.ReauthenticationResult{}, domain.ErrOTPRateLimited
+	}
+
+	code, err := h.otpService.Generate(ctx, email, services.OTPPurposeReauthentication)
+	if err != nil {
+		return dtos.ReauthenticationResult{}, fmt.Errorf("failed to generate reauthentication OTP: %w", err)
+	}
+	if err := h.otpService.SendEmail(ctx, email, code, services.OTPPurposeReauthentication); err != nil {
+		return dtos.ReauthenticationResult{}, fmt.Errorf("failed to send OTP: %w", err)
+	}
+
+	return dtos.ReauthenticationResult{OTPSent: true}, nil
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeRiskEngine blocks a user after maxFailures failed attempts in a row,
+// like the login lockout.
+type fakeRiskEngine struct {
+	maxFailures int
+	failures    map[string]int
+	methods     []string
+}
+
+func (e *fakeRiskEngine) Evaluate(_ context.Context, attempt services.LoginAttempt) (services.RiskAssessment, error) {
+	e.methods = append(e.methods, attempt.Method)
+	if e.failures[attempt.UserID] >= e.maxFailures {
+		return services.RiskAssessment{Decision: services.RiskDecisionBlock}, nil
+	}
+	return services.RiskAssessment{Decision: services.RiskDecisionAllow}, nil
+}
+
+func (e *fakeRiskEngine) RecordSuccess(_ context.Context, attempt services.LoginAttempt) error {
+	delete(e.failures, attempt.UserID)
+	return nil
+}
+
+func (e *fakeRiskEngine) RecordFailure(_ context.Context, attempt services.LoginAttempt) error {
+	e.failures[attempt.UserID]++
+	return nil
+}
+
+type fakeAccessTokenIssuer struct {
+	issued []services.AuthenticationContext
+}
+
+func (i *fakeAccessTokenIssuer) GenerateAccessToken(
+	_, _, _, _, _ string,
+	_ services.OrganizationClaims,
+	auth services.AuthenticationContext,
+) (*valueobjects.Token, error) {
+	i.issued = append(i.issued, auth)
+	return valueobjects.NewToken("reauthenticated", valueobjects.TokenTypeAccess, time.Now().Add(time.Minute))
+}
+
+func TestReauthenticateLockout(t *testing.T) {
+	const password = "Correct-Horse-Battery-9"
+
+	hasher := domainServices.NewPasswordHashingService(domainServices.NewPasswordPolicyService())
+	hashed, err := hasher.HashPassword(password)
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+	username, _ := valueobjects.NewUsername("alice")
+	email, _ := valueobjects.NewEmail("alice@example.com")
+	user := aggregates.NewEmailUserAggregate(username, email, hashed, "Alice", "", valueobjects.RoleUser)
+
+	risk := &fakeRiskEngine{maxFailures: 3, failures: map[string]int{}}
+	issuer := &fakeAccessTokenIssuer{}
+	handler := NewReauthenticateHandler(
+		&fakeUserRepository{users: map[string]*aggregates.UserAggregate{user.ID(): user}},
+		&fakeSessionRepository{},
+		&fakeAuditRepository{},
+		&hasher,
+		nil,
+		issuer,
+		risk,
+		nopLogger{},
+	)
+	reauthenticate := func(password string) error {
+		_, err := handler.Handle(context.Background(), commands.ReauthenticateCommand{
+			UserID:   user.ID(),
+			Password: password,
+		})
+		return err
+	}
+
+	if err := reauthenticate(password); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if len(issuer.issued) != 1 || issuer.issued[0].Level() != valueobjects.AuthLevelSingleFactor {
+		t.Fatalf("issued = %+v, want one single-factor token", issuer.issued)
+	}
+
+	for i := 0; i < risk.maxFailures; i++ {
+		if err := reauthenticate("wrong-password"); !errors.Is(err, domain.ErrInvalidCredentials) {
+			t.Fatalf("Handle() wrong password #%d error = %v, want %v", i+1, err, domain.ErrInvalidCredentials)
+		}
+	}
+	if got := risk.failures[user.ID()]; got != risk.maxFailures {
+		t.Fatalf("failures = %d, want %d", got, risk.maxFailures)
+	}
+
+	// Once locked out, even the right password is refused.
+	if err := reauthenticate(password); !errors.Is(err, domain.ErrLoginBlocked) {
+		t.Fatalf("Handle() after lockout error = %v, want %v", err, domain.ErrLoginBlocked)
+	}
+	if len(issuer.issued) != 1 {
+		t.Errorf("issued = %d tokens, want 1", len(issuer.issued))
+	}
+	for _, method := range risk.methods {
+		if method != "reauthenticate" {
+			t.Errorf("attempt method = %q, want %q", method, "reauthenticate")
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const oauthClientIDLength = 18
+
+type RegisterOAuthClientHandler struct {
+	clientRepo    repositories.OAuthClientRepository
+	auditRepo     repositories.AuditRepository
+	uow           persistence.UnitOfWork
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewRegisterOAuthClientHandler(
+	clientRepo repositories.OAuthClientRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RegisterOAuthClientCommand, dtos.OAuthClientSecretResult] {
+	return &RegisterOAuthClientHandler{
+		clientRepo:    clientRepo,
+		auditRepo:     auditRepo,
+		uow:           uow,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "register_oauth_client")),
+	}
+}
+
+func (h *RegisterOAuthClientHandler) Handle(
+	ctx context.Context,
+	cmd commands.RegisterOAuthClientCommand,
+) (dtos.OAuthClientSecretResult, error) {
+	name := strings.TrimSpace(cmd.Name)
+	if name == "" {
+		return dtos.OAuthClientSecretResult{}, domain.ErrEmptyClientName
+	}
+
+	redirectURIs, err := validateRedirectURIs(cmd.RedirectURIs)
+	if err != nil {
+		return dtos.OAuthClientSecretResult{}, err
+	}
+
+	scopes, err := valueobjects.NewScopes(cmd.Scopes)
+	if err != nil {
+		return dtos.OAuthClientSecretResult{}, err
+	}
+
+	clientID, err := h.secretService.Generate(oauthClientIDLength)
+	if err != nil {
+		return dtos.OAuthClientSecretResult{}, fmt.Errorf("failed to generate client id: %w", err)
+	}
+
+	var secret, secretHash string
+	if !cmd.Public {
+		secret, err = h.secretService.Generate(domainServices.DefaultSecretLength)
+		if err != nil {
+			return dtos.OAuthClientSecretResult{}, fmt.Errorf("failed to generate client secret: %w", err)
+		}
+		secretHash = h.secretService.Hash(secret)
+	}
+
+	client := aggregates.NewOAuthClient(
+		clientID,
+		secretHash,
+		aggregates.OAuthClientProviderInternal,
+		name,
+		redirectURIs,
+		valueobjects.ScopeStrings(scopes),
+	)
+
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		return h.clientRepo.Create(ctx, client)
+	})
+	if err != nil {
+		return dtos.OAuthClientSecretResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOAuthClientCreated,
+		"oauth_client",
+		client.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"client_id":     client.ClientID,
+			"name":          client.Name,
+			"redirect_uris": client.RedirectURIs,
+			"scopes":        client.Scopes,
+			"public":        client.IsPublic(),
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record oauth client audit log",
+			zap.Error(err),
+			zap.String("client_id", client.ClientID),
+		)
+	}
+
+	h.logger.Info(ctx, "OAuth client registered",
+		zap.String("id", client.ID()),
+		zap.String("client_id", client.ClientID),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return dtos.OAuthClientSecretResult{
+		OAuthClientResult: toOAuthClientResult(client),
+		ClientSecret:      secret,
+	}, nil
+}
+
+func validateRedirectURIs(raw []string) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, domain.ErrInvalidRedirectURI
+	}
+
+	uris := make([]string, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for _, value := range raw {
+		uri, err := valueobjects.NewRedirectURI(value)
+		if err != nil {
+			return nil, err
+		}
+		if seen[uri.String()] {
+			continue
+		}
+		seen[uri.String()] = true
+		uris = append(uris, uri.String())
+	}
+	return uris, nil
+}
+
+func toOAuthClientResult(client *aggregates.OAuthClient) dtos.OAuthClientResult {
+	return dtos.OAuthClientResult{
+		ID:           client.ID(),
+		ClientID:     client.ClientID,
+		Name:         client.Name,
+		RedirectURIs: client.RedirectURIs,
+		Scopes:       client.Scopes,
+		Public:       client.IsPublic(),
+		IsActive:     client.IsActive,
+	}
+}
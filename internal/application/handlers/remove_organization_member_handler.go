@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RemoveOrganizationMemberHandler struct {
+	orgRepo   repositories.OrganizationRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewRemoveOrganizationMemberHandler(
+	orgRepo repositories.OrganizationRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RemoveOrganizationMemberCommand, dtos.OrganizationMemberResult] {
+	return &RemoveOrganizationMemberHandler{
+		orgRepo:   orgRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "remove_organization_member")),
+	}
+}
+
+func (h *RemoveOrganizationMemberHandler) Handle(
+	ctx context.Context,
+	cmd commands.RemoveOrganizationMemberCommand,
+) (dtos.OrganizationMemberResult, error) {
+	var target *entities.OrganizationMember
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		org, actor, err := findMembership(ctx, h.orgRepo, cmd.OrganizationID, cmd.ActorID)
+		if err != nil {
+			return err
+		}
+
+		target = actor
+		if cmd.UserID != cmd.ActorID {
+			target, err = h.orgRepo.FindMember(ctx, org.ID(), cmd.UserID)
+			if err != nil {
+				if repositories.IsNotFoundError(err) {
+					return domain.ErrNotOrganizationMember
+				}
+				return fmt.Errorf("failed to find organization member: %w", err)
+			}
+
+			// Admins can remove members, owners can remove anyone else.
+			if !actor.Role.CanManageMembers() || !actor.Role.Outranks(target.Role) {
+				return domain.ErrPermissionDenied
+			}
+		}
+
+		if org.IsOwner(target.UserID) {
+			return domain.ErrCannotRemoveOwner
+		}
+
+		if err := h.orgRepo.RemoveMember(ctx, org.ID(), target.UserID); err != nil {
+			return fmt.Errorf("failed to remove organization member: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OrganizationMemberResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOrgMemberRemoved,
+		"organization",
+		cmd.OrganizationID,
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"user_id": target.UserID,
+			"role":    target.Role.String(),
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record organization member audit log",
+			zap.Error(err),
+			zap.String("organization_id", cmd.OrganizationID),
+		)
+	}
+
+	h.logger.Info(ctx, "Organization member removed",
+		zap.String("organization_id", cmd.OrganizationID),
+		zap.String("user_id", target.UserID),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toOrganizationMemberResult(target), nil
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+const signInAlertUsedKeyPrefix = "sign_in_alert_used:"
+
+type ReportSignInHandler struct {
+	userRepo    repositories.UserRepository
+	sessionRepo repositories.SessionRepository
+	auditRepo   repositories.AuditRepository
+	uow         persistence.UnitOfWork
+	cache       persistence.Cache
+	signer      services.SignInAlertSigner
+	otpService  services.OTPService
+	logger      logging.Logger
+}
+
+func NewReportSignInHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	cache persistence.Cache,
+	signer services.SignInAlertSigner,
+	otpService services.OTPService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.ReportSignInCommand, dtos.SignInReportResult] {
+	return &ReportSignInHandler{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		uow:         uow,
+		cache:       cache,
+		signer:      signer,
+		otpService:  otpService,
+		logger:      logger.With(zap.String("handler", "report_sign_in")),
+	}
+}
+
+func (h *ReportSignInHandler) Handle(
+	ctx context.Context,
+	cmd commands.ReportSignInCommand,
+) (dtos.SignInReportResult, error) {
+	alert, err := h.signer.Verify(cmd.Token)
+	if err != nil {
+		return dtos.SignInReportResult{}, err
+	}
+
+	// The link is opened without a session, so the tenant recorded in the
+	// token applies unless the host named another.
+	if tenantID, ok := utils.TenantIDFromContext(ctx); ok && tenantID != alert.TenantID {
+		return dtos.SignInReportResult{}, domain.ErrTenantMismatch
+	}
+	if alert.TenantID != "" {
+		ctx = utils.WithTenantID(ctx, alert.TenantID)
+	}
+
+	// Each link works once; a copy lifted from the mailbox later cannot
+	// lock the user out of their password again.
+	usedKey := signInAlertUsedKeyPrefix + alert.ID
+	claimed, err := h.cache.SetIfAbsent(ctx, usedKey, true, time.Until(alert.ExpiresAt))
+	if err != nil {
+		return dtos.SignInReportResult{}, fmt.Errorf("failed to claim sign-in alert: %w", err)
+	}
+	if !claimed {
+		return dtos.SignInReportResult{}, domain.ErrSignInAlertUsed
+	}
+
+	var (
+		email         string
+		resetRequired bool
+	)
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, alert.UserID)
+		if err != nil {
+			return err
+		}
+
+		if alert.Scope == services.SignInAlertScopeAll {
+			err = h.sessionRepo.RevokeByUserID(ctx, user.ID())
+		} else {
+			err = h.sessionRepo.RevokeByID(ctx, alert.SessionID)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		// Whoever signed in knew the password, so it must not work again.
+		user.RequirePasswordReset()
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to lock password: %w", err)
+		}
+
+		email = user.User.Email.String()
+		resetRequired = user.User.Password.ResetRequired()
+		return nil
+	})
+	if err != nil {
+		// Nothing was revoked, so let the user try the link again.
+		if delErr := h.cache.Delete(ctx, usedKey); delErr != nil {
+			h.logger.Warn(ctx, "Failed to release sign-in alert", zap.Error(delErr))
+		}
+		return dtos.SignInReportResult{}, err
+	}
+
+	if resetRequired {
+		h.sendResetCode(ctx, alert.UserID, email)
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, alert.UserID, valueobjects.AuditActionSignInReported,
+		alert.UserID, cmd.IPAddress, cmd.UserAgent, map[string]interface{}{
+			"session_id":              alert.SessionID,
+			"scope":                   alert.Scope,
+			"password_reset_required": resetRequired,
+		})
+
+	h.logger.Warn(ctx, "User reported a sign-in they did not make",
+		zap.String("user_id", alert.UserID),
+		zap.String("session_id", alert.SessionID),
+		zap.String("scope", alert.Scope),
+	)
+
+	return dtos.SignInReportResult{
+		UserID:                alert.UserID,
+		SessionID:             alert.SessionID,
+		Scope:                 alert.Scope,
+		PasswordResetRequired: resetRequired,
+	}, nil
+}
+
+// sendResetCode emails a password reset code right away so the user can
+// get back in. Failures are only logged: the sessions are already revoked
+// and the user can request another code.
+func (h *ReportSignInHandler) sendResetCode(ctx context.Context, userID, email string) {
+	code, err := h.otpService.Generate(ctx, email, services.OTPPurposePasswordReset)
+	if err == nil {
+		err = h.otpService.SendEmail(ctx, email, code, services.OTPPurposePasswordReset)
+	}
+	if err != nil {
+		h.logger.Error(ctx, "Failed to send password reset code after sign-in report",
+			zap.Error(err),
+			zap.String("user_id", userID),
+		)
+	}
+}
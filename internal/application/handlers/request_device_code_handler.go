@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const (
+	deviceCodeTTL          = 10 * time.Minute
+	deviceCodePollInterval = 5 * time.Second
+)
+
+type RequestDeviceCodeHandler struct {
+	clientRepo      repositories.OAuthClientRepository
+	cache           persistence.Cache
+	secretService   domainServices.SecretService
+	verificationURI string
+	logger          logging.Logger
+}
+
+func NewRequestDeviceCodeHandler(
+	clientRepo repositories.OAuthClientRepository,
+	cache persistence.Cache,
+	secretService domainServices.SecretService,
+	verificationURI string,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RequestDeviceCodeCommand, dtos.DeviceAuthorizationResult] {
+	return &RequestDeviceCodeHandler{
+		clientRepo:      clientRepo,
+		cache:           cache,
+		secretService:   secretService,
+		verificationURI: verificationURI,
+		logger:          logger.With(zap.String("handler", "request_device_code")),
+	}
+}
+
+func (h *RequestDeviceCodeHandler) Handle(
+	ctx context.Context,
+	cmd commands.RequestDeviceCodeCommand,
+) (dtos.DeviceAuthorizationResult, error) {
+	client, err := authenticateDeviceClient(ctx, h.clientRepo, h.secretService, cmd.ClientID, cmd.ClientSecret)
+	if err != nil {
+		return dtos.DeviceAuthorizationResult{}, err
+	}
+
+	scopes, err := resolveScopes(client.Scopes, cmd.Scope)
+	if err != nil {
+		return dtos.DeviceAuthorizationResult{}, err
+	}
+
+	deviceCode, err := h.secretService.Generate(domainServices.DefaultSecretLength)
+	if err != nil {
+		return dtos.DeviceAuthorizationResult{}, fmt.Errorf("failed to generate device code: %w", err)
+	}
+
+	userCode, err := h.secretService.GenerateCode(userCodeLength, userCodeAlphabet)
+	if err != nil {
+		return dtos.DeviceAuthorizationResult{}, fmt.Errorf("failed to generate user code: %w", err)
+	}
+
+	auth := entities.NewDeviceAuthorization(userCode, client.ClientID, scopes, deviceCodePollInterval, deviceCodeTTL)
+	if err := saveDeviceAuthorization(ctx, h.cache, h.secretService.Hash(deviceCode), auth); err != nil {
+		return dtos.DeviceAuthorizationResult{}, err
+	}
+
+	h.logger.Info(ctx, "Device authorization started",
+		zap.String("client_id", client.ClientID),
+		zap.String("ip_address", cmd.IPAddress),
+	)
+
+	formatted := formatUserCode(userCode)
+	return dtos.DeviceAuthorizationResult{
+		DeviceCode:              deviceCode,
+		UserCode:                formatted,
+		VerificationURI:         h.verificationURI,
+		VerificationURIComplete: h.verificationURI + "?user_code=" + url.QueryEscape(formatted),
+		ExpiresIn:               int64(deviceCodeTTL.Seconds()),
+		Interval:                int64(deviceCodePollInterval.Seconds()),
+	}, nil
+}
+
+// resolveScopes narrows a requested scope string to the scopes a client or
+// service account was granted. An empty request grants all of them.
+func resolveScopes(granted []string, requested string) ([]string, error) {
+	parsed, err := valueobjects.ParseScopes(requested)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed) == 0 {
+		return granted, nil
+	}
+
+	grantedSet := make(map[string]bool, len(granted))
+	for _, scope := range granted {
+		grantedSet[scope] = true
+	}
+	for _, scope := range parsed {
+		if !grantedSet[scope.String()] {
+			return nil, domain.ErrInvalidScope
+		}
+	}
+	return valueobjects.ScopeStrings(parsed), nil
+}
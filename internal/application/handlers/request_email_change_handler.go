@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const emailChangeTemplate = "email_change"
+
+type RequestEmailChangeHandler struct {
+	userRepo       repositories.UserRepository
+	auditRepo      repositories.AuditRepository
+	signer         services.EmailChangeSigner
+	emailService   services.EmailService
+	passwordHasher *domainServices.PasswordHashingService
+	changeURL      string
+	changeTTL      time.Duration
+	logger         logging.Logger
+}
+
+func NewRequestEmailChangeHandler(
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	signer services.EmailChangeSigner,
+	emailService services.EmailService,
+	passwordHasher *domainServices.PasswordHashingService,
+	changeURL string,
+	changeTTL time.Duration,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RequestEmailChangeCommand, dtos.EmailChangeResult] {
+	return &RequestEmailChangeHandler{
+		userRepo:       userRepo,
+		auditRepo:      auditRepo,
+		signer:         signer,
+		emailService:   emailService,
+		passwordHasher: passwordHasher,
+		changeURL:      changeURL,
+		changeTTL:      changeTTL,
+		logger:         logger.With(zap.String("handler", "request_email_change")),
+	}
+}
+
+func (h *RequestEmailChangeHandler) Handle(
+	ctx context.Context,
+	cmd commands.RequestEmailChangeCommand,
+) (dtos.EmailChangeResult, error) {
+	newEmail, err := valueobjects.NewEmail(cmd.NewEmail)
+	if err != nil {
+		return dtos.EmailChangeResult{}, err
+	}
+
+	user, err := findUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return dtos.EmailChangeResult{}, err
+	}
+	if identityManagedExternally(user) {
+		return dtos.EmailChangeResult{}, domain.ErrIdentityManagedExternally
+	}
+	if newEmail.Equals(user.User.Email) {
+		return dtos.EmailChangeResult{}, domain.ErrEmailUnchanged
+	}
+
+	// A stolen session alone must not be enough to take over the account.
+	if !user.User.Password.IsEmpty() && !h.passwordHasher.Verify(cmd.Password, user.User.Password) {
+		return dtos.EmailChangeResult{}, domain.ErrInvalidCredentials
+	}
+
+	if err := ensureEmailAvailable(ctx, h.userRepo, user.ID(), newEmail); err != nil {
+		return dtos.EmailChangeResult{}, err
+	}
+
+	expiresAt := time.Now().UTC().Add(h.changeTTL)
+	token, err := h.signer.Sign(services.EmailChange{
+		UserID:       user.ID(),
+		CurrentEmail: user.User.Email.String(),
+		NewEmail:     newEmail.String(),
+	}, expiresAt)
+	if err != nil {
+		return dtos.EmailChangeResult{}, fmt.Errorf("failed to sign email change: %w", err)
+	}
+
+	err = h.emailService.SendEmail(ctx, services.SendEmailInput{
+		To:       newEmail.String(),
+		Subject:  "Confirm your new email address",
+		Template: emailChangeTemplate,
+		Data: map[string]interface{}{
+			"username":   user.User.Username.String(),
+			"link":       h.confirmationLink(token),
+			"expires_at": expiresAt,
+		},
+	})
+	if err != nil {
+		return dtos.EmailChangeResult{}, fmt.Errorf("failed to send email change confirmation: %w", err)
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.UserID, valueobjects.AuditActionEmailChangeRequested,
+		user.ID(), cmd.IPAddress, cmd.UserAgent, map[string]interface{}{"new_email": newEmail.String()})
+
+	return dtos.EmailChangeResult{
+		UserID:    user.ID(),
+		NewEmail:  newEmail.String(),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (h *RequestEmailChangeHandler) confirmationLink(token string) string {
+	separator := "?"
+	if strings.Contains(h.changeURL, "?") {
+		separator = "&"
+	}
+	return h.changeURL + separator + "token=" + url.QueryEscape(token)
+}
+
+// ensureEmailAvailable rejects an email held by any account other than
+// userID.
+func ensureEmailAvailable(
+	ctx context.Context,
+	userRepo repositories.UserRepository,
+	userID string,
+	email valueobjects.Email,
+) error {
+	existing, err := userRepo.FindByEmail(ctx, email)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return fmt.Errorf("failed to find user by email: %w", err)
+	}
+	if existing != nil && existing.ID() != userID {
+		return domain.ErrEmailAlreadyInUse
+	}
+	return nil
+}
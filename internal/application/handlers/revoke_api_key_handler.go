@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RevokeAPIKeyHandler struct {
+	keyRepo   repositories.APIKeyRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewRevokeAPIKeyHandler(
+	keyRepo repositories.APIKeyRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RevokeAPIKeyCommand, dtos.APIKeyResult] {
+	return &RevokeAPIKeyHandler{
+		keyRepo:   keyRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "revoke_api_key")),
+	}
+}
+
+func (h *RevokeAPIKeyHandler) Handle(
+	ctx context.Context,
+	cmd commands.RevokeAPIKeyCommand,
+) (dtos.APIKeyResult, error) {
+	var key *aggregates.APIKey
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		key, err = h.keyRepo.FindByID(ctx, cmd.KeyID)
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return domain.ErrAPIKeyNotFound
+			}
+			return fmt.Errorf("failed to find api key: %w", err)
+		}
+
+		// Users only see their own keys; hide the rest as not found.
+		if cmd.OwnerID != "" && key.UserID != cmd.OwnerID {
+			return domain.ErrAPIKeyNotFound
+		}
+
+		if err := key.Revoke(); err != nil {
+			return err
+		}
+
+		if err := h.keyRepo.Update(ctx, key); err != nil {
+			return fmt.Errorf("failed to revoke api key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.APIKeyResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionAPIKeyRevoked,
+		"api_key",
+		key.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"owner_id": key.UserID},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record api key audit log",
+			zap.Error(err),
+			zap.String("key_id", key.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "API key revoked",
+		zap.String("key_id", key.ID()),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toAPIKeyResult(key), nil
+}
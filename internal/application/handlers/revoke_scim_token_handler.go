@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RevokeSCIMTokenHandler struct {
+	tokenRepo repositories.SCIMTokenRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewRevokeSCIMTokenHandler(
+	tokenRepo repositories.SCIMTokenRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RevokeSCIMTokenCommand, dtos.SCIMTokenResult] {
+	return &RevokeSCIMTokenHandler{
+		tokenRepo: tokenRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "revoke_scim_token")),
+	}
+}
+
+func (h *RevokeSCIMTokenHandler) Handle(
+	ctx context.Context,
+	cmd commands.RevokeSCIMTokenCommand,
+) (dtos.SCIMTokenResult, error) {
+	var token *aggregates.SCIMToken
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		token, err = h.tokenRepo.FindByID(ctx, cmd.TokenID)
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return domain.ErrSCIMTokenNotFound
+			}
+			return fmt.Errorf("failed to find scim token: %w", err)
+		}
+
+		if err := token.Revoke(); err != nil {
+			return err
+		}
+
+		if err := h.tokenRepo.Update(ctx, token); err != nil {
+			return fmt.Errorf("failed to revoke scim token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.SCIMTokenResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionSCIMTokenRevoked,
+		"scim_token",
+		token.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"name": token.Name},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record scim token audit log",
+			zap.Error(err),
+			zap.String("token_id", token.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "SCIM token revoked",
+		zap.String("token_id", token.ID()),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toSCIMTokenResult(token), nil
+}
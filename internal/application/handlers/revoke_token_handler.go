@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RevokeTokenHandler struct {
+	clientRepo    repositories.OAuthClientRepository
+	auditRepo     repositories.AuditRepository
+	tokenService  services.TokenService
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewRevokeTokenHandler(
+	clientRepo repositories.OAuthClientRepository,
+	auditRepo repositories.AuditRepository,
+	tokenService services.TokenService,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RevokeTokenCommand, dtos.RevokeTokenResult] {
+	return &RevokeTokenHandler{
+		clientRepo:    clientRepo,
+		auditRepo:     auditRepo,
+		tokenService:  tokenService,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "revoke_token")),
+	}
+}
+
+// Handle revokes the session behind an access or refresh token. Per RFC 7009
+// an invalid or already revoked token is not an error, and neither is a
+// token issued to another client, which is left untouched. First-party
+// tokens, issued by the login endpoints rather than to an OAuth client,
+// carry no client id and are never revoked here either; users end those
+// sessions by logging out or from the sessions list.
+func (h *RevokeTokenHandler) Handle(
+	ctx context.Context,
+	cmd commands.RevokeTokenCommand,
+) (dtos.RevokeTokenResult, error) {
+	client, err := authenticateOAuthClient(ctx, h.clientRepo, h.secretService, cmd.ClientID, cmd.ClientSecret)
+	if err != nil {
+		return dtos.RevokeTokenResult{}, err
+	}
+
+	claims, tokenType, err := verifyTokenWithHint(ctx, h.tokenService, cmd.Token, cmd.TokenTypeHint)
+	if err != nil {
+		h.logger.Debug(ctx, "Ignoring revocation of invalid token",
+			zap.String("client_id", client.ClientID),
+			zap.Error(err),
+		)
+		return dtos.RevokeTokenResult{Revoked: false}, nil
+	}
+
+	if claims.SessionID == "" {
+		return dtos.RevokeTokenResult{Revoked: false}, nil
+	}
+
+	if claims.ClientID == "" {
+		h.logger.Debug(ctx, "Ignoring revocation of first-party token",
+			zap.String("client_id", client.ClientID),
+			zap.String("session_id", claims.SessionID),
+		)
+		return dtos.RevokeTokenResult{Revoked: false}, nil
+	}
+
+	if claims.ClientID != client.ClientID {
+		h.logger.Warn(ctx, "Ignoring revocation of token issued to another client",
+			zap.String("client_id", client.ClientID),
+			zap.String("token_client_id", claims.ClientID),
+			zap.String("session_id", claims.SessionID),
+		)
+		return dtos.RevokeTokenResult{Revoked: false}, nil
+	}
+
+	if err := h.tokenService.RevokeSession(ctx, claims.SessionID); err != nil {
+		return dtos.RevokeTokenResult{}, fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	userID := cmd.UserID
+	if userID == "" {
+		userID = claims.UserID
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		userID,
+		valueobjects.AuditActionTokenRevoked,
+		"session",
+		claims.SessionID,
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"client_id":  client.ClientID,
+			"token_type": tokenType,
+			"token_id":   claims.TokenID,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record token revocation audit log",
+			zap.Error(err),
+			zap.String("session_id", claims.SessionID),
+		)
+	}
+
+	h.logger.Info(ctx, "Token revoked",
+		zap.String("client_id", client.ClientID),
+		zap.String("session_id", claims.SessionID),
+		zap.String("token_type", tokenType),
+	)
+
+	return dtos.RevokeTokenResult{Revoked: true}, nil
+}
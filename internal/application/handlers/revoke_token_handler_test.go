@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/aggregates"
+	domainServices "authentication/internal/domain/services"
+)
+
+func TestRevokeToken(t *testing.T) {
+	secrets := domainServices.NewSecretService()
+	client := aggregates.NewOAuthClient("client-a", secrets.Hash("secret-a"), "", "Client A", nil, nil)
+	client.IsActive = true
+
+	tests := []struct {
+		name        string
+		claims      *services.TokenClaims
+		wantRevoked bool
+	}{
+		{
+			name:        "token issued to the client",
+			claims:      &services.TokenClaims{UserID: "user-1", SessionID: "session-1", ClientID: "client-a"},
+			wantRevoked: true,
+		},
+		{
+			name:   "token issued to another client",
+			claims: &services.TokenClaims{UserID: "user-1", SessionID: "session-1", ClientID: "client-b"},
+		},
+		{
+			// First-party tokens come from the login endpoints; no OAuth
+			// client may end those sessions.
+			name:   "first-party token",
+			claims: &services.TokenClaims{UserID: "user-1", SessionID: "session-1"},
+		},
+		{
+			name:   "token without a session",
+			claims: &services.TokenClaims{UserID: "user-1", ClientID: "client-a"},
+		},
+		{
+			name: "invalid token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := &fakeTokenService{access: map[string]*services.TokenClaims{}}
+			if tt.claims != nil {
+				tokens.access["token"] = tt.claims
+			}
+			auditRepo := &fakeAuditRepository{}
+			handler := NewRevokeTokenHandler(
+				&fakeOAuthClientRepository{clients: map[string]*aggregates.OAuthClient{"client-a": client}},
+				auditRepo,
+				tokens,
+				secrets,
+				nopLogger{},
+			)
+
+			result, err := handler.Handle(context.Background(), commands.RevokeTokenCommand{
+				Token:        "token",
+				ClientID:     "client-a",
+				ClientSecret: "secret-a",
+			})
+			if err != nil {
+				t.Fatalf("Handle() error = %v", err)
+			}
+			if result.Revoked != tt.wantRevoked {
+				t.Fatalf("Revoked = %v, want %v", result.Revoked, tt.wantRevoked)
+			}
+
+			wantSessions := 0
+			if tt.wantRevoked {
+				wantSessions = 1
+			}
+			if len(tokens.revoked) != wantSessions {
+				t.Fatalf("revoked sessions = %v, want %d", tokens.revoked, wantSessions)
+			}
+			if len(auditRepo.logs) != wantSessions {
+				t.Fatalf("audit logs = %d, want %d", len(auditRepo.logs), wantSessions)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RevokeTrustedDeviceHandler struct {
+	deviceRepo repositories.TrustedDeviceRepository
+	auditRepo  repositories.AuditRepository
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewRevokeTrustedDeviceHandler(
+	deviceRepo repositories.TrustedDeviceRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RevokeTrustedDeviceCommand, dtos.TrustedDeviceResult] {
+	return &RevokeTrustedDeviceHandler{
+		deviceRepo: deviceRepo,
+		auditRepo:  auditRepo,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "revoke_trusted_device")),
+	}
+}
+
+func (h *RevokeTrustedDeviceHandler) Handle(
+	ctx context.Context,
+	cmd commands.RevokeTrustedDeviceCommand,
+) (dtos.TrustedDeviceResult, error) {
+	var device *aggregates.TrustedDevice
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		device, err = h.deviceRepo.FindByID(ctx, cmd.TrustedDeviceID)
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return domain.ErrTrustedDeviceNotFound
+			}
+			return fmt.Errorf("failed to find trusted device: %w", err)
+		}
+
+		// Another user's device is hidden as not found.
+		if device.UserID != cmd.UserID {
+			return domain.ErrTrustedDeviceNotFound
+		}
+
+		if err := device.Revoke(); err != nil {
+			return err
+		}
+
+		if err := h.deviceRepo.Update(ctx, device); err != nil {
+			return fmt.Errorf("failed to revoke trusted device: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.TrustedDeviceResult{}, err
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, valueobjects.AuditActionTrustedDeviceRevoked,
+		device.UserID, cmd.IPAddress, cmd.UserAgent, map[string]interface{}{
+			"trusted_device_id": device.ID(),
+		})
+
+	h.logger.Info(ctx, "Trusted device revoked",
+		zap.String("trusted_device_id", device.ID()),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toTrustedDeviceResult(device), nil
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RevokeUserSessionsHandler struct {
+	userRepo    repositories.UserRepository
+	sessionRepo repositories.SessionRepository
+	auditRepo   repositories.AuditRepository
+	logger      logging.Logger
+}
+
+func NewRevokeUserSessionsHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RevokeUserSessionsCommand, dtos.UserResult] {
+	return &RevokeUserSessionsHandler{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		logger:      logger.With(zap.String("handler", "revoke_user_sessions")),
+	}
+}
+
+func (h *RevokeUserSessionsHandler) Handle(
+	ctx context.Context,
+	cmd commands.RevokeUserSessionsCommand,
+) (dtos.UserResult, error) {
+	user, err := findUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	if err := h.sessionRepo.RevokeByUserID(ctx, user.ID()); err != nil {
+		return dtos.UserResult{}, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, valueobjects.AuditActionUserSessionsRevoked,
+		user.ID(), cmd.IPAddress, cmd.UserAgent, nil)
+
+	h.logger.Info(ctx, "User sessions revoked",
+		zap.String("user_id", user.ID()),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return toUserResult(user), nil
+}
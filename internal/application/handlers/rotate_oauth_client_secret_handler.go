@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RotateOAuthClientSecretHandler struct {
+	clientRepo    repositories.OAuthClientRepository
+	auditRepo     repositories.AuditRepository
+	uow           persistence.UnitOfWork
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewRotateOAuthClientSecretHandler(
+	clientRepo repositories.OAuthClientRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RotateOAuthClientSecretCommand, dtos.OAuthClientSecretResult] {
+	return &RotateOAuthClientSecretHandler{
+		clientRepo:    clientRepo,
+		auditRepo:     auditRepo,
+		uow:           uow,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "rotate_oauth_client_secret")),
+	}
+}
+
+func (h *RotateOAuthClientSecretHandler) Handle(
+	ctx context.Context,
+	cmd commands.RotateOAuthClientSecretCommand,
+) (dtos.OAuthClientSecretResult, error) {
+	secret, err := h.secretService.Generate(domainServices.DefaultSecretLength)
+	if err != nil {
+		return dtos.OAuthClientSecretResult{}, fmt.Errorf("failed to generate client secret: %w", err)
+	}
+
+	var client *aggregates.OAuthClient
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		client, err = findOAuthClient(ctx, h.clientRepo, cmd.ClientID)
+		if err != nil {
+			return err
+		}
+
+		if !client.IsActive {
+			return domain.ErrOAuthClientInactive
+		}
+		if client.IsPublic() {
+			return domain.ErrOAuthClientPublic
+		}
+
+		client.RotateSecret(h.secretService.Hash(secret))
+		if err := h.clientRepo.Update(ctx, client); err != nil {
+			return fmt.Errorf("failed to rotate oauth client secret: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OAuthClientSecretResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOAuthClientSecretRotated,
+		"oauth_client",
+		client.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"client_id": client.ClientID},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record oauth client audit log",
+			zap.Error(err),
+			zap.String("client_id", client.ClientID),
+		)
+	}
+
+	h.logger.Info(ctx, "OAuth client secret rotated",
+		zap.String("client_id", client.ClientID),
+		zap.String("actor_id", cmd.ActorID),
+	)
+
+	return dtos.OAuthClientSecretResult{
+		OAuthClientResult: toOAuthClientResult(client),
+		ClientSecret:      secret,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type RotateServiceAccountSecretHandler struct {
+	accountRepo   repositories.ServiceAccountRepository
+	auditRepo     repositories.AuditRepository
+	uow           persistence.UnitOfWork
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewRotateServiceAccountSecretHandler(
+	accountRepo repositories.ServiceAccountRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.RotateServiceAccountSecretCommand, dtos.ServiceAccountSecretResult] {
+	return &RotateServiceAccountSecretHandler{
+		accountRepo:   accountRepo,
+		auditRepo:     auditRepo,
+		uow:           uow,
+		secretService: secretService,
+		logger:        logger.With(zap.String("handler", "rotate_service_account_secret")),
+	}
+}
+
+func (h *RotateServiceAccountSecretHandler) Handle(
+	ctx context.Context,
+	cmd commands.RotateServiceAccountSecretCommand,
+) (dtos.ServiceAccountSecretResult, error) {
+	secret, err := h.secretService.Generate(domainServices.DefaultSecretLength)
+	if err != nil {
+		return dtos.ServiceAccountSecretResult{}, fmt.Errorf("failed to generate client secret: %w", err)
+	}
+
+	var account *aggregates.ServiceAccount
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		account, err = findServiceAccount(ctx, h.accountRepo, cmd.ServiceAccountID)
+		if err != nil {
+			return err
+		}
+
+		if !account.IsActive {
+			return domain.ErrInvalidClient
+		}
+
+		account.RotateSecret(h.secretService.Hash(secret))
+		if err := h.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("failed to rotate service account secret: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.ServiceAccountSecretResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionServiceAccountSecretRotated,
+		"service_account",
+		account.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"client_id": account.ClientID},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record service account audit log",
+			zap.Error(err),
+			zap.String("client_id", account.ClientID),
+		)
+	}
+
+	return dtos.ServiceAccountSecretResult{
+		ServiceAccountResult: toServiceAccountResult(account),
+		ClientSecret:         secret,
+	}, nil
+}
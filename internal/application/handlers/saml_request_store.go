@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain"
+)
+
+const (
+	samlRequestKeyPrefix   = "saml_request:"
+	samlAssertionKeyPrefix = "saml_assertion:"
+)
+
+// samlPendingRequest is kept between sending the AuthnRequest and receiving
+// the response, keyed by the RelayState that round-trips through the IdP.
+type samlPendingRequest struct {
+	RequestID    string `json:"request_id"`
+	ConnectionID string `json:"connection_id"`
+	TenantID     string `json:"tenant_id"`
+}
+
+func saveSAMLRequest(
+	ctx context.Context,
+	cache persistence.Cache,
+	relayState string,
+	pending samlPendingRequest,
+	ttl time.Duration,
+) error {
+	if err := cache.Set(ctx, samlRequestKeyPrefix+relayState, pending, ttl); err != nil {
+		return fmt.Errorf("failed to store saml request: %w", err)
+	}
+	return nil
+}
+
+// takeSAMLRequest loads and deletes the pending request so each RelayState
+// completes at most one login.
+func takeSAMLRequest(
+	ctx context.Context,
+	cache persistence.Cache,
+	relayState string,
+) (*samlPendingRequest, error) {
+	if relayState == "" {
+		return nil, domain.ErrSAMLRequestNotFound
+	}
+
+	var pending samlPendingRequest
+	if err := cache.Get(ctx, samlRequestKeyPrefix+relayState, &pending); err != nil {
+		if errors.Is(err, persistence.ErrCacheMiss) {
+			return nil, domain.ErrSAMLRequestNotFound
+		}
+		return nil, fmt.Errorf("failed to load saml request: %w", err)
+	}
+	if err := cache.Delete(ctx, samlRequestKeyPrefix+relayState); err != nil {
+		return nil, fmt.Errorf("failed to delete saml request: %w", err)
+	}
+	return &pending, nil
+}
+
+// markSAMLAssertionUsed rejects an assertion id that was already consumed.
+// The marker lives until the assertion would have expired anyway.
+func markSAMLAssertionUsed(
+	ctx context.Context,
+	cache persistence.Cache,
+	connectionID string,
+	assertionID string,
+	notOnOrAfter time.Time,
+) error {
+	key := samlAssertionKeyPrefix + connectionID + ":" + assertionID
+
+	used, err := cache.Exists(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to check saml assertion replay: %w", err)
+	}
+	if used {
+		return domain.ErrSAMLAssertionReplayed
+	}
+
+	ttl := time.Until(notOnOrAfter)
+	if ttl < time.Minute {
+		ttl = time.Minute
+	}
+	if err := cache.Set(ctx, key, true, ttl); err != nil {
+		return fmt.Errorf("failed to record saml assertion: %w", err)
+	}
+	return nil
+}
@@ -1 +1,105 @@
-package handlers
\ No newline at end of file
+package handlers
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/queries"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type SearchAuditLogsHandler struct {
+	auditRepo repositories.AuditRepository
+	logger    logging.Logger
+}
+
+func NewSearchAuditLogsHandler(
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.QueryHandler[queries.SearchAuditLogsQuery, dtos.SearchAuditLogsResult] {
+	return &SearchAuditLogsHandler{
+		auditRepo: auditRepo,
+		logger:    logger.With(zap.String("handler", "search_audit_logs")),
+	}
+}
+
+func (h *SearchAuditLogsHandler) Handle(
+	ctx context.Context,
+	query queries.SearchAuditLogsQuery,
+) (dtos.SearchAuditLogsResult, error) {
+	text := strings.TrimSpace(query.Query)
+	if text == "" {
+		return dtos.SearchAuditLogsResult{}, domain.ErrAuditSearchQueryRequired
+	}
+	if err := checkAuditDateRange(query.StartDate, query.EndDate); err != nil {
+		return dtos.SearchAuditLogsResult{}, err
+	}
+
+	_, pageSize := utils.NormalizePagination(1, query.PageSize)
+
+	search := repositories.AuditLogSearch{
+		Query:     text,
+		StartDate: query.StartDate,
+		EndDate:   query.EndDate,
+		// One extra row tells whether another page follows.
+		Limit: pageSize + 1,
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeAuditCursor(query.Cursor)
+		if err != nil {
+			return dtos.SearchAuditLogsResult{}, err
+		}
+		search.After = &cursor
+	}
+
+	logs, err := h.auditRepo.Search(ctx, search)
+	if err != nil {
+		return dtos.SearchAuditLogsResult{}, fmt.Errorf("failed to search audit logs: %w", err)
+	}
+
+	result := dtos.SearchAuditLogsResult{}
+	if len(logs) > pageSize {
+		logs = logs[:pageSize]
+		last := logs[len(logs)-1]
+		result.NextCursor = encodeAuditCursor(repositories.AuditLogCursor{Timestamp: last.Timestamp, ID: last.ID()})
+	}
+	result.Logs = toAuditLogResults(logs)
+
+	return result, nil
+}
+
+// encodeAuditCursor makes an opaque page token from the position of the
+// last entry on a page.
+func encodeAuditCursor(cursor repositories.AuditLogCursor) string {
+	raw := strconv.FormatInt(cursor.Timestamp.UnixNano(), 10) + ":" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeAuditCursor(token string) (repositories.AuditLogCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return repositories.AuditLogCursor{}, domain.ErrInvalidAuditCursor
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return repositories.AuditLogCursor{}, domain.ErrInvalidAuditCursor
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return repositories.AuditLogCursor{}, domain.ErrInvalidAuditCursor
+	}
+
+	return repositories.AuditLogCursor{Timestamp: time.Unix(0, unixNano).UTC(), ID: id}, nil
+}
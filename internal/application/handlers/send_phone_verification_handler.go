@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type SendPhoneVerificationHandler struct {
+	userRepo   repositories.UserRepository
+	otpService services.OTPService
+	logger     logging.Logger
+}
+
+func NewSendPhoneVerificationHandler(
+	userRepo repositories.UserRepository,
+	otpService services.OTPService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.SendPhoneVerificationCommand, dtos.PhoneVerificationResult] {
+	return &SendPhoneVerificationHandler{
+		userRepo:   userRepo,
+		otpService: otpService,
+		logger:     logger.With(zap.String("handler", "send_phone_verification")),
+	}
+}
+
+func (h *SendPhoneVerificationHandler) Handle(
+	ctx context.Context,
+	cmd commands.SendPhoneVerificationCommand,
+) (dtos.PhoneVerificationResult, error) {
+	user, err := findUser(ctx, h.userRepo, cmd.UserID)
+	if err != nil {
+		return dtos.PhoneVerificationResult{}, err
+	}
+	if user.User.Phone.IsEmpty() {
+		return dtos.PhoneVerificationResult{}, domain.ErrPhoneNotSet
+	}
+	if user.User.PhoneVerified {
+		return dtos.PhoneVerificationResult{}, domain.ErrPhoneAlreadyVerified
+	}
+
+	key := phoneVerificationKey(user)
+	isLimited, err := h.otpService.IsRateLimited(ctx, key)
+	if err != nil {
+		return dtos.PhoneVerificationResult{}, fmt.Errorf("failed to check otp rate limit: %w", err)
+	}
+	if isLimited {
+		return dtos.PhoneVerificationResult{}, domain.ErrOTPRateLimited
+	}
+
+	code, err := h.otpService.Generate(ctx, key, services.OTPPurposePhoneVerification)
+	if err != nil {
+		return dtos.PhoneVerificationResult{}, fmt.Errorf("failed to generate otp: %w", err)
+	}
+
+	phone := user.User.Phone.String()
+	if err := h.otpService.SendSMS(ctx, phone, code, services.OTPPurposePhoneVerification); err != nil {
+		return dtos.PhoneVerificationResult{}, fmt.Errorf("failed to send verification code: %w", err)
+	}
+
+	h.logger.Info(ctx, "Phone verification code sent", zap.String("user_id", user.ID()))
+
+	return dtos.PhoneVerificationResult{UserID: user.ID(), Phone: phone}, nil
+}
+
+// phoneVerificationKey ties a code to both the user and the number, so
+// changing the number invalidates any code already sent.
+func phoneVerificationKey(user *aggregates.UserAggregate) string {
+	return user.ID() + ":" + user.User.Phone.String()
+}
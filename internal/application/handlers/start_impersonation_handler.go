@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// StartImpersonationHandler lets support staff sign in as a user. The
+// session is recorded like any other so the user can see and revoke it, but
+// it has no refresh token and lasts at most sessionTTL.
+type StartImpersonationHandler struct {
+	userRepo    repositories.UserRepository
+	sessionRepo repositories.SessionRepository
+	auditRepo   repositories.AuditRepository
+	uow         persistence.UnitOfWork
+	authorizer  services.Authorizer
+	tokenIssuer services.ImpersonationTokenIssuer
+	sessionTTL  time.Duration
+	logger      logging.Logger
+}
+
+func NewStartImpersonationHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	authorizer services.Authorizer,
+	tokenIssuer services.ImpersonationTokenIssuer,
+	sessionTTL time.Duration,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.StartImpersonationCommand, dtos.ImpersonationResult] {
+	return &StartImpersonationHandler{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		uow:         uow,
+		authorizer:  authorizer,
+		tokenIssuer: tokenIssuer,
+		sessionTTL:  sessionTTL,
+		logger:      logger.With(zap.String("handler", "start_impersonation")),
+	}
+}
+
+func (h *StartImpersonationHandler) Handle(
+	ctx context.Context,
+	cmd commands.StartImpersonationCommand,
+) (dtos.ImpersonationResult, error) {
+	reason := strings.TrimSpace(cmd.Reason)
+	if reason == "" {
+		return dtos.ImpersonationResult{}, domain.ErrImpersonationReasonRequired
+	}
+	if cmd.TargetUserID == cmd.ActorID {
+		return dtos.ImpersonationResult{}, domain.ErrCannotImpersonateSelf
+	}
+
+	user, err := findUser(ctx, h.userRepo, cmd.TargetUserID)
+	if err != nil {
+		return dtos.ImpersonationResult{}, err
+	}
+	if !user.User.IsActive {
+		return dtos.ImpersonationResult{}, domain.ErrInactiveUser
+	}
+
+	// Acting as someone who may impersonate would hand out their reach too.
+	err = h.authorizer.Authorize(ctx, user.ID(), valueobjects.PermissionUsersImpersonate)
+	if err == nil {
+		return dtos.ImpersonationResult{}, domain.ErrCannotImpersonatePrivileged
+	}
+	if !errors.Is(err, domain.ErrPermissionDenied) {
+		return dtos.ImpersonationResult{}, fmt.Errorf("failed to check target permissions: %w", err)
+	}
+
+	var (
+		session *entities.Session
+		token   *valueobjects.Token
+	)
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		session = entities.NewSession(user.ID(), "", "", cmd.IPAddress, cmd.UserAgent,
+			time.Now().UTC().Add(h.sessionTTL))
+		session.TenantID = user.User.TenantID
+
+		var err error
+		token, err = h.tokenIssuer.GenerateImpersonationToken(services.ImpersonationClaims{
+			TenantID:  user.User.TenantID,
+			UserID:    user.ID(),
+			Email:     user.User.Email.String(),
+			Username:  user.User.Username.String(),
+			Role:      user.User.Role.String(),
+			ActorID:   cmd.ActorID,
+			SessionID: session.ID,
+		}, h.sessionTTL)
+		if err != nil {
+			return fmt.Errorf("failed to generate impersonation token: %w", err)
+		}
+		session.AccessToken = token.Value()
+		session.ExpiresAt = token.ExpiresAt()
+
+		if err := h.sessionRepo.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to create impersonation session: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.ImpersonationResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionImpersonationStarted,
+		"user",
+		user.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"impersonator_id":      cmd.ActorID,
+			"impersonated_user_id": user.ID(),
+			"session_id":           session.ID,
+			"reason":               reason,
+			"expires_at":           session.ExpiresAt,
+		},
+	)
+	for _, entry := range []*aggregates.AuditLog{auditLog, auditLog.CopyFor(user.ID())} {
+		if err := h.auditRepo.Create(ctx, entry); err != nil {
+			h.logger.Error(ctx, "Failed to record impersonation audit log",
+				zap.Error(err),
+				zap.String("user_id", entry.UserID),
+			)
+		}
+	}
+
+	h.logger.Info(ctx, "Impersonation session started",
+		zap.String("actor_id", cmd.ActorID),
+		zap.String("user_id", user.ID()),
+		zap.String("session_id", session.ID),
+	)
+
+	return dtos.ImpersonationResult{
+		UserID:      user.ID(),
+		Email:       user.User.Email.String(),
+		Username:    user.User.Username.String(),
+		ActorID:     cmd.ActorID,
+		SessionID:   session.ID,
+		AccessToken: token.Value(),
+		ExpiresAt:   token.ExpiresAt(),
+		ExpiresIn:   int64(time.Until(token.ExpiresAt()).Seconds()),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+type StartSAMLLoginHandler struct {
+	connRepo      repositories.SAMLConnectionRepository
+	cache         persistence.Cache
+	sp            services.SAMLServiceProvider
+	secretService domainServices.SecretService
+	requestTTL    time.Duration
+	logger        logging.Logger
+}
+
+func NewStartSAMLLoginHandler(
+	connRepo repositories.SAMLConnectionRepository,
+	cache persistence.Cache,
+	sp services.SAMLServiceProvider,
+	secretService domainServices.SecretService,
+	requestTTL time.Duration,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.StartSAMLLoginCommand, dtos.SAMLLoginRequestResult] {
+	return &StartSAMLLoginHandler{
+		connRepo:      connRepo,
+		cache:         cache,
+		sp:            sp,
+		secretService: secretService,
+		requestTTL:    requestTTL,
+		logger:        logger.With(zap.String("handler", "start_saml_login")),
+	}
+}
+
+func (h *StartSAMLLoginHandler) Handle(
+	ctx context.Context,
+	cmd commands.StartSAMLLoginCommand,
+) (dtos.SAMLLoginRequestResult, error) {
+	conn, err := findSAMLConnection(ctx, h.connRepo, cmd.ConnectionID)
+	if err != nil {
+		return dtos.SAMLLoginRequestResult{}, err
+	}
+	if !conn.IsActive {
+		return dtos.SAMLLoginRequestResult{}, domain.ErrSAMLConnectionInactive
+	}
+
+	relayState, err := h.secretService.Generate(domainServices.DefaultSecretLength)
+	if err != nil {
+		return dtos.SAMLLoginRequestResult{}, fmt.Errorf("failed to generate relay state: %w", err)
+	}
+
+	request, err := h.sp.NewAuthnRequest(conn.ID(), samlIdentityProvider(conn), relayState)
+	if err != nil {
+		return dtos.SAMLLoginRequestResult{}, fmt.Errorf("failed to build saml authn request: %w", err)
+	}
+
+	tenantID, _ := utils.TenantIDFromContext(ctx)
+	pending := samlPendingRequest{
+		RequestID:    request.ID,
+		ConnectionID: conn.ID(),
+		TenantID:     tenantID,
+	}
+	if err := saveSAMLRequest(ctx, h.cache, relayState, pending, h.requestTTL); err != nil {
+		return dtos.SAMLLoginRequestResult{}, err
+	}
+
+	h.logger.Debug(ctx, "SAML authn request issued",
+		zap.String("connection_id", conn.ID()),
+		zap.String("request_id", request.ID),
+	)
+
+	return dtos.SAMLLoginRequestResult{RedirectURL: request.RedirectURL}, nil
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type SwitchOrganizationHandler struct {
+	orgRepo     repositories.OrganizationRepository
+	userRepo    repositories.UserRepository
+	tokenIssuer services.AccessTokenIssuer
+	logger      logging.Logger
+}
+
+func NewSwitchOrganizationHandler(
+	orgRepo repositories.OrganizationRepository,
+	userRepo repositories.UserRepository,
+	tokenIssuer services.AccessTokenIssuer,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.SwitchOrganizationCommand, dtos.OrganizationTokenResult] {
+	return &SwitchOrganizationHandler{
+		orgRepo:     orgRepo,
+		userRepo:    userRepo,
+		tokenIssuer: tokenIssuer,
+		logger:      logger.With(zap.String("handler", "switch_organization")),
+	}
+}
+
+func (h *SwitchOrganizationHandler) Handle(
+	ctx context.Context,
+	cmd commands.SwitchOrganizationCommand,
+) (dtos.OrganizationTokenResult, error) {
+	org, member, err := findMembership(ctx, h.orgRepo, cmd.OrganizationID, cmd.ActorID)
+	if err != nil {
+		return dtos.OrganizationTokenResult{}, err
+	}
+
+	user, err := h.userRepo.FindByID(ctx, cmd.ActorID)
+	if err != nil {
+		return dtos.OrganizationTokenResult{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return dtos.OrganizationTokenResult{}, domain.ErrUserNotFound
+	}
+	if !user.User.IsActive {
+		return dtos.OrganizationTokenResult{}, domain.ErrInactiveUser
+	}
+
+	token, err := h.tokenIssuer.GenerateAccessToken(
+		user.User.TenantID,
+		user.ID(),
+		user.User.Email.String(),
+		user.User.Username.String(),
+		user.User.Role.String(),
+		services.OrganizationClaims{ID: org.ID(), Role: member.Role.String()},
+		services.AuthenticationContext{
+			SessionID: cmd.SessionID,
+			Time:      cmd.AuthTime,
+			Methods:   valueobjects.ParseAuthMethods(cmd.AuthMethods),
+			ActorID:   cmd.ImpersonatorID,
+			NotAfter:  cmd.ExpiresAt,
+		},
+	)
+	if err != nil {
+		return dtos.OrganizationTokenResult{}, fmt.Errorf("failed to generate access token: %w", err)
+	}
+
+	h.logger.Info(ctx, "Organization access token issued",
+		zap.String("organization_id", org.ID()),
+		zap.String("user_id", cmd.ActorID),
+	)
+
+	return dtos.OrganizationTokenResult{
+		AccessToken:    token.Value(),
+		TokenType:      "Bearer",
+		ExpiresAt:      token.ExpiresAt(),
+		OrganizationID: org.ID(),
+		Role:           member.Role.String(),
+	}, nil
+}
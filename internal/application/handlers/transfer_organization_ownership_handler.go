@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type TransferOrganizationOwnershipHandler struct {
+	orgRepo   repositories.OrganizationRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewTransferOrganizationOwnershipHandler(
+	orgRepo repositories.OrganizationRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.TransferOrganizationOwnershipCommand, dtos.OrganizationResult] {
+	return &TransferOrganizationOwnershipHandler{
+		orgRepo:   orgRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "transfer_organization_ownership")),
+	}
+}
+
+// Handle makes NewOwnerID the owner. The previous owner stays on as an
+// admin so they do not lose access to the organization they handed over.
+func (h *TransferOrganizationOwnershipHandler) Handle(
+	ctx context.Context,
+	cmd commands.TransferOrganizationOwnershipCommand,
+) (dtos.OrganizationResult, error) {
+	var org *aggregates.Organization
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var (
+			actor *entities.OrganizationMember
+			err   error
+		)
+		org, actor, err = findMembership(ctx, h.orgRepo, cmd.OrganizationID, cmd.ActorID)
+		if err != nil {
+			return err
+		}
+		if !org.IsOwner(actor.UserID) {
+			return domain.ErrPermissionDenied
+		}
+
+		newOwner, err := h.orgRepo.FindMember(ctx, org.ID(), cmd.NewOwnerID)
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return domain.ErrNotOrganizationMember
+			}
+			return fmt.Errorf("failed to find organization member: %w", err)
+		}
+
+		if err := org.TransferOwnership(newOwner.UserID); err != nil {
+			return err
+		}
+		if err := h.orgRepo.Update(ctx, org); err != nil {
+			return fmt.Errorf("failed to update organization: %w", err)
+		}
+
+		newOwner.Role = valueobjects.OrgRoleOwner
+		actor.Role = valueobjects.OrgRoleAdmin
+		if err := h.orgRepo.UpdateMember(ctx, newOwner); err != nil {
+			return fmt.Errorf("failed to update organization member: %w", err)
+		}
+		if err := h.orgRepo.UpdateMember(ctx, actor); err != nil {
+			return fmt.Errorf("failed to update organization member: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OrganizationResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOrgOwnershipTransferred,
+		"organization",
+		org.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"previous_owner_id": cmd.ActorID,
+			"new_owner_id":      cmd.NewOwnerID,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record ownership transfer audit log",
+			zap.Error(err),
+			zap.String("organization_id", org.ID()),
+		)
+	}
+
+	h.logger.Info(ctx, "Organization ownership transferred",
+		zap.String("organization_id", org.ID()),
+		zap.String("previous_owner_id", cmd.ActorID),
+		zap.String("new_owner_id", cmd.NewOwnerID),
+	)
+
+	return toOrganizationResult(org, valueobjects.OrgRoleAdmin), nil
+}
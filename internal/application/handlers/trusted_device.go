@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+// isTrustedDevice reports whether token lets deviceID skip the login code
+// step for userID. The token must have been issued to this very device,
+// from a browser or client with the same user agent, and its grant must
+// still be on record; anything else is simply untrusted. A nil signer means
+// trusted devices are disabled.
+func isTrustedDevice(
+	ctx context.Context,
+	deviceRepo repositories.TrustedDeviceRepository,
+	signer services.TrustedDeviceSigner,
+	logger logging.Logger,
+	userID, deviceID, userAgent, token string,
+) bool {
+	if signer == nil || token == "" || deviceID == "" {
+		return false
+	}
+
+	grant, err := signer.Verify(token)
+	if err != nil || grant.UserID != userID || grant.DeviceID != deviceID {
+		return false
+	}
+	if subtle.ConstantTimeCompare([]byte(grant.UserAgentHash), []byte(userAgentHash(userAgent))) != 1 {
+		return false
+	}
+
+	device, err := deviceRepo.FindByID(ctx, grant.GrantID)
+	if err != nil {
+		if !repositories.IsNotFoundError(err) {
+			logger.Error(ctx, "Failed to load trusted device",
+				zap.Error(err),
+				zap.String("user_id", userID),
+			)
+		}
+		return false
+	}
+	if !device.Matches(userID, deviceID) {
+		return false
+	}
+
+	if err := deviceRepo.UpdateLastUsed(ctx, device.ID(), time.Now().UTC()); err != nil {
+		logger.Warn(ctx, "Failed to record trusted device use",
+			zap.Error(err),
+			zap.String("device_id", device.ID()),
+		)
+	}
+	return true
+}
+
+// trustDevice records deviceID as trusted for ttl and returns the token
+// the device presents on later logins.
+func trustDevice(
+	ctx context.Context,
+	deviceRepo repositories.TrustedDeviceRepository,
+	signer services.TrustedDeviceSigner,
+	ttl time.Duration,
+	user *aggregates.UserAggregate,
+	deviceID, ipAddress, userAgent string,
+) (*aggregates.TrustedDevice, string, error) {
+	device, err := aggregates.NewTrustedDevice(
+		user.ID(),
+		deviceID,
+		utils.DescribeUserAgent(userAgent),
+		ipAddress,
+		time.Now().UTC().Add(ttl),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := deviceRepo.Create(ctx, device); err != nil {
+		return nil, "", fmt.Errorf("failed to save trusted device: %w", err)
+	}
+
+	token, err := signer.Sign(services.TrustedDeviceGrant{
+		GrantID:       device.ID(),
+		UserID:        user.ID(),
+		TenantID:      device.TenantID,
+		DeviceID:      device.DeviceID,
+		UserAgentHash: userAgentHash(userAgent),
+	}, device.ExpiresAt)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign trusted device token: %w", err)
+	}
+
+	return device, token, nil
+}
+
+// userAgentHash fingerprints the user agent a trusted device token is
+// issued to, so that a token copied along with its device id does not work
+// from another browser or client.
+func userAgentHash(userAgent string) string {
+	sum := sha256.Sum256([]byte(userAgent))
+	return hex.EncodeToString(sum[:])
+}
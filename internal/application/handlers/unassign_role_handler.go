@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type UnassignRoleHandler struct {
+	userRepo   repositories.UserRepository
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewUnassignRoleHandler(
+	userRepo repositories.UserRepository,
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.UnassignRoleCommand, dtos.UserPermissionsResult] {
+	return &UnassignRoleHandler{
+		userRepo:   userRepo,
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "unassign_role")),
+	}
+}
+
+// Handle removes an assigned role. The primary role on the user record is
+// not an assignment and cannot be removed this way.
+func (h *UnassignRoleHandler) Handle(ctx context.Context, cmd commands.UnassignRoleCommand) (dtos.UserPermissionsResult, error) {
+	if err := h.authorizer.Authorize(ctx, cmd.ActorID, valueobjects.PermissionRolesManage); err != nil {
+		return dtos.UserPermissionsResult{}, err
+	}
+
+	roleName, err := valueobjects.NewRoleName(cmd.Role)
+	if err != nil {
+		return dtos.UserPermissionsResult{}, domain.ErrRoleNotAssigned
+	}
+
+	if err := h.roleRepo.UnassignFromUser(ctx, cmd.UserID, roleName); err != nil {
+		if repositories.IsNotFoundError(err) {
+			return dtos.UserPermissionsResult{}, domain.ErrRoleNotAssigned
+		}
+		return dtos.UserPermissionsResult{}, err
+	}
+
+	if err := h.authorizer.InvalidateUser(ctx, cmd.UserID); err != nil {
+		h.logger.Error(ctx, "Failed to invalidate cached permissions",
+			zap.Error(err),
+			zap.String("user_id", cmd.UserID),
+		)
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionRoleUnassigned,
+		"user",
+		cmd.UserID,
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"role": roleName.String()},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record role audit log",
+			zap.Error(err),
+			zap.String("user_id", cmd.UserID),
+		)
+	}
+
+	return userPermissions(ctx, h.userRepo, h.roleRepo, h.authorizer, cmd.UserID)
+}
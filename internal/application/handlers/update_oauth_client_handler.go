@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type UpdateOAuthClientHandler struct {
+	clientRepo repositories.OAuthClientRepository
+	auditRepo  repositories.AuditRepository
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewUpdateOAuthClientHandler(
+	clientRepo repositories.OAuthClientRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.UpdateOAuthClientCommand, dtos.OAuthClientResult] {
+	return &UpdateOAuthClientHandler{
+		clientRepo: clientRepo,
+		auditRepo:  auditRepo,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "update_oauth_client")),
+	}
+}
+
+func (h *UpdateOAuthClientHandler) Handle(
+	ctx context.Context,
+	cmd commands.UpdateOAuthClientCommand,
+) (dtos.OAuthClientResult, error) {
+	var redirectURIs []string
+	if cmd.RedirectURIs != nil {
+		var err error
+		redirectURIs, err = validateRedirectURIs(cmd.RedirectURIs)
+		if err != nil {
+			return dtos.OAuthClientResult{}, err
+		}
+	}
+
+	var scopes []string
+	if cmd.Scopes != nil {
+		parsed, err := valueobjects.NewScopes(cmd.Scopes)
+		if err != nil {
+			return dtos.OAuthClientResult{}, err
+		}
+		scopes = valueobjects.ScopeStrings(parsed)
+	}
+
+	var client *aggregates.OAuthClient
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		client, err = findOAuthClient(ctx, h.clientRepo, cmd.ClientID)
+		if err != nil {
+			return err
+		}
+
+		if redirectURIs != nil {
+			client.UpdateRedirectURIs(redirectURIs)
+		}
+		if scopes != nil {
+			client.UpdateScopes(scopes)
+		}
+
+		if err := h.clientRepo.Update(ctx, client); err != nil {
+			return fmt.Errorf("failed to update oauth client: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.OAuthClientResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionOAuthClientUpdated,
+		"oauth_client",
+		client.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"client_id":     client.ClientID,
+			"redirect_uris": client.RedirectURIs,
+			"scopes":        client.Scopes,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record oauth client audit log",
+			zap.Error(err),
+			zap.String("client_id", client.ClientID),
+		)
+	}
+
+	return toOAuthClientResult(client), nil
+}
+
+func findOAuthClient(
+	ctx context.Context,
+	clientRepo repositories.OAuthClientRepository,
+	clientID string,
+) (*aggregates.OAuthClient, error) {
+	client, err := clientRepo.FindByClientID(ctx, clientID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrOAuthClientNotFound
+		}
+		return nil, fmt.Errorf("failed to find oauth client: %w", err)
+	}
+	if client == nil {
+		return nil, domain.ErrOAuthClientNotFound
+	}
+	return client, nil
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type UpdateRoleHandler struct {
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewUpdateRoleHandler(
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.UpdateRoleCommand, dtos.RoleResult] {
+	return &UpdateRoleHandler{
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "update_role")),
+	}
+}
+
+// Handle replaces the role definition. Every cached permission set is
+// dropped afterwards, since any user may inherit from this role.
+func (h *UpdateRoleHandler) Handle(ctx context.Context, cmd commands.UpdateRoleCommand) (dtos.RoleResult, error) {
+	if err := h.authorizer.Authorize(ctx, cmd.ActorID, valueobjects.PermissionRolesManage); err != nil {
+		return dtos.RoleResult{}, err
+	}
+
+	var role *aggregates.Role
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		role, err = findRole(ctx, h.roleRepo, cmd.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := role.Update(cmd.Description, cmd.Permissions, cmd.Parents); err != nil {
+			return err
+		}
+
+		if err := aggregates.CheckRoleHierarchy(role, roleLookup(ctx, h.roleRepo)); err != nil {
+			return err
+		}
+
+		if err := checkRoleGrantable(ctx, h.authorizer, h.roleRepo, cmd.ActorID, role); err != nil {
+			return err
+		}
+
+		if err := h.roleRepo.Update(ctx, role); err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.RoleResult{}, err
+	}
+
+	if err := h.authorizer.InvalidateAll(ctx); err != nil {
+		h.logger.Error(ctx, "Failed to invalidate cached permissions", zap.Error(err))
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionRoleUpdated,
+		"role",
+		role.Name.String(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{"permissions": valueobjects.SortedPermissionStrings(role.Permissions)},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record role audit log",
+			zap.Error(err),
+			zap.String("role", role.Name.String()),
+		)
+	}
+
+	return toRoleResult(role), nil
+}
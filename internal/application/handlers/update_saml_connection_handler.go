@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type UpdateSAMLConnectionHandler struct {
+	connRepo  repositories.SAMLConnectionRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	sp        services.SAMLServiceProvider
+	logger    logging.Logger
+}
+
+func NewUpdateSAMLConnectionHandler(
+	connRepo repositories.SAMLConnectionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	sp services.SAMLServiceProvider,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.UpdateSAMLConnectionCommand, dtos.SAMLConnectionResult] {
+	return &UpdateSAMLConnectionHandler{
+		connRepo:  connRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		sp:        sp,
+		logger:    logger.With(zap.String("handler", "update_saml_connection")),
+	}
+}
+
+func (h *UpdateSAMLConnectionHandler) Handle(
+	ctx context.Context,
+	cmd commands.UpdateSAMLConnectionCommand,
+) (dtos.SAMLConnectionResult, error) {
+	var idp *services.SAMLIdentityProvider
+	if cmd.MetadataXML != nil {
+		var err error
+		idp, err = h.sp.ParseMetadata([]byte(*cmd.MetadataXML))
+		if err != nil {
+			return dtos.SAMLConnectionResult{}, err
+		}
+	}
+
+	var conn *aggregates.SAMLConnection
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		conn, err = findSAMLConnection(ctx, h.connRepo, cmd.ConnectionID)
+		if err != nil {
+			return err
+		}
+
+		if idp != nil {
+			if err := conn.UpdateIdentityProvider(idp.EntityID, idp.SSOURL, idp.Certificates); err != nil {
+				return err
+			}
+		}
+		if cmd.AttributeMapping != nil {
+			if err := conn.UpdateAttributeMapping(cmd.AttributeMapping); err != nil {
+				return err
+			}
+		}
+		if cmd.Domains != nil {
+			if err := conn.UpdateDomains(cmd.Domains); err != nil {
+				return err
+			}
+		}
+		if cmd.JITProvisioning != nil || cmd.DefaultRole != nil {
+			jit, role := conn.JITProvisioning, conn.DefaultRole
+			if cmd.JITProvisioning != nil {
+				jit = *cmd.JITProvisioning
+			}
+			if cmd.DefaultRole != nil {
+				role = valueobjects.Role(strings.ToUpper(*cmd.DefaultRole))
+			}
+			if err := conn.UpdateProvisioning(jit, role); err != nil {
+				return err
+			}
+		}
+		if cmd.IsActive != nil {
+			if *cmd.IsActive {
+				conn.Activate()
+			} else {
+				conn.Deactivate()
+			}
+		}
+
+		if err := h.connRepo.Update(ctx, conn); err != nil {
+			return fmt.Errorf("failed to update saml connection: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.SAMLConnectionResult{}, err
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.ActorID,
+		valueobjects.AuditActionSAMLConnectionUpdated,
+		"saml_connection",
+		conn.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"idp_entity_id":     conn.IdPEntityID,
+			"metadata_replaced": idp != nil,
+			"domains":           conn.Domains,
+			"jit_provisioning":  conn.JITProvisioning,
+			"is_active":         conn.IsActive,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record saml connection audit log",
+			zap.Error(err),
+			zap.String("connection_id", conn.ID()),
+		)
+	}
+
+	return toSAMLConnectionResult(conn), nil
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type UpdateSCIMGroupHandler struct {
+	roleRepo   repositories.RoleRepository
+	auditRepo  repositories.AuditRepository
+	authorizer services.Authorizer
+	uow        persistence.UnitOfWork
+	logger     logging.Logger
+}
+
+func NewUpdateSCIMGroupHandler(
+	roleRepo repositories.RoleRepository,
+	auditRepo repositories.AuditRepository,
+	authorizer services.Authorizer,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.UpdateSCIMGroupCommand, dtos.SCIMGroupResult] {
+	return &UpdateSCIMGroupHandler{
+		roleRepo:   roleRepo,
+		auditRepo:  auditRepo,
+		authorizer: authorizer,
+		uow:        uow,
+		logger:     logger.With(zap.String("handler", "update_scim_group")),
+	}
+}
+
+func (h *UpdateSCIMGroupHandler) Handle(
+	ctx context.Context,
+	cmd commands.UpdateSCIMGroupCommand,
+) (dtos.SCIMGroupResult, error) {
+	var (
+		role    *aggregates.Role
+		result  dtos.SCIMGroupResult
+		changed []string
+	)
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		role, err = findSCIMGroup(ctx, h.roleRepo, cmd.GroupID)
+		if err != nil {
+			return err
+		}
+
+		if cmd.DisplayName != nil && scimGroupRoleName(*cmd.DisplayName) != role.Name.String() {
+			return domain.ErrSCIMGroupImmutable
+		}
+
+		add, remove := cmd.AddMembers, cmd.RemoveMembers
+		if cmd.ReplaceMembers {
+			current, err := h.roleRepo.FindRoleMembers(ctx, role.Name)
+			if err != nil {
+				return err
+			}
+			add, remove = diffMembers(current, cmd.Members)
+		}
+
+		if err := changeSCIMGroupMembers(ctx, h.roleRepo, role, add, remove); err != nil {
+			return err
+		}
+		changed = append(append(changed, add...), remove...)
+
+		result, err = toSCIMGroupResult(ctx, h.roleRepo, role)
+		return err
+	})
+	if err != nil {
+		return dtos.SCIMGroupResult{}, err
+	}
+
+	invalidateSCIMMembers(ctx, h.authorizer, h.logger, changed)
+
+	recordSCIMAudit(ctx, h.auditRepo, h.logger, valueobjects.AuditActionSCIMGroupUpdated,
+		"role", role.Name.String(), cmd.TokenID, cmd.IPAddress, cmd.UserAgent)
+
+	h.logger.Info(ctx, "SCIM group updated",
+		zap.String("role", role.Name.String()),
+		zap.Int("changed_members", len(changed)),
+		zap.String("scim_token_id", cmd.TokenID),
+	)
+
+	return result, nil
+}
+
+// diffMembers returns who has to be added and removed to turn current into
+// desired.
+func diffMembers(current, desired []string) (add, remove []string) {
+	inCurrent := make(map[string]bool, len(current))
+	for _, userID := range current {
+		inCurrent[userID] = true
+	}
+	inDesired := make(map[string]bool, len(desired))
+	for _, userID := range desired {
+		if inDesired[userID] {
+			continue
+		}
+		inDesired[userID] = true
+		if !inCurrent[userID] {
+			add = append(add, userID)
+		}
+	}
+	for _, userID := range current {
+		if !inDesired[userID] {
+			remove = append(remove, userID)
+		}
+	}
+	return add, remove
+}
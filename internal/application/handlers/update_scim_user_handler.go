@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type UpdateSCIMUserHandler struct {
+	userRepo    repositories.UserRepository
+	sessionRepo repositories.SessionRepository
+	auditRepo   repositories.AuditRepository
+	uow         persistence.UnitOfWork
+	logger      logging.Logger
+}
+
+func NewUpdateSCIMUserHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.UpdateSCIMUserCommand, dtos.SCIMUserResult] {
+	return &UpdateSCIMUserHandler{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		uow:         uow,
+		logger:      logger.With(zap.String("handler", "update_scim_user")),
+	}
+}
+
+func (h *UpdateSCIMUserHandler) Handle(
+	ctx context.Context,
+	cmd commands.UpdateSCIMUserCommand,
+) (dtos.SCIMUserResult, error) {
+	var (
+		user        *aggregates.UserAggregate
+		deactivated bool
+	)
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = findSCIMUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+
+		if err := h.applyIdentity(ctx, user, cmd); err != nil {
+			return err
+		}
+		h.applyProfile(ctx, user, cmd)
+
+		if cmd.Active != nil && *cmd.Active != user.User.IsActive {
+			if *cmd.Active {
+				user.Activate()
+			} else {
+				user.Deactivate()
+				deactivated = true
+			}
+		}
+
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		if deactivated {
+			if err := h.sessionRepo.RevokeByUserID(ctx, user.ID()); err != nil {
+				return fmt.Errorf("failed to revoke sessions: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.SCIMUserResult{}, err
+	}
+
+	action := valueobjects.AuditActionSCIMUserUpdated
+	if deactivated {
+		action = valueobjects.AuditActionSCIMUserDeactivated
+	}
+	recordSCIMAudit(ctx, h.auditRepo, h.logger, action,
+		"user", user.ID(), cmd.TokenID, cmd.IPAddress, cmd.UserAgent)
+
+	h.logger.Info(ctx, "SCIM user updated",
+		zap.String("user_id", user.ID()),
+		zap.String("scim_token_id", cmd.TokenID),
+		zap.Bool("deactivated", deactivated),
+	)
+
+	return toSCIMUserResult(user), nil
+}
+
+func (h *UpdateSCIMUserHandler) applyIdentity(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	cmd commands.UpdateSCIMUserCommand,
+) error {
+	if cmd.UserName == nil && cmd.Email == nil {
+		return nil
+	}
+
+	userName := scimUserName(user)
+	if cmd.UserName != nil {
+		userName = *cmd.UserName
+	}
+	email := user.User.Email.String()
+	if cmd.Email != nil {
+		email = *cmd.Email
+	}
+
+	username, parsedEmail, err := scimIdentity(userName, email)
+	if err != nil {
+		return err
+	}
+	if username == user.User.Username && parsedEmail == user.User.Email {
+		return nil
+	}
+
+	if err := checkSCIMIdentityAvailable(ctx, h.userRepo, user.ID(), username, parsedEmail); err != nil {
+		return err
+	}
+	user.ChangeIdentity(username, parsedEmail)
+	return nil
+}
+
+func (h *UpdateSCIMUserHandler) applyProfile(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	cmd commands.UpdateSCIMUserCommand,
+) {
+	if cmd.GivenName == nil && cmd.FamilyName == nil && cmd.Phone == nil {
+		return
+	}
+
+	firstName := user.User.FirstName
+	if cmd.GivenName != nil {
+		firstName = strings.TrimSpace(*cmd.GivenName)
+	}
+	lastName := user.User.LastName
+	if cmd.FamilyName != nil {
+		lastName = strings.TrimSpace(*cmd.FamilyName)
+	}
+	phone := user.User.Phone
+	if cmd.Phone != nil {
+		phone, _ = scimPhone(ctx, h.logger, *cmd.Phone)
+	}
+
+	user.UpdateProfile(firstName, lastName, phone)
+}
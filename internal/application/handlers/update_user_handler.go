@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type UpdateUserHandler struct {
+	userRepo  repositories.UserRepository
+	auditRepo repositories.AuditRepository
+	uow       persistence.UnitOfWork
+	logger    logging.Logger
+}
+
+func NewUpdateUserHandler(
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.UpdateUserCommand, dtos.UserResult] {
+	return &UpdateUserHandler{
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
+		uow:       uow,
+		logger:    logger.With(zap.String("handler", "update_user")),
+	}
+}
+
+func (h *UpdateUserHandler) Handle(ctx context.Context, cmd commands.UpdateUserCommand) (dtos.UserResult, error) {
+	var phone valueobjects.PhoneNumber
+	if cmd.Phone != "" {
+		var err error
+		phone, err = valueobjects.NewPhoneNumber(cmd.Phone)
+		if err != nil {
+			return dtos.UserResult{}, err
+		}
+	}
+
+	var username valueobjects.Username
+	if cmd.Username != "" {
+		var err error
+		username, err = valueobjects.NewUsername(cmd.Username)
+		if err != nil {
+			return dtos.UserResult{}, err
+		}
+	}
+
+	var (
+		result  dtos.UserResult
+		changed []string
+	)
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if cmd.Version != 0 && cmd.Version != user.Version() {
+			return domain.ErrUserVersionConflict
+		}
+
+		firstName, lastName, newPhone := user.User.FirstName, user.User.LastName, user.User.Phone
+		if cmd.FirstName != "" && cmd.FirstName != firstName {
+			firstName = cmd.FirstName
+			changed = append(changed, "first_name")
+		}
+		if cmd.LastName != "" && cmd.LastName != lastName {
+			lastName = cmd.LastName
+			changed = append(changed, "last_name")
+		}
+		if cmd.Phone != "" && !phone.Equals(newPhone) {
+			newPhone = phone
+			changed = append(changed, "phone")
+		}
+		if len(changed) > 0 {
+			user.UpdateProfile(firstName, lastName, newPhone)
+		}
+
+		if cmd.Username != "" && username.String() != user.User.Username.String() {
+			if err := h.ensureUsernameAvailable(ctx, user, username); err != nil {
+				return err
+			}
+			user.ChangeIdentity(username, user.User.Email)
+			changed = append(changed, "username")
+		}
+
+		if len(changed) > 0 {
+			if err := h.userRepo.Update(ctx, user); err != nil {
+				return fmt.Errorf("failed to update user: %w", err)
+			}
+		}
+
+		result = toUserResult(user)
+		return nil
+	})
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	if len(changed) > 0 {
+		recordUserAudit(ctx, h.auditRepo, h.logger, cmd.ActorID, valueobjects.AuditActionUserUpdated,
+			result.ID, cmd.IPAddress, cmd.UserAgent, map[string]interface{}{"fields": changed})
+	}
+
+	return result, nil
+}
+
+func (h *UpdateUserHandler) ensureUsernameAvailable(
+	ctx context.Context,
+	user *aggregates.UserAggregate,
+	username valueobjects.Username,
+) error {
+	if identityManagedExternally(user) {
+		return domain.ErrIdentityManagedExternally
+	}
+
+	existing, err := h.userRepo.FindByUsername(ctx, username)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return fmt.Errorf("failed to find user by username: %w", err)
+	}
+	if existing != nil && existing.ID() != user.ID() {
+		return domain.ErrUsernameAlreadyTaken
+	}
+	return nil
+}
+
+// identityManagedExternally reports whether the username and email are kept
+// in sync from a directory or identity provider, which would undo any local
+// change.
+func identityManagedExternally(user *aggregates.UserAggregate) bool {
+	switch user.User.Provider() {
+	case aggregates.LDAPUserProvider, aggregates.SAMLUserProvider, aggregates.SCIMUserProvider:
+		return true
+	}
+	return false
+}
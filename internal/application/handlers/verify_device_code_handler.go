@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type VerifyDeviceCodeHandler struct {
+	clientRepo repositories.OAuthClientRepository
+	auditRepo  repositories.AuditRepository
+	cache      persistence.Cache
+	logger     logging.Logger
+}
+
+func NewVerifyDeviceCodeHandler(
+	clientRepo repositories.OAuthClientRepository,
+	auditRepo repositories.AuditRepository,
+	cache persistence.Cache,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.VerifyDeviceCodeCommand, dtos.DeviceVerificationResult] {
+	return &VerifyDeviceCodeHandler{
+		clientRepo: clientRepo,
+		auditRepo:  auditRepo,
+		cache:      cache,
+		logger:     logger.With(zap.String("handler", "verify_device_code")),
+	}
+}
+
+// Handle lets a signed-in user approve or deny the device that displayed the
+// user code.
+func (h *VerifyDeviceCodeHandler) Handle(
+	ctx context.Context,
+	cmd commands.VerifyDeviceCodeCommand,
+) (dtos.DeviceVerificationResult, error) {
+	deviceCodeHash, err := findDeviceCodeHashByUserCode(ctx, h.cache, cmd.UserCode)
+	if err != nil {
+		return dtos.DeviceVerificationResult{}, err
+	}
+
+	auth, err := loadDeviceAuthorization(ctx, h.cache, deviceCodeHash)
+	if err != nil {
+		return dtos.DeviceVerificationResult{}, err
+	}
+
+	client, err := findOAuthClient(ctx, h.clientRepo, auth.ClientID)
+	if err != nil {
+		return dtos.DeviceVerificationResult{}, err
+	}
+
+	action := valueobjects.AuditActionDeviceAuthorized
+	if cmd.Approve {
+		err = auth.Approve(cmd.UserID)
+	} else {
+		action = valueobjects.AuditActionDeviceDenied
+		err = auth.Deny()
+	}
+	if err != nil {
+		return dtos.DeviceVerificationResult{}, err
+	}
+
+	if err := saveDeviceAuthorization(ctx, h.cache, deviceCodeHash, auth); err != nil {
+		return dtos.DeviceVerificationResult{}, fmt.Errorf("failed to save device authorization: %w", err)
+	}
+
+	auditLog := aggregates.NewAuditLog(
+		cmd.UserID,
+		action,
+		"oauth_client",
+		client.ID(),
+		cmd.IPAddress,
+		cmd.UserAgent,
+		"SUCCESS",
+		map[string]interface{}{
+			"client_id": client.ClientID,
+			"scopes":    auth.Scopes,
+		},
+	)
+	if err := h.auditRepo.Create(ctx, auditLog); err != nil {
+		h.logger.Error(ctx, "Failed to record device authorization audit log",
+			zap.Error(err),
+			zap.String("user_id", cmd.UserID),
+		)
+	}
+
+	return dtos.DeviceVerificationResult{
+		ClientID:   client.ClientID,
+		ClientName: client.Name,
+		Scopes:     auth.Scopes,
+		Approved:   cmd.Approve,
+	}, nil
+}
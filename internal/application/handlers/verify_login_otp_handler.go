@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// VerifyLoginOTPHandler completes a login that was answered with
+// RequiresOTP: a step-up after a risky password or provider login, or an
+// email account signing in through an OAuth provider. With TrustDevice set
+// it also trusts the device so later logins from it skip the code.
+type VerifyLoginOTPHandler struct {
+	userRepo     repositories.UserRepository
+	sessionRepo  repositories.SessionRepository
+	auditRepo    repositories.AuditRepository
+	uow          persistence.UnitOfWork
+	otpService   services.OTPService
+	tokenService services.TokenService
+	risk         services.RiskEngine
+	deviceRepo   repositories.TrustedDeviceRepository
+	deviceSigner services.TrustedDeviceSigner
+	deviceTTL    time.Duration
+	logger       logging.Logger
+}
+
+func NewVerifyLoginOTPHandler(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	otpService services.OTPService,
+	tokenService services.TokenService,
+	risk services.RiskEngine,
+	deviceRepo repositories.TrustedDeviceRepository,
+	deviceSigner services.TrustedDeviceSigner,
+	deviceTTL time.Duration,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.VerifyLoginOTPCommand, dtos.VerifyLoginOTPResult] {
+	return &VerifyLoginOTPHandler{
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		auditRepo:    auditRepo,
+		uow:          uow,
+		otpService:   otpService,
+		tokenService: tokenService,
+		risk:         risk,
+		deviceRepo:   deviceRepo,
+		deviceSigner: deviceSigner,
+		deviceTTL:    deviceTTL,
+		logger:       logger.With(zap.String("handler", "verify_login_otp")),
+	}
+}
+
+func (h *VerifyLoginOTPHandler) Handle(
+	ctx context.Context,
+	cmd commands.VerifyLoginOTPCommand,
+) (dtos.VerifyLoginOTPResult, error) {
+	email, err := valueobjects.NewEmail(cmd.Email)
+	if err != nil {
+		return dtos.VerifyLoginOTPResult{}, err
+	}
+	// Checked before the code is spent so the user can simply retry.
+	trust := cmd.TrustDevice && h.deviceSigner != nil
+	if trust && cmd.DeviceID == "" {
+		return dtos.VerifyLoginOTPResult{}, domain.ErrTrustedDeviceIDRequired
+	}
+
+	valid, err := h.otpService.Verify(ctx, email.String(), cmd.OTPCode, services.OTPPurposeLogin)
+	if err != nil {
+		return dtos.VerifyLoginOTPResult{}, fmt.Errorf("failed to verify otp: %w", err)
+	}
+	attempt := services.LoginAttempt{
+		Email:     email.String(),
+		IPAddress: cmd.IPAddress,
+		UserAgent: cmd.UserAgent,
+		DeviceID:  cmd.DeviceID,
+		Method:    "email_otp",
+	}
+	if !valid {
+		recordLoginOutcome(ctx, h.risk, h.logger, attempt, false)
+		return dtos.VerifyLoginOTPResult{}, domain.ErrInvalidOTP
+	}
+
+	user, err := h.userRepo.FindByEmail(ctx, email)
+	if err != nil && !repositories.IsNotFoundError(err) && err != domain.ErrUserNotFound {
+		return dtos.VerifyLoginOTPResult{}, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return dtos.VerifyLoginOTPResult{}, domain.ErrInvalidOTP
+	}
+	if !user.User.IsActive {
+		return dtos.VerifyLoginOTPResult{}, domain.ErrInactiveUser
+	}
+
+	// The code answers a step-up, so only a block still refuses the login;
+	// the assessment is recorded again against the verified user.
+	attempt.UserID = user.ID()
+	if assessLogin(ctx, h.risk, h.auditRepo, h.logger, attempt).Decision == services.RiskDecisionBlock {
+		return dtos.VerifyLoginOTPResult{}, domain.ErrLoginBlocked
+	}
+
+	var (
+		session     *entities.Session
+		tokenPair   *services.TokenPair
+		device      *aggregates.TrustedDevice
+		deviceToken string
+	)
+	err = h.uow.Execute(ctx, func(ctx context.Context) error {
+		var err error
+		tokenPair, err = h.tokenService.Generate(
+			ctx,
+			user.ID(),
+			user.User.Role.String(),
+			user.User.Email.String(),
+			services.SessionMetadata{
+				IPAddress:   cmd.IPAddress,
+				UserAgent:   cmd.UserAgent,
+				DeviceID:    cmd.DeviceID,
+				AuthMethods: []valueobjects.AuthMethod{valueobjects.AuthMethodOTP},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate tokens: %w", err)
+		}
+
+		session = user.Login(
+			cmd.IPAddress,
+			cmd.UserAgent,
+			tokenPair.RefreshToken,
+			tokenPair.AccessToken,
+			tokenPair.ExpiresAt,
+		)
+		if err := h.sessionRepo.Create(ctx, session); err != nil {
+			return fmt.Errorf("failed to create session: %w", err)
+		}
+
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		if trust {
+			device, deviceToken, err = trustDevice(ctx, h.deviceRepo, h.deviceSigner, h.deviceTTL,
+				user, cmd.DeviceID, cmd.IPAddress, cmd.UserAgent)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return dtos.VerifyLoginOTPResult{}, err
+	}
+
+	recordLoginOutcome(ctx, h.risk, h.logger, attempt, true)
+	recordUserAudit(ctx, h.auditRepo, h.logger, user.ID(), valueobjects.AuditActionUserLogin,
+		user.ID(), cmd.IPAddress, cmd.UserAgent, map[string]interface{}{
+			"method":     "email_otp",
+			"session_id": session.ID,
+		})
+	if device != nil {
+		recordUserAudit(ctx, h.auditRepo, h.logger, user.ID(), valueobjects.AuditActionDeviceTrusted,
+			user.ID(), cmd.IPAddress, cmd.UserAgent, map[string]interface{}{
+				"trusted_device_id": device.ID(),
+				"expires_at":        device.ExpiresAt,
+			})
+	}
+
+	h.logger.Info(ctx, "User logged in with login code",
+		zap.String("user_id", user.ID()),
+	)
+
+	result := toVerifyLoginOTPResult(user, tokenPair, session)
+	if device != nil {
+		result.TrustedDeviceToken = deviceToken
+		result.TrustedDeviceExpiresAt = device.ExpiresAt.Format(time.RFC3339)
+	}
+	return result, nil
+}
+
+func toVerifyLoginOTPResult(
+	user *aggregates.UserAggregate,
+	tokenPair *services.TokenPair,
+	session *entities.Session,
+) dtos.VerifyLoginOTPResult {
+	return dtos.VerifyLoginOTPResult{
+		UserID:       user.ID(),
+		Email:        user.User.Email.String(),
+		Username:     user.User.Username.String(),
+		FirstName:    user.User.FirstName,
+		LastName:     user.User.LastName,
+		Role:         user.User.Role.String(),
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		ExpiresAt:    tokenPair.ExpiresAt.Format(time.RFC3339),
+		ExpiresIn:    tokenPair.ExpiresIn,
+		SessionID:    session.ID,
+	}
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/dtos"
+	"authentication/internal/domain"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+type VerifyPhoneHandler struct {
+	userRepo   repositories.UserRepository
+	auditRepo  repositories.AuditRepository
+	uow        persistence.UnitOfWork
+	otpService services.OTPService
+	logger     logging.Logger
+}
+
+func NewVerifyPhoneHandler(
+	userRepo repositories.UserRepository,
+	auditRepo repositories.AuditRepository,
+	uow persistence.UnitOfWork,
+	otpService services.OTPService,
+	logger logging.Logger,
+) messaging.CommandHandler[commands.VerifyPhoneCommand, dtos.UserResult] {
+	return &VerifyPhoneHandler{
+		userRepo:   userRepo,
+		auditRepo:  auditRepo,
+		uow:        uow,
+		otpService: otpService,
+		logger:     logger.With(zap.String("handler", "verify_phone")),
+	}
+}
+
+func (h *VerifyPhoneHandler) Handle(ctx context.Context, cmd commands.VerifyPhoneCommand) (dtos.UserResult, error) {
+	var result dtos.UserResult
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		user, err := findUser(ctx, h.userRepo, cmd.UserID)
+		if err != nil {
+			return err
+		}
+		if user.User.Phone.IsEmpty() {
+			return domain.ErrPhoneNotSet
+		}
+		if user.User.PhoneVerified {
+			return domain.ErrPhoneAlreadyVerified
+		}
+
+		valid, err := h.otpService.Verify(ctx, phoneVerificationKey(user), cmd.Code, services.OTPPurposePhoneVerification)
+		if err != nil {
+			return fmt.Errorf("failed to verify otp: %w", err)
+		}
+		if !valid {
+			return domain.ErrInvalidOTP
+		}
+
+		user.VerifyPhone()
+		if err := h.userRepo.Update(ctx, user); err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+
+		result = toUserResult(user)
+		return nil
+	})
+	if err != nil {
+		return dtos.UserResult{}, err
+	}
+
+	recordUserAudit(ctx, h.auditRepo, h.logger, cmd.UserID, valueobjects.AuditActionPhoneVerified,
+		result.ID, cmd.IPAddress, cmd.UserAgent, nil)
+
+	return result, nil
+}
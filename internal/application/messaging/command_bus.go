@@ -3,7 +3,9 @@ package messaging
 import (
 	"authentication/internal/application/contracts/messaging"
 	"authentication/internal/application/contracts/observability"
+	"authentication/internal/application/contracts/services"
 	"authentication/internal/application/messaging/middleware"
+	"authentication/internal/domain/repositories"
 	"authentication/internal/infrastructure/observability/metrics"
 	"authentication/shared/logging"
 	"context"
@@ -37,8 +39,17 @@ type CommandBus struct {
 
 type handlerWrapper func(ctx context.Context, cmd any) (any, error)
 
-// New creates a new CommandBus instance with default middleware
-func New(logger logging.Logger, tracer observability.Tracer, metricsRecorder *metrics.MetricsRecorder) *CommandBus {
+// New creates a new CommandBus instance with default middleware. Every
+// command has its AuthorizationRule enforced and is checked against the
+// policies before it reaches its handler.
+func New(
+	logger logging.Logger,
+	tracer observability.Tracer,
+	metricsRecorder *metrics.MetricsRecorder,
+	authorizer services.Authorizer,
+	policies services.PolicyEvaluator,
+	auditRepo repositories.AuditRepository,
+) *CommandBus {
 	bus := &CommandBus{
 		handlers:        make(map[reflect.Type]handlerWrapper),
 		middleware:      make([]messaging.Middleware, 0),
@@ -47,10 +58,17 @@ func New(logger logging.Logger, tracer observability.Tracer, metricsRecorder *me
 		metricsRecorder: metricsRecorder,
 	}
 
-	// Register default middleware in order (last added executes first in chain)
+	// Register default middleware in order (first added executes first in
+	// chain). Authorization comes after the instrumentation so rejected
+	// commands are still traced, counted and logged, and impersonation
+	// auditing wraps it so refused attempts are recorded too. The declared
+	// rule is checked before the policies, which only refine what it allows.
 	bus.Use(middleware.TracingMiddleware(tracer))
 	bus.Use(middleware.MetricsMiddleware(metricsRecorder))
 	bus.Use(middleware.LoggingMiddleware(logger))
+	bus.Use(middleware.ImpersonationAuditMiddleware(auditRepo, logger))
+	bus.Use(middleware.PermissionMiddleware(authorizer, logger))
+	bus.Use(middleware.AuthorizationMiddleware(policies, auditRepo, logger))
 
 	return bus
 }
@@ -0,0 +1,242 @@
+package middleware
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+// policyEnforcer evaluates every command and query against the policy
+// engine before it reaches its handler, and records each decision in the
+// audit log.
+type policyEnforcer struct {
+	evaluator services.PolicyEvaluator
+	auditRepo repositories.AuditRepository
+	logger    logging.Logger
+}
+
+// AuthorizationMiddleware enforces policies on the command bus.
+func AuthorizationMiddleware(
+	evaluator services.PolicyEvaluator,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.Middleware {
+	enforcer := newPolicyEnforcer(evaluator, auditRepo, logger)
+	return func(next messaging.HandlerFunc) messaging.HandlerFunc {
+		return func(ctx context.Context, cmd messaging.Command) (any, error) {
+			if err := enforcer.enforce(ctx, getCommandName(cmd), cmd); err != nil {
+				return nil, err
+			}
+			return next(ctx, cmd)
+		}
+	}
+}
+
+// QueryAuthorizationMiddleware enforces policies on the query bus.
+func QueryAuthorizationMiddleware(
+	evaluator services.PolicyEvaluator,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.UntypedQueryMiddleware {
+	return &queryAuthorizationMiddleware{enforcer: newPolicyEnforcer(evaluator, auditRepo, logger)}
+}
+
+type queryAuthorizationMiddleware struct {
+	enforcer *policyEnforcer
+}
+
+func (m *queryAuthorizationMiddleware) ExecuteUntyped(
+	ctx context.Context,
+	query messaging.Query,
+	next messaging.UntypedQueryHandler,
+) (any, error) {
+	if err := m.enforcer.enforce(ctx, query.QueryName(), query); err != nil {
+		return nil, err
+	}
+	return next.HandleUntyped(ctx, query)
+}
+
+func newPolicyEnforcer(
+	evaluator services.PolicyEvaluator,
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) *policyEnforcer {
+	return &policyEnforcer{
+		evaluator: evaluator,
+		auditRepo: auditRepo,
+		logger:    logger.With(zap.String("middleware", "authorization")),
+	}
+}
+
+func (e *policyEnforcer) enforce(ctx context.Context, action string, message any) error {
+	subject, _ := services.SubjectFromContext(ctx)
+	resource := describeResource(message)
+
+	ip := utils.ClientIPFromContext(ctx)
+	if ip == "" {
+		ip = resource.Attributes["ip_address"]
+	}
+
+	request := services.AccessRequest{
+		Subject:     subject,
+		Action:      action,
+		Resource:    resource,
+		Environment: services.Environment{IPAddress: ip, Time: time.Now().UTC()},
+	}
+
+	decision, err := e.evaluator.Evaluate(ctx, request)
+	if err != nil {
+		// Fail closed: an engine error must not grant access.
+		e.logger.Error(ctx, "Policy evaluation failed",
+			zap.String("action", action),
+			zap.Error(err),
+		)
+		decision = services.PolicyDecision{Allowed: false, Reason: "policy evaluation failed"}
+	}
+
+	e.audit(ctx, request, decision)
+
+	if !decision.Allowed {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+func (e *policyEnforcer) audit(ctx context.Context, request services.AccessRequest, decision services.PolicyDecision) {
+	actor := request.Subject.UserID
+	if actor == "" {
+		actor = request.Subject.ClientID
+	}
+
+	resourceType := request.Resource.Type
+	if resourceType == "" {
+		resourceType = request.Action
+	}
+
+	metadata := map[string]interface{}{
+		"action":    request.Action,
+		"policy_id": decision.PolicyID,
+		"reason":    decision.Reason,
+	}
+	if request.Subject.ActorID != "" {
+		metadata["impersonator_id"] = request.Subject.ActorID
+	}
+
+	var auditLog *aggregates.AuditLog
+	if decision.Allowed {
+		auditLog = aggregates.NewAuditLog(
+			actor,
+			valueobjects.AuditActionAccessGranted,
+			resourceType,
+			request.Resource.ID,
+			request.Environment.IPAddress,
+			request.Resource.Attributes["user_agent"],
+			"SUCCESS",
+			metadata,
+		)
+	} else {
+		auditLog = aggregates.NewAuditLogWithError(
+			actor,
+			valueobjects.AuditActionAccessDenied,
+			resourceType,
+			request.Resource.ID,
+			request.Environment.IPAddress,
+			request.Resource.Attributes["user_agent"],
+			domain.ErrPermissionDenied.Error(),
+			metadata,
+		)
+	}
+
+	if err := e.auditRepo.Create(ctx, auditLog); err != nil {
+		e.logger.Error(ctx, "Failed to record authorization audit log",
+			zap.String("action", request.Action),
+			zap.Error(err),
+		)
+	}
+}
+
+// describeResource uses PolicyResource when the message provides it.
+// Otherwise the message's plain fields become resource attributes under
+// snake_case names, so a policy can test resource.user_id on
+// GetUserSessionsQuery.
+func describeResource(message any) services.Resource {
+	if describer, ok := message.(services.PolicyResourceDescriber); ok {
+		resource := describer.PolicyResource()
+		if resource.Attributes == nil {
+			resource.Attributes = map[string]string{}
+		}
+		return resource
+	}
+
+	resource := services.Resource{Attributes: map[string]string{}}
+
+	v := reflect.ValueOf(message)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return resource
+	}
+
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || isSecretField(field.Name) {
+			continue
+		}
+
+		value := v.Field(i)
+		key := toSnakeCase(field.Name)
+		switch value.Kind() {
+		case reflect.String:
+			resource.Attributes[key] = value.String()
+		case reflect.Bool:
+			resource.Attributes[key] = strconv.FormatBool(value.Bool())
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			resource.Attributes[key] = strconv.FormatInt(value.Int(), 10)
+		}
+	}
+
+	return resource
+}
+
+// isSecretField is broader than isSensitiveField because these values end up
+// in audit metadata via policy attributes, not just trace spans.
+func isSecretField(name string) bool {
+	for _, marker := range []string{"Password", "Secret", "Token", "Code", "Assertion", "OTP"} {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"context"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+// impersonationAuditor records every command and query run by an
+// impersonation session, once under the impersonating actor and once under
+// the impersonated user.
+type impersonationAuditor struct {
+	auditRepo repositories.AuditRepository
+	logger    logging.Logger
+}
+
+// ImpersonationAuditMiddleware audits commands sent with an impersonation
+// token. messaging.New registers it ahead of PermissionMiddleware so refused
+// commands are recorded too.
+func ImpersonationAuditMiddleware(
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.Middleware {
+	auditor := newImpersonationAuditor(auditRepo, logger)
+	return func(next messaging.HandlerFunc) messaging.HandlerFunc {
+		return func(ctx context.Context, cmd messaging.Command) (any, error) {
+			subject, ok := services.SubjectFromContext(ctx)
+			if !ok || subject.ActorID == "" {
+				return next(ctx, cmd)
+			}
+
+			result, err := next(ctx, cmd)
+			auditor.record(ctx, subject, getCommandName(cmd), cmd, err)
+			return result, err
+		}
+	}
+}
+
+// QueryImpersonationAuditMiddleware audits queries sent with an
+// impersonation token.
+func QueryImpersonationAuditMiddleware(
+	auditRepo repositories.AuditRepository,
+	logger logging.Logger,
+) messaging.UntypedQueryMiddleware {
+	return &queryImpersonationAuditMiddleware{auditor: newImpersonationAuditor(auditRepo, logger)}
+}
+
+type queryImpersonationAuditMiddleware struct {
+	auditor *impersonationAuditor
+}
+
+func (m *queryImpersonationAuditMiddleware) ExecuteUntyped(
+	ctx context.Context,
+	query messaging.Query,
+	next messaging.UntypedQueryHandler,
+) (any, error) {
+	subject, ok := services.SubjectFromContext(ctx)
+	if !ok || subject.ActorID == "" {
+		return next.HandleUntyped(ctx, query)
+	}
+
+	result, err := next.HandleUntyped(ctx, query)
+	m.auditor.record(ctx, subject, query.QueryName(), query, err)
+	return result, err
+}
+
+func newImpersonationAuditor(auditRepo repositories.AuditRepository, logger logging.Logger) *impersonationAuditor {
+	return &impersonationAuditor{
+		auditRepo: auditRepo,
+		logger:    logger.With(zap.String("middleware", "impersonation_audit")),
+	}
+}
+
+func (a *impersonationAuditor) record(
+	ctx context.Context,
+	subject services.Subject,
+	action string,
+	message any,
+	err error,
+) {
+	resource := describeResource(message)
+	resourceType := resource.Type
+	if resourceType == "" {
+		resourceType = action
+	}
+
+	metadata := map[string]interface{}{
+		"action":               action,
+		"impersonator_id":      subject.ActorID,
+		"impersonated_user_id": subject.UserID,
+	}
+
+	ip := utils.ClientIPFromContext(ctx)
+	userAgent := resource.Attributes["user_agent"]
+
+	var auditLog *aggregates.AuditLog
+	if err == nil {
+		auditLog = aggregates.NewAuditLog(
+			subject.ActorID,
+			valueobjects.AuditActionImpersonatedAction,
+			resourceType,
+			resource.ID,
+			ip,
+			userAgent,
+			"SUCCESS",
+			metadata,
+		)
+	} else {
+		auditLog = aggregates.NewAuditLogWithError(
+			subject.ActorID,
+			valueobjects.AuditActionImpersonatedAction,
+			resourceType,
+			resource.ID,
+			ip,
+			userAgent,
+			err.Error(),
+			metadata,
+		)
+	}
+
+	for _, entry := range []*aggregates.AuditLog{auditLog, auditLog.CopyFor(subject.UserID)} {
+		if err := a.auditRepo.Create(ctx, entry); err != nil {
+			a.logger.Error(ctx, "Failed to record impersonation audit log",
+				zap.String("action", action),
+				zap.String("user_id", entry.UserID),
+				zap.Error(err),
+			)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+)
+
+type fakeAuditRepository struct {
+	repositories.AuditRepository
+	logs []*aggregates.AuditLog
+}
+
+func (r *fakeAuditRepository) Create(_ context.Context, log *aggregates.AuditLog) error {
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+type updateProfileCommand struct {
+	UserID    string
+	FirstName string
+}
+
+func TestImpersonationAuditMiddleware(t *testing.T) {
+	failed := errors.New("handler failed")
+
+	tests := []struct {
+		name     string
+		subject  *services.Subject
+		err      error
+		wantLogs int
+	}{
+		{
+			name:    "regular session is not audited here",
+			subject: &services.Subject{UserID: "alice"},
+		},
+		{
+			name:     "impersonated command",
+			subject:  &services.Subject{UserID: "alice", ActorID: "support"},
+			wantLogs: 2,
+		},
+		{
+			name:     "refused impersonated command",
+			subject:  &services.Subject{UserID: "alice", ActorID: "support"},
+			err:      failed,
+			wantLogs: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.subject != nil {
+				ctx = services.WithSubject(ctx, *tt.subject)
+			}
+			auditRepo := &fakeAuditRepository{}
+			next := func(context.Context, messaging.Command) (any, error) {
+				return nil, tt.err
+			}
+
+			cmd := updateProfileCommand{UserID: "alice", FirstName: "Alice"}
+			if _, err := ImpersonationAuditMiddleware(auditRepo, nopLogger{})(next)(ctx, cmd); !errors.Is(err, tt.err) {
+				t.Fatalf("ImpersonationAuditMiddleware() error = %v, want %v", err, tt.err)
+			}
+
+			if len(auditRepo.logs) != tt.wantLogs {
+				t.Fatalf("audit logs = %d, want %d", len(auditRepo.logs), tt.wantLogs)
+			}
+			if tt.wantLogs == 0 {
+				return
+			}
+
+			// One entry for the support agent, one for the user they acted
+			// as, both naming the impersonator.
+			if auditRepo.logs[0].UserID != "support" || auditRepo.logs[1].UserID != "alice" {
+				t.Errorf("audit log users = %q, %q, want %q, %q",
+					auditRepo.logs[0].UserID, auditRepo.logs[1].UserID, "support", "alice")
+			}
+			for _, log := range auditRepo.logs {
+				if log.Action != valueobjects.AuditActionImpersonatedAction {
+					t.Errorf("Action = %q, want %q", log.Action, valueobjects.AuditActionImpersonatedAction)
+				}
+				if log.Metadata["impersonator_id"] != "support" {
+					t.Errorf("impersonator_id = %v, want %q", log.Metadata["impersonator_id"], "support")
+				}
+				if _, ok := log.Metadata["password"]; ok {
+					t.Errorf("metadata leaks the password")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// AuthorizationError is returned when a command's AuthorizationRule is not
+// satisfied. It unwraps to domain.ErrPermissionDenied.
+type AuthorizationError struct {
+	Command    string
+	Permission valueobjects.Permission
+	Reason     string
+}
+
+func (e *AuthorizationError) Error() string {
+	if e.Permission != "" {
+		return fmt.Sprintf("%s: %s requires %s: %s", domain.ErrPermissionDenied, e.Command, e.Permission, e.Reason)
+	}
+	return fmt.Sprintf("%s: %s: %s", domain.ErrPermissionDenied, e.Command, e.Reason)
+}
+
+func (e *AuthorizationError) Unwrap() error {
+	return domain.ErrPermissionDenied
+}
+
+// StepUpRequiredError is returned when a command needs a more recent or
+// stronger sign-in than the caller's token records. It unwraps to
+// domain.ErrReauthenticationRequired so clients can reauthenticate and retry
+// instead of treating it as a denial.
+type StepUpRequiredError struct {
+	Command      string
+	MaxAuthAge   time.Duration
+	MinAuthLevel valueobjects.AuthLevel
+	Reason       string
+}
+
+func (e *StepUpRequiredError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", domain.ErrReauthenticationRequired, e.Command, e.Reason)
+}
+
+func (e *StepUpRequiredError) Unwrap() error {
+	return domain.ErrReauthenticationRequired
+}
+
+// PermissionMiddleware enforces the AuthorizationRule declared by each
+// command against the principal that the HTTP layer stored with
+// services.WithSubject. messaging.New registers it on every CommandBus.
+func PermissionMiddleware(authorizer services.Authorizer, logger logging.Logger) messaging.Middleware {
+	logger = logger.With(zap.String("middleware", "permission"))
+
+	return func(next messaging.HandlerFunc) messaging.HandlerFunc {
+		return func(ctx context.Context, cmd messaging.Command) (any, error) {
+			authorized, ok := cmd.(messaging.AuthorizedCommand)
+			if !ok {
+				return next(ctx, cmd)
+			}
+
+			cmdName := getCommandName(cmd)
+			if err := checkRule(ctx, authorizer, cmdName, authorized.AuthorizationRule()); err != nil {
+				var (
+					authErr   *AuthorizationError
+					stepUpErr *StepUpRequiredError
+				)
+				switch {
+				case errors.As(err, &authErr):
+					logger.Warn(ctx, "Command rejected",
+						zap.String("command.name", cmdName),
+						zap.String("reason", authErr.Reason),
+					)
+				case errors.As(err, &stepUpErr):
+					logger.Info(ctx, "Command requires reauthentication",
+						zap.String("command.name", cmdName),
+						zap.String("reason", stepUpErr.Reason),
+					)
+				}
+				return nil, err
+			}
+
+			return next(ctx, cmd)
+		}
+	}
+}
+
+func checkRule(
+	ctx context.Context,
+	authorizer services.Authorizer,
+	cmdName string,
+	rule messaging.AuthorizationRule,
+) error {
+	deny := func(reason string) error {
+		return &AuthorizationError{Command: cmdName, Permission: rule.Permission, Reason: reason}
+	}
+
+	subject, ok := services.SubjectFromContext(ctx)
+	if !ok || (subject.UserID == "" && subject.ClientID == "") {
+		return deny("no authenticated principal")
+	}
+
+	// SCIM tokens belong to a tenant rather than a user and grant nothing
+	// beyond provisioning.
+	if subject.TokenType == string(valueobjects.TokenTypeSCIM) {
+		if rule.Permission != valueobjects.PermissionSCIMProvision {
+			return deny("scim tokens may only provision users and groups")
+		}
+		return nil
+	}
+
+	// Support staff see what the user sees but never take over the account.
+	if rule.Sensitive && subject.ActorID != "" {
+		return deny("not allowed while impersonating a user")
+	}
+
+	isService := subject.TokenType == string(valueobjects.TokenTypeService)
+	if !isService {
+		if err := checkStepUp(cmdName, subject, rule); err != nil {
+			return err
+		}
+	}
+
+	// Personal access and service tokens never exceed the scopes they were
+	// issued with, not even on the owner's own resources. A command that
+	// declares no permission has nothing to scope a personal access token
+	// by, so it needs a real sign-in.
+	switch subject.TokenType {
+	case string(valueobjects.TokenTypePersonalAccess):
+		if rule.Permission == "" {
+			return deny("not allowed with a personal access token")
+		}
+		if !valueobjects.ScopesGrant(subject.Scopes, rule.Permission) {
+			return deny("permission not in token scopes")
+		}
+	case string(valueobjects.TokenTypeService):
+		if rule.Permission != "" && !valueobjects.ScopesGrant(subject.Scopes, rule.Permission) {
+			return deny("permission not in client scopes")
+		}
+	}
+
+	if rule.OwnerID != "" && !isService && subject.UserID == rule.OwnerID {
+		return nil
+	}
+
+	if rule.Permission == "" {
+		if rule.OwnerID != "" {
+			return deny("principal does not own the resource")
+		}
+		return nil
+	}
+
+	// An impersonation session may act on the user's own resources but never
+	// exercises the permissions the user holds over anyone else's.
+	if subject.ActorID != "" {
+		return deny("not allowed while impersonating a user")
+	}
+
+	var err error
+	if isService {
+		role := valueobjects.Role(strings.ToUpper(subject.Role))
+		err = authorizer.AuthorizeRoles(ctx, []valueobjects.Role{role}, rule.Permission)
+	} else {
+		err = authorizer.Authorize(ctx, subject.UserID, rule.Permission)
+	}
+
+	if err != nil {
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return deny("permission not granted")
+		}
+		return fmt.Errorf("failed to check permission: %w", err)
+	}
+	return nil
+}
+
+// checkStepUp holds the caller's sign-in, as recorded in the auth_time and
+// acr claims of their token, to the rule's MaxAuthAge and MinAuthLevel.
+func checkStepUp(cmdName string, subject services.Subject, rule messaging.AuthorizationRule) error {
+	stepUp := func(reason string) error {
+		return &StepUpRequiredError{
+			Command:      cmdName,
+			MaxAuthAge:   rule.MaxAuthAge,
+			MinAuthLevel: rule.MinAuthLevel,
+			Reason:       reason,
+		}
+	}
+
+	if rule.MaxAuthAge > 0 {
+		if subject.AuthTime.IsZero() {
+			return stepUp("token does not record a sign-in time")
+		}
+		if time.Since(subject.AuthTime) > rule.MaxAuthAge {
+			return stepUp(fmt.Sprintf("last sign-in was more than %s ago", rule.MaxAuthAge))
+		}
+	}
+
+	level := valueobjects.AuthLevel(subject.AuthLevel)
+	if !level.Satisfies(rule.MinAuthLevel) {
+		return stepUp(fmt.Sprintf("sign-in reached %q but %q is required", level, rule.MinAuthLevel))
+	}
+	return nil
+}
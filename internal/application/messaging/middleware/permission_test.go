@@ -0,0 +1,381 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(context.Context, string, ...zap.Field) {}
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Warn(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+func (nopLogger) Fatal(context.Context, string, ...zap.Field) {}
+func (l nopLogger) With(...zap.Field) logging.Logger          { return l }
+
+// fakeAuthorizer grants users and roles fixed permission sets.
+type fakeAuthorizer struct {
+	services.Authorizer
+	users map[string][]valueobjects.Permission
+	roles map[valueobjects.Role][]valueobjects.Permission
+	err   error
+}
+
+func (a *fakeAuthorizer) Authorize(_ context.Context, userID string, permission valueobjects.Permission) error {
+	if a.err != nil {
+		return a.err
+	}
+	if !valueobjects.PermissionsGrant(a.users[userID], permission) {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+func (a *fakeAuthorizer) AuthorizeRoles(_ context.Context, roles []valueobjects.Role, permission valueobjects.Permission) error {
+	for _, role := range roles {
+		if valueobjects.PermissionsGrant(a.roles[role], permission) {
+			return nil
+		}
+	}
+	return domain.ErrPermissionDenied
+}
+
+func TestCheckRule(t *testing.T) {
+	authorizer := &fakeAuthorizer{
+		users: map[string][]valueobjects.Permission{
+			"admin": {valueobjects.PermissionAll},
+			"alice": {valueobjects.PermissionProfileRead, valueobjects.PermissionProfileWrite},
+		},
+		roles: map[valueobjects.Role][]valueobjects.Permission{
+			valueobjects.RoleAdmin: {valueobjects.PermissionAll},
+		},
+	}
+
+	signedIn := time.Now().Add(-time.Minute)
+	user := func(id string) services.Subject {
+		return services.Subject{
+			UserID:    id,
+			TokenType: string(valueobjects.TokenTypeAccess),
+			AuthTime:  signedIn,
+			AuthLevel: string(valueobjects.AuthLevelSingleFactor),
+		}
+	}
+	pat := func(id string, scopes ...string) services.Subject {
+		s := user(id)
+		s.TokenType = string(valueobjects.TokenTypePersonalAccess)
+		s.Scopes = scopes
+		return s
+	}
+	service := func(scopes ...string) services.Subject {
+		return services.Subject{
+			ClientID:  "svc_client",
+			Role:      "admin",
+			TokenType: string(valueobjects.TokenTypeService),
+			Scopes:    scopes,
+		}
+	}
+	impersonating := func(target string) services.Subject {
+		s := user(target)
+		s.ActorID = "admin"
+		return s
+	}
+
+	usersWrite := valueobjects.PermissionUsersWrite
+
+	tests := []struct {
+		name       string
+		subject    *services.Subject
+		rule       messaging.AuthorizationRule
+		wantErr    error
+		wantReason string
+	}{
+		{
+			name:       "anonymous",
+			rule:       messaging.AuthorizationRule{OwnerID: "alice"},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "no authenticated principal",
+		},
+		{
+			name:    "owner",
+			subject: ptr(user("alice")),
+			rule:    messaging.AuthorizationRule{OwnerID: "alice"},
+		},
+		{
+			name:       "not the owner",
+			subject:    ptr(user("bob")),
+			rule:       messaging.AuthorizationRule{OwnerID: "alice"},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "principal does not own the resource",
+		},
+		{
+			name:    "owner shortcut skips the permission",
+			subject: ptr(user("alice")),
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", Permission: usersWrite},
+		},
+		{
+			name:    "permission held",
+			subject: ptr(user("admin")),
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", Permission: usersWrite},
+		},
+		{
+			name:       "permission not held",
+			subject:    ptr(user("alice")),
+			rule:       messaging.AuthorizationRule{Permission: usersWrite},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "permission not granted",
+		},
+
+		// Personal access tokens.
+		{
+			name:    "pat with scope on own resource",
+			subject: ptr(pat("alice", "profile:write")),
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", Permission: valueobjects.PermissionProfileWrite},
+		},
+		{
+			name:       "pat scopes apply to own resources",
+			subject:    ptr(pat("alice", "profile:read")),
+			rule:       messaging.AuthorizationRule{OwnerID: "alice", Permission: valueobjects.PermissionProfileWrite},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "permission not in token scopes",
+		},
+		{
+			name:       "pat on a command without permission",
+			subject:    ptr(pat("alice", "*")),
+			rule:       messaging.AuthorizationRule{OwnerID: "alice"},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "not allowed with a personal access token",
+		},
+		{
+			name:       "pat scope beyond the owner's permissions",
+			subject:    ptr(pat("alice", "users:*")),
+			rule:       messaging.AuthorizationRule{Permission: usersWrite},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "permission not granted",
+		},
+		{
+			name:    "pat with scope and permission",
+			subject: ptr(pat("admin", "users:write")),
+			rule:    messaging.AuthorizationRule{Permission: usersWrite},
+		},
+
+		// Service tokens.
+		{
+			name:    "service with scope",
+			subject: ptr(service("users:write")),
+			rule:    messaging.AuthorizationRule{Permission: usersWrite},
+		},
+		{
+			name:       "service role is capped by client scopes",
+			subject:    ptr(service("users:read")),
+			rule:       messaging.AuthorizationRule{Permission: usersWrite},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "permission not in client scopes",
+		},
+		{
+			name:       "service never owns a user resource",
+			subject:    &services.Subject{UserID: "alice", TokenType: string(valueobjects.TokenTypeService), Role: "user"},
+			rule:       messaging.AuthorizationRule{OwnerID: "alice"},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "principal does not own the resource",
+		},
+		{
+			name:    "service skips step-up",
+			subject: ptr(service("users:write")),
+			rule:    messaging.AuthorizationRule{Permission: usersWrite, MaxAuthAge: time.Minute},
+		},
+
+		// SCIM tokens.
+		{
+			name:    "scim provisioning",
+			subject: &services.Subject{ClientID: "tenant", TokenType: string(valueobjects.TokenTypeSCIM)},
+			rule:    messaging.AuthorizationRule{Permission: valueobjects.PermissionSCIMProvision},
+		},
+		{
+			name:       "scim outside provisioning",
+			subject:    &services.Subject{ClientID: "tenant", TokenType: string(valueobjects.TokenTypeSCIM)},
+			rule:       messaging.AuthorizationRule{Permission: valueobjects.PermissionUsersRead},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "scim tokens may only provision users and groups",
+		},
+
+		// Impersonation.
+		{
+			name:    "impersonating on the user's own resource",
+			subject: ptr(impersonating("alice")),
+			rule:    messaging.AuthorizationRule{OwnerID: "alice"},
+		},
+		{
+			name:       "impersonating a sensitive command",
+			subject:    ptr(impersonating("alice")),
+			rule:       messaging.AuthorizationRule{OwnerID: "alice", Sensitive: true},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "not allowed while impersonating a user",
+		},
+		{
+			name:       "impersonating an admin does not grant admin permissions",
+			subject:    ptr(impersonating("admin")),
+			rule:       messaging.AuthorizationRule{Permission: usersWrite},
+			wantErr:    domain.ErrPermissionDenied,
+			wantReason: "not allowed while impersonating a user",
+		},
+
+		// Step-up.
+		{
+			name:    "recent sign-in",
+			subject: ptr(user("alice")),
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", MaxAuthAge: messaging.RecentAuthMaxAge},
+		},
+		{
+			name: "stale sign-in",
+			subject: &services.Subject{
+				UserID:    "alice",
+				TokenType: string(valueobjects.TokenTypeAccess),
+				AuthTime:  time.Now().Add(-time.Hour),
+			},
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", MaxAuthAge: messaging.RecentAuthMaxAge},
+			wantErr: domain.ErrReauthenticationRequired,
+		},
+		{
+			name:    "token without sign-in time",
+			subject: &services.Subject{UserID: "alice", TokenType: string(valueobjects.TokenTypeAccess)},
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", MaxAuthAge: messaging.RecentAuthMaxAge},
+			wantErr: domain.ErrReauthenticationRequired,
+		},
+		{
+			name:    "single factor where multi-factor is required",
+			subject: ptr(user("alice")),
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", MinAuthLevel: valueobjects.AuthLevelMultiFactor},
+			wantErr: domain.ErrReauthenticationRequired,
+		},
+		{
+			name: "multi-factor sign-in",
+			subject: &services.Subject{
+				UserID:    "alice",
+				TokenType: string(valueobjects.TokenTypeAccess),
+				AuthTime:  signedIn,
+				AuthLevel: string(valueobjects.AuthLevelMultiFactor),
+			},
+			rule: messaging.AuthorizationRule{OwnerID: "alice", MinAuthLevel: valueobjects.AuthLevelMultiFactor},
+		},
+		{
+			name: "step-up applies to personal access tokens",
+			subject: &services.Subject{
+				UserID:    "alice",
+				TokenType: string(valueobjects.TokenTypePersonalAccess),
+				Scopes:    []string{"profile:write"},
+			},
+			rule:    messaging.AuthorizationRule{OwnerID: "alice", Permission: valueobjects.PermissionProfileWrite, MaxAuthAge: time.Hour},
+			wantErr: domain.ErrReauthenticationRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.subject != nil {
+				ctx = services.WithSubject(ctx, *tt.subject)
+			}
+
+			err := checkRule(ctx, authorizer, "TestCommand", tt.rule)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("checkRule() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantReason != "" {
+				var authErr *AuthorizationError
+				if !errors.As(err, &authErr) || authErr.Reason != tt.wantReason {
+					t.Fatalf("checkRule() error = %v, want reason %q", err, tt.wantReason)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckRuleAuthorizerError(t *testing.T) {
+	broken := errors.New("database is down")
+	ctx := services.WithSubject(context.Background(), services.Subject{
+		UserID:    "alice",
+		TokenType: string(valueobjects.TokenTypeAccess),
+	})
+
+	err := checkRule(ctx, &fakeAuthorizer{err: broken}, "TestCommand",
+		messaging.AuthorizationRule{Permission: valueobjects.PermissionUsersRead})
+	if !errors.Is(err, broken) || errors.Is(err, domain.ErrPermissionDenied) {
+		t.Fatalf("checkRule() error = %v, want %v", err, broken)
+	}
+}
+
+type openCommand struct{}
+
+type guardedCommand struct {
+	UserID string
+}
+
+func (c guardedCommand) AuthorizationRule() messaging.AuthorizationRule {
+	return messaging.AuthorizationRule{OwnerID: c.UserID, Sensitive: true}
+}
+
+func TestPermissionMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  *services.Subject
+		cmd      messaging.Command
+		wantErr  error
+		wantNext bool
+	}{
+		{
+			name:     "command without a rule",
+			cmd:      openCommand{},
+			wantNext: true,
+		},
+		{
+			name:     "rule satisfied",
+			subject:  &services.Subject{UserID: "alice", TokenType: string(valueobjects.TokenTypeAccess)},
+			cmd:      guardedCommand{UserID: "alice"},
+			wantNext: true,
+		},
+		{
+			name:    "rule not satisfied",
+			subject: &services.Subject{UserID: "alice", ActorID: "admin", TokenType: string(valueobjects.TokenTypeAccess)},
+			cmd:     &guardedCommand{UserID: "alice"},
+			wantErr: domain.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.subject != nil {
+				ctx = services.WithSubject(ctx, *tt.subject)
+			}
+
+			called := false
+			next := func(context.Context, messaging.Command) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := PermissionMiddleware(&fakeAuthorizer{}, nopLogger{})(next)(ctx, tt.cmd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PermissionMiddleware() error = %v, want %v", err, tt.wantErr)
+			}
+			if called != tt.wantNext {
+				t.Errorf("next called = %v, want %v", called, tt.wantNext)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -5,6 +5,9 @@ import (
 	"authentication/internal/infrastructure/observability/metrics"
 	"authentication/shared/logging"
 	"authentication/internal/application/contracts/observability"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/application/messaging/middleware"
+	"authentication/internal/domain/repositories"
 	"authentication/shared/utils"
 	"context"
 	"fmt"
@@ -26,19 +29,30 @@ type QueryBus struct {
 	metrics  *metrics.MetricsRecorder
 }
 
-// NewQueryBus creates a new instrumented QueryBus with logger, tracer, and metrics
+// NewQueryBus creates a new instrumented QueryBus with logger, tracer, and
+// metrics. Every query is checked against the policies before it reaches
+// its handler.
 func NewQueryBus(
 	logger logging.Logger,
 	tracer observability.Tracer,
 	metricsRecorder *metrics.MetricsRecorder,
+	policies services.PolicyEvaluator,
+	auditRepo repositories.AuditRepository,
 ) *QueryBus {
-	return &QueryBus{
+	qb := &QueryBus{
 		handlers: make(map[string]messaging.UntypedQueryHandler),
 		chain:    make([]messaging.UntypedQueryMiddleware, 0),
 		logger:   logger.With(zap.String("component", "query_bus")),
 		tracer:   tracer,
 		metrics:  metricsRecorder,
 	}
+
+	// Middleware runs in the order it is added, so refused queries are
+	// still audited for impersonation sessions.
+	qb.UseUntyped(middleware.QueryImpersonationAuditMiddleware(auditRepo, logger))
+	qb.UseUntyped(middleware.QueryAuthorizationMiddleware(policies, auditRepo, logger))
+
+	return qb
 }
 
 // Register registers a typed query handler
@@ -91,6 +105,14 @@ func UseQuery[Q messaging.Query, R any](
 	qb.chain = append(qb.chain, &typedQueryMiddlewareAdapter[Q, R]{inner: middleware})
 }
 
+// UseUntyped adds a middleware that runs for every query
+func (qb *QueryBus) UseUntyped(middleware messaging.UntypedQueryMiddleware) {
+	qb.mu.Lock()
+	defer qb.mu.Unlock()
+
+	qb.chain = append(qb.chain, middleware)
+}
+
 // ExecuteQuery executes a query and returns the result
 func ExecuteQuery[Q messaging.Query, R any](
 	qb *QueryBus,
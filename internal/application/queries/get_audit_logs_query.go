@@ -2,6 +2,8 @@ package queries
 
 import "time"
 
+// GetAuditLogsQuery pages through audit entries matching every filter that
+// is set, newest first.
 type GetAuditLogsQuery struct {
     UserID       *string
     Action       *string
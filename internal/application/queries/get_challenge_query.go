@@ -0,0 +1,9 @@
+package queries
+
+// GetChallengeQuery issues a challenge for a client that was told to solve
+// one before logging in or registering.
+type GetChallengeQuery struct{}
+
+func (q GetChallengeQuery) QueryName() string {
+	return "GetChallengeQuery"
+}
@@ -0,0 +1,10 @@
+package queries
+
+type GetUserPermissionsQuery struct {
+	ActorID string
+	UserID  string
+}
+
+func (q GetUserPermissionsQuery) QueryName() string {
+	return "GetUserPermissionsQuery"
+}
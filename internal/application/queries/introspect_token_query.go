@@ -0,0 +1,12 @@
+package queries
+
+type IntrospectTokenQuery struct {
+	Token         string
+	TokenTypeHint string
+	ClientID      string
+	ClientSecret  string
+}
+
+func (q IntrospectTokenQuery) QueryName() string {
+	return "IntrospectTokenQuery"
+}
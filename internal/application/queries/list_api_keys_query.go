@@ -0,0 +1,12 @@
+package queries
+
+// ListAPIKeysQuery lists one user's keys, or every key when UserID is empty.
+type ListAPIKeysQuery struct {
+	UserID   string
+	Page     int
+	PageSize int
+}
+
+func (q ListAPIKeysQuery) QueryName() string {
+	return "ListAPIKeysQuery"
+}
@@ -0,0 +1,10 @@
+package queries
+
+type ListOAuthClientsQuery struct {
+	Page     int
+	PageSize int
+}
+
+func (q ListOAuthClientsQuery) QueryName() string {
+	return "ListOAuthClientsQuery"
+}
@@ -0,0 +1,10 @@
+package queries
+
+type ListOrganizationMembersQuery struct {
+	ActorID        string
+	OrganizationID string
+}
+
+func (q ListOrganizationMembersQuery) QueryName() string {
+	return "ListOrganizationMembersQuery"
+}
@@ -0,0 +1,10 @@
+package queries
+
+// ListOrganizationsQuery lists the organizations the actor belongs to.
+type ListOrganizationsQuery struct {
+	ActorID string
+}
+
+func (q ListOrganizationsQuery) QueryName() string {
+	return "ListOrganizationsQuery"
+}
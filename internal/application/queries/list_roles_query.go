@@ -0,0 +1,9 @@
+package queries
+
+type ListRolesQuery struct {
+	ActorID string
+}
+
+func (q ListRolesQuery) QueryName() string {
+	return "ListRolesQuery"
+}
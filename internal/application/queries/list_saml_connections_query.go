@@ -0,0 +1,17 @@
+package queries
+
+type ListSAMLConnectionsQuery struct{}
+
+func (q ListSAMLConnectionsQuery) QueryName() string {
+	return "ListSAMLConnectionsQuery"
+}
+
+// GetSAMLMetadataQuery returns the service provider metadata an IdP admin
+// imports to set up the connection.
+type GetSAMLMetadataQuery struct {
+	ConnectionID string
+}
+
+func (q GetSAMLMetadataQuery) QueryName() string {
+	return "GetSAMLMetadataQuery"
+}
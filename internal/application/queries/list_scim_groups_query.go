@@ -0,0 +1,19 @@
+package queries
+
+type ListSCIMGroupsQuery struct {
+	DisplayName string
+	StartIndex  int
+	Count       int
+}
+
+func (q ListSCIMGroupsQuery) QueryName() string {
+	return "ListSCIMGroupsQuery"
+}
+
+type GetSCIMGroupQuery struct {
+	GroupID string
+}
+
+func (q GetSCIMGroupQuery) QueryName() string {
+	return "GetSCIMGroupQuery"
+}
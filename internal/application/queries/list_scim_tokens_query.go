@@ -0,0 +1,7 @@
+package queries
+
+type ListSCIMTokensQuery struct{}
+
+func (q ListSCIMTokensQuery) QueryName() string {
+	return "ListSCIMTokensQuery"
+}
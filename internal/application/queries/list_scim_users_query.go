@@ -0,0 +1,24 @@
+package queries
+
+// ListSCIMUsersQuery supports the single-attribute equality filters SCIM
+// clients use to look users up: userName, emails and active. StartIndex is
+// 1-based as in SCIM.
+type ListSCIMUsersQuery struct {
+	UserName   string
+	Email      string
+	Active     *bool
+	StartIndex int
+	Count      int
+}
+
+func (q ListSCIMUsersQuery) QueryName() string {
+	return "ListSCIMUsersQuery"
+}
+
+type GetSCIMUserQuery struct {
+	UserID string
+}
+
+func (q GetSCIMUserQuery) QueryName() string {
+	return "GetSCIMUserQuery"
+}
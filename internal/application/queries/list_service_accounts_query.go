@@ -0,0 +1,10 @@
+package queries
+
+type ListServiceAccountsQuery struct {
+	Page     int
+	PageSize int
+}
+
+func (q ListServiceAccountsQuery) QueryName() string {
+	return "ListServiceAccountsQuery"
+}
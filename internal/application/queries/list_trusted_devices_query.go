@@ -0,0 +1,11 @@
+package queries
+
+// ListTrustedDevicesQuery lists the devices of UserID that may currently
+// skip the login code step.
+type ListTrustedDevicesQuery struct {
+	UserID string
+}
+
+func (q ListTrustedDevicesQuery) QueryName() string {
+	return "ListTrustedDevicesQuery"
+}
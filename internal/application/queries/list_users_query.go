@@ -1,10 +1,16 @@
 package queries
 
+// ListUsersQuery pages through users. Search matches the start of the email
+// or username. SortBy is one of created_at, email, username or
+// last_login_at.
 type ListUsersQuery struct {
-    Page     int
-    PageSize int
-    Role     *string
-    IsActive *bool
+    Page       int
+    PageSize   int
+    Role       *string
+    IsActive   *bool
+    Search     string
+    SortBy     string
+    Descending bool
 }
 
 func (q ListUsersQuery) QueryName() string {
@@ -2,11 +2,13 @@ package queries
 
 import "time"
 
+// SearchAuditLogsQuery runs a full-text search over audit entries. Cursor
+// is the NextCursor of the previous page and is empty for the first one.
 type SearchAuditLogsQuery struct {
     Query     string
     StartDate *time.Time
     EndDate   *time.Time
-    Page      int
+    Cursor    string
     PageSize  int
 }
 
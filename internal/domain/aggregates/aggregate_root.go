@@ -9,6 +9,7 @@ import (
 type AggregateRoot struct {
 	id                string
 	version           int
+	persistedVersion  int
 	events            []events.DomainEvent
 	createdAt         time.Time
 	updatedAt         time.Time
@@ -27,6 +28,19 @@ func NewAggregateRoot(id string) *AggregateRoot {
 	}
 }
 
+// RestoreAggregateRoot rebuilds the root of an aggregate loaded from
+// storage at the given version.
+func RestoreAggregateRoot(id string, version int, createdAt, updatedAt time.Time) *AggregateRoot {
+	return &AggregateRoot{
+		id:               id,
+		version:          version,
+		persistedVersion: version,
+		events:           make([]events.DomainEvent, 0),
+		createdAt:        createdAt,
+		updatedAt:        updatedAt,
+	}
+}
+
 func (a *AggregateRoot) ID() string {
 	return a.id
 }
@@ -35,6 +49,18 @@ func (a *AggregateRoot) Version() int {
 	return a.version
 }
 
+// PersistedVersion is the version last read from or written to storage,
+// or zero for an aggregate that has not been saved. Repositories use it for
+// optimistic concurrency checks.
+func (a *AggregateRoot) PersistedVersion() int {
+	return a.persistedVersion
+}
+
+// MarkPersisted records that the current version has been saved.
+func (a *AggregateRoot) MarkPersisted() {
+	a.persistedVersion = a.version
+}
+
 func (a *AggregateRoot) GetEvents() []events.DomainEvent {
 	return a.events
 }
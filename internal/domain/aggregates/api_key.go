@@ -0,0 +1,137 @@
+package aggregates
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"authentication/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix marks personal access tokens so they can be told apart from
+// JWTs and recognised by secret scanners.
+const APIKeyPrefix = "pat_"
+
+// APIKey is a long-lived personal access token. Only the SHA-256 hash of the
+// key is stored; DisplayPrefix keeps the first few characters for listings.
+type APIKey struct {
+	*AggregateRoot
+	TenantID      string
+	UserID        string
+	Name          string
+	KeyHash       string
+	DisplayPrefix string
+	Scopes        []string
+	AllowedIPs    []string
+	ExpiresAt     *time.Time
+	LastUsedAt    *time.Time
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+}
+
+func NewAPIKey(
+	userID string,
+	name string,
+	keyHash string,
+	displayPrefix string,
+	scopes []string,
+	allowedIPs []string,
+	expiresAt *time.Time,
+) (*APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrEmptyAPIKeyName
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, domain.ErrInvalidAPIKeyExpiry
+	}
+	for _, entry := range allowedIPs {
+		if !isValidAllowlistEntry(entry) {
+			return nil, domain.ErrInvalidIPAllowlist
+		}
+	}
+
+	return &APIKey{
+		AggregateRoot: NewAggregateRoot(uuid.New().String()),
+		UserID:        userID,
+		Name:          name,
+		KeyHash:       keyHash,
+		DisplayPrefix: displayPrefix,
+		Scopes:        scopes,
+		AllowedIPs:    allowedIPs,
+		ExpiresAt:     expiresAt,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+func (k *APIKey) IsExpired() bool {
+	return k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt)
+}
+
+// Validate reports why the key cannot be used from ip, if at all.
+func (k *APIKey) Validate(ip string) error {
+	if k.IsRevoked() {
+		return domain.ErrAPIKeyRevoked
+	}
+	if k.IsExpired() {
+		return domain.ErrAPIKeyExpired
+	}
+	if !k.AllowsIP(ip) {
+		return domain.ErrAPIKeyIPNotAllowed
+	}
+	return nil
+}
+
+// AllowsIP checks ip against the allowlist. An empty allowlist allows any
+// address.
+func (k *APIKey) AllowsIP(ip string) bool {
+	if len(k.AllowedIPs) == 0 {
+		return true
+	}
+
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	for _, entry := range k.AllowedIPs {
+		if strings.Contains(entry, "/") {
+			if _, network, err := net.ParseCIDR(entry); err == nil && network.Contains(addr) {
+				return true
+			}
+			continue
+		}
+		if allowed := net.ParseIP(entry); allowed != nil && allowed.Equal(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *APIKey) Revoke() error {
+	if k.IsRevoked() {
+		return domain.ErrAPIKeyRevoked
+	}
+	now := time.Now().UTC()
+	k.RevokedAt = &now
+	k.IncrementVersion()
+	return nil
+}
+
+func (k *APIKey) RecordUsage(at time.Time) {
+	k.LastUsedAt = &at
+}
+
+func isValidAllowlistEntry(entry string) bool {
+	if strings.Contains(entry, "/") {
+		_, _, err := net.ParseCIDR(entry)
+		return err == nil
+	}
+	return net.ParseIP(entry) != nil
+}
@@ -9,6 +9,7 @@ import (
 
 type AuditLog struct {
 	*AggregateRoot
+	TenantID     string
 	UserID       string
 	Action       valueobjects.AuditAction
 	ResourceType string
@@ -60,3 +61,13 @@ func NewAuditLogWithError(
 	log.ErrorMessage = errorMessage
 	return log
 }
+
+// CopyFor returns the same entry filed under another user. Actions taken
+// while impersonating are recorded once for the impersonating actor and
+// once for the impersonated user, so each finds them in their own history.
+func (l *AuditLog) CopyFor(userID string) *AuditLog {
+	copied := *l
+	copied.AggregateRoot = NewAggregateRoot(uuid.New().String())
+	copied.UserID = userID
+	return &copied
+}
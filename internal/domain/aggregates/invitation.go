@@ -0,0 +1,75 @@
+package aggregates
+
+import (
+	"time"
+
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+// Invitation lets an organization owner or admin bring in someone by email.
+// The link sent to the invitee carries a signed token naming the invitation;
+// this record decides whether it can still be accepted.
+type Invitation struct {
+	*AggregateRoot
+	TenantID       string
+	OrganizationID string
+	Email          valueobjects.Email
+	Role           valueobjects.OrgRole
+	InvitedBy      string
+	ExpiresAt      time.Time
+	AcceptedBy     string
+	AcceptedAt     *time.Time
+	RevokedAt      *time.Time
+	CreatedAt      time.Time
+}
+
+func NewInvitation(
+	organizationID string,
+	email valueobjects.Email,
+	role valueobjects.OrgRole,
+	invitedBy string,
+	ttl time.Duration,
+) (*Invitation, error) {
+	// Ownership only changes hands through an explicit transfer.
+	if !role.IsValid() || role == valueobjects.OrgRoleOwner {
+		return nil, domain.ErrInvalidInvitationRole
+	}
+
+	now := time.Now().UTC()
+	return &Invitation{
+		AggregateRoot:  NewAggregateRoot(uuid.New().String()),
+		OrganizationID: organizationID,
+		Email:          email,
+		Role:           role,
+		InvitedBy:      invitedBy,
+		ExpiresAt:      now.Add(ttl),
+		CreatedAt:      now,
+	}, nil
+}
+
+func (i *Invitation) IsPending() bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && time.Now().Before(i.ExpiresAt)
+}
+
+// Accept records that userID, signed in as email, joined through this
+// invitation. Invitations are bound to the address they were sent to.
+func (i *Invitation) Accept(userID string, email valueobjects.Email) error {
+	if i.AcceptedAt != nil || i.RevokedAt != nil {
+		return domain.ErrInvitationAlreadyUsed
+	}
+	if !time.Now().Before(i.ExpiresAt) {
+		return domain.ErrInvitationExpired
+	}
+	if !i.Email.Equals(email) {
+		return domain.ErrInvitationEmailMismatch
+	}
+
+	now := time.Now().UTC()
+	i.AcceptedBy = userID
+	i.AcceptedAt = &now
+	i.IncrementVersion()
+	return nil
+}
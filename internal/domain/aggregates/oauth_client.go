@@ -1,11 +1,21 @@
 package aggregates
 
 import (
+	"authentication/internal/domain/valueobjects"
+
 	"github.com/google/uuid"
 )
 
+// OAuthClientProviderInternal marks clients registered against this service,
+// as opposed to upstream identity providers such as google.
+const OAuthClientProviderInternal = "internal"
+
+// OAuthClient holds the hashed client secret in ClientSecret; the plain
+// secret is only ever returned to the caller that created or rotated it.
+// Public clients have no secret.
 type OAuthClient struct {
 	*AggregateRoot
+	TenantID     string
 	ClientID     string
 	ClientSecret string
 	Provider     string
@@ -36,6 +46,12 @@ func NewOAuthClient(
 	}
 }
 
+// IsPublic reports whether the client was registered without a secret, as
+// for apps on devices that cannot keep one.
+func (o *OAuthClient) IsPublic() bool {
+	return o.ClientSecret == ""
+}
+
 func (o *OAuthClient) Activate() {
 	o.IsActive = true
 	o.IncrementVersion()
@@ -60,3 +76,25 @@ func (o *OAuthClient) RotateSecret(newSecret string) {
 	o.ClientSecret = newSecret
 	o.IncrementVersion()
 }
+
+func (o *OAuthClient) AllowsRedirectURI(candidate string) bool {
+	for _, registered := range o.RedirectURIs {
+		uri, err := valueobjects.NewRedirectURI(registered)
+		if err != nil {
+			continue
+		}
+		if uri.Matches(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *OAuthClient) HasScope(scope string) bool {
+	for _, s := range o.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
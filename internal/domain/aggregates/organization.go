@@ -0,0 +1,53 @@
+package aggregates
+
+import (
+	"strings"
+	"time"
+
+	"authentication/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Organization groups users inside a tenant. Exactly one member is the owner;
+// OwnerID mirrors that member's OWNER role so ownership can be checked
+// without loading the membership list.
+type Organization struct {
+	*AggregateRoot
+	TenantID  string
+	Name      string
+	OwnerID   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewOrganization(name, ownerID string) (*Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrEmptyOrganizationName
+	}
+
+	now := time.Now().UTC()
+	return &Organization{
+		AggregateRoot: NewAggregateRoot(uuid.New().String()),
+		Name:          name,
+		OwnerID:       ownerID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (o *Organization) IsOwner(userID string) bool {
+	return o.OwnerID == userID
+}
+
+func (o *Organization) TransferOwnership(newOwnerID string) error {
+	if newOwnerID == o.OwnerID {
+		return domain.ErrAlreadyOrganizationOwner
+	}
+
+	o.OwnerID = newOwnerID
+	o.UpdatedAt = time.Now().UTC()
+	o.IncrementVersion()
+	return nil
+}
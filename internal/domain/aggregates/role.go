@@ -0,0 +1,258 @@
+package aggregates
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+// Role is a named set of permissions. A role inherits every permission of
+// its parents, so the effective permissions of a role are the union over the
+// whole hierarchy above it. Custom roles belong to a tenant; the built-in
+// roles have no TenantID and are shared by every tenant. Source tells
+// whether a custom role was created through the admin API or for a SCIM
+// group.
+type Role struct {
+	*AggregateRoot
+	TenantID    string
+	Name        valueobjects.Role
+	Description string
+	Permissions []valueobjects.Permission
+	Parents     []valueobjects.Role
+	IsSystem    bool
+	Source      RoleSource
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// RoleSource records who created a custom role.
+type RoleSource string
+
+const (
+	RoleSourceAdmin RoleSource = "admin"
+	// RoleSourceSCIM marks roles created for SCIM groups. SCIM clients may
+	// only see and change these.
+	RoleSourceSCIM RoleSource = "scim"
+)
+
+// systemRoles mirrors the fixed USER < MODERATOR < ADMIN hierarchy. These
+// definitions live in code and cannot be changed through the admin API.
+var systemRoles = map[valueobjects.Role]*Role{
+	valueobjects.RoleUser: {
+		Name:        valueobjects.RoleUser,
+		Description: "Default role for every account",
+		Permissions: []valueobjects.Permission{
+			valueobjects.PermissionProfileRead,
+			valueobjects.PermissionProfileWrite,
+		},
+		IsSystem: true,
+	},
+	valueobjects.RoleModerator: {
+		Name:        valueobjects.RoleModerator,
+		Description: "Can view users and audit history",
+		Permissions: []valueobjects.Permission{
+			valueobjects.PermissionUsersRead,
+			valueobjects.PermissionRolesRead,
+			valueobjects.PermissionAuditRead,
+		},
+		Parents:  []valueobjects.Role{valueobjects.RoleUser},
+		IsSystem: true,
+	},
+	valueobjects.RoleAdmin: {
+		Name:        valueobjects.RoleAdmin,
+		Description: "Full administrative access",
+		Permissions: []valueobjects.Permission{valueobjects.PermissionAll},
+		Parents:     []valueobjects.Role{valueobjects.RoleModerator},
+		IsSystem:    true,
+	},
+}
+
+// SystemRole returns the built-in definition for name, if there is one.
+func SystemRole(name valueobjects.Role) (*Role, bool) {
+	role, ok := systemRoles[name]
+	if !ok {
+		return nil, false
+	}
+	return role.clone(), true
+}
+
+// SystemRoles returns the built-in role definitions, most privileged last.
+func SystemRoles() []*Role {
+	return []*Role{
+		systemRoles[valueobjects.RoleUser].clone(),
+		systemRoles[valueobjects.RoleModerator].clone(),
+		systemRoles[valueobjects.RoleAdmin].clone(),
+	}
+}
+
+func NewRole(name, description string, permissions, parents []string) (*Role, error) {
+	roleName, err := valueobjects.NewRoleName(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := systemRoles[roleName]; ok {
+		return nil, domain.ErrRoleAlreadyExists
+	}
+
+	now := time.Now().UTC()
+	role := &Role{
+		AggregateRoot: NewAggregateRoot(uuid.New().String()),
+		Name:          roleName,
+		Source:        RoleSourceAdmin,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := role.apply(description, permissions, parents); err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// NewSCIMGroupRole creates the role behind a SCIM group. It starts without
+// permissions; an administrator grants them afterwards.
+func NewSCIMGroupRole(name, description string) (*Role, error) {
+	role, err := NewRole(name, description, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	role.Source = RoleSourceSCIM
+	return role, nil
+}
+
+// IsSCIMGroup reports whether the role was created for a SCIM group.
+func (r *Role) IsSCIMGroup() bool {
+	return r.Source == RoleSourceSCIM
+}
+
+// Update replaces the description, permissions and parents. Callers must
+// check the resulting hierarchy with CheckRoleHierarchy before saving.
+func (r *Role) Update(description string, permissions, parents []string) error {
+	if r.IsSystem {
+		return domain.ErrSystemRoleImmutable
+	}
+	if err := r.apply(description, permissions, parents); err != nil {
+		return err
+	}
+	r.UpdatedAt = time.Now().UTC()
+	r.IncrementVersion()
+	return nil
+}
+
+// CanDelete reports whether the role may be removed. Whether it is still in
+// use is checked against the repository.
+func (r *Role) CanDelete() error {
+	if r.IsSystem {
+		return domain.ErrSystemRoleImmutable
+	}
+	return nil
+}
+
+func (r *Role) apply(description string, permissions, parents []string) error {
+	parsedPermissions, err := valueobjects.NewPermissions(permissions)
+	if err != nil {
+		return err
+	}
+
+	parsedParents := make([]valueobjects.Role, 0, len(parents))
+	seen := make(map[valueobjects.Role]bool, len(parents))
+	for _, p := range parents {
+		parent, err := valueobjects.NewRoleName(p)
+		if err != nil {
+			return err
+		}
+		if parent == r.Name {
+			return domain.ErrRoleHierarchyCycle
+		}
+		if seen[parent] {
+			continue
+		}
+		seen[parent] = true
+		parsedParents = append(parsedParents, parent)
+	}
+
+	r.Description = strings.TrimSpace(description)
+	r.Permissions = parsedPermissions
+	r.Parents = parsedParents
+	return nil
+}
+
+func (r *Role) clone() *Role {
+	c := *r
+	c.AggregateRoot = NewAggregateRoot(string(r.Name))
+	c.Permissions = append([]valueobjects.Permission(nil), r.Permissions...)
+	c.Parents = append([]valueobjects.Role(nil), r.Parents...)
+	return &c
+}
+
+// RoleLookup loads a role definition by name.
+type RoleLookup func(name valueobjects.Role) (*Role, error)
+
+// CheckRoleHierarchy verifies that every ancestor of role exists and that
+// role does not end up inheriting from itself.
+func CheckRoleHierarchy(role *Role, lookup RoleLookup) error {
+	visited := make(map[valueobjects.Role]bool)
+	var walk func(parents []valueobjects.Role) error
+	walk = func(parents []valueobjects.Role) error {
+		for _, name := range parents {
+			if name == role.Name {
+				return domain.ErrRoleHierarchyCycle
+			}
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+
+			parent, err := lookup(name)
+			if err != nil {
+				return err
+			}
+			if err := walk(parent.Parents); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk(role.Parents)
+}
+
+// ResolvePermissions returns the union of permissions granted by roles and
+// everything they inherit. Roles that no longer exist are skipped so that a
+// deleted role cannot lock a user out of the rest of their permissions.
+func ResolvePermissions(roles []valueobjects.Role, lookup RoleLookup) ([]valueobjects.Permission, error) {
+	visited := make(map[valueobjects.Role]bool)
+	seen := make(map[valueobjects.Permission]bool)
+	var permissions []valueobjects.Permission
+
+	queue := append([]valueobjects.Role(nil), roles...)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		role, err := lookup(name)
+		if err != nil {
+			if errors.Is(err, domain.ErrRoleNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		for _, p := range role.Permissions {
+			if !seen[p] {
+				seen[p] = true
+				permissions = append(permissions, p)
+			}
+		}
+		queue = append(queue, role.Parents...)
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,237 @@
+package aggregates
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+)
+
+// lookupFrom serves custom roles from roles and falls back to the built-in
+// definitions, as the role repository does.
+func lookupFrom(roles ...*Role) RoleLookup {
+	byName := make(map[valueobjects.Role]*Role, len(roles))
+	for _, r := range roles {
+		byName[r.Name] = r
+	}
+	return func(name valueobjects.Role) (*Role, error) {
+		if r, ok := byName[name]; ok {
+			return r, nil
+		}
+		if r, ok := SystemRole(name); ok {
+			return r, nil
+		}
+		return nil, domain.ErrRoleNotFound
+	}
+}
+
+func mustRole(t *testing.T, name string, permissions, parents []string) *Role {
+	t.Helper()
+	role, err := NewRole(name, "", permissions, parents)
+	if err != nil {
+		t.Fatalf("NewRole(%q) error = %v", name, err)
+	}
+	return role
+}
+
+func TestNewRole(t *testing.T) {
+	tests := []struct {
+		name        string
+		roleName    string
+		permissions []string
+		parents     []string
+		wantErr     error
+	}{
+		{
+			name:        "valid",
+			roleName:    "support",
+			permissions: []string{"users:read", "audit:*"},
+			parents:     []string{"USER"},
+		},
+		{
+			name:     "shadows a system role",
+			roleName: "ADMIN",
+			wantErr:  domain.ErrRoleAlreadyExists,
+		},
+		{
+			name:        "invalid permission",
+			roleName:    "support",
+			permissions: []string{"users"},
+			wantErr:     domain.ErrInvalidPermission,
+		},
+		{
+			name:     "own parent",
+			roleName: "support",
+			parents:  []string{"support"},
+			wantErr:  domain.ErrRoleHierarchyCycle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := NewRole(tt.roleName, "", tt.permissions, tt.parents)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("NewRole() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewRole() error = %v", err)
+			}
+			if role.Source != RoleSourceAdmin || role.IsSCIMGroup() {
+				t.Errorf("NewRole() Source = %q, want %q", role.Source, RoleSourceAdmin)
+			}
+		})
+	}
+}
+
+func TestNewSCIMGroupRole(t *testing.T) {
+	role, err := NewSCIMGroupRole("engineering", "SCIM group")
+	if err != nil {
+		t.Fatalf("NewSCIMGroupRole() error = %v", err)
+	}
+	if !role.IsSCIMGroup() {
+		t.Errorf("IsSCIMGroup() = false, want true")
+	}
+	if len(role.Permissions) != 0 || len(role.Parents) != 0 {
+		t.Errorf("NewSCIMGroupRole() granted %v and %v, want nothing", role.Permissions, role.Parents)
+	}
+}
+
+func TestSystemRolesAreImmutable(t *testing.T) {
+	admin, ok := SystemRole(valueobjects.RoleAdmin)
+	if !ok {
+		t.Fatal("SystemRole(ADMIN) not found")
+	}
+	if err := admin.Update("", nil, nil); !errors.Is(err, domain.ErrSystemRoleImmutable) {
+		t.Errorf("Update() error = %v, want %v", err, domain.ErrSystemRoleImmutable)
+	}
+	if err := admin.CanDelete(); !errors.Is(err, domain.ErrSystemRoleImmutable) {
+		t.Errorf("CanDelete() error = %v, want %v", err, domain.ErrSystemRoleImmutable)
+	}
+
+	// The returned definition is a copy.
+	admin.Permissions[0] = valueobjects.PermissionProfileRead
+	again, _ := SystemRole(valueobjects.RoleAdmin)
+	if again.Permissions[0] != valueobjects.PermissionAll {
+		t.Errorf("SystemRole() shares state with its caller")
+	}
+}
+
+func TestCheckRoleHierarchy(t *testing.T) {
+	base := mustRole(t, "base", nil, []string{"USER"})
+	middle := mustRole(t, "middle", nil, []string{"base"})
+	top := mustRole(t, "top", nil, []string{"middle"})
+
+	tests := []struct {
+		name    string
+		role    func() *Role
+		lookup  RoleLookup
+		wantErr error
+	}{
+		{
+			name:   "acyclic chain",
+			role:   func() *Role { return top },
+			lookup: lookupFrom(base, middle, top),
+		},
+		{
+			name: "diamond is not a cycle",
+			role: func() *Role {
+				return mustRole(t, "diamond", nil, []string{"base", "middle"})
+			},
+			lookup: lookupFrom(base, middle),
+		},
+		{
+			name: "indirect cycle",
+			role: func() *Role {
+				// base now inherits from top, which inherits from base.
+				return mustRole(t, "base", nil, []string{"top"})
+			},
+			lookup:  lookupFrom(middle, top, mustRole(t, "base", nil, []string{"top"})),
+			wantErr: domain.ErrRoleHierarchyCycle,
+		},
+		{
+			name: "missing parent",
+			role: func() *Role {
+				return mustRole(t, "orphan", nil, []string{"ghost"})
+			},
+			lookup:  lookupFrom(),
+			wantErr: domain.ErrRoleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckRoleHierarchy(tt.role(), tt.lookup)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CheckRoleHierarchy() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolvePermissions(t *testing.T) {
+	support := mustRole(t, "support", []string{"users:read", "audit:read"}, []string{"USER"})
+	lead := mustRole(t, "lead", []string{"users:write"}, []string{"support", "ghost"})
+	// A cycle that slipped into storage must not loop forever.
+	loopA := mustRole(t, "loop_a", []string{"sso:manage"}, []string{"loop_b"})
+	loopB := mustRole(t, "loop_b", []string{"scim:manage"}, []string{"loop_a"})
+	lookup := lookupFrom(support, lead, loopA, loopB)
+
+	tests := []struct {
+		name  string
+		roles []valueobjects.Role
+		want  []string
+	}{
+		{
+			name:  "system hierarchy",
+			roles: []valueobjects.Role{valueobjects.RoleModerator},
+			want:  []string{"audit:read", "profile:read", "profile:write", "roles:read", "users:read"},
+		},
+		{
+			name:  "inherited and de-duplicated",
+			roles: []valueobjects.Role{"LEAD", "SUPPORT"},
+			want:  []string{"audit:read", "profile:read", "profile:write", "users:read", "users:write"},
+		},
+		{
+			name:  "deleted roles are skipped",
+			roles: []valueobjects.Role{"GHOST", valueobjects.RoleUser},
+			want:  []string{"profile:read", "profile:write"},
+		},
+		{
+			name:  "cycle terminates",
+			roles: []valueobjects.Role{"LOOP_A"},
+			want:  []string{"scim:manage", "sso:manage"},
+		},
+		{
+			name:  "no roles",
+			roles: nil,
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions, err := ResolvePermissions(tt.roles, lookup)
+			if err != nil {
+				t.Fatalf("ResolvePermissions() error = %v", err)
+			}
+			if got := valueobjects.SortedPermissionStrings(permissions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolvePermissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolvePermissionsLookupError(t *testing.T) {
+	broken := errors.New("database is down")
+	_, err := ResolvePermissions([]valueobjects.Role{"SUPPORT"}, func(valueobjects.Role) (*Role, error) {
+		return nil, broken
+	})
+	if !errors.Is(err, broken) {
+		t.Fatalf("ResolvePermissions() error = %v, want %v", err, broken)
+	}
+}
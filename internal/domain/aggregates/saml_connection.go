@@ -0,0 +1,228 @@
+package aggregates
+
+import (
+	"strings"
+	"time"
+
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+// SAML attribute mapping targets. Each maps a user field to the name of the
+// assertion attribute that carries it.
+const (
+	SAMLFieldEmail     = "email"
+	SAMLFieldFirstName = "first_name"
+	SAMLFieldLastName  = "last_name"
+	SAMLFieldUsername  = "username"
+	SAMLFieldPhone     = "phone"
+)
+
+// SAMLUserProvider is recorded as the OAuth provider of users provisioned
+// through a SAML connection.
+const SAMLUserProvider = "saml"
+
+// SAMLConnection links a tenant, or a single organization when
+// OrganizationID is set, to an external SAML identity provider.
+// Certificates holds the IdP signing certificates as PEM blocks; more than
+// one is kept during key rollover. Domains are the email domains verified
+// as belonging to the IdP's organization; an existing account is only
+// signed in through the connection when it is already linked to it or its
+// email is in one of them.
+type SAMLConnection struct {
+	*AggregateRoot
+	TenantID         string
+	OrganizationID   string
+	Name             string
+	IdPEntityID      string
+	SSOURL           string
+	Certificates     []string
+	AttributeMapping map[string]string
+	Domains          []string
+	JITProvisioning  bool
+	DefaultRole      valueobjects.Role
+	IsActive         bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func NewSAMLConnection(
+	organizationID string,
+	name string,
+	idpEntityID string,
+	ssoURL string,
+	certificates []string,
+	attributeMapping map[string]string,
+	domains []string,
+	jitProvisioning bool,
+	defaultRole valueobjects.Role,
+) (*SAMLConnection, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrEmptySAMLConnectionName
+	}
+	if !defaultRole.IsValid() {
+		return nil, domain.ErrInvalidRole
+	}
+
+	mapping, err := normalizeSAMLAttributeMapping(attributeMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	normalizedDomains, err := normalizeDomains(domains, domain.ErrInvalidSAMLDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	conn := &SAMLConnection{
+		AggregateRoot:    NewAggregateRoot(uuid.New().String()),
+		OrganizationID:   organizationID,
+		Name:             name,
+		AttributeMapping: mapping,
+		Domains:          normalizedDomains,
+		JITProvisioning:  jitProvisioning,
+		DefaultRole:      defaultRole,
+		IsActive:         true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := conn.setIdentityProvider(idpEntityID, ssoURL, certificates); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// UpdateIdentityProvider replaces the IdP details, typically after a fresh
+// metadata import.
+func (c *SAMLConnection) UpdateIdentityProvider(idpEntityID, ssoURL string, certificates []string) error {
+	if err := c.setIdentityProvider(idpEntityID, ssoURL, certificates); err != nil {
+		return err
+	}
+	c.touch()
+	return nil
+}
+
+func (c *SAMLConnection) UpdateAttributeMapping(attributeMapping map[string]string) error {
+	mapping, err := normalizeSAMLAttributeMapping(attributeMapping)
+	if err != nil {
+		return err
+	}
+	c.AttributeMapping = mapping
+	c.touch()
+	return nil
+}
+
+func (c *SAMLConnection) UpdateDomains(domains []string) error {
+	normalized, err := normalizeDomains(domains, domain.ErrInvalidSAMLDomain)
+	if err != nil {
+		return err
+	}
+	c.Domains = normalized
+	c.touch()
+	return nil
+}
+
+// CoversEmail reports whether email is in one of the connection's domains.
+// Subdomains are not covered unless listed themselves.
+func (c *SAMLConnection) CoversEmail(email valueobjects.Email) bool {
+	_, emailDomain, ok := strings.Cut(email.String(), "@")
+	if !ok {
+		return false
+	}
+	emailDomain = strings.ToLower(emailDomain)
+	for _, d := range c.Domains {
+		if d == emailDomain {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *SAMLConnection) UpdateProvisioning(jitProvisioning bool, defaultRole valueobjects.Role) error {
+	if !defaultRole.IsValid() {
+		return domain.ErrInvalidRole
+	}
+	c.JITProvisioning = jitProvisioning
+	c.DefaultRole = defaultRole
+	c.touch()
+	return nil
+}
+
+func (c *SAMLConnection) Activate() {
+	c.IsActive = true
+	c.touch()
+}
+
+func (c *SAMLConnection) Deactivate() {
+	c.IsActive = false
+	c.touch()
+}
+
+// MapAttributes picks the user fields out of the assertion attributes. The
+// first value wins for multi-valued attributes. The email field falls back
+// to the subject NameID when no attribute is mapped for it.
+func (c *SAMLConnection) MapAttributes(nameID string, attributes map[string][]string) map[string]string {
+	fields := make(map[string]string, len(c.AttributeMapping))
+	for field, attribute := range c.AttributeMapping {
+		if values := attributes[attribute]; len(values) > 0 {
+			fields[field] = strings.TrimSpace(values[0])
+		}
+	}
+	if fields[SAMLFieldEmail] == "" && strings.Contains(nameID, "@") {
+		fields[SAMLFieldEmail] = strings.TrimSpace(nameID)
+	}
+	return fields
+}
+
+func (c *SAMLConnection) setIdentityProvider(idpEntityID, ssoURL string, certificates []string) error {
+	idpEntityID = strings.TrimSpace(idpEntityID)
+	ssoURL = strings.TrimSpace(ssoURL)
+	if idpEntityID == "" || ssoURL == "" || len(certificates) == 0 {
+		return domain.ErrInvalidSAMLMetadata
+	}
+	c.IdPEntityID = idpEntityID
+	c.SSOURL = ssoURL
+	c.Certificates = certificates
+	return nil
+}
+
+func (c *SAMLConnection) touch() {
+	c.UpdatedAt = time.Now().UTC()
+	c.IncrementVersion()
+}
+
+// DefaultSAMLAttributeMapping uses the attribute names most IdPs emit out
+// of the box.
+func DefaultSAMLAttributeMapping() map[string]string {
+	return map[string]string{
+		SAMLFieldEmail:     "email",
+		SAMLFieldFirstName: "firstName",
+		SAMLFieldLastName:  "lastName",
+	}
+}
+
+func normalizeSAMLAttributeMapping(mapping map[string]string) (map[string]string, error) {
+	if len(mapping) == 0 {
+		return DefaultSAMLAttributeMapping(), nil
+	}
+
+	normalized := make(map[string]string, len(mapping))
+	for field, attribute := range mapping {
+		field = strings.ToLower(strings.TrimSpace(field))
+		attribute = strings.TrimSpace(attribute)
+		switch field {
+		case SAMLFieldEmail, SAMLFieldFirstName, SAMLFieldLastName, SAMLFieldUsername, SAMLFieldPhone:
+		default:
+			return nil, domain.ErrInvalidSAMLAttributeMapping
+		}
+		if attribute == "" {
+			return nil, domain.ErrInvalidSAMLAttributeMapping
+		}
+		normalized[field] = attribute
+	}
+	return normalized, nil
+}
@@ -0,0 +1,83 @@
+package aggregates
+
+import (
+	"strings"
+	"time"
+
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+// ServiceAccountClientIDPrefix distinguishes service account client ids from
+// OAuth client ids at the token endpoint.
+const ServiceAccountClientIDPrefix = "svc_"
+
+// ServiceAccount is a non-human principal for service-to-service calls. It
+// authenticates with client_credentials using either the hashed secret or,
+// when PublicKeyPEM is set, a private_key_jwt assertion.
+type ServiceAccount struct {
+	*AggregateRoot
+	TenantID     string
+	Name         string
+	ClientID     string
+	SecretHash   string
+	PublicKeyPEM string
+	Role         valueobjects.Role
+	Scopes       []string
+	IsActive     bool
+	CreatedAt    time.Time
+}
+
+func NewServiceAccount(
+	name string,
+	clientID string,
+	secretHash string,
+	publicKeyPEM string,
+	role valueobjects.Role,
+	scopes []string,
+) (*ServiceAccount, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrEmptyServiceAccountName
+	}
+	if !role.IsValid() {
+		return nil, domain.ErrInvalidRole
+	}
+
+	return &ServiceAccount{
+		AggregateRoot: NewAggregateRoot(uuid.New().String()),
+		Name:          name,
+		ClientID:      clientID,
+		SecretHash:    secretHash,
+		PublicKeyPEM:  publicKeyPEM,
+		Role:          role,
+		Scopes:        scopes,
+		IsActive:      true,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+func (s *ServiceAccount) UsesPrivateKeyJWT() bool {
+	return s.PublicKeyPEM != ""
+}
+
+func (s *ServiceAccount) HasScope(scope string) bool {
+	for _, sc := range s.Scopes {
+		if sc == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ServiceAccount) RotateSecret(secretHash string) {
+	s.SecretHash = secretHash
+	s.IncrementVersion()
+}
+
+func (s *ServiceAccount) Deactivate() {
+	s.IsActive = false
+	s.IncrementVersion()
+}
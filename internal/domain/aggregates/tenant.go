@@ -0,0 +1,85 @@
+package aggregates
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"authentication/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+var tenantSlugRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// Tenant is an isolated customer namespace. Users, sessions, OAuth clients
+// and audit logs all belong to exactly one tenant. Slug doubles as the
+// subdomain label used to resolve the tenant from the request host.
+type Tenant struct {
+	*AggregateRoot
+	Name      string
+	Slug      string
+	Domains   []string
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewTenant(name, slug string, domains []string) (*Tenant, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrEmptyTenantName
+	}
+
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if !tenantSlugRegex.MatchString(slug) {
+		return nil, domain.ErrInvalidTenantSlug
+	}
+
+	normalized, err := normalizeDomains(domains, domain.ErrInvalidTenantDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &Tenant{
+		AggregateRoot: NewAggregateRoot(uuid.New().String()),
+		Name:          name,
+		Slug:          slug,
+		Domains:       normalized,
+		IsActive:      true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (t *Tenant) Deactivate() {
+	t.IsActive = false
+	t.UpdatedAt = time.Now().UTC()
+	t.IncrementVersion()
+}
+
+func (t *Tenant) Activate() {
+	t.IsActive = true
+	t.UpdatedAt = time.Now().UTC()
+	t.IncrementVersion()
+}
+
+// normalizeDomains lowercases and de-duplicates bare domain names,
+// returning invalid for anything else.
+func normalizeDomains(domains []string, invalid error) ([]string, error) {
+	seen := make(map[string]bool, len(domains))
+	normalized := make([]string, 0, len(domains))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d == "" || strings.ContainsAny(d, "/:@ ") || !strings.Contains(d, ".") {
+			return nil, invalid
+		}
+		if seen[d] {
+			continue
+		}
+		seen[d] = true
+		normalized = append(normalized, d)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,81 @@
+package aggregates
+
+import (
+	"strings"
+	"time"
+
+	"authentication/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// TrustedDevice records that a user passed the login code step on a device
+// and asked not to be asked again. The device holds a signed token naming
+// this record; revoking the record invalidates the token.
+type TrustedDevice struct {
+	*AggregateRoot
+	TenantID   string
+	UserID     string
+	DeviceID   string
+	Name       string
+	IPAddress  string
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func NewTrustedDevice(
+	userID string,
+	deviceID string,
+	name string,
+	ipAddress string,
+	expiresAt time.Time,
+) (*TrustedDevice, error) {
+	deviceID = strings.TrimSpace(deviceID)
+	if deviceID == "" {
+		return nil, domain.ErrTrustedDeviceIDRequired
+	}
+
+	return &TrustedDevice{
+		AggregateRoot: NewAggregateRoot(uuid.New().String()),
+		UserID:        userID,
+		DeviceID:      deviceID,
+		Name:          name,
+		IPAddress:     ipAddress,
+		ExpiresAt:     expiresAt,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+func (d *TrustedDevice) IsRevoked() bool {
+	return d.RevokedAt != nil
+}
+
+func (d *TrustedDevice) IsExpired() bool {
+	return time.Now().After(d.ExpiresAt)
+}
+
+func (d *TrustedDevice) IsActive() bool {
+	return !d.IsRevoked() && !d.IsExpired()
+}
+
+// Matches reports whether the record still vouches for deviceID signing in
+// as userID.
+func (d *TrustedDevice) Matches(userID, deviceID string) bool {
+	return d.IsActive() && d.UserID == userID && d.DeviceID == deviceID
+}
+
+func (d *TrustedDevice) Revoke() error {
+	if d.IsRevoked() {
+		return domain.ErrTrustedDeviceRevoked
+	}
+	now := time.Now().UTC()
+	d.RevokedAt = &now
+	d.IncrementVersion()
+	return nil
+}
+
+func (d *TrustedDevice) RecordUsage(at time.Time) {
+	d.LastUsedAt = &at
+}
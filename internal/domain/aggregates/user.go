@@ -12,6 +12,10 @@ import (
 	"authentication/internal/domain/valueobjects"
 )
 
+// LDAPUserProvider is recorded as the provider of accounts whose password
+// is checked against the LDAP directory.
+const LDAPUserProvider = "ldap"
+
 type UserAggregate struct {
 	*AggregateRoot
 	User     *entities.User
@@ -38,7 +42,8 @@ func NewEmailUserAggregate(
     }
 
     agg.AddEvent(events.NewUserCreatedEvent(
-        id, username.String(), email.String(), role.String(),
+        id, username.String(), email.String(), role.String(), firstName, lastName,
+        "", "", "SUCCESS", "email",
     ))
 
     return agg
@@ -90,7 +95,7 @@ func (u *UserAggregate) ChangePassword(
 	u.User.UpdatePassword(newPasswordVO)
 
 	u.IncrementVersion()
-	u.AddEvent(events.NewPasswordChangedEvent(u.ID(), u.User.Email.String()))
+	u.AddEvent(events.NewPasswordChangedEvent(u.ID(), u.User.TenantID, u.User.Email.String()))
 	return nil
 }
 
@@ -102,10 +107,13 @@ func (u *UserAggregate) Login(
 	u.User.RecordLogin()
 
 	session := entities.NewSession(u.ID(), refreshToken, accessToken, ipAddress, userAgent, expiresAt)
+	session.TenantID = u.User.TenantID
 	u.Sessions = append(u.Sessions, session)
 	u.IncrementVersion()
 
-	u.AddEvent(events.NewUserLoggedInEvent(u.ID(), u.User.Email.String(), ipAddress))
+	u.AddEvent(events.NewUserLoggedInEvent(
+		u.ID(), u.User.TenantID, u.User.Email.String(), ipAddress, userAgent, session.ID,
+	))
 	return session
 }
 
@@ -130,12 +138,43 @@ func (u *UserAggregate) RevokeAllSessions() {
 	u.IncrementVersion()
 }
 
+// RequirePasswordReset locks the local password until the user sets a new
+// one through password reset. Accounts without a local password are left
+// alone.
+func (u *UserAggregate) RequirePasswordReset() {
+	if u.User.Password.IsEmpty() {
+		return
+	}
+	u.User.UpdatePassword(valueobjects.ResetRequiredPassword())
+	u.IncrementVersion()
+	u.AddEvent(events.NewPasswordChangedEvent(u.ID(), u.User.TenantID, u.User.Email.String()))
+}
+
 func (u *UserAggregate) UpdateProfile(firstName, lastName string, phone valueobjects.PhoneNumber) {
 	u.User.UpdateProfile(firstName, lastName, phone)
 	u.IncrementVersion()
 	u.AddEvent(events.NewUserUpdatedEvent(u.ID(), u.User.Email.String()))
 }
 
+// ChangeIdentity replaces the username and email the user signs in with.
+// Callers check that neither is taken by another account.
+func (u *UserAggregate) ChangeIdentity(username valueobjects.Username, email valueobjects.Email) {
+	u.User.Username = username
+	u.User.Email = email
+	u.User.UpdatedAt = time.Now()
+	u.IncrementVersion()
+	u.AddEvent(events.NewUserUpdatedEvent(u.ID(), email.String()))
+}
+
+// ChangeRole replaces the user's primary role. Callers check that the role
+// exists.
+func (u *UserAggregate) ChangeRole(role valueobjects.Role) {
+	u.User.Role = role
+	u.User.UpdatedAt = time.Now()
+	u.IncrementVersion()
+	u.AddEvent(events.NewUserUpdatedEvent(u.ID(), u.User.Email.String()))
+}
+
 func (u *UserAggregate) Deactivate() {
 	u.User.Deactivate()
 	u.RevokeAllSessions()
@@ -147,6 +186,16 @@ func (u *UserAggregate) Activate() {
 	u.IncrementVersion()
 }
 
+func (u *UserAggregate) VerifyPhone() {
+	if u.User.PhoneVerified {
+		return
+	}
+
+	u.User.VerifyPhone()
+	u.IncrementVersion()
+	u.AddEvent(events.NewUserUpdatedEvent(u.ID(), u.User.Email.String()))
+}
+
 func (u *UserAggregate) VerifyEmail() {
 	if u.User.IsVerified {
 		return
@@ -0,0 +1,105 @@
+package entities
+
+import (
+	"time"
+
+	"authentication/internal/domain"
+)
+
+type DeviceAuthorizationStatus string
+
+const (
+	DeviceAuthorizationPending  DeviceAuthorizationStatus = "pending"
+	DeviceAuthorizationApproved DeviceAuthorizationStatus = "approved"
+	DeviceAuthorizationDenied   DeviceAuthorizationStatus = "denied"
+)
+
+// slowDownIncrement is added to the polling interval each time a client polls
+// too early (RFC 8628 section 3.5).
+const slowDownIncrement = 5 * time.Second
+
+// DeviceAuthorization tracks a pending RFC 8628 device flow. It lives in the
+// cache for the lifetime of the device code, keyed by the hashed device code.
+type DeviceAuthorization struct {
+	UserCode     string
+	ClientID     string
+	Scopes       []string
+	Status       DeviceAuthorizationStatus
+	UserID       string
+	Interval     time.Duration
+	ExpiresAt    time.Time
+	LastPolledAt *time.Time
+	CreatedAt    time.Time
+}
+
+func NewDeviceAuthorization(
+	userCode string,
+	clientID string,
+	scopes []string,
+	interval time.Duration,
+	expiresIn time.Duration,
+) *DeviceAuthorization {
+	now := time.Now()
+	return &DeviceAuthorization{
+		UserCode:  userCode,
+		ClientID:  clientID,
+		Scopes:    scopes,
+		Status:    DeviceAuthorizationPending,
+		Interval:  interval,
+		ExpiresAt: now.Add(expiresIn),
+		CreatedAt: now,
+	}
+}
+
+func (d *DeviceAuthorization) IsExpired() bool {
+	return time.Now().After(d.ExpiresAt)
+}
+
+func (d *DeviceAuthorization) Approve(userID string) error {
+	if d.IsExpired() {
+		return domain.ErrDeviceCodeExpired
+	}
+	if d.Status != DeviceAuthorizationPending {
+		return domain.ErrInvalidUserCode
+	}
+	d.Status = DeviceAuthorizationApproved
+	d.UserID = userID
+	return nil
+}
+
+func (d *DeviceAuthorization) Deny() error {
+	if d.Status != DeviceAuthorizationPending {
+		return domain.ErrInvalidUserCode
+	}
+	d.Status = DeviceAuthorizationDenied
+	return nil
+}
+
+// Poll records a token request from the device and reports whether tokens
+// may be issued. A nil error means the user approved the request.
+func (d *DeviceAuthorization) Poll() error {
+	now := time.Now()
+	if d.IsExpired() {
+		return domain.ErrDeviceCodeExpired
+	}
+
+	if d.LastPolledAt != nil && now.Sub(*d.LastPolledAt) < d.Interval {
+		d.Interval += slowDownIncrement
+		d.LastPolledAt = &now
+		return domain.ErrSlowDown
+	}
+	d.LastPolledAt = &now
+
+	switch d.Status {
+	case DeviceAuthorizationApproved:
+		return nil
+	case DeviceAuthorizationDenied:
+		return domain.ErrDeviceAccessDenied
+	default:
+		return domain.ErrAuthorizationPending
+	}
+}
+
+func (d *DeviceAuthorization) ExpiresIn() time.Duration {
+	return time.Until(d.ExpiresAt)
+}
@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+
+	"authentication/internal/domain/valueobjects"
+)
+
+type OrganizationMember struct {
+	OrganizationID string
+	UserID         string
+	Role           valueobjects.OrgRole
+	InvitedBy      string
+	JoinedAt       time.Time
+}
+
+func NewOrganizationMember(organizationID, userID string, role valueobjects.OrgRole, invitedBy string) *OrganizationMember {
+	return &OrganizationMember{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		Role:           role,
+		InvitedBy:      invitedBy,
+		JoinedAt:       time.Now().UTC(),
+	}
+}
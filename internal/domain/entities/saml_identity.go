@@ -0,0 +1,22 @@
+package entities
+
+import "time"
+
+// SAMLIdentity links the subject NameID an identity provider asserts to the
+// account it signs in as. Once linked, the account is found by subject
+// rather than by the asserted email.
+type SAMLIdentity struct {
+	ConnectionID string
+	Subject      string
+	UserID       string
+	CreatedAt    time.Time
+}
+
+func NewSAMLIdentity(connectionID, subject, userID string) *SAMLIdentity {
+	return &SAMLIdentity{
+		ConnectionID: connectionID,
+		Subject:      subject,
+		UserID:       userID,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
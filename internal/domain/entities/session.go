@@ -8,6 +8,7 @@ import (
 
 type Session struct {
 	ID           string
+	TenantID     string
 	UserID       string
 	RefreshToken string
 	AccessToken  string
@@ -19,10 +19,12 @@ const (
 
 type User struct {
 	ID            string
+	TenantID      string
 	Username      valueobjects.Username
 	Email         valueobjects.Email
 	Password      valueobjects.Password
 	Phone         valueobjects.PhoneNumber
+	PhoneVerified bool
 	FirstName     string
 	LastName      string
 	Role          valueobjects.Role
@@ -84,13 +86,22 @@ func (u *User) UpdatePassword(password valueobjects.Password) {
 	u.UpdatedAt = time.Now()
 }
 
+// UpdateProfile clears PhoneVerified when the phone number changes.
 func (u *User) UpdateProfile(firstName, lastName string, phone valueobjects.PhoneNumber) {
+	if !u.Phone.Equals(phone) {
+		u.PhoneVerified = false
+	}
 	u.FirstName = firstName
 	u.LastName = lastName
 	u.Phone = phone
 	u.UpdatedAt = time.Now()
 }
 
+func (u *User) VerifyPhone() {
+	u.PhoneVerified = true
+	u.UpdatedAt = time.Now()
+}
+
 func (u *User) RecordLogin() {
 	now := time.Now()
 	u.LastLoginAt = &now
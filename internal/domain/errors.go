@@ -55,4 +55,158 @@ var (
 	// OAuth errors
 	ErrOAuthProviderMismatch = errors.New("email registered with different oauth provider")
 	ErrOTPRateLimited 	 = errors.New("otp requests are rate limited, please try again later")
+
+	// OAuth client registry errors
+	ErrOAuthClientNotFound = errors.New("oauth client not found")
+	ErrOAuthClientInactive = errors.New("oauth client is inactive")
+	ErrOAuthClientPublic   = errors.New("public oauth clients have no secret")
+	ErrInvalidRedirectURI  = errors.New("redirect uri must be an absolute https uri or a loopback http uri")
+	ErrInvalidScope        = errors.New("invalid scope")
+	ErrEmptyClientName     = errors.New("client name is required")
+	ErrInvalidClient       = errors.New("invalid client credentials")
+
+	// Device authorization grant errors
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("polling too frequently")
+	ErrDeviceCodeExpired    = errors.New("device code expired")
+	ErrDeviceAccessDenied   = errors.New("device authorization denied")
+	ErrInvalidDeviceCode    = errors.New("invalid device code")
+	ErrInvalidUserCode      = errors.New("invalid or expired user code")
+
+	// API key errors
+	ErrAPIKeyNotFound      = errors.New("api key not found")
+	ErrAPIKeyRevoked       = errors.New("api key has been revoked")
+	ErrAPIKeyExpired       = errors.New("api key has expired")
+	ErrAPIKeyIPNotAllowed  = errors.New("api key is not allowed from this ip address")
+	ErrInvalidIPAllowlist  = errors.New("ip allowlist entries must be ip addresses or cidr ranges")
+	ErrInvalidAPIKeyExpiry = errors.New("api key expiry must be in the future")
+	ErrEmptyAPIKeyName     = errors.New("api key name is required")
+
+	// Service account errors
+	ErrServiceAccountNotFound   = errors.New("service account not found")
+	ErrEmptyServiceAccountName  = errors.New("service account name is required")
+	ErrInvalidPublicKey         = errors.New("public key must be a pem encoded rsa, ecdsa or ed25519 key")
+	ErrInvalidClientAssertion   = errors.New("invalid client assertion")
+	ErrClientAssertionReplayed  = errors.New("client assertion has already been used")
+	ErrServiceTokenNotPermitted = errors.New("service tokens are not permitted here")
+
+	// Role and permission errors
+	ErrRoleNotFound        = errors.New("role not found")
+	ErrRoleAlreadyExists   = errors.New("role already exists")
+	ErrInvalidRoleName     = errors.New("role name must be 2-50 upper-case letters, digits or underscores")
+	ErrInvalidPermission   = errors.New("permission must be written as resource:action")
+	ErrRoleHierarchyCycle  = errors.New("role hierarchy must not contain cycles")
+	ErrSystemRoleImmutable = errors.New("built-in roles cannot be modified")
+	ErrRoleInUse           = errors.New("role is still assigned to users or inherited by other roles")
+	ErrRoleNotAssigned     = errors.New("role is not assigned to user")
+	ErrPermissionDenied    = errors.New("permission denied")
+	ErrRoleEscalation      = errors.New("roles may only grant permissions the caller already holds")
+
+	// Tenant errors
+	ErrTenantNotFound      = errors.New("tenant not found")
+	ErrTenantInactive      = errors.New("tenant is inactive")
+	ErrTenantRequired      = errors.New("no tenant in request context")
+	ErrTenantMismatch      = errors.New("credential was issued for a different tenant")
+	ErrEmptyTenantName     = errors.New("tenant name is required")
+	ErrInvalidTenantSlug   = errors.New("tenant slug must be a lower-case dns label")
+	ErrInvalidTenantDomain = errors.New("tenant domains must be bare host names")
+	ErrTenantSlugTaken     = errors.New("tenant slug is already taken")
+
+	// Organization errors
+	ErrOrganizationNotFound      = errors.New("organization not found")
+	ErrEmptyOrganizationName     = errors.New("organization name is required")
+	ErrInvalidOrgRole            = errors.New("organization role must be OWNER, ADMIN or MEMBER")
+	ErrNotOrganizationMember     = errors.New("user is not a member of this organization")
+	ErrAlreadyOrganizationMember = errors.New("user is already a member of this organization")
+	ErrAlreadyOrganizationOwner  = errors.New("user already owns this organization")
+	ErrCannotRemoveOwner         = errors.New("the organization owner cannot be removed; transfer ownership first")
+	ErrInvitationNotFound        = errors.New("invitation not found")
+	ErrInvalidInvitationRole     = errors.New("invitations can only grant the ADMIN or MEMBER role")
+	ErrInvalidInvitationToken    = errors.New("invalid invitation token")
+	ErrInvitationExpired         = errors.New("invitation has expired")
+	ErrInvitationAlreadyUsed     = errors.New("invitation has already been accepted or revoked")
+	ErrInvitationEmailMismatch   = errors.New("invitation was sent to a different email address")
+
+	// SAML errors
+	ErrSAMLConnectionNotFound      = errors.New("saml connection not found")
+	ErrSAMLConnectionInactive      = errors.New("saml connection is disabled")
+	ErrEmptySAMLConnectionName     = errors.New("saml connection name is required")
+	ErrInvalidSAMLMetadata         = errors.New("invalid identity provider metadata")
+	ErrInvalidSAMLAttributeMapping = errors.New("saml attribute mapping may only target email, first_name, last_name, username or phone")
+	ErrSAMLRequestNotFound         = errors.New("saml login request is unknown or has expired")
+	ErrInvalidSAMLResponse         = errors.New("invalid saml response")
+	ErrSAMLSignatureInvalid        = errors.New("saml response signature is invalid")
+	ErrSAMLAssertionExpired        = errors.New("saml assertion is expired or not yet valid")
+	ErrSAMLAudienceMismatch        = errors.New("saml assertion was issued for a different service provider")
+	ErrSAMLAssertionReplayed       = errors.New("saml assertion has already been used")
+	ErrSAMLMissingEmail            = errors.New("saml assertion does not carry an email address")
+	ErrSAMLProvisioningDisabled    = errors.New("user does not exist and just-in-time provisioning is disabled")
+	ErrInvalidSAMLDomain           = errors.New("saml connection domains must be bare domain names")
+	ErrSAMLAccountNotLinked        = errors.New("account is not linked to this saml connection")
+
+	// SCIM errors
+	ErrSCIMTokenNotFound    = errors.New("scim token not found")
+	ErrSCIMTokenRevoked     = errors.New("scim token has been revoked")
+	ErrEmptySCIMTokenName   = errors.New("scim token name is required")
+	ErrInvalidSCIMFilter    = errors.New("unsupported or malformed scim filter")
+	ErrInvalidSCIMPatch     = errors.New("unsupported or malformed scim patch operation")
+	ErrSCIMUserNameRequired = errors.New("scim userName or a primary email is required")
+	ErrSCIMGroupImmutable   = errors.New("scim group displayName cannot be changed")
+
+	// LDAP errors
+	ErrLDAPUnavailable          = errors.New("ldap directory is unavailable")
+	ErrLDAPAccountConflict      = errors.New("an account with this email already signs in without the directory")
+	ErrLDAPProvisioningDisabled = errors.New("user does not exist and ldap just-in-time provisioning is disabled")
+	ErrLDAPMissingEmail         = errors.New("ldap entry does not carry an email address")
+
+	// User administration errors
+	ErrSelfAdministration = errors.New("administrators cannot deactivate, delete or change the roles of their own account")
+
+	// Profile errors
+	ErrUserVersionConflict       = errors.New("user was changed by another request; reload and try again")
+	ErrIdentityManagedExternally = errors.New("username and email of this account are managed by its identity provider")
+	ErrEmailUnchanged            = errors.New("new email matches the current email")
+	ErrInvalidEmailChangeToken   = errors.New("invalid email change token")
+	ErrEmailChangeExpired        = errors.New("email change link has expired")
+	ErrEmailChangeSuperseded     = errors.New("email address has changed since this link was sent")
+
+	// SMS errors
+	ErrSMSRateLimited       = errors.New("too many text messages sent to this number, please try again later")
+	ErrSMSCountryNotAllowed = errors.New("text messages cannot be sent to this country")
+	ErrSMSUnavailable       = errors.New("text message delivery is unavailable")
+	ErrPhoneNotSet          = errors.New("no phone number is set on this account")
+	ErrPhoneAlreadyVerified = errors.New("phone number is already verified")
+	ErrInvalidOTP           = errors.New("invalid or expired verification code")
+
+	// Risk errors
+	ErrLoginBlocked = errors.New("sign-in blocked due to suspicious activity")
+
+	// Challenge errors
+	ErrChallengeRequired = errors.New("a challenge must be solved before trying again")
+	ErrChallengeFailed   = errors.New("challenge response is invalid or expired")
+
+	// Sign-in alert errors
+	ErrInvalidSignInAlertToken = errors.New("invalid sign-in alert link")
+	ErrSignInAlertExpired      = errors.New("sign-in alert link has expired")
+	ErrSignInAlertUsed         = errors.New("sign-in alert link has already been used")
+
+	// Trusted device errors
+	ErrTrustedDeviceNotFound     = errors.New("trusted device not found")
+	ErrTrustedDeviceRevoked      = errors.New("trusted device has been revoked")
+	ErrInvalidTrustedDeviceToken = errors.New("invalid trusted device token")
+	ErrTrustedDeviceIDRequired   = errors.New("a device id is required to trust a device")
+
+	// Impersonation errors
+	ErrCannotImpersonateSelf       = errors.New("you cannot impersonate yourself")
+	ErrCannotImpersonatePrivileged = errors.New("users who may impersonate others cannot be impersonated")
+	ErrImpersonationReasonRequired = errors.New("a reason is required to impersonate a user")
+
+	// Step-up authentication errors
+	ErrReauthenticationRequired = errors.New("recent stronger authentication is required")
+
+	// Audit log query errors
+	ErrInvalidAuditAction       = errors.New("invalid audit action")
+	ErrInvalidAuditDateRange    = errors.New("start date must be before end date")
+	ErrAuditSearchQueryRequired = errors.New("a search query is required")
+	ErrInvalidAuditCursor       = errors.New("invalid audit log cursor")
 )
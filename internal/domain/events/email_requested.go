@@ -0,0 +1,33 @@
+package events
+
+const EmailRequestedEventName = "email.requested"
+
+// EmailRequestedPayload carries everything needed to render and send one
+// email, so delivery can happen (and be retried) outside the request.
+type EmailRequestedPayload struct {
+	To       string                 `json:"to"`
+	Subject  string                 `json:"subject"`
+	Template string                 `json:"template"`
+	Locale   string                 `json:"locale,omitempty"`
+	Data     map[string]interface{} `json:"data,omitempty"`
+}
+
+func NewEmailRequestedEvent(to, subject, template, locale string, data map[string]interface{}) DomainEvent {
+	payload := EmailRequestedPayload{
+		To:       to,
+		Subject:  subject,
+		Template: template,
+		Locale:   locale,
+		Data:     data,
+	}
+
+	return newEvent(
+		EmailRequestedEventName,
+		to,
+		payload,
+		map[string]string{
+			"event_source": "auth-service",
+			"template":     template,
+		},
+	)
+}
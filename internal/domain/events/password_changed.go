@@ -1,15 +1,21 @@
 package events
 
+const UserPasswordChangedEventName = "user.password_changed"
+
+// PasswordChangedPayload is published whenever the credential a user signs
+// in with stops being valid, whether they chose a new one or it was locked
+// pending a reset.
 type PasswordChangedPayload struct {
-    UserID string `json:"user_id"`
-    Email  string `json:"email"`
+    UserID   string `json:"user_id"`
+    TenantID string `json:"tenant_id,omitempty"`
+    Email    string `json:"email"`
 }
 
-func NewPasswordChangedEvent(userID, email string) DomainEvent {
+func NewPasswordChangedEvent(userID, tenantID, email string) DomainEvent {
     return newEvent(
-        "user.password_changed",
+        UserPasswordChangedEventName,
         userID,
-        PasswordChangedPayload{UserID: userID, Email: email},
+        PasswordChangedPayload{UserID: userID, TenantID: tenantID, Email: email},
         nil,
     )
 }
@@ -0,0 +1,15 @@
+package events
+
+type UserEmailVerifiedPayload struct {
+    UserID string `json:"user_id"`
+    Email  string `json:"email"`
+}
+
+func NewUserEmailVerifiedEvent(userID, email string) DomainEvent {
+    return newEvent(
+        "user.email_verified",
+        userID,
+        UserEmailVerifiedPayload{UserID: userID, Email: email},
+        nil,
+    )
+}
@@ -1,16 +1,30 @@
 package events
 
+const UserLoggedInEventName = "user.logged_in"
+
+// UserLoggedInPayload describes the session a login created, so handlers
+// can tell the user about it without reloading it.
 type UserLoggedInPayload struct {
     UserID    string `json:"user_id"`
+    TenantID  string `json:"tenant_id,omitempty"`
     Email     string `json:"email"`
     IPAddress string `json:"ip_address"`
+    UserAgent string `json:"user_agent,omitempty"`
+    SessionID string `json:"session_id,omitempty"`
 }
 
-func NewUserLoggedInEvent(userID, email, ip string) DomainEvent {
+func NewUserLoggedInEvent(userID, tenantID, email, ip, userAgent, sessionID string) DomainEvent {
     return newEvent(
-        "user.logged_in",
+        UserLoggedInEventName,
         userID,
-        UserLoggedInPayload{UserID: userID, Email: email, IPAddress: ip},
+        UserLoggedInPayload{
+            UserID:    userID,
+            TenantID:  tenantID,
+            Email:     email,
+            IPAddress: ip,
+            UserAgent: userAgent,
+            SessionID: sessionID,
+        },
         nil,
     )
 }
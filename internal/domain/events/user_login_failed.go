@@ -0,0 +1,30 @@
+package events
+
+const UserLoginFailedEventName = "user.login_failed"
+
+// UserLoginFailedPayload describes a rejected sign-in. The email is the one
+// that was tried, which need not belong to any account.
+type UserLoginFailedPayload struct {
+    Email     string `json:"email"`
+    Method    string `json:"method"`
+    Reason    string `json:"reason"`
+    IPAddress string `json:"ip_address"`
+    UserAgent string `json:"user_agent,omitempty"`
+    DeviceID  string `json:"device_id,omitempty"`
+}
+
+func NewUserLoginFailedEvent(email, method, reason, ip, userAgent, deviceID string) DomainEvent {
+    return newEvent(
+        UserLoginFailedEventName,
+        email,
+        UserLoginFailedPayload{
+            Email:     email,
+            Method:    method,
+            Reason:    reason,
+            IPAddress: ip,
+            UserAgent: userAgent,
+            DeviceID:  deviceID,
+        },
+        nil,
+    )
+}
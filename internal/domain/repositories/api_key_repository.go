@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"authentication/internal/domain/aggregates"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *aggregates.APIKey) error
+	FindByID(ctx context.Context, id string) (*aggregates.APIKey, error)
+	FindByHash(ctx context.Context, keyHash string) (*aggregates.APIKey, error)
+	Update(ctx context.Context, key *aggregates.APIKey) error
+	UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error
+	ListByUser(ctx context.Context, userID string, page, pageSize int) ([]*aggregates.APIKey, int64, error)
+	List(ctx context.Context, page, pageSize int) ([]*aggregates.APIKey, int64, error)
+	// DeleteByUserID removes every key of the user, revoked ones included.
+	DeleteByUserID(ctx context.Context, userID string) error
+}
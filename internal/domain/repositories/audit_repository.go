@@ -2,12 +2,15 @@ package repositories
 
 import (
 	"context"
-	//"time"
+	"time"
+
 	"authentication/internal/domain/aggregates"
-	//"authentication/internal/domain/valueobjects"
+	"authentication/internal/domain/valueobjects"
 )
 
-/*type AuditLogFilter struct {
+// AuditLogFilter narrows AuditRepository.List to entries matching every
+// field that is set. StartDate is inclusive and EndDate exclusive.
+type AuditLogFilter struct {
 	UserID       *string
 	Action       *valueobjects.AuditAction
 	ResourceType *string
@@ -17,10 +20,37 @@ import (
 	EndDate      *time.Time
 	Page         int
 	PageSize     int
-}*/
+}
+
+// AuditLogCursor is the position of the last entry of a search page. Entries
+// are ordered newest first, with id breaking ties between equal timestamps.
+type AuditLogCursor struct {
+	Timestamp time.Time
+	ID        string
+}
+
+// AuditLogSearch is a full-text query over the action, resource, error
+// message and metadata of audit entries. Pages are read with a cursor
+// rather than an offset so deep pages stay cheap on large tenants.
+type AuditLogSearch struct {
+	Query     string
+	StartDate *time.Time
+	EndDate   *time.Time
+	After     *AuditLogCursor
+	Limit     int
+}
 
 type AuditRepository interface {
 	Create(ctx context.Context, log *aggregates.AuditLog) error
-	//List(ctx context.Context, filter AuditLogFilter) ([]*aggregates.AuditLog, error)
+	// FindByUserID returns the newest entries recorded for or about a user.
+	FindByUserID(ctx context.Context, userID string, limit int) ([]*aggregates.AuditLog, error)
+	// AnonymizeByUserID strips the IP address, user agent and metadata from
+	// every entry recorded for or about a user, keeping the trail itself.
+	AnonymizeByUserID(ctx context.Context, userID string) error
+	// List returns one page of entries matching filter, newest first, and
+	// the number of matching entries.
+	List(ctx context.Context, filter AuditLogFilter) ([]*aggregates.AuditLog, int64, error)
+	// Search returns up to search.Limit entries matching search.Query,
+	// newest first, starting after search.After.
+	Search(ctx context.Context, search AuditLogSearch) ([]*aggregates.AuditLog, error)
 }
- 
\ No newline at end of file
@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"authentication/internal/domain/aggregates"
+)
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *aggregates.Invitation) error
+	FindByID(ctx context.Context, id string) (*aggregates.Invitation, error)
+	Update(ctx context.Context, invitation *aggregates.Invitation) error
+	ListPending(ctx context.Context, organizationID string) ([]*aggregates.Invitation, error)
+}
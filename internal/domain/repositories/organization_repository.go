@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+)
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *aggregates.Organization) error
+	FindByID(ctx context.Context, id string) (*aggregates.Organization, error)
+	Update(ctx context.Context, org *aggregates.Organization) error
+	ListByUser(ctx context.Context, userID string) ([]*aggregates.Organization, error)
+
+	AddMember(ctx context.Context, member *entities.OrganizationMember) error
+	FindMember(ctx context.Context, organizationID, userID string) (*entities.OrganizationMember, error)
+	UpdateMember(ctx context.Context, member *entities.OrganizationMember) error
+	RemoveMember(ctx context.Context, organizationID, userID string) error
+	ListMembers(ctx context.Context, organizationID string) ([]*entities.OrganizationMember, error)
+	// RemoveUserMemberships removes the user from every organization of the
+	// tenant.
+	RemoveUserMemberships(ctx context.Context, userID string) error
+}
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/valueobjects"
+)
+
+// RoleRepository stores custom roles and role assignments. The built-in
+// roles are served from aggregates.SystemRoles and are never persisted.
+type RoleRepository interface {
+	Create(ctx context.Context, role *aggregates.Role) error
+	FindByName(ctx context.Context, name valueobjects.Role) (*aggregates.Role, error)
+	Update(ctx context.Context, role *aggregates.Role) error
+	Delete(ctx context.Context, name valueobjects.Role) error
+	List(ctx context.Context) ([]*aggregates.Role, error)
+
+	// CountDependents returns how many users hold the role plus how many
+	// roles inherit from it.
+	CountDependents(ctx context.Context, name valueobjects.Role) (int64, error)
+
+	AssignToUser(ctx context.Context, userID string, name valueobjects.Role, assignedBy string) error
+	UnassignFromUser(ctx context.Context, userID string, name valueobjects.Role) error
+	FindUserRoles(ctx context.Context, userID string) ([]valueobjects.Role, error)
+	// UnassignAllFromUser removes every role assignment of the user.
+	UnassignAllFromUser(ctx context.Context, userID string) error
+
+	// FindRoleMembers returns the ids of the users the role is assigned to.
+	FindRoleMembers(ctx context.Context, name valueobjects.Role) ([]string, error)
+}
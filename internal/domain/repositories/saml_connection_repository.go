@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+)
+
+type SAMLConnectionRepository interface {
+	Create(ctx context.Context, conn *aggregates.SAMLConnection) error
+	FindByID(ctx context.Context, id string) (*aggregates.SAMLConnection, error)
+	Update(ctx context.Context, conn *aggregates.SAMLConnection) error
+	List(ctx context.Context) ([]*aggregates.SAMLConnection, error)
+	// FindIdentity returns the account linked to subject on the connection,
+	// or ErrNotFound.
+	FindIdentity(ctx context.Context, connectionID, subject string) (*entities.SAMLIdentity, error)
+	// LinkIdentity records identity, replacing any earlier link of the same
+	// subject.
+	LinkIdentity(ctx context.Context, identity *entities.SAMLIdentity) error
+	// HasIdentity reports whether userID is linked to the connection under
+	// any subject.
+	HasIdentity(ctx context.Context, connectionID, userID string) (bool, error)
+}
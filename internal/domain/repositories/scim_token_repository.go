@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"authentication/internal/domain/aggregates"
+)
+
+type SCIMTokenRepository interface {
+	Create(ctx context.Context, token *aggregates.SCIMToken) error
+	FindByID(ctx context.Context, id string) (*aggregates.SCIMToken, error)
+	FindByHash(ctx context.Context, tokenHash string) (*aggregates.SCIMToken, error)
+	Update(ctx context.Context, token *aggregates.SCIMToken) error
+	UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error
+	List(ctx context.Context) ([]*aggregates.SCIMToken, error)
+}
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"authentication/internal/domain/aggregates"
+)
+
+type ServiceAccountRepository interface {
+	Create(ctx context.Context, account *aggregates.ServiceAccount) error
+	FindByID(ctx context.Context, id string) (*aggregates.ServiceAccount, error)
+	FindByClientID(ctx context.Context, clientID string) (*aggregates.ServiceAccount, error)
+	Update(ctx context.Context, account *aggregates.ServiceAccount) error
+	List(ctx context.Context, page, pageSize int) ([]*aggregates.ServiceAccount, int64, error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"authentication/internal/domain/aggregates"
+)
+
+// TenantRepository is the one repository that is not itself tenant scoped.
+type TenantRepository interface {
+	Create(ctx context.Context, tenant *aggregates.Tenant) error
+	FindByID(ctx context.Context, id string) (*aggregates.Tenant, error)
+	FindBySlug(ctx context.Context, slug string) (*aggregates.Tenant, error)
+	FindByDomain(ctx context.Context, domain string) (*aggregates.Tenant, error)
+	Update(ctx context.Context, tenant *aggregates.Tenant) error
+	List(ctx context.Context, page, pageSize int) ([]*aggregates.Tenant, int64, error)
+}
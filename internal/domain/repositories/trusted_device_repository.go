@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"authentication/internal/domain/aggregates"
+)
+
+type TrustedDeviceRepository interface {
+	Create(ctx context.Context, device *aggregates.TrustedDevice) error
+	FindByID(ctx context.Context, id string) (*aggregates.TrustedDevice, error)
+	Update(ctx context.Context, device *aggregates.TrustedDevice) error
+	UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error
+	// ListActiveByUser returns the user's unrevoked, unexpired devices,
+	// most recently trusted first.
+	ListActiveByUser(ctx context.Context, userID string) ([]*aggregates.TrustedDevice, error)
+	// RevokeAllByUser revokes every active device of the user and returns
+	// how many there were.
+	RevokeAllByUser(ctx context.Context, userID string, revokedAt time.Time) (int64, error)
+	// DeleteByUserID removes every device of the user, revoked ones included.
+	DeleteByUserID(ctx context.Context, userID string) error
+}
@@ -2,6 +2,8 @@ package repositories
 
 import (
 	"context"
+	"time"
+
 	"authentication/internal/domain/aggregates"
 	"authentication/internal/domain/valueobjects"
 )
@@ -15,10 +17,48 @@ type UserRepository interface {
 	ExistsByUsername(ctx context.Context, username valueobjects.Username) (bool, error)
 	Update(ctx context.Context, user *aggregates.UserAggregate) error
 	Delete(ctx context.Context, id string) error
+	// HardDelete removes the user row permanently, including a row that was
+	// already soft deleted.
+	HardDelete(ctx context.Context, id string) error
+	// FindDeletedBefore returns the ids of soft deleted users whose
+	// deleted_at is older than before, oldest first.
+	FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]string, error)
+	Search(ctx context.Context, filter UserFilter) ([]*aggregates.UserAggregate, int64, error)
 	List(
 		ctx context.Context,
 		page, pageSize int,
 		role *valueobjects.Role,
 		isActive *bool,
 	) ([]*aggregates.UserAggregate, int64, error)
-}
\ No newline at end of file
+}
+
+// UserSortField names a column UserRepository.Search can order by.
+type UserSortField string
+
+const (
+	UserSortCreatedAt   UserSortField = "created_at"
+	UserSortEmail       UserSortField = "email"
+	UserSortUsername    UserSortField = "username"
+	UserSortLastLoginAt UserSortField = "last_login_at"
+)
+
+func (f UserSortField) IsValid() bool {
+	switch f {
+	case UserSortCreatedAt, UserSortEmail, UserSortUsername, UserSortLastLoginAt:
+		return true
+	}
+	return false
+}
+
+// UserFilter narrows and orders UserRepository.Search. Search matches the
+// start of the email or username, case-insensitively. An empty SortBy
+// orders by creation time.
+type UserFilter struct {
+	Page       int
+	PageSize   int
+	Role       *valueobjects.Role
+	IsActive   *bool
+	Search     string
+	SortBy     UserSortField
+	Descending bool
+}
@@ -0,0 +1,60 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+)
+
+const DefaultSecretLength = 32
+
+// SecretService issues high-entropy opaque secrets (client secrets, API keys)
+// and hashes them for storage. Unlike passwords these are random, so a single
+// SHA-256 round is enough and lookups can be done by hash.
+type SecretService struct{}
+
+func NewSecretService() SecretService {
+	return SecretService{}
+}
+
+func (s SecretService) Generate(length int) (string, error) {
+	if length <= 0 {
+		length = DefaultSecretLength
+	}
+
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// GenerateCode returns a uniformly random code drawn from alphabet, for
+// values a human has to read and type such as device user codes.
+func (s SecretService) GenerateCode(length int, alphabet string) (string, error) {
+	max := big.NewInt(int64(len(alphabet)))
+	code := make([]byte, length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate code: %w", err)
+		}
+		code[i] = alphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+func (s SecretService) Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s SecretService) Verify(secret, hash string) bool {
+	computed := s.Hash(secret)
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(hash)) == 1
+}
@@ -16,6 +16,73 @@ const (
     AuditActionTokenRevoked       AuditAction = "TOKEN_REVOKED"
     AuditActionOAuthLogin         AuditAction = "OAUTH_LOGIN"
     AuditActionOAuthLoginFailed   AuditAction = "OAUTH_LOGIN_FAILED"
+
+    AuditActionOAuthClientCreated       AuditAction = "OAUTH_CLIENT_CREATED"
+    AuditActionOAuthClientUpdated       AuditAction = "OAUTH_CLIENT_UPDATED"
+    AuditActionOAuthClientDeactivated   AuditAction = "OAUTH_CLIENT_DEACTIVATED"
+    AuditActionOAuthClientSecretRotated AuditAction = "OAUTH_CLIENT_SECRET_ROTATED"
+
+    AuditActionDeviceAuthorized AuditAction = "DEVICE_AUTHORIZED"
+    AuditActionDeviceDenied     AuditAction = "DEVICE_DENIED"
+
+    AuditActionAPIKeyCreated AuditAction = "API_KEY_CREATED"
+    AuditActionAPIKeyRevoked AuditAction = "API_KEY_REVOKED"
+
+    AuditActionServiceAccountCreated       AuditAction = "SERVICE_ACCOUNT_CREATED"
+    AuditActionServiceAccountDeactivated   AuditAction = "SERVICE_ACCOUNT_DEACTIVATED"
+    AuditActionServiceAccountSecretRotated AuditAction = "SERVICE_ACCOUNT_SECRET_ROTATED"
+    AuditActionServiceTokenIssued          AuditAction = "SERVICE_TOKEN_ISSUED"
+
+    AuditActionRoleCreated    AuditAction = "ROLE_CREATED"
+    AuditActionRoleUpdated    AuditAction = "ROLE_UPDATED"
+    AuditActionRoleDeleted    AuditAction = "ROLE_DELETED"
+    AuditActionRoleAssigned   AuditAction = "ROLE_ASSIGNED"
+    AuditActionRoleUnassigned AuditAction = "ROLE_UNASSIGNED"
+
+    AuditActionAccessGranted AuditAction = "ACCESS_GRANTED"
+    AuditActionAccessDenied  AuditAction = "ACCESS_DENIED"
+
+    AuditActionOrganizationCreated     AuditAction = "ORGANIZATION_CREATED"
+    AuditActionOrgMemberInvited        AuditAction = "ORG_MEMBER_INVITED"
+    AuditActionOrgInvitationAccepted   AuditAction = "ORG_INVITATION_ACCEPTED"
+    AuditActionOrgMemberRemoved        AuditAction = "ORG_MEMBER_REMOVED"
+    AuditActionOrgOwnershipTransferred AuditAction = "ORG_OWNERSHIP_TRANSFERRED"
+
+    AuditActionSAMLConnectionCreated AuditAction = "SAML_CONNECTION_CREATED"
+    AuditActionSAMLConnectionUpdated AuditAction = "SAML_CONNECTION_UPDATED"
+    AuditActionSAMLLogin             AuditAction = "SAML_LOGIN"
+    AuditActionSAMLUserProvisioned   AuditAction = "SAML_USER_PROVISIONED"
+
+    AuditActionSCIMTokenCreated    AuditAction = "SCIM_TOKEN_CREATED"
+    AuditActionSCIMTokenRevoked    AuditAction = "SCIM_TOKEN_REVOKED"
+    AuditActionSCIMUserProvisioned AuditAction = "SCIM_USER_PROVISIONED"
+    AuditActionSCIMUserUpdated     AuditAction = "SCIM_USER_UPDATED"
+    AuditActionSCIMUserDeactivated AuditAction = "SCIM_USER_DEACTIVATED"
+    AuditActionSCIMGroupCreated    AuditAction = "SCIM_GROUP_CREATED"
+    AuditActionSCIMGroupUpdated    AuditAction = "SCIM_GROUP_UPDATED"
+    AuditActionSCIMGroupDeleted    AuditAction = "SCIM_GROUP_DELETED"
+
+    AuditActionUserRoleChanged     AuditAction = "USER_ROLE_CHANGED"
+    AuditActionUserSessionsRevoked AuditAction = "USER_SESSIONS_REVOKED"
+    AuditActionUserPurged          AuditAction = "USER_PURGED"
+
+    AuditActionEmailChangeRequested AuditAction = "EMAIL_CHANGE_REQUESTED"
+    AuditActionEmailChanged         AuditAction = "EMAIL_CHANGED"
+    AuditActionPhoneVerified        AuditAction = "PHONE_VERIFIED"
+
+    AuditActionAccountDeletionRequested AuditAction = "ACCOUNT_DELETION_REQUESTED"
+    AuditActionUserDataExported         AuditAction = "USER_DATA_EXPORTED"
+
+    AuditActionLoginRiskAssessed AuditAction = "LOGIN_RISK_ASSESSED"
+    AuditActionSignInReported    AuditAction = "SIGN_IN_REPORTED"
+
+    AuditActionDeviceTrusted        AuditAction = "DEVICE_TRUSTED"
+    AuditActionTrustedDeviceRevoked AuditAction = "TRUSTED_DEVICE_REVOKED"
+
+    AuditActionImpersonationStarted AuditAction = "IMPERSONATION_STARTED"
+    AuditActionImpersonatedAction   AuditAction = "IMPERSONATED_ACTION"
+
+    AuditActionUserReauthenticated AuditAction = "USER_REAUTHENTICATED"
 )
 
 func (a AuditAction) String() string {
@@ -28,7 +95,30 @@ func (a AuditAction) IsValid() bool {
         AuditActionUserLogout, AuditActionUserPasswordChanged, AuditActionUserUpdated,
         AuditActionUserDeleted, AuditActionUserDeactivated, AuditActionUserActivated,
         AuditActionTokenRefreshed, AuditActionTokenRevoked, AuditActionOAuthLogin,
-        AuditActionOAuthLoginFailed:
+        AuditActionOAuthLoginFailed, AuditActionOAuthClientCreated, AuditActionOAuthClientUpdated,
+        AuditActionOAuthClientDeactivated, AuditActionOAuthClientSecretRotated,
+        AuditActionDeviceAuthorized, AuditActionDeviceDenied,
+        AuditActionAPIKeyCreated, AuditActionAPIKeyRevoked,
+        AuditActionServiceAccountCreated, AuditActionServiceAccountDeactivated,
+        AuditActionServiceAccountSecretRotated, AuditActionServiceTokenIssued,
+        AuditActionRoleCreated, AuditActionRoleUpdated, AuditActionRoleDeleted,
+        AuditActionRoleAssigned, AuditActionRoleUnassigned,
+        AuditActionAccessGranted, AuditActionAccessDenied,
+        AuditActionOrganizationCreated, AuditActionOrgMemberInvited,
+        AuditActionOrgInvitationAccepted, AuditActionOrgMemberRemoved,
+        AuditActionOrgOwnershipTransferred,
+        AuditActionSAMLConnectionCreated, AuditActionSAMLConnectionUpdated,
+        AuditActionSAMLLogin, AuditActionSAMLUserProvisioned,
+        AuditActionSCIMTokenCreated, AuditActionSCIMTokenRevoked,
+        AuditActionSCIMUserProvisioned, AuditActionSCIMUserUpdated, AuditActionSCIMUserDeactivated,
+        AuditActionSCIMGroupCreated, AuditActionSCIMGroupUpdated, AuditActionSCIMGroupDeleted,
+        AuditActionUserRoleChanged, AuditActionUserSessionsRevoked, AuditActionUserPurged,
+        AuditActionEmailChangeRequested, AuditActionEmailChanged, AuditActionPhoneVerified,
+        AuditActionAccountDeletionRequested, AuditActionUserDataExported,
+        AuditActionLoginRiskAssessed, AuditActionSignInReported,
+        AuditActionDeviceTrusted, AuditActionTrustedDeviceRevoked,
+        AuditActionImpersonationStarted, AuditActionImpersonatedAction,
+        AuditActionUserReauthenticated:
         return true
     }
     return false
@@ -0,0 +1,128 @@
+package valueobjects
+
+// AuthMethod is an authentication method reference as carried in the amr
+// claim of an access token (RFC 8176).
+type AuthMethod string
+
+const (
+	AuthMethodPassword AuthMethod = "pwd"
+	// AuthMethodOTP is a one-time code delivered by email or SMS.
+	AuthMethodOTP      AuthMethod = "otp"
+	AuthMethodTOTP     AuthMethod = "totp"
+	AuthMethodWebAuthn AuthMethod = "webauthn"
+	// AuthMethodOAuth covers sign-in delegated to an external identity
+	// provider, including SAML.
+	AuthMethodOAuth AuthMethod = "oauth"
+)
+
+func (m AuthMethod) IsValid() bool {
+	switch m {
+	case AuthMethodPassword, AuthMethodOTP, AuthMethodTOTP, AuthMethodWebAuthn, AuthMethodOAuth:
+		return true
+	}
+	return false
+}
+
+func (m AuthMethod) String() string {
+	return string(m)
+}
+
+// isPossession reports whether m proves the user holds something, as opposed
+// to knowing a secret or being vouched for by a provider.
+func (m AuthMethod) isPossession() bool {
+	return m == AuthMethodOTP || m == AuthMethodTOTP || m == AuthMethodWebAuthn
+}
+
+// ParseAuthMethods converts amr claim values, dropping unknown ones and
+// duplicates.
+func ParseAuthMethods(values []string) []AuthMethod {
+	return MergeAuthMethods(nil, authMethodsOf(values)...)
+}
+
+// MergeAuthMethods appends added to existing, keeping the first occurrence
+// of each method.
+func MergeAuthMethods(existing []AuthMethod, added ...AuthMethod) []AuthMethod {
+	merged := make([]AuthMethod, 0, len(existing)+len(added))
+	seen := make(map[AuthMethod]bool, len(existing)+len(added))
+	for _, m := range append(append([]AuthMethod{}, existing...), added...) {
+		if !m.IsValid() || seen[m] {
+			continue
+		}
+		seen[m] = true
+		merged = append(merged, m)
+	}
+	return merged
+}
+
+// AuthMethodStrings converts methods to amr claim values.
+func AuthMethodStrings(methods []AuthMethod) []string {
+	values := make([]string, len(methods))
+	for i, m := range methods {
+		values[i] = m.String()
+	}
+	return values
+}
+
+func authMethodsOf(values []string) []AuthMethod {
+	methods := make([]AuthMethod, len(values))
+	for i, v := range values {
+		methods[i] = AuthMethod(v)
+	}
+	return methods
+}
+
+// AuthLevel is the authentication assurance level carried in the acr claim,
+// named after the NIST SP 800-63B levels.
+type AuthLevel string
+
+const (
+	AuthLevelNone AuthLevel = ""
+	// AuthLevelSingleFactor is any completed sign-in.
+	AuthLevelSingleFactor AuthLevel = "aal1"
+	// AuthLevelMultiFactor needs a possession factor (otp, totp) plus a
+	// password or provider sign-in, or a WebAuthn authenticator on its own.
+	AuthLevelMultiFactor AuthLevel = "aal2"
+)
+
+// AuthLevelFor derives the level reached by methods.
+func AuthLevelFor(methods []AuthMethod) AuthLevel {
+	methods = MergeAuthMethods(nil, methods...)
+	if len(methods) == 0 {
+		return AuthLevelNone
+	}
+
+	var possession, other bool
+	for _, m := range methods {
+		if m == AuthMethodWebAuthn {
+			return AuthLevelMultiFactor
+		}
+		if m.isPossession() {
+			possession = true
+		} else {
+			other = true
+		}
+	}
+	if possession && other {
+		return AuthLevelMultiFactor
+	}
+	return AuthLevelSingleFactor
+}
+
+func (l AuthLevel) String() string {
+	return string(l)
+}
+
+// Satisfies reports whether l is at least required.
+func (l AuthLevel) Satisfies(required AuthLevel) bool {
+	return l.rank() >= required.rank()
+}
+
+func (l AuthLevel) rank() int {
+	switch l {
+	case AuthLevelMultiFactor:
+		return 2
+	case AuthLevelSingleFactor:
+		return 1
+	}
+	return 0
+}
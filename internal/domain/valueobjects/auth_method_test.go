@@ -0,0 +1,61 @@
+package valueobjects
+
+import "testing"
+
+func TestAuthLevelFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []AuthMethod
+		want    AuthLevel
+	}{
+		{"no methods", nil, AuthLevelNone},
+		{"unknown methods only", []AuthMethod{"sms"}, AuthLevelNone},
+		{"password", []AuthMethod{AuthMethodPassword}, AuthLevelSingleFactor},
+		{"email code alone", []AuthMethod{AuthMethodOTP}, AuthLevelSingleFactor},
+		{"two possession factors", []AuthMethod{AuthMethodOTP, AuthMethodTOTP}, AuthLevelSingleFactor},
+		{"password and totp", []AuthMethod{AuthMethodPassword, AuthMethodTOTP}, AuthLevelMultiFactor},
+		{"provider and otp", []AuthMethod{AuthMethodOAuth, AuthMethodOTP}, AuthLevelMultiFactor},
+		{"webauthn alone", []AuthMethod{AuthMethodWebAuthn}, AuthLevelMultiFactor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AuthLevelFor(tt.methods); got != tt.want {
+				t.Errorf("AuthLevelFor(%v) = %q, want %q", tt.methods, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthLevelSatisfies(t *testing.T) {
+	tests := []struct {
+		level    AuthLevel
+		required AuthLevel
+		want     bool
+	}{
+		{AuthLevelNone, AuthLevelNone, true},
+		{AuthLevelNone, AuthLevelSingleFactor, false},
+		{AuthLevelSingleFactor, AuthLevelMultiFactor, false},
+		{AuthLevelMultiFactor, AuthLevelSingleFactor, true},
+		{"aal9", AuthLevelSingleFactor, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.level.Satisfies(tt.required); got != tt.want {
+			t.Errorf("%q.Satisfies(%q) = %v, want %v", tt.level, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestParseAuthMethods(t *testing.T) {
+	got := ParseAuthMethods([]string{"pwd", "otp", "pwd", "sms", "totp"})
+	want := []AuthMethod{AuthMethodPassword, AuthMethodOTP, AuthMethodTOTP}
+	if len(got) != len(want) {
+		t.Fatalf("ParseAuthMethods() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ParseAuthMethods() = %v, want %v", got, want)
+		}
+	}
+}
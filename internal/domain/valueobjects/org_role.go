@@ -0,0 +1,60 @@
+package valueobjects
+
+import (
+	"strings"
+
+	"authentication/internal/domain"
+)
+
+// OrgRole is a user's role inside a single organization. It is separate from
+// the tenant-wide Role used for RBAC.
+type OrgRole string
+
+const (
+	OrgRoleOwner  OrgRole = "OWNER"
+	OrgRoleAdmin  OrgRole = "ADMIN"
+	OrgRoleMember OrgRole = "MEMBER"
+)
+
+func NewOrgRole(role string) (OrgRole, error) {
+	r := OrgRole(strings.ToUpper(strings.TrimSpace(role)))
+	if !r.IsValid() {
+		return "", domain.ErrInvalidOrgRole
+	}
+	return r, nil
+}
+
+func (r OrgRole) IsValid() bool {
+	switch r {
+	case OrgRoleOwner, OrgRoleAdmin, OrgRoleMember:
+		return true
+	}
+	return false
+}
+
+func (r OrgRole) String() string {
+	return string(r)
+}
+
+// CanManageMembers reports whether r may invite and remove members.
+func (r OrgRole) CanManageMembers() bool {
+	return r == OrgRoleOwner || r == OrgRoleAdmin
+}
+
+// Outranks reports whether r sits strictly above other, which is required to
+// remove a member or invite someone into other.
+func (r OrgRole) Outranks(other OrgRole) bool {
+	return r.rank() > other.rank()
+}
+
+func (r OrgRole) rank() int {
+	switch r {
+	case OrgRoleOwner:
+		return 3
+	case OrgRoleAdmin:
+		return 2
+	case OrgRoleMember:
+		return 1
+	}
+	return 0
+}
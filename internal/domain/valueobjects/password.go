@@ -19,3 +19,16 @@ func (p Password) Value() string {
 func (p Password) IsEmpty() bool {
 	return p.value == ""
 }
+
+// resetRequired is stored instead of a hash when the user must choose a new
+// password. It never verifies, so the old password stops working, and it is
+// not empty, so checks that skip password-less accounts still apply.
+const resetRequired = "!reset-required"
+
+func ResetRequiredPassword() Password {
+	return Password{value: resetRequired}
+}
+
+func (p Password) ResetRequired() bool {
+	return p.value == resetRequired
+}
@@ -0,0 +1,117 @@
+package valueobjects
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"authentication/internal/domain"
+)
+
+// Permission names an action on a resource, written "resource:action".
+// The action may be "*" to cover every action on the resource, and the
+// bare "*" grants everything.
+type Permission string
+
+const (
+	PermissionAll Permission = "*"
+
+	PermissionProfileRead           Permission = "profile:read"
+	PermissionProfileWrite          Permission = "profile:write"
+	PermissionUsersRead             Permission = "users:read"
+	PermissionUsersWrite            Permission = "users:write"
+	PermissionUsersDelete           Permission = "users:delete"
+	PermissionUsersImpersonate      Permission = "users:impersonate"
+	PermissionRolesRead             Permission = "roles:read"
+	PermissionRolesManage           Permission = "roles:manage"
+	PermissionAuditRead             Permission = "audit:read"
+	PermissionAuditSearch           Permission = "audit:search"
+	PermissionOAuthClientsManage    Permission = "oauth_clients:manage"
+	PermissionAPIKeysManage         Permission = "api_keys:manage"
+	PermissionServiceAccountsManage Permission = "service_accounts:manage"
+	PermissionSSOManage             Permission = "sso:manage"
+	PermissionSCIMManage            Permission = "scim:manage"
+	PermissionSCIMProvision         Permission = "scim:provision"
+)
+
+var permissionRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*:([a-z][a-z0-9_]*|\*)$`)
+
+func NewPermission(permission string) (Permission, error) {
+	p := Permission(strings.ToLower(strings.TrimSpace(permission)))
+	if !p.IsValid() {
+		return "", domain.ErrInvalidPermission
+	}
+	return p, nil
+}
+
+// NewPermissions parses and de-duplicates a list of permissions.
+func NewPermissions(values []string) ([]Permission, error) {
+	seen := make(map[Permission]bool, len(values))
+	permissions := make([]Permission, 0, len(values))
+	for _, v := range values {
+		p, err := NewPermission(v)
+		if err != nil {
+			return nil, err
+		}
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		permissions = append(permissions, p)
+	}
+	return permissions, nil
+}
+
+func (p Permission) IsValid() bool {
+	return p == PermissionAll || permissionRegex.MatchString(string(p))
+}
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+// Grants reports whether holding p allows required, taking wildcards into
+// account.
+func (p Permission) Grants(required Permission) bool {
+	if p == PermissionAll || p == required {
+		return true
+	}
+	resource, action, ok := strings.Cut(string(p), ":")
+	if !ok || action != "*" {
+		return false
+	}
+	return strings.HasPrefix(string(required), resource+":")
+}
+
+// PermissionsGrant reports whether any of held allows required.
+func PermissionsGrant(held []Permission, required Permission) bool {
+	for _, p := range held {
+		if p.Grants(required) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopesGrant reports whether any of the scopes, read as permissions, allows
+// required. Scopes that are not permissions grant nothing.
+func ScopesGrant(scopes []string, required Permission) bool {
+	for _, scope := range scopes {
+		p, err := NewPermission(scope)
+		if err == nil && p.Grants(required) {
+			return true
+		}
+	}
+	return false
+}
+
+// SortedPermissionStrings returns the permissions as sorted strings for
+// stable output.
+func SortedPermissionStrings(permissions []Permission) []string {
+	values := make([]string, len(permissions))
+	for i, p := range permissions {
+		values[i] = string(p)
+	}
+	sort.Strings(values)
+	return values
+}
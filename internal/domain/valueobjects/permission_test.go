@@ -0,0 +1,74 @@
+package valueobjects
+
+import "testing"
+
+func TestPermissionGrants(t *testing.T) {
+	tests := []struct {
+		name     string
+		held     Permission
+		required Permission
+		want     bool
+	}{
+		{"exact", PermissionUsersRead, PermissionUsersRead, true},
+		{"different action", PermissionUsersRead, PermissionUsersWrite, false},
+		{"all", PermissionAll, PermissionSCIMManage, true},
+		{"resource wildcard", "users:*", PermissionUsersDelete, true},
+		{"resource wildcard is not a prefix match", "users:*", "users_archive:read", false},
+		{"wildcard of another resource", "audit:*", PermissionUsersRead, false},
+		{"roles:manage is not all", PermissionRolesManage, PermissionUsersWrite, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.held.Grants(tt.required); got != tt.want {
+				t.Errorf("%q.Grants(%q) = %v, want %v", tt.held, tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopesGrant(t *testing.T) {
+	tests := []struct {
+		name     string
+		scopes   []string
+		required Permission
+		want     bool
+	}{
+		{"matching scope", []string{"openid", "users:read"}, PermissionUsersRead, true},
+		{"wildcard scope", []string{"users:*"}, PermissionUsersWrite, true},
+		{"scopes are normalized", []string{" Users:Read "}, PermissionUsersRead, true},
+		{"no matching scope", []string{"users:read"}, PermissionUsersWrite, false},
+		{"oidc scopes grant nothing", []string{"openid", "profile", "email"}, PermissionProfileRead, false},
+		{"no scopes", nil, PermissionProfileRead, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScopesGrant(tt.scopes, tt.required); got != tt.want {
+				t.Errorf("ScopesGrant(%v, %q) = %v, want %v", tt.scopes, tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewPermissions(t *testing.T) {
+	got, err := NewPermissions([]string{"users:read", " USERS:READ", "*", "audit:*"})
+	if err != nil {
+		t.Fatalf("NewPermissions() error = %v", err)
+	}
+	want := []Permission{PermissionUsersRead, PermissionAll, "audit:*"}
+	if len(got) != len(want) {
+		t.Fatalf("NewPermissions() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("NewPermissions() = %v, want %v", got, want)
+		}
+	}
+
+	for _, invalid := range []string{"", "users", "users:", ":read", "users:read:all", "Users:r-ead"} {
+		if _, err := NewPermission(invalid); err == nil {
+			t.Errorf("NewPermission(%q) error = nil, want an error", invalid)
+		}
+	}
+}
@@ -37,3 +37,38 @@ func (p PhoneNumber) String() string {
 func (p PhoneNumber) Equals(other PhoneNumber) bool {
 	return p.value == other.value
 }
+
+func (p PhoneNumber) IsEmpty() bool {
+	return p.value == ""
+}
+
+// twoDigitCallingCodes lists the ITU country calling codes that are two
+// digits long. Codes starting with 1 or 7 are one digit; every other code
+// is three digits, since calling codes never prefix one another.
+var twoDigitCallingCodes = map[string]bool{
+	"20": true, "27": true, "30": true, "31": true, "32": true, "33": true,
+	"34": true, "36": true, "39": true, "40": true, "41": true, "43": true,
+	"44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "52": true, "53": true, "54": true, "55": true, "56": true,
+	"57": true, "58": true, "60": true, "61": true, "62": true, "63": true,
+	"64": true, "65": true, "66": true, "81": true, "82": true, "84": true,
+	"86": true, "90": true, "91": true, "92": true, "93": true, "94": true,
+	"95": true, "98": true,
+}
+
+// CallingCode returns the country calling code without the leading +,
+// e.g. "44" for +447911123456.
+func (p PhoneNumber) CallingCode() string {
+	if len(p.value) < 4 {
+		return ""
+	}
+	digits := p.value[1:]
+	switch {
+	case digits[0] == '1' || digits[0] == '7':
+		return digits[:1]
+	case twoDigitCallingCodes[digits[:2]]:
+		return digits[:2]
+	default:
+		return digits[:3]
+	}
+}
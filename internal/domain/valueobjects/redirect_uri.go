@@ -0,0 +1,85 @@
+package valueobjects
+
+import (
+	"net"
+	"net/url"
+	"strings"
+
+	"authentication/internal/domain"
+)
+
+type RedirectURI struct {
+	value string
+}
+
+// NewRedirectURI accepts absolute https URIs, plus plain http on a loopback
+// address for native apps (RFC 8252 section 7.3).
+func NewRedirectURI(raw string) (RedirectURI, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return RedirectURI{}, domain.ErrInvalidRedirectURI
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.Fragment != "" || u.User != nil {
+		return RedirectURI{}, domain.ErrInvalidRedirectURI
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !isLoopbackHost(u.Hostname()) {
+			return RedirectURI{}, domain.ErrInvalidRedirectURI
+		}
+	default:
+		return RedirectURI{}, domain.ErrInvalidRedirectURI
+	}
+
+	return RedirectURI{value: raw}, nil
+}
+
+func (r RedirectURI) String() string {
+	return r.value
+}
+
+func (r RedirectURI) IsLoopback() bool {
+	u, err := url.Parse(r.value)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "http" && isLoopbackHost(u.Hostname())
+}
+
+// Matches compares a requested redirect URI against the registered one.
+// Comparison is exact, except that loopback URIs may use any port.
+func (r RedirectURI) Matches(candidate string) bool {
+	if r.value == candidate {
+		return true
+	}
+	if !r.IsLoopback() {
+		return false
+	}
+
+	registered, err := url.Parse(r.value)
+	if err != nil {
+		return false
+	}
+	requested, err := url.Parse(candidate)
+	if err != nil {
+		return false
+	}
+
+	return requested.Scheme == registered.Scheme &&
+		requested.Hostname() == registered.Hostname() &&
+		requested.EscapedPath() == registered.EscapedPath() &&
+		requested.RawQuery == registered.RawQuery &&
+		requested.Fragment == ""
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
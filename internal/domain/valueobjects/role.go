@@ -1,6 +1,12 @@
 package valueobjects
 
-import "fmt"
+import (
+    "fmt"
+    "regexp"
+    "strings"
+
+    "authentication/internal/domain"
+)
 
 type Role string
 
@@ -18,6 +24,19 @@ func NewRole(role string) (Role, error) {
     return r, nil
 }
 
+var roleNameRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]{1,49}$`)
+
+// NewRoleName accepts any well-formed role name, including custom roles
+// defined at runtime. Use NewRole where only the built-in roles are allowed.
+func NewRoleName(name string) (Role, error) {
+    r := Role(strings.ToUpper(strings.TrimSpace(name)))
+    if !roleNameRegex.MatchString(string(r)) {
+        return "", domain.ErrInvalidRoleName
+    }
+    return r, nil
+}
+
+// IsValid reports whether r is one of the built-in roles.
 func (r Role) IsValid() bool {
     switch r {
     case RoleUser, RoleAdmin, RoleModerator:
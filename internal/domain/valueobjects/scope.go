@@ -0,0 +1,57 @@
+package valueobjects
+
+import (
+	"regexp"
+	"strings"
+
+	"authentication/internal/domain"
+)
+
+type Scope struct {
+	value string
+}
+
+// scope-token from RFC 6749 section 3.3
+var scopeRegex = regexp.MustCompile(`^[\x21\x23-\x5B\x5D-\x7E]+$`)
+
+func NewScope(scope string) (Scope, error) {
+	scope = strings.TrimSpace(scope)
+	if scope == "" || !scopeRegex.MatchString(scope) {
+		return Scope{}, domain.ErrInvalidScope
+	}
+	return Scope{value: scope}, nil
+}
+
+func (s Scope) String() string {
+	return s.value
+}
+
+// ParseScopes splits a space-delimited scope string and removes duplicates.
+func ParseScopes(raw string) ([]Scope, error) {
+	return NewScopes(strings.Fields(raw))
+}
+
+func NewScopes(values []string) ([]Scope, error) {
+	seen := make(map[string]bool, len(values))
+	scopes := make([]Scope, 0, len(values))
+	for _, v := range values {
+		scope, err := NewScope(v)
+		if err != nil {
+			return nil, err
+		}
+		if seen[scope.value] {
+			continue
+		}
+		seen[scope.value] = true
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+func ScopeStrings(scopes []Scope) []string {
+	values := make([]string, len(scopes))
+	for i, s := range scopes {
+		values[i] = s.value
+	}
+	return values
+}
@@ -10,6 +10,13 @@ type TokenType string
 const (
 	TokenTypeAccess  TokenType = "ACCESS"
 	TokenTypeRefresh TokenType = "REFRESH"
+	// TokenTypeService marks machine tokens issued to service accounts.
+	TokenTypeService TokenType = "SERVICE"
+	// TokenTypeSCIM marks the per-tenant bearer tokens of SCIM clients.
+	TokenTypeSCIM TokenType = "SCIM"
+	// TokenTypePersonalAccess marks personal access tokens, which act for
+	// their owner but only within the scopes chosen at creation.
+	TokenTypePersonalAccess TokenType = "PAT"
 )
 
 type Token struct {
@@ -40,7 +47,8 @@ func NewToken(value string, tokenType TokenType, expiresAt time.Time) (*Token, e
 }
 
 func (t TokenType) IsValid() bool {
-	return t == TokenTypeAccess || t == TokenTypeRefresh
+	return t == TokenTypeAccess || t == TokenTypeRefresh || t == TokenTypeService || t == TokenTypeSCIM ||
+		t == TokenTypePersonalAccess
 }
 
 func (t *Token) Value() string {
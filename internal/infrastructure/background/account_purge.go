@@ -0,0 +1,53 @@
+package background
+
+import (
+	"context"
+	"time"
+
+	"authentication/internal/application/commands"
+	"authentication/internal/application/dtos"
+	"authentication/internal/application/messaging"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// AccountPurgeJob periodically issues PurgeDeletedAccountsCommand so
+// accounts deleted by their owners are removed once the grace period ends.
+type AccountPurgeJob struct {
+	commandBus *messaging.CommandBus
+	logger     logging.Logger
+}
+
+func NewAccountPurgeJob(commandBus *messaging.CommandBus, logger logging.Logger) *AccountPurgeJob {
+	return &AccountPurgeJob{
+		commandBus: commandBus,
+		logger:     logger.With(zap.String("component", "account_purge")),
+	}
+}
+
+// Run purges every interval until ctx is cancelled.
+func (j *AccountPurgeJob) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.RunOnce(ctx)
+		}
+	}
+}
+
+func (j *AccountPurgeJob) RunOnce(ctx context.Context) {
+	_, err := messaging.Execute[commands.PurgeDeletedAccountsCommand, dtos.PurgeResult](
+		j.commandBus,
+		ctx,
+		commands.PurgeDeletedAccountsCommand{},
+	)
+	if err != nil {
+		j.logger.Error(ctx, "Account purge failed", zap.Error(err))
+	}
+}
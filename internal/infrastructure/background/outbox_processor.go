@@ -1,18 +1,24 @@
 package background
 
 import (
-	contracts "authentication/internal/application/contracts/persistence"
 	"authentication/internal/application/contracts/messaging"
+	contracts "authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/events"
 	"authentication/shared/logging"
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
-// OutboxProcessor handles processing of outbox events
+// OutboxProcessor handles processing of outbox events. A message whose
+// handlers fail stays pending and is picked up again once the repository's
+// backoff has elapsed, which makes the outbox the retry queue for side
+// effects such as email delivery.
 type OutboxProcessor struct {
 	outboxRepo contracts.OutboxRepository
-	serializer messaging.EventSerializer
 	logger     logging.Logger
 	batchSize  int
 	dispatcher messaging.EventDispatcher
@@ -20,50 +26,63 @@ type OutboxProcessor struct {
 
 func NewOutboxProcessor(
 	outboxRepo contracts.OutboxRepository,
-	serializer messaging.EventSerializer,
 	logger logging.Logger,
 	dispatcher messaging.EventDispatcher,
 ) *OutboxProcessor {
 	return &OutboxProcessor{
 		outboxRepo: outboxRepo,
-		serializer: serializer,
-		logger:     logger,
+		logger:     logger.With(zap.String("component", "outbox_processor")),
 		dispatcher: dispatcher,
 		batchSize:  100,
 	}
 }
 
+// Run processes the outbox every interval until ctx is cancelled.
+func (p *OutboxProcessor) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_ = p.Process(ctx)
+		}
+	}
+}
+
 // Process fetches and processes pending outbox events
 func (p *OutboxProcessor) Process(ctx context.Context) error {
-	events, err := p.outboxRepo.FetchPending(ctx, p.batchSize)
+	pending, err := p.outboxRepo.FetchPending(ctx, p.batchSize)
 	if err != nil {
 		p.logger.Error(ctx, "Failed to fetch pending outbox events", zap.Error(err))
 		return err
 	}
 
-	if len(events) == 0 {
+	if len(pending) == 0 {
 		p.logger.Debug(ctx, "No pending outbox events to process")
 		return nil
 	}
 
-	p.logger.Info(ctx, "Processing outbox events", zap.Int("count", len(events)))
+	p.logger.Info(ctx, "Processing outbox events", zap.Int("count", len(pending)))
 
 	var processed, failed int
 
-	for _, outboxEvent := range events {
-		if err := p.Process(ctx); err != nil {
+	for _, msg := range pending {
+		if err := p.dispatch(ctx, msg); err != nil {
 			p.logger.Error(ctx, "Failed to process outbox event",
 				zap.Error(err),
-				zap.String("event_id", outboxEvent.EventId.String()),
-				zap.String("event_name", outboxEvent.EventName),
+				zap.String("event_id", msg.ID),
+				zap.String("event_name", msg.EventType),
 			)
 
-			if markErr := p.outboxRepo.MarkFailed(ctx, outboxEvent.EventId, err.Error()); markErr != nil {
+			if markErr := p.outboxRepo.MarkFailed(ctx, msg.ID, err.Error()); markErr != nil {
 				p.logger.Error(ctx, "Failed to mark event as failed", zap.Error(markErr))
 			}
 			failed++
 		} else {
-			if markErr := p.outboxRepo.MarkSent(ctx, outboxEvent.EventId); markErr != nil {
+			if markErr := p.outboxRepo.MarkAsSent(ctx, msg.ID); markErr != nil {
 				p.logger.Error(ctx, "Failed to mark event as sent", zap.Error(markErr))
 			}
 			processed++
@@ -78,3 +97,20 @@ func (p *OutboxProcessor) Process(ctx context.Context) error {
 	return nil
 }
 
+func (p *OutboxProcessor) dispatch(ctx context.Context, msg *contracts.OutboxMessage) error {
+	id, err := uuid.Parse(msg.ID)
+	if err != nil {
+		return fmt.Errorf("invalid outbox message id: %w", err)
+	}
+
+	event := events.BaseDomainEvent{
+		ID:          id,
+		Name:        msg.EventType,
+		Timestamp:   time.Unix(msg.OccurredAt, 0).UTC(),
+		AggregateId: msg.AggregateID,
+		Data:        msg.Payload,
+		Meta:        msg.Metadata,
+	}
+
+	return p.dispatcher.DispatchWithContext(ctx, event)
+}
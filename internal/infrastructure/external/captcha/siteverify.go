@@ -0,0 +1,87 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+)
+
+const providerName = "captcha"
+
+// SiteVerifyProvider checks CAPTCHA responses against a siteverify
+// endpoint. reCAPTCHA, hCaptcha and Cloudflare Turnstile share the protocol:
+// a form post of secret, response and remoteip answered with a JSON
+// success flag.
+type SiteVerifyProvider struct {
+	verifyURL string
+	siteKey   string
+	secret    string
+	client    *http.Client
+}
+
+var _ services.ChallengeProvider = (*SiteVerifyProvider)(nil)
+
+func NewSiteVerifyProvider(verifyURL, siteKey, secret string, timeout time.Duration) *SiteVerifyProvider {
+	return &SiteVerifyProvider{
+		verifyURL: verifyURL,
+		siteKey:   siteKey,
+		secret:    secret,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *SiteVerifyProvider) Name() string {
+	return providerName
+}
+
+// Issue only tells the client which widget to render; the CAPTCHA service
+// generates the puzzle itself.
+func (p *SiteVerifyProvider) Issue(ctx context.Context) (services.Challenge, error) {
+	return services.Challenge{Provider: providerName, SiteKey: p.siteKey}, nil
+}
+
+func (p *SiteVerifyProvider) Verify(ctx context.Context, response, remoteIP string) error {
+	form := url.Values{
+		"secret":   {p.secret},
+		"response": {response},
+	}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to build captcha request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach captcha service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("captcha service returned %d: %s", resp.StatusCode, body)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode captcha response: %w", err)
+	}
+	if !result.Success {
+		return domain.ErrChallengeFailed
+	}
+	return nil
+}
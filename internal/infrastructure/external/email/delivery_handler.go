@@ -0,0 +1,94 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/events"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// DeliveryHandler renders and sends queued email.requested events. It
+// returns an error for transient failures so the outbox keeps the message
+// and retries it; permanent failures are logged and dropped.
+type DeliveryHandler struct {
+	renderer *Renderer
+	sender   Sender
+	from     string
+	fromName string
+	logger   logging.Logger
+}
+
+var _ messaging.EventHandler = (*DeliveryHandler)(nil)
+
+func NewDeliveryHandler(renderer *Renderer, sender Sender, from, fromName string, logger logging.Logger) *DeliveryHandler {
+	return &DeliveryHandler{
+		renderer: renderer,
+		sender:   sender,
+		from:     from,
+		fromName: fromName,
+		logger:   logger.With(zap.String("handler", "email_delivery")),
+	}
+}
+
+func (h *DeliveryHandler) CanHandle(eventName string) bool {
+	return eventName == events.EmailRequestedEventName
+}
+
+func (h *DeliveryHandler) Handle(ctx context.Context, event events.DomainEvent) error {
+	var payload events.EmailRequestedPayload
+	if err := json.Unmarshal(event.Payload(), &payload); err != nil {
+		h.logger.Error(ctx, "Dropping malformed email event",
+			zap.Error(err),
+			zap.String("event_id", event.EventID().String()),
+		)
+		return nil
+	}
+
+	err := h.deliver(ctx, payload)
+	if err == nil {
+		h.logger.Info(ctx, "Email sent",
+			zap.String("template", payload.Template),
+			zap.String("event_id", event.EventID().String()),
+		)
+		return nil
+	}
+
+	if IsPermanent(err) {
+		h.logger.Error(ctx, "Dropping undeliverable email",
+			zap.Error(err),
+			zap.String("template", payload.Template),
+			zap.String("event_id", event.EventID().String()),
+		)
+		return nil
+	}
+	return err
+}
+
+func (h *DeliveryHandler) deliver(ctx context.Context, payload events.EmailRequestedPayload) error {
+	rendered, err := h.renderer.Render(payload.Template, payload.Locale, payload.Data)
+	if err != nil {
+		return err
+	}
+
+	subject := payload.Subject
+	if rendered.Subject != "" {
+		subject = rendered.Subject
+	}
+
+	if err := h.sender.Send(ctx, Message{
+		From:     h.from,
+		FromName: h.fromName,
+		To:       payload.To,
+		Subject:  subject,
+		Text:     rendered.Text,
+		HTML:     rendered.HTML,
+	}); err != nil {
+		return fmt.Errorf("failed to send %s email: %w", payload.Template, err)
+	}
+	return nil
+}
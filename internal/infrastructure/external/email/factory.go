@@ -0,0 +1,21 @@
+package email
+
+import (
+	"fmt"
+
+	"authentication/shared/config"
+)
+
+// NewSender builds the configured sender. For the "mailbox" provider the
+// returned Mailbox is also non-nil so it can back the /dev/mailbox endpoint.
+func NewSender(cfg config.EmailConfig) (Sender, *Mailbox, error) {
+	switch cfg.Provider {
+	case "smtp":
+		return NewSMTPSender(cfg.Host, cfg.Port, cfg.Username, cfg.Password, cfg.TLSMode, cfg.Timeout), nil, nil
+	case "mailbox":
+		mailbox := NewMailbox(cfg.MailboxDir)
+		return mailbox, mailbox, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown email provider %q", cfg.Provider)
+	}
+}
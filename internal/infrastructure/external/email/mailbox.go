@@ -0,0 +1,130 @@
+package email
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+)
+
+// Mailbox stores messages as JSON files in a directory instead of sending
+// them, so local development and end-to-end tests can read back OTPs and
+// links, either from disk or through the /dev/mailbox endpoint.
+type Mailbox struct {
+	dir string
+	mu  sync.Mutex
+}
+
+var (
+	_ Sender           = (*Mailbox)(nil)
+	_ services.Mailbox = (*Mailbox)(nil)
+)
+
+func NewMailbox(dir string) *Mailbox {
+	return &Mailbox{dir: dir}
+}
+
+func (m *Mailbox) Send(ctx context.Context, message Message) error {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	now := time.Now().UTC()
+
+	stored := services.CapturedEmail{
+		ID:      fmt.Sprintf("%d-%s", now.UnixNano(), hex.EncodeToString(b)),
+		From:    message.From,
+		To:      message.To,
+		Subject: message.Subject,
+		Text:    message.Text,
+		HTML:    message.HTML,
+		SentAt:  now,
+	}
+	data, err := json.MarshalIndent(stored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode mailbox message: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := os.MkdirAll(m.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create mailbox directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(m.dir, stored.ID+".json"), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write mailbox message: %w", err)
+	}
+	return nil
+}
+
+// List returns captured messages, newest first. A non-empty to keeps only
+// messages for that recipient (case-insensitive).
+func (m *Mailbox) List(ctx context.Context, to string, limit int) ([]services.CapturedEmail, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries, err := os.ReadDir(m.dir)
+	if os.IsNotExist(err) {
+		return []services.CapturedEmail{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mailbox: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+
+	messages := make([]services.CapturedEmail, 0)
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(m.dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mailbox message: %w", err)
+		}
+		var msg services.CapturedEmail
+		if err := json.Unmarshal(data, &msg); err != nil {
+			continue
+		}
+		if to != "" && !strings.EqualFold(msg.To, to) {
+			continue
+		}
+		messages = append(messages, msg)
+		if limit > 0 && len(messages) == limit {
+			break
+		}
+	}
+	return messages, nil
+}
+
+// Clear removes all captured messages.
+func (m *Mailbox) Clear(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries, err := os.ReadDir(m.dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read mailbox: %w", err)
+	}
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), ".json") {
+			if err := os.Remove(filepath.Join(m.dir, e.Name())); err != nil {
+				return fmt.Errorf("failed to clear mailbox: %w", err)
+			}
+		}
+	}
+	return nil
+}
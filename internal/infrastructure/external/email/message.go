@@ -0,0 +1,40 @@
+package email
+
+import (
+	"context"
+	"errors"
+)
+
+// Message is a rendered email ready for delivery.
+type Message struct {
+	From     string
+	FromName string
+	To       string
+	Subject  string
+	Text     string
+	HTML     string
+}
+
+// Sender delivers rendered messages.
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// permanentError marks failures that retrying will not fix, such as a
+// missing template or a rejected recipient.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err should not be retried.
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
@@ -0,0 +1,128 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*
+var embeddedTemplates embed.FS
+
+// Renderer turns a template name, locale and data into a message body.
+// Each template has an HTML and a text variant, named
+// <name>.<locale>.html / .txt with a lower-case locale such as "pt-br",
+// and <name>.html / .txt as the locale-independent fallback. The text
+// variant may define a "subject" block to localise the subject line.
+type Renderer struct {
+	override      fs.FS
+	defaultLocale string
+}
+
+// NewRenderer builds a renderer over the embedded templates. Files in dir,
+// when set, take precedence so deployments can restyle mails without a
+// rebuild.
+func NewRenderer(dir, defaultLocale string) *Renderer {
+	r := &Renderer{defaultLocale: defaultLocale}
+	if dir != "" {
+		r.override = os.DirFS(dir)
+	}
+	return r
+}
+
+// Rendered is the output of a template.
+type Rendered struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+func (r *Renderer) Render(name, locale string, data map[string]interface{}) (Rendered, error) {
+	if strings.ContainsAny(name, "./\\") {
+		return Rendered{}, permanent(fmt.Errorf("invalid template name %q", name))
+	}
+
+	var out Rendered
+
+	if src, ok := r.lookup(name, locale, "html"); ok {
+		tmpl, err := htmltemplate.New(name).Parse(src)
+		if err != nil {
+			return Rendered{}, permanent(fmt.Errorf("failed to parse %s html template: %w", name, err))
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return Rendered{}, permanent(fmt.Errorf("failed to render %s html template: %w", name, err))
+		}
+		out.HTML = buf.String()
+	}
+
+	if src, ok := r.lookup(name, locale, "txt"); ok {
+		tmpl, err := texttemplate.New(name).Parse(src)
+		if err != nil {
+			return Rendered{}, permanent(fmt.Errorf("failed to parse %s text template: %w", name, err))
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return Rendered{}, permanent(fmt.Errorf("failed to render %s text template: %w", name, err))
+		}
+		out.Text = strings.TrimSpace(buf.String()) + "\n"
+
+		if subject := tmpl.Lookup("subject"); subject != nil {
+			buf.Reset()
+			if err := subject.Execute(&buf, data); err != nil {
+				return Rendered{}, permanent(fmt.Errorf("failed to render %s subject: %w", name, err))
+			}
+			out.Subject = strings.TrimSpace(buf.String())
+		}
+	}
+
+	if out.HTML == "" && out.Text == "" {
+		return Rendered{}, permanent(fmt.Errorf("email template %q not found", name))
+	}
+	return out, nil
+}
+
+// lookup returns the most specific template source for the locale, falling
+// back from "pt-BR" to "pt", then to the default locale, then to the
+// unlocalised file.
+func (r *Renderer) lookup(name, locale, ext string) (string, bool) {
+	for _, candidate := range r.candidates(name, locale, ext) {
+		if r.override != nil {
+			if src, err := fs.ReadFile(r.override, candidate); err == nil {
+				return string(src), true
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+		}
+		if src, err := fs.ReadFile(embeddedTemplates, "templates/"+candidate); err == nil {
+			return string(src), true
+		}
+	}
+	return "", false
+}
+
+func (r *Renderer) candidates(name, locale, ext string) []string {
+	var locales []string
+	for _, l := range []string{locale, r.defaultLocale} {
+		l = strings.ToLower(strings.ReplaceAll(l, "_", "-"))
+		if l == "" {
+			continue
+		}
+		locales = append(locales, l)
+		if i := strings.Index(l, "-"); i > 0 {
+			locales = append(locales, l[:i])
+		}
+	}
+
+	files := make([]string, 0, len(locales)+1)
+	for _, l := range locales {
+		files = append(files, name+"."+l+"."+ext)
+	}
+	return append(files, name+"."+ext)
+}
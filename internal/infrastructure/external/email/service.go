@@ -0,0 +1,95 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/events"
+)
+
+const (
+	verificationTemplate  = "email_verification"
+	passwordResetTemplate = "password_reset"
+	welcomeTemplate       = "welcome"
+)
+
+// OutboxService implements services.EmailService by queueing an
+// email.requested event in the outbox. Delivery happens in the outbox
+// processor through DeliveryHandler, so a slow or failing SMTP server
+// neither blocks the request nor loses the message.
+type OutboxService struct {
+	outbox persistence.OutboxRepository
+	appURL string
+}
+
+var _ services.EmailService = (*OutboxService)(nil)
+
+func NewOutboxService(outbox persistence.OutboxRepository, appURL string) *OutboxService {
+	return &OutboxService{outbox: outbox, appURL: strings.TrimRight(appURL, "/")}
+}
+
+func (s *OutboxService) SendEmail(ctx context.Context, input services.SendEmailInput) error {
+	if input.To == "" || input.Template == "" {
+		return fmt.Errorf("email recipient and template are required")
+	}
+
+	event := events.NewEmailRequestedEvent(input.To, input.Subject, input.Template, input.Locale, input.Data)
+
+	msg := &persistence.OutboxMessage{
+		ID:          event.EventID().String(),
+		EventType:   event.EventName(),
+		AggregateID: event.AggregateID(),
+		Payload:     event.Payload(),
+		Metadata:    event.Metadata(),
+		OccurredAt:  event.OccurredAt().Unix(),
+	}
+
+	if err := s.outbox.Save(ctx, msg); err != nil {
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+	return nil
+}
+
+func (s *OutboxService) SendVerificationEmail(ctx context.Context, email, username, token string) error {
+	return s.SendEmail(ctx, services.SendEmailInput{
+		To:       email,
+		Subject:  "Verify your email address",
+		Template: verificationTemplate,
+		Data: map[string]interface{}{
+			"username": username,
+			"link":     s.link("/verify-email", token),
+		},
+	})
+}
+
+func (s *OutboxService) SendPasswordResetEmail(ctx context.Context, email, username, token string) error {
+	return s.SendEmail(ctx, services.SendEmailInput{
+		To:       email,
+		Subject:  "Reset your password",
+		Template: passwordResetTemplate,
+		Data: map[string]interface{}{
+			"username": username,
+			"link":     s.link("/reset-password", token),
+		},
+	})
+}
+
+func (s *OutboxService) SendWelcomeEmail(ctx context.Context, email, username string) error {
+	return s.SendEmail(ctx, services.SendEmailInput{
+		To:       email,
+		Subject:  "Welcome",
+		Template: welcomeTemplate,
+		Data: map[string]interface{}{
+			"username": username,
+			"link":     s.appURL,
+		},
+	})
+}
+
+func (s *OutboxService) link(path, token string) string {
+	return s.appURL + path + "?token=" + url.QueryEscape(token)
+}
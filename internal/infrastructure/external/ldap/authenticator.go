@@ -0,0 +1,214 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"go.uber.org/zap"
+)
+
+const loginPlaceholder = "{login}"
+
+// Authenticator finds the user's entry with the service account and then
+// binds as that entry with the supplied password. Group membership is read
+// from GroupAttribute (memberOf on Active Directory) and mapped to a role.
+type Authenticator struct {
+	cfg    config.LDAPConfig
+	pool   *connPool
+	logger logging.Logger
+}
+
+var _ services.LDAPAuthenticator = (*Authenticator)(nil)
+
+// New returns the authenticator for cfg, or a nil interface when LDAP is
+// disabled. Handlers test the interface against nil, so a nil *Authenticator
+// must never be passed in its place.
+func New(cfg config.LDAPConfig, logger logging.Logger) (services.LDAPAuthenticator, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	return NewAuthenticator(cfg, logger)
+}
+
+func NewAuthenticator(cfg config.LDAPConfig, logger logging.Logger) (*Authenticator, error) {
+	pool, err := newConnPool(
+		cfg.URL,
+		cfg.StartTLS,
+		cfg.InsecureSkipVerify,
+		cfg.BindDN,
+		cfg.BindPassword,
+		cfg.PoolSize,
+		cfg.Timeout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authenticator{
+		cfg:    cfg,
+		pool:   pool,
+		logger: logger.With(zap.String("service", "ldap")),
+	}, nil
+}
+
+func (a *Authenticator) JITProvisioning() bool {
+	return a.cfg.JITProvisioning
+}
+
+// Close closes the pooled connections.
+func (a *Authenticator) Close() {
+	a.pool.close()
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context, login, password string) (*services.LDAPIdentity, error) {
+	login = strings.TrimSpace(login)
+	// An empty password would be an unauthenticated bind, which many
+	// servers accept for any DN.
+	if login == "" || password == "" {
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	conn, err := a.pool.get()
+	if err != nil {
+		a.logger.Error(ctx, "LDAP directory unreachable", zap.Error(err))
+		return nil, domain.ErrLDAPUnavailable
+	}
+
+	entry, err := a.findEntry(conn, login)
+	if isNetworkError(err) {
+		// The server may have dropped an idle connection; retry once on a
+		// fresh one.
+		conn.Close()
+		if conn, err = a.pool.dial(); err != nil {
+			a.logger.Error(ctx, "LDAP directory unreachable", zap.Error(err))
+			return nil, domain.ErrLDAPUnavailable
+		}
+		entry, err = a.findEntry(conn, login)
+	}
+	if err != nil {
+		a.pool.put(conn, false)
+		if errors.Is(err, domain.ErrInvalidCredentials) {
+			return nil, err
+		}
+		a.logger.Error(ctx, "LDAP user search failed", zap.Error(err))
+		return nil, domain.ErrLDAPUnavailable
+	}
+
+	err = conn.Bind(entry.DN, password)
+	a.pool.put(conn, true)
+	if err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, domain.ErrInvalidCredentials
+		}
+		a.logger.Error(ctx, "LDAP user bind failed",
+			zap.String("dn", entry.DN),
+			zap.Error(err),
+		)
+		return nil, domain.ErrLDAPUnavailable
+	}
+
+	return a.toIdentity(entry), nil
+}
+
+// findEntry returns the single entry matching login. No match and several
+// matches are both reported as invalid credentials.
+func (a *Authenticator) findEntry(conn *goldap.Conn, login string) (*goldap.Entry, error) {
+	filter := strings.ReplaceAll(a.cfg.UserFilter, loginPlaceholder, goldap.EscapeFilter(login))
+
+	attributes := []string{a.cfg.EmailAttribute}
+	for _, attr := range []string{
+		a.cfg.FirstNameAttribute,
+		a.cfg.LastNameAttribute,
+		a.cfg.UsernameAttribute,
+		a.cfg.GroupAttribute,
+	} {
+		if attr != "" {
+			attributes = append(attributes, attr)
+		}
+	}
+
+	result, err := conn.Search(goldap.NewSearchRequest(
+		a.cfg.SearchBaseDN,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		2,
+		int(a.cfg.Timeout.Seconds()),
+		false,
+		filter,
+		attributes,
+		nil,
+	))
+	if err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+			return nil, domain.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+	if len(result.Entries) != 1 {
+		return nil, domain.ErrInvalidCredentials
+	}
+	return result.Entries[0], nil
+}
+
+func (a *Authenticator) toIdentity(entry *goldap.Entry) *services.LDAPIdentity {
+	identity := &services.LDAPIdentity{
+		DN:    entry.DN,
+		Email: entry.GetEqualFoldAttributeValue(a.cfg.EmailAttribute),
+		Role:  a.cfg.DefaultRole,
+	}
+	if a.cfg.FirstNameAttribute != "" {
+		identity.FirstName = entry.GetEqualFoldAttributeValue(a.cfg.FirstNameAttribute)
+	}
+	if a.cfg.LastNameAttribute != "" {
+		identity.LastName = entry.GetEqualFoldAttributeValue(a.cfg.LastNameAttribute)
+	}
+	if a.cfg.UsernameAttribute != "" {
+		identity.Username = entry.GetEqualFoldAttributeValue(a.cfg.UsernameAttribute)
+	}
+	if a.cfg.GroupAttribute != "" {
+		identity.Groups = entry.GetEqualFoldAttributeValues(a.cfg.GroupAttribute)
+	}
+
+	if role, ok := a.mapRole(identity.Groups); ok {
+		identity.Role = role
+	}
+	return identity
+}
+
+// mapRole returns the role of the first configured mapping whose group the
+// user is a member of. Group DNs compare case-insensitively.
+func (a *Authenticator) mapRole(groups []string) (string, bool) {
+	for _, mapping := range a.cfg.GroupRoleMappings {
+		for _, group := range groups {
+			if sameDN(mapping.GroupDN, group) {
+				return mapping.Role, true
+			}
+		}
+	}
+	return "", false
+}
+
+// sameDN compares DNs structurally, so spacing and attribute order do not
+// matter. Values compare case-insensitively as Active Directory does.
+func sameDN(a, b string) bool {
+	parsedA, errA := goldap.ParseDN(strings.ToLower(a))
+	parsedB, errB := goldap.ParseDN(strings.ToLower(b))
+	if errA != nil || errB != nil {
+		return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+	}
+	return parsedA.Equal(parsedB)
+}
+
+func isNetworkError(err error) bool {
+	return err != nil && goldap.IsErrorWithCode(err, goldap.ErrorNetwork)
+}
@@ -0,0 +1,276 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"authentication/internal/domain"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+)
+
+const (
+	testServiceDN       = "cn=svc,dc=example,dc=com"
+	testServicePassword = "svc-secret"
+	testAdminsGroup     = "cn=admins,ou=groups,dc=example,dc=com"
+	testStaffGroup      = "cn=staff,ou=groups,dc=example,dc=com"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(context.Context, string, ...zap.Field) {}
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Warn(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+func (nopLogger) Fatal(context.Context, string, ...zap.Field) {}
+func (l nopLogger) With(...zap.Field) logging.Logger          { return l }
+
+func testEntries() []testEntry {
+	return []testEntry{
+		{
+			dn:       "uid=alice,ou=people,dc=example,dc=com",
+			password: "alice-secret",
+			attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"alice"},
+				"mail":        {"alice@example.com"},
+				"givenName":   {"Alice"},
+				"sn":          {"Liddell"},
+				"memberOf":    {testStaffGroup, testAdminsGroup},
+			},
+		},
+		{
+			dn:       "uid=bob,ou=people,dc=example,dc=com",
+			password: "bob-secret",
+			attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"bob"},
+				"mail":        {"bob@example.com"},
+			},
+		},
+	}
+}
+
+func newTestAuthenticator(t *testing.T, directory *testDirectory) *Authenticator {
+	t.Helper()
+
+	authenticator, err := NewAuthenticator(config.LDAPConfig{
+		Enabled:            true,
+		URL:                directory.url(),
+		BindDN:             testServiceDN,
+		BindPassword:       testServicePassword,
+		SearchBaseDN:       "ou=people,dc=example,dc=com",
+		UserFilter:         "(&(objectClass=person)(uid={login}))",
+		EmailAttribute:     "mail",
+		FirstNameAttribute: "givenName",
+		LastNameAttribute:  "sn",
+		UsernameAttribute:  "uid",
+		GroupAttribute:     "memberOf",
+		GroupRoleMappings: []config.LDAPGroupRoleMapping{
+			{GroupDN: "CN=Admins, OU=Groups, DC=Example, DC=Com", Role: "admin"},
+			{GroupDN: testStaffGroup, Role: "staff"},
+		},
+		DefaultRole: "user",
+		PoolSize:    1,
+		Timeout:     5 * time.Second,
+	}, nopLogger{})
+	if err != nil {
+		t.Fatalf("NewAuthenticator() error = %v", err)
+	}
+	t.Cleanup(authenticator.Close)
+	return authenticator
+}
+
+func TestAuthenticate(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword, testEntries()...)
+	authenticator := newTestAuthenticator(t, directory)
+
+	tests := []struct {
+		name     string
+		login    string
+		password string
+		wantErr  error
+		wantDN   string
+	}{
+		{
+			name:     "valid credentials",
+			login:    "alice",
+			password: "alice-secret",
+			wantDN:   "uid=alice,ou=people,dc=example,dc=com",
+		},
+		{
+			name:     "login is trimmed",
+			login:    "  bob ",
+			password: "bob-secret",
+			wantDN:   "uid=bob,ou=people,dc=example,dc=com",
+		},
+		{
+			name:     "wrong password",
+			login:    "alice",
+			password: "bob-secret",
+			wantErr:  domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "unknown user",
+			login:    "carol",
+			password: "alice-secret",
+			wantErr:  domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "empty password",
+			login:    "alice",
+			password: "",
+			wantErr:  domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "empty login",
+			login:    " ",
+			password: "alice-secret",
+			wantErr:  domain.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := authenticator.Authenticate(context.Background(), tt.login, tt.password)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if identity.DN != tt.wantDN {
+				t.Errorf("Authenticate() DN = %q, want %q", identity.DN, tt.wantDN)
+			}
+		})
+	}
+}
+
+func TestAuthenticateIdentity(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword, testEntries()...)
+	authenticator := newTestAuthenticator(t, directory)
+
+	identity, err := authenticator.Authenticate(context.Background(), "alice", "alice-secret")
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	if identity.Email != "alice@example.com" {
+		t.Errorf("Email = %q, want %q", identity.Email, "alice@example.com")
+	}
+	if identity.Username != "alice" {
+		t.Errorf("Username = %q, want %q", identity.Username, "alice")
+	}
+	if identity.FirstName != "Alice" || identity.LastName != "Liddell" {
+		t.Errorf("name = %q %q, want %q %q", identity.FirstName, identity.LastName, "Alice", "Liddell")
+	}
+	if want := []string{testStaffGroup, testAdminsGroup}; !reflect.DeepEqual(identity.Groups, want) {
+		t.Errorf("Groups = %v, want %v", identity.Groups, want)
+	}
+	// The first configured mapping wins, and its DN differs from the
+	// directory's in case and spacing only.
+	if identity.Role != "admin" {
+		t.Errorf("Role = %q, want %q", identity.Role, "admin")
+	}
+}
+
+func TestAuthenticateEscapesLogin(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword, testEntries()...)
+	authenticator := newTestAuthenticator(t, directory)
+
+	// Unescaped, each of these would match alice and the bind with her
+	// password would succeed.
+	logins := []string{"a*", "*", "alice)(uid=*", "*)(objectClass=*"}
+	for _, login := range logins {
+		t.Run(login, func(t *testing.T) {
+			_, err := authenticator.Authenticate(context.Background(), login, "alice-secret")
+			if !errors.Is(err, domain.ErrInvalidCredentials) {
+				t.Fatalf("Authenticate(%q) error = %v, want %v", login, err, domain.ErrInvalidCredentials)
+			}
+		})
+	}
+
+	for _, filter := range directory.searchFilters() {
+		if filter == "(&(objectClass=person)(uid=a*))" {
+			t.Fatalf("login reached the directory unescaped: %s", filter)
+		}
+	}
+	for _, dn := range directory.bindLog() {
+		if dn != testServiceDN {
+			t.Fatalf("unexpected bind as %q", dn)
+		}
+	}
+}
+
+func TestMapRole(t *testing.T) {
+	authenticator := &Authenticator{cfg: config.LDAPConfig{
+		GroupRoleMappings: []config.LDAPGroupRoleMapping{
+			{GroupDN: "cn=admins,ou=groups,dc=example,dc=com", Role: "admin"},
+			{GroupDN: "cn=staff,ou=groups,dc=example,dc=com", Role: "staff"},
+		},
+	}}
+
+	tests := []struct {
+		name     string
+		groups   []string
+		wantRole string
+		wantOK   bool
+	}{
+		{
+			name:     "exact match",
+			groups:   []string{"cn=staff,ou=groups,dc=example,dc=com"},
+			wantRole: "staff",
+			wantOK:   true,
+		},
+		{
+			name:     "case and spacing are ignored",
+			groups:   []string{"CN=Staff, OU=Groups, DC=Example, DC=Com"},
+			wantRole: "staff",
+			wantOK:   true,
+		},
+		{
+			name:     "first mapping wins over group order",
+			groups:   []string{"cn=staff,ou=groups,dc=example,dc=com", "cn=admins,ou=groups,dc=example,dc=com"},
+			wantRole: "admin",
+			wantOK:   true,
+		},
+		{
+			name:   "suffix of a mapped group does not match",
+			groups: []string{"ou=groups,dc=example,dc=com"},
+		},
+		{
+			name:   "no groups",
+			groups: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, ok := authenticator.mapRole(tt.groups)
+			if role != tt.wantRole || ok != tt.wantOK {
+				t.Errorf("mapRole() = (%q, %v), want (%q, %v)", role, ok, tt.wantRole, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAuthenticateDefaultRole(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword, testEntries()...)
+	authenticator := newTestAuthenticator(t, directory)
+
+	identity, err := authenticator.Authenticate(context.Background(), "bob", "bob-secret")
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if identity.Role != "user" {
+		t.Errorf("Role = %q, want %q", identity.Role, "user")
+	}
+}
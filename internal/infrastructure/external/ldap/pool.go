@@ -0,0 +1,155 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+var errPoolClosed = errors.New("ldap: connection pool is closed")
+
+// connPool keeps up to size idle connections bound as the service account.
+// A connection used for a user bind is re-bound as the service account
+// before it goes back, so a pooled connection never carries a user's
+// authorization.
+type connPool struct {
+	url          string
+	startTLS     bool
+	tlsConfig    *tls.Config
+	bindDN       string
+	bindPassword string
+	timeout      time.Duration
+
+	mu     sync.Mutex
+	idle   chan *goldap.Conn
+	closed bool
+}
+
+func newConnPool(
+	rawURL string,
+	startTLS, insecureSkipVerify bool,
+	bindDN, bindPassword string,
+	size int,
+	timeout time.Duration,
+) (*connPool, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: invalid url: %w", err)
+	}
+
+	return &connPool{
+		url: rawURL,
+		// StartTLS only applies to plain ldap://; ldaps:// is TLS from the
+		// first byte.
+		startTLS: startTLS && parsed.Scheme == "ldap",
+		tlsConfig: &tls.Config{
+			ServerName:         parsed.Hostname(),
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: insecureSkipVerify,
+		},
+		bindDN:       bindDN,
+		bindPassword: bindPassword,
+		timeout:      timeout,
+		idle:         make(chan *goldap.Conn, size),
+	}, nil
+}
+
+// get returns an idle connection or dials a new one. It fails once the pool
+// has been closed.
+func (p *connPool) get() (*goldap.Conn, error) {
+	for {
+		select {
+		case conn, ok := <-p.idle:
+			if !ok {
+				return nil, errPoolClosed
+			}
+			if conn.IsClosing() {
+				conn.Close()
+				continue
+			}
+			return conn, nil
+		default:
+			return p.dial()
+		}
+	}
+}
+
+// put re-binds conn as the service account when rebind is set and returns
+// it to the pool. Connections that fail to re-bind, or that do not fit, are
+// closed.
+func (p *connPool) put(conn *goldap.Conn, rebind bool) {
+	if conn.IsClosing() {
+		conn.Close()
+		return
+	}
+	if rebind {
+		if err := p.bindService(conn); err != nil {
+			conn.Close()
+			return
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		conn.Close()
+		return
+	}
+	select {
+	case p.idle <- conn:
+	default:
+		conn.Close()
+	}
+}
+
+func (p *connPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return
+	}
+	p.closed = true
+	close(p.idle)
+	for conn := range p.idle {
+		conn.Close()
+	}
+}
+
+func (p *connPool) dial() (*goldap.Conn, error) {
+	conn, err := goldap.DialURL(p.url,
+		goldap.DialWithDialer(&net.Dialer{Timeout: p.timeout}),
+		goldap.DialWithTLSConfig(p.tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: failed to connect: %w", err)
+	}
+	conn.SetTimeout(p.timeout)
+
+	if p.startTLS {
+		if err := conn.StartTLS(p.tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap: failed to start tls: %w", err)
+		}
+	}
+
+	if err := p.bindService(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("ldap: failed to bind service account: %w", err)
+	}
+	return conn, nil
+}
+
+// bindService binds as the configured service account, or anonymously when
+// none is configured.
+func (p *connPool) bindService(conn *goldap.Conn) error {
+	if p.bindDN == "" {
+		return conn.UnauthenticatedBind("")
+	}
+	return conn.Bind(p.bindDN, p.bindPassword)
+}
@@ -0,0 +1,81 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPoolRebindsAfterUserBind(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword, testEntries()...)
+	authenticator := newTestAuthenticator(t, directory)
+
+	// The directory refuses searches from anyone but the service account,
+	// so the second sign-in only works if the pooled connection was bound
+	// back after alice's bind.
+	for i := 0; i < 2; i++ {
+		if _, err := authenticator.Authenticate(context.Background(), "alice", "alice-secret"); err != nil {
+			t.Fatalf("Authenticate() #%d error = %v", i+1, err)
+		}
+	}
+
+	if got := directory.connections(); got != 1 {
+		t.Errorf("connections = %d, want 1", got)
+	}
+	alice := "uid=alice,ou=people,dc=example,dc=com"
+	want := []string{testServiceDN, alice, testServiceDN, alice, testServiceDN}
+	if got := directory.bindLog(); !reflect.DeepEqual(got, want) {
+		t.Errorf("binds = %v, want %v", got, want)
+	}
+}
+
+func TestPoolKeepsConnectionAfterFailedLookup(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword, testEntries()...)
+	authenticator := newTestAuthenticator(t, directory)
+
+	if _, err := authenticator.Authenticate(context.Background(), "carol", "secret"); err == nil {
+		t.Fatal("Authenticate() error = nil, want an error")
+	}
+	if _, err := authenticator.Authenticate(context.Background(), "bob", "bob-secret"); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	if got := directory.connections(); got != 1 {
+		t.Errorf("connections = %d, want 1", got)
+	}
+}
+
+func TestPoolGetAfterClose(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword)
+
+	pool, err := newConnPool(directory.url(), false, false, testServiceDN, testServicePassword, 1, 5*time.Second)
+	if err != nil {
+		t.Fatalf("newConnPool() error = %v", err)
+	}
+	conn, err := pool.get()
+	if err != nil {
+		t.Fatalf("get() error = %v", err)
+	}
+	pool.put(conn, false)
+	pool.close()
+
+	if _, err := pool.get(); !errors.Is(err, errPoolClosed) {
+		t.Fatalf("get() error = %v, want %v", err, errPoolClosed)
+	}
+}
+
+func TestPoolRejectsWrongServicePassword(t *testing.T) {
+	directory := newTestDirectory(t, testServiceDN, testServicePassword)
+
+	pool, err := newConnPool(directory.url(), false, false, testServiceDN, "wrong", 1, 5*time.Second)
+	if err != nil {
+		t.Fatalf("newConnPool() error = %v", err)
+	}
+	defer pool.close()
+
+	if _, err := pool.get(); err == nil {
+		t.Fatal("get() error = nil, want a bind error")
+	}
+}
@@ -0,0 +1,295 @@
+package ldap
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// testDirectory is a minimal in-process LDAP server: simple binds, subtree
+// searches and unbind, which is all the authenticator uses. Like most real
+// directories it only lets the service account search, so a connection
+// left bound as a user cannot look anyone up.
+type testDirectory struct {
+	listener        net.Listener
+	serviceDN       string
+	servicePassword string
+	entries         []testEntry
+
+	mu      sync.Mutex
+	binds   []string
+	filters []string
+	conns   int
+}
+
+type testEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+func newTestDirectory(t *testing.T, serviceDN, servicePassword string, entries ...testEntry) *testDirectory {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	d := &testDirectory{
+		listener:        listener,
+		serviceDN:       serviceDN,
+		servicePassword: servicePassword,
+		entries:         entries,
+	}
+	go d.serve()
+	t.Cleanup(func() { listener.Close() })
+	return d
+}
+
+func (d *testDirectory) url() string {
+	return "ldap://" + d.listener.Addr().String()
+}
+
+// bindLog returns the DNs of every successful bind, in order.
+func (d *testDirectory) bindLog() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.binds...)
+}
+
+// searchFilters returns every search filter received, in string form.
+func (d *testDirectory) searchFilters() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.filters...)
+}
+
+func (d *testDirectory) connections() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.conns
+}
+
+func (d *testDirectory) serve() {
+	for {
+		conn, err := d.listener.Accept()
+		if err != nil {
+			return
+		}
+		d.mu.Lock()
+		d.conns++
+		d.mu.Unlock()
+		go d.handle(conn)
+	}
+}
+
+func (d *testDirectory) handle(conn net.Conn) {
+	defer conn.Close()
+
+	boundDN := ""
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			// The client hung up, usually mid-teardown.
+			return
+		}
+
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			boundDN = d.bind(op)
+			code := int64(goldap.LDAPResultSuccess)
+			if boundDN == "" {
+				code = goldap.LDAPResultInvalidCredentials
+			}
+			d.write(conn, result(messageID, goldap.ApplicationBindResponse, code))
+		case goldap.ApplicationSearchRequest:
+			d.search(conn, messageID, op, boundDN)
+		case goldap.ApplicationUnbindRequest:
+			return
+		default:
+			// Anything else is unsupported; drop the connection so the
+			// client sees a network error.
+			return
+		}
+	}
+}
+
+// bind returns the DN now bound, or "" when the credentials are wrong.
+func (d *testDirectory) bind(op *ber.Packet) string {
+	dn := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+
+	ok := dn == d.serviceDN && password == d.servicePassword
+	for _, entry := range d.entries {
+		if strings.EqualFold(entry.dn, dn) && entry.password == password {
+			ok = true
+		}
+	}
+	if !ok || password == "" {
+		return ""
+	}
+
+	d.mu.Lock()
+	d.binds = append(d.binds, dn)
+	d.mu.Unlock()
+	return dn
+}
+
+func (d *testDirectory) search(conn net.Conn, messageID int64, op *ber.Packet, boundDN string) {
+	if boundDN != d.serviceDN {
+		d.write(conn, result(messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultInsufficientAccessRights))
+		return
+	}
+
+	base := strings.ToLower(op.Children[0].Value.(string))
+	sizeLimit := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+	var requested []string
+	for _, attr := range op.Children[7].Children {
+		requested = append(requested, attr.Value.(string))
+	}
+
+	if decompiled, err := goldap.DecompileFilter(filter); err == nil {
+		d.mu.Lock()
+		d.filters = append(d.filters, decompiled)
+		d.mu.Unlock()
+	}
+
+	sent := int64(0)
+	for _, entry := range d.entries {
+		if !strings.HasSuffix(strings.ToLower(entry.dn), base) || !matchFilter(filter, entry) {
+			continue
+		}
+		if sizeLimit > 0 && sent == sizeLimit {
+			d.write(conn, result(messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultSizeLimitExceeded))
+			return
+		}
+		d.write(conn, searchEntry(messageID, entry, requested))
+		sent++
+	}
+	d.write(conn, result(messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+}
+
+func (d *testDirectory) write(conn net.Conn, packet *ber.Packet) {
+	// A failed write means the client is gone; its next read fails.
+	_, _ = conn.Write(packet.Bytes())
+}
+
+// matchFilter evaluates the filter forms the authenticator can produce.
+func matchFilter(filter *ber.Packet, entry testEntry) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(child, entry) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, child := range filter.Children {
+			if matchFilter(child, entry) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return !matchFilter(filter.Children[0], entry)
+	case goldap.FilterEqualityMatch:
+		want := filter.Children[1].Value.(string)
+		for _, value := range entry.values(filter.Children[0].Value.(string)) {
+			if strings.EqualFold(value, want) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterSubstrings:
+		for _, value := range entry.values(filter.Children[0].Value.(string)) {
+			if matchSubstrings(strings.ToLower(value), filter.Children[1].Children) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		return len(entry.values(filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func matchSubstrings(value string, parts []*ber.Packet) bool {
+	for _, part := range parts {
+		s := strings.ToLower(part.Data.String())
+		switch part.Tag {
+		case goldap.FilterSubstringsInitial:
+			if !strings.HasPrefix(value, s) {
+				return false
+			}
+			value = value[len(s):]
+		case goldap.FilterSubstringsAny:
+			i := strings.Index(value, s)
+			if i < 0 {
+				return false
+			}
+			value = value[i+len(s):]
+		case goldap.FilterSubstringsFinal:
+			if !strings.HasSuffix(value, s) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (e testEntry) values(attribute string) []string {
+	for name, values := range e.attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+func result(messageID int64, application ber.Tag, code int64) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, application, nil, "Response")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	return envelope(messageID, op)
+}
+
+func searchEntry(messageID int64, entry testEntry, requested []string) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "objectName"))
+
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for _, name := range requested {
+		values := entry.values(name)
+		if len(values) == 0 {
+			continue
+		}
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "value"))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+	op.AppendChild(attributes)
+	return envelope(messageID, op)
+}
+
+func envelope(messageID int64, op *ber.Packet) *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	packet.AppendChild(op)
+	return packet
+}
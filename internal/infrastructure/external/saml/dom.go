@@ -0,0 +1,318 @@
+package saml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	nsSAMLAssertion = "urn:oasis:names:tc:SAML:2.0:assertion"
+	nsSAMLProtocol  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	nsSAMLMetadata  = "urn:oasis:names:tc:SAML:2.0:metadata"
+	nsXMLDSig       = "http://www.w3.org/2000/09/xmldsig#"
+	nsExcC14N       = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	nsXML           = "http://www.w3.org/XML/1998/namespace"
+)
+
+// element is a minimal DOM node. encoding/xml resolves prefixes away while
+// decoding, but signature verification has to canonicalize the document
+// exactly as it was written, so prefixes and namespace declarations are
+// kept as they appear.
+type element struct {
+	parent  *element
+	prefix  string
+	local   string
+	attrs   []xml.Attr
+	nsDecls map[string]string
+	// nodes holds child *element values and string character data.
+	nodes []interface{}
+}
+
+// parseDocument builds the tree for a complete document. DTDs are refused
+// outright; SAML never needs them and they enable entity expansion attacks.
+func parseDocument(data []byte) (*element, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+
+	var root, cur *element
+	for {
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			el := &element{parent: cur, prefix: t.Name.Space, local: t.Name.Local, nsDecls: map[string]string{}}
+			for _, a := range t.Attr {
+				switch {
+				case a.Name.Space == "" && a.Name.Local == "xmlns":
+					el.nsDecls[""] = a.Value
+				case a.Name.Space == "xmlns":
+					el.nsDecls[a.Name.Local] = a.Value
+				default:
+					el.attrs = append(el.attrs, a)
+				}
+			}
+			if cur == nil {
+				if root != nil {
+					return nil, errors.New("document has more than one root element")
+				}
+				root = el
+			} else {
+				cur.nodes = append(cur.nodes, el)
+			}
+			cur = el
+		case xml.EndElement:
+			if cur == nil || cur.prefix != t.Name.Space || cur.local != t.Name.Local {
+				return nil, fmt.Errorf("unexpected end element %s", t.Name.Local)
+			}
+			cur = cur.parent
+		case xml.CharData:
+			if cur != nil {
+				cur.nodes = append(cur.nodes, string(t))
+			}
+		case xml.Directive:
+			return nil, errors.New("xml directives are not allowed")
+		}
+	}
+
+	if root == nil || cur != nil {
+		return nil, errors.New("incomplete xml document")
+	}
+	return root, nil
+}
+
+// lookupNamespace resolves prefix against the declarations in scope. The
+// default namespace is always in scope, possibly as the empty namespace.
+func (e *element) lookupNamespace(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return nsXML, true
+	}
+	for el := e; el != nil; el = el.parent {
+		if uri, ok := el.nsDecls[prefix]; ok {
+			return uri, true
+		}
+	}
+	return "", prefix == ""
+}
+
+func (e *element) namespace() string {
+	uri, _ := e.lookupNamespace(e.prefix)
+	return uri
+}
+
+func (e *element) is(namespace, local string) bool {
+	return e.local == local && e.namespace() == namespace
+}
+
+// attr returns the value of an unqualified attribute.
+func (e *element) attr(name string) string {
+	for _, a := range e.attrs {
+		if a.Name.Space == "" && a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (e *element) childrenNamed(namespace, local string) []*element {
+	var matches []*element
+	for _, n := range e.nodes {
+		if child, ok := n.(*element); ok && child.is(namespace, local) {
+			matches = append(matches, child)
+		}
+	}
+	return matches
+}
+
+func (e *element) child(namespace, local string) *element {
+	for _, n := range e.nodes {
+		if child, ok := n.(*element); ok && child.is(namespace, local) {
+			return child
+		}
+	}
+	return nil
+}
+
+// path follows a chain of direct children, all in namespace.
+func (e *element) path(namespace string, locals ...string) *element {
+	cur := e
+	for _, local := range locals {
+		if cur = cur.child(namespace, local); cur == nil {
+			return nil
+		}
+	}
+	return cur
+}
+
+func (e *element) text() string {
+	var sb strings.Builder
+	for _, n := range e.nodes {
+		if s, ok := n.(string); ok {
+			sb.WriteString(s)
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+func qualifiedName(prefix, local string) string {
+	if prefix == "" {
+		return local
+	}
+	return prefix + ":" + local
+}
+
+// canonicalize serializes e with Exclusive XML Canonicalization 1.0,
+// omitting comments. skip, when set, is left out along with its subtree,
+// which is how the enveloped-signature transform is applied. inclusive is
+// the InclusiveNamespaces PrefixList, with "#default" for the default
+// namespace.
+func canonicalize(e *element, skip *element, inclusive []string) []byte {
+	c := &canonicalizer{skip: skip, inclusive: inclusive}
+	c.writeElement(e, map[string]string{})
+	return c.buf.Bytes()
+}
+
+type canonicalizer struct {
+	buf       bytes.Buffer
+	skip      *element
+	inclusive []string
+}
+
+type namespaceDecl struct {
+	prefix string
+	uri    string
+}
+
+type canonicalAttr struct {
+	namespace string
+	name      string
+	value     string
+}
+
+func (c *canonicalizer) writeElement(e *element, rendered map[string]string) {
+	// Only namespaces visibly used by the element or its attributes are
+	// output, plus any named in the inclusive prefix list.
+	utilized := map[string]bool{e.prefix: true}
+	for _, a := range e.attrs {
+		if a.Name.Space != "" && a.Name.Space != "xml" {
+			utilized[a.Name.Space] = true
+		}
+	}
+	for _, prefix := range c.inclusive {
+		if prefix == "#default" {
+			prefix = ""
+		}
+		if _, ok := e.lookupNamespace(prefix); ok {
+			utilized[prefix] = true
+		}
+	}
+
+	var decls []namespaceDecl
+	for prefix := range utilized {
+		uri, ok := e.lookupNamespace(prefix)
+		if !ok {
+			continue
+		}
+		previous, wasRendered := rendered[prefix]
+		if wasRendered && previous == uri {
+			continue
+		}
+		if !wasRendered && uri == "" {
+			continue
+		}
+		decls = append(decls, namespaceDecl{prefix: prefix, uri: uri})
+	}
+	sort.Slice(decls, func(i, j int) bool { return decls[i].prefix < decls[j].prefix })
+
+	attrs := make([]canonicalAttr, 0, len(e.attrs))
+	for _, a := range e.attrs {
+		namespace := ""
+		if a.Name.Space != "" {
+			namespace, _ = e.lookupNamespace(a.Name.Space)
+		}
+		attrs = append(attrs, canonicalAttr{
+			namespace: namespace,
+			name:      qualifiedName(a.Name.Space, a.Name.Local),
+			value:     a.Value,
+		})
+	}
+	sort.Slice(attrs, func(i, j int) bool {
+		if attrs[i].namespace != attrs[j].namespace {
+			return attrs[i].namespace < attrs[j].namespace
+		}
+		return localName(attrs[i].name) < localName(attrs[j].name)
+	})
+
+	name := qualifiedName(e.prefix, e.local)
+	c.buf.WriteByte('<')
+	c.buf.WriteString(name)
+
+	scope := rendered
+	if len(decls) > 0 {
+		scope = make(map[string]string, len(rendered)+len(decls))
+		for k, v := range rendered {
+			scope[k] = v
+		}
+	}
+	for _, d := range decls {
+		if d.prefix == "" {
+			c.buf.WriteString(` xmlns="`)
+		} else {
+			c.buf.WriteString(` xmlns:` + d.prefix + `="`)
+		}
+		c.buf.WriteString(escapeAttr(d.uri))
+		c.buf.WriteByte('"')
+		scope[d.prefix] = d.uri
+	}
+	for _, a := range attrs {
+		c.buf.WriteString(" " + a.name + `="`)
+		c.buf.WriteString(escapeAttr(a.value))
+		c.buf.WriteByte('"')
+	}
+	c.buf.WriteByte('>')
+
+	for _, n := range e.nodes {
+		switch child := n.(type) {
+		case *element:
+			if child != c.skip {
+				c.writeElement(child, scope)
+			}
+		case string:
+			c.buf.WriteString(escapeText(child))
+		}
+	}
+
+	c.buf.WriteString("</" + name + ">")
+}
+
+func localName(qname string) string {
+	if i := strings.IndexByte(qname, ':'); i >= 0 {
+		return qname[i+1:]
+	}
+	return qname
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+	attrEscaper = strings.NewReplacer(
+		"&", "&amp;", "<", "&lt;", `"`, "&quot;",
+		"\t", "&#x9;", "\n", "&#xA;", "\r", "&#xD;",
+	)
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func escapeAttr(s string) string {
+	return attrEscaper.Replace(s)
+}
@@ -0,0 +1,146 @@
+package saml
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+)
+
+const (
+	bindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	bindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	nameIDFormatEmail   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+)
+
+// ParseMetadata accepts an EntityDescriptor, or an EntitiesDescriptor whose
+// first IdP entry is used.
+func (p *ServiceProvider) ParseMetadata(metadata []byte) (*services.SAMLIdentityProvider, error) {
+	doc, err := parseDocument(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidSAMLMetadata, err)
+	}
+
+	entity := findIdPEntity(doc)
+	if entity == nil {
+		return nil, fmt.Errorf("%w: no IDPSSODescriptor found", domain.ErrInvalidSAMLMetadata)
+	}
+	idpDescriptor := entity.child(nsSAMLMetadata, "IDPSSODescriptor")
+
+	idp := &services.SAMLIdentityProvider{EntityID: entity.attr("entityID")}
+	if idp.EntityID == "" {
+		return nil, fmt.Errorf("%w: missing entityID", domain.ErrInvalidSAMLMetadata)
+	}
+
+	for _, sso := range idpDescriptor.childrenNamed(nsSAMLMetadata, "SingleSignOnService") {
+		if sso.attr("Binding") == bindingHTTPRedirect {
+			idp.SSOURL = sso.attr("Location")
+			break
+		}
+	}
+	if idp.SSOURL == "" {
+		return nil, fmt.Errorf("%w: no HTTP-Redirect SingleSignOnService", domain.ErrInvalidSAMLMetadata)
+	}
+
+	for _, key := range idpDescriptor.childrenNamed(nsSAMLMetadata, "KeyDescriptor") {
+		if use := key.attr("use"); use != "" && use != "signing" {
+			continue
+		}
+		x509Data := key.path(nsXMLDSig, "KeyInfo", "X509Data")
+		if x509Data == nil {
+			continue
+		}
+		for _, certElem := range x509Data.childrenNamed(nsXMLDSig, "X509Certificate") {
+			der, err := decodeBase64(certElem.text())
+			if err != nil {
+				return nil, fmt.Errorf("%w: malformed signing certificate", domain.ErrInvalidSAMLMetadata)
+			}
+			if _, err := x509.ParseCertificate(der); err != nil {
+				return nil, fmt.Errorf("%w: malformed signing certificate", domain.ErrInvalidSAMLMetadata)
+			}
+			idp.Certificates = append(idp.Certificates,
+				string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+		}
+	}
+	if len(idp.Certificates) == 0 {
+		return nil, fmt.Errorf("%w: no signing certificate", domain.ErrInvalidSAMLMetadata)
+	}
+
+	return idp, nil
+}
+
+func findIdPEntity(doc *element) *element {
+	switch {
+	case doc.is(nsSAMLMetadata, "EntityDescriptor"):
+		if doc.child(nsSAMLMetadata, "IDPSSODescriptor") != nil {
+			return doc
+		}
+	case doc.is(nsSAMLMetadata, "EntitiesDescriptor"):
+		for _, entity := range doc.childrenNamed(nsSAMLMetadata, "EntityDescriptor") {
+			if entity.child(nsSAMLMetadata, "IDPSSODescriptor") != nil {
+				return entity
+			}
+		}
+	}
+	return nil
+}
+
+type spEntityDescriptor struct {
+	XMLName      xml.Name        `xml:"md:EntityDescriptor"`
+	MD           string          `xml:"xmlns:md,attr"`
+	DS           string          `xml:"xmlns:ds,attr"`
+	EntityID     string          `xml:"entityID,attr"`
+	SPDescriptor spSSODescriptor `xml:"md:SPSSODescriptor"`
+}
+
+type spSSODescriptor struct {
+	AuthnRequestsSigned        bool                       `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       bool                       `xml:"WantAssertionsSigned,attr"`
+	ProtocolSupportEnumeration string                     `xml:"protocolSupportEnumeration,attr"`
+	KeyDescriptor              spKeyDescriptor            `xml:"md:KeyDescriptor"`
+	NameIDFormat               string                     `xml:"md:NameIDFormat"`
+	AssertionConsumerService   spAssertionConsumerService `xml:"md:AssertionConsumerService"`
+}
+
+type spKeyDescriptor struct {
+	Use         string `xml:"use,attr"`
+	Certificate string `xml:"ds:KeyInfo>ds:X509Data>ds:X509Certificate"`
+}
+
+type spAssertionConsumerService struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+	Index    int    `xml:"index,attr"`
+}
+
+func (p *ServiceProvider) Metadata(connectionID string) ([]byte, error) {
+	descriptor := spEntityDescriptor{
+		MD:       nsSAMLMetadata,
+		DS:       nsXMLDSig,
+		EntityID: p.entityID(connectionID),
+		SPDescriptor: spSSODescriptor{
+			AuthnRequestsSigned:        true,
+			WantAssertionsSigned:       true,
+			ProtocolSupportEnumeration: nsSAMLProtocol,
+			KeyDescriptor: spKeyDescriptor{
+				Use:         "signing",
+				Certificate: base64.StdEncoding.EncodeToString(p.cert.Raw),
+			},
+			NameIDFormat: nameIDFormatEmail,
+			AssertionConsumerService: spAssertionConsumerService{
+				Binding:  bindingHTTPPost,
+				Location: p.acsURL(connectionID),
+			},
+		},
+	}
+
+	out, err := xml.MarshalIndent(descriptor, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
@@ -0,0 +1,371 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+)
+
+const (
+	samlVersion        = "2.0"
+	statusSuccess      = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	confirmationBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+)
+
+// ServiceProvider is a SAML 2.0 SP supporting SP-initiated login: signed
+// AuthnRequests over HTTP-Redirect and signed responses over HTTP-POST.
+// Unsolicited (IdP-initiated) responses and encrypted assertions are not
+// accepted.
+type ServiceProvider struct {
+	baseURL   string
+	key       *rsa.PrivateKey
+	cert      *x509.Certificate
+	clockSkew time.Duration
+	now       func() time.Time
+}
+
+var _ services.SAMLServiceProvider = (*ServiceProvider)(nil)
+
+func NewServiceProvider(baseURL, privateKeyPEM, certificatePEM string, clockSkew time.Duration) (*ServiceProvider, error) {
+	key, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := parseCertificates([]string{certificatePEM})
+	if len(certs) == 0 {
+		return nil, errors.New("saml: invalid service provider certificate")
+	}
+
+	return &ServiceProvider{
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		key:       key,
+		cert:      certs[0],
+		clockSkew: clockSkew,
+		now:       time.Now,
+	}, nil
+}
+
+func parsePrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errors.New("saml: service provider key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("saml: failed to parse service provider key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("saml: service provider key must be RSA")
+	}
+	return key, nil
+}
+
+func (p *ServiceProvider) entityID(connectionID string) string {
+	return p.baseURL + "/api/v1/saml/" + url.PathEscape(connectionID) + "/metadata"
+}
+
+func (p *ServiceProvider) acsURL(connectionID string) string {
+	return p.baseURL + "/api/v1/saml/" + url.PathEscape(connectionID) + "/acs"
+}
+
+type authnRequest struct {
+	XMLName                     xml.Name     `xml:"samlp:AuthnRequest"`
+	SAMLP                       string       `xml:"xmlns:samlp,attr"`
+	SAML                        string       `xml:"xmlns:saml,attr"`
+	ID                          string       `xml:"ID,attr"`
+	Version                     string       `xml:"Version,attr"`
+	IssueInstant                string       `xml:"IssueInstant,attr"`
+	Destination                 string       `xml:"Destination,attr"`
+	AssertionConsumerServiceURL string       `xml:"AssertionConsumerServiceURL,attr"`
+	ProtocolBinding             string       `xml:"ProtocolBinding,attr"`
+	Issuer                      string       `xml:"saml:Issuer"`
+	NameIDPolicy                nameIDPolicy `xml:"samlp:NameIDPolicy"`
+}
+
+type nameIDPolicy struct {
+	Format      string `xml:"Format,attr"`
+	AllowCreate bool   `xml:"AllowCreate,attr"`
+}
+
+// NewAuthnRequest encodes the request for the HTTP-Redirect binding, where
+// the signature covers the query string rather than the XML.
+func (p *ServiceProvider) NewAuthnRequest(
+	connectionID string,
+	idp services.SAMLIdentityProvider,
+	relayState string,
+) (*services.SAMLAuthnRequest, error) {
+	id, err := newRequestID()
+	if err != nil {
+		return nil, err
+	}
+
+	request := authnRequest{
+		SAMLP:                       nsSAMLProtocol,
+		SAML:                        nsSAMLAssertion,
+		ID:                          id,
+		Version:                     samlVersion,
+		IssueInstant:                p.now().UTC().Format(time.RFC3339),
+		Destination:                 idp.SSOURL,
+		AssertionConsumerServiceURL: p.acsURL(connectionID),
+		ProtocolBinding:             bindingHTTPPost,
+		Issuer:                      p.entityID(connectionID),
+		NameIDPolicy:                nameIDPolicy{Format: nameIDFormatEmail, AllowCreate: true},
+	}
+
+	raw, err := xml.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode authn request: %w", err)
+	}
+
+	var deflated bytes.Buffer
+	writer, err := flate.NewWriter(&deflated, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(raw); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(deflated.Bytes()))
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+	query += "&SigAlg=" + url.QueryEscape(algRSASHA256)
+
+	digest := sha256.Sum256([]byte(query))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign authn request: %w", err)
+	}
+	query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+
+	separator := "?"
+	if strings.Contains(idp.SSOURL, "?") {
+		separator = "&"
+	}
+
+	return &services.SAMLAuthnRequest{
+		ID:          id,
+		RedirectURL: idp.SSOURL + separator + query,
+	}, nil
+}
+
+// newRequestID returns an xs:ID, which may not start with a digit.
+func newRequestID() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate request id: %w", err)
+	}
+	return "_" + hex.EncodeToString(buf), nil
+}
+
+func (p *ServiceProvider) ValidateResponse(
+	connectionID string,
+	idp services.SAMLIdentityProvider,
+	samlResponse string,
+	requestID string,
+) (*services.SAMLAssertion, error) {
+	raw, err := decodeBase64(samlResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w: response is not base64", domain.ErrInvalidSAMLResponse)
+	}
+
+	response, err := parseDocument(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidSAMLResponse, err)
+	}
+	if !response.is(nsSAMLProtocol, "Response") || response.attr("Version") != samlVersion {
+		return nil, fmt.Errorf("%w: not a SAML 2.0 response", domain.ErrInvalidSAMLResponse)
+	}
+
+	if response.child(nsSAMLAssertion, "EncryptedAssertion") != nil {
+		return nil, fmt.Errorf("%w: encrypted assertions are not supported", domain.ErrInvalidSAMLResponse)
+	}
+	assertions := response.childrenNamed(nsSAMLAssertion, "Assertion")
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one assertion", domain.ErrInvalidSAMLResponse)
+	}
+	assertion := assertions[0]
+
+	// Either the response or the assertion has to be signed. The assertion
+	// is taken from the verified tree, never looked up by ID, which keeps
+	// signature wrapping attacks out.
+	certs := parseCertificates(idp.Certificates)
+	responseErr := verifySignature(response, certs)
+	assertionErr := verifySignature(assertion, certs)
+	switch {
+	case responseErr != nil && !errors.Is(responseErr, errUnsigned):
+		return nil, responseErr
+	case assertionErr != nil && !errors.Is(assertionErr, errUnsigned):
+		return nil, assertionErr
+	case responseErr != nil && assertionErr != nil:
+		return nil, fmt.Errorf("%w: response is not signed", domain.ErrSAMLSignatureInvalid)
+	}
+
+	acsURL := p.acsURL(connectionID)
+	if response.attr("InResponseTo") != requestID {
+		return nil, fmt.Errorf("%w: response does not answer the pending request", domain.ErrInvalidSAMLResponse)
+	}
+	if destination := response.attr("Destination"); destination != "" && destination != acsURL {
+		return nil, fmt.Errorf("%w: unexpected destination", domain.ErrInvalidSAMLResponse)
+	}
+	if issuer := response.child(nsSAMLAssertion, "Issuer"); issuer != nil && issuer.text() != idp.EntityID {
+		return nil, fmt.Errorf("%w: unexpected response issuer", domain.ErrInvalidSAMLResponse)
+	}
+	statusCode := response.path(nsSAMLProtocol, "Status", "StatusCode")
+	if statusCode == nil || statusCode.attr("Value") != statusSuccess {
+		status := ""
+		if statusCode != nil {
+			status = statusCode.attr("Value")
+		}
+		return nil, fmt.Errorf("%w: identity provider returned status %q", domain.ErrInvalidSAMLResponse, status)
+	}
+
+	return p.readAssertion(assertion, idp, p.entityID(connectionID), acsURL, requestID)
+}
+
+func (p *ServiceProvider) readAssertion(
+	assertion *element,
+	idp services.SAMLIdentityProvider,
+	audience string,
+	acsURL string,
+	requestID string,
+) (*services.SAMLAssertion, error) {
+	now := p.now().UTC()
+
+	result := &services.SAMLAssertion{
+		ID:         assertion.attr("ID"),
+		Attributes: map[string][]string{},
+	}
+	if result.ID == "" {
+		return nil, fmt.Errorf("%w: assertion has no ID", domain.ErrInvalidSAMLResponse)
+	}
+
+	issuer := assertion.child(nsSAMLAssertion, "Issuer")
+	if issuer == nil || issuer.text() != idp.EntityID {
+		return nil, fmt.Errorf("%w: unexpected assertion issuer", domain.ErrInvalidSAMLResponse)
+	}
+
+	subject := assertion.child(nsSAMLAssertion, "Subject")
+	if subject == nil {
+		return nil, fmt.Errorf("%w: assertion has no subject", domain.ErrInvalidSAMLResponse)
+	}
+	if nameID := subject.child(nsSAMLAssertion, "NameID"); nameID != nil {
+		result.NameID = nameID.text()
+	}
+
+	confirmed := false
+	for _, confirmation := range subject.childrenNamed(nsSAMLAssertion, "SubjectConfirmation") {
+		if confirmation.attr("Method") != confirmationBearer {
+			continue
+		}
+		data := confirmation.child(nsSAMLAssertion, "SubjectConfirmationData")
+		if data == nil || data.attr("Recipient") != acsURL {
+			continue
+		}
+		if inResponseTo := data.attr("InResponseTo"); inResponseTo != "" && inResponseTo != requestID {
+			continue
+		}
+		notOnOrAfter, err := parseTime(data.attr("NotOnOrAfter"))
+		if err != nil || !now.Before(notOnOrAfter.Add(p.clockSkew)) {
+			continue
+		}
+		confirmed = true
+		result.NotOnOrAfter = notOnOrAfter
+		break
+	}
+	if !confirmed {
+		return nil, fmt.Errorf("%w: no valid bearer subject confirmation", domain.ErrSAMLAssertionExpired)
+	}
+
+	conditions := assertion.child(nsSAMLAssertion, "Conditions")
+	if conditions == nil {
+		return nil, fmt.Errorf("%w: assertion has no conditions", domain.ErrInvalidSAMLResponse)
+	}
+	if value := conditions.attr("NotBefore"); value != "" {
+		notBefore, err := parseTime(value)
+		if err != nil || now.Add(p.clockSkew).Before(notBefore) {
+			return nil, domain.ErrSAMLAssertionExpired
+		}
+	}
+	if value := conditions.attr("NotOnOrAfter"); value != "" {
+		notOnOrAfter, err := parseTime(value)
+		if err != nil || !now.Before(notOnOrAfter.Add(p.clockSkew)) {
+			return nil, domain.ErrSAMLAssertionExpired
+		}
+		if notOnOrAfter.Before(result.NotOnOrAfter) {
+			result.NotOnOrAfter = notOnOrAfter
+		}
+	}
+
+	// Every AudienceRestriction has to name this SP.
+	restrictions := conditions.childrenNamed(nsSAMLAssertion, "AudienceRestriction")
+	if len(restrictions) == 0 {
+		return nil, domain.ErrSAMLAudienceMismatch
+	}
+	for _, restriction := range restrictions {
+		matched := false
+		for _, a := range restriction.childrenNamed(nsSAMLAssertion, "Audience") {
+			if a.text() == audience {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return nil, domain.ErrSAMLAudienceMismatch
+		}
+	}
+
+	if statement := assertion.child(nsSAMLAssertion, "AuthnStatement"); statement != nil {
+		result.SessionIndex = statement.attr("SessionIndex")
+	}
+
+	for _, statement := range assertion.childrenNamed(nsSAMLAssertion, "AttributeStatement") {
+		for _, attribute := range statement.childrenNamed(nsSAMLAssertion, "Attribute") {
+			var values []string
+			for _, v := range attribute.childrenNamed(nsSAMLAssertion, "AttributeValue") {
+				values = append(values, v.text())
+			}
+			// Mappings may use either the formal name or the friendly name.
+			for _, name := range []string{attribute.attr("Name"), attribute.attr("FriendlyName")} {
+				if name != "" {
+					result.Attributes[name] = append(result.Attributes[name], values...)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("missing timestamp")
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
@@ -0,0 +1,204 @@
+package saml
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"authentication/internal/domain"
+)
+
+const (
+	algEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+	algRSASHA256          = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	algRSASHA512          = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	algSHA256             = "http://www.w3.org/2001/04/xmlenc#sha256"
+	algSHA512             = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// errUnsigned reports that an element carries no signature at all, which
+// is not an error as long as an enclosing or enclosed element is signed.
+var errUnsigned = errors.New("element is not signed")
+
+// SHA-1 based algorithms are deliberately absent.
+var (
+	signatureHashes = map[string]crypto.Hash{
+		algRSASHA256: crypto.SHA256,
+		algRSASHA512: crypto.SHA512,
+	}
+	digestHashes = map[string]crypto.Hash{
+		algSHA256: crypto.SHA256,
+		algSHA512: crypto.SHA512,
+	}
+)
+
+// verifySignature checks the enveloped signature that is a direct child of
+// el. The signature must reference el itself by its ID, so whatever is read
+// from el afterwards is what the IdP signed.
+func verifySignature(el *element, certs []*x509.Certificate) error {
+	sigs := el.childrenNamed(nsXMLDSig, "Signature")
+	if len(sigs) == 0 {
+		return errUnsigned
+	}
+	if len(sigs) > 1 {
+		return fmt.Errorf("%w: multiple signatures", domain.ErrSAMLSignatureInvalid)
+	}
+	sig := sigs[0]
+
+	signedInfo := sig.child(nsXMLDSig, "SignedInfo")
+	if signedInfo == nil {
+		return fmt.Errorf("%w: missing SignedInfo", domain.ErrSAMLSignatureInvalid)
+	}
+
+	c14nMethod := signedInfo.child(nsXMLDSig, "CanonicalizationMethod")
+	if c14nMethod == nil || c14nMethod.attr("Algorithm") != nsExcC14N {
+		return fmt.Errorf("%w: unsupported canonicalization method", domain.ErrSAMLSignatureInvalid)
+	}
+
+	sigMethod := signedInfo.child(nsXMLDSig, "SignatureMethod")
+	if sigMethod == nil {
+		return fmt.Errorf("%w: missing SignatureMethod", domain.ErrSAMLSignatureInvalid)
+	}
+	sigHash, ok := signatureHashes[sigMethod.attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("%w: unsupported signature method", domain.ErrSAMLSignatureInvalid)
+	}
+
+	refs := signedInfo.childrenNamed(nsXMLDSig, "Reference")
+	if len(refs) != 1 {
+		return fmt.Errorf("%w: expected exactly one reference", domain.ErrSAMLSignatureInvalid)
+	}
+	ref := refs[0]
+
+	id := el.attr("ID")
+	if id == "" || ref.attr("URI") != "#"+id {
+		return fmt.Errorf("%w: reference does not cover the signed element", domain.ErrSAMLSignatureInvalid)
+	}
+
+	refPrefixes, err := referenceTransforms(ref)
+	if err != nil {
+		return err
+	}
+
+	digestMethod := ref.child(nsXMLDSig, "DigestMethod")
+	if digestMethod == nil {
+		return fmt.Errorf("%w: missing DigestMethod", domain.ErrSAMLSignatureInvalid)
+	}
+	digestHash, ok := digestHashes[digestMethod.attr("Algorithm")]
+	if !ok {
+		return fmt.Errorf("%w: unsupported digest method", domain.ErrSAMLSignatureInvalid)
+	}
+
+	digestValue := ref.child(nsXMLDSig, "DigestValue")
+	if digestValue == nil {
+		return fmt.Errorf("%w: missing DigestValue", domain.ErrSAMLSignatureInvalid)
+	}
+	expectedDigest, err := decodeBase64(digestValue.text())
+	if err != nil {
+		return fmt.Errorf("%w: malformed DigestValue", domain.ErrSAMLSignatureInvalid)
+	}
+
+	h := digestHash.New()
+	h.Write(canonicalize(el, sig, refPrefixes))
+	if !bytes.Equal(h.Sum(nil), expectedDigest) {
+		return fmt.Errorf("%w: digest mismatch", domain.ErrSAMLSignatureInvalid)
+	}
+
+	sigValue := sig.child(nsXMLDSig, "SignatureValue")
+	if sigValue == nil {
+		return fmt.Errorf("%w: missing SignatureValue", domain.ErrSAMLSignatureInvalid)
+	}
+	signature, err := decodeBase64(sigValue.text())
+	if err != nil {
+		return fmt.Errorf("%w: malformed SignatureValue", domain.ErrSAMLSignatureInvalid)
+	}
+
+	h = sigHash.New()
+	h.Write(canonicalize(signedInfo, nil, inclusivePrefixes(c14nMethod)))
+	hashed := h.Sum(nil)
+
+	for _, cert := range certs {
+		pub, ok := cert.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			continue
+		}
+		if rsa.VerifyPKCS1v15(pub, sigHash, hashed, signature) == nil {
+			return nil
+		}
+	}
+	return domain.ErrSAMLSignatureInvalid
+}
+
+// referenceTransforms accepts only the enveloped-signature plus exclusive
+// canonicalization pair that SAML profiles mandate, and returns the
+// inclusive prefix list of the latter.
+func referenceTransforms(ref *element) ([]string, error) {
+	transforms := ref.path(nsXMLDSig, "Transforms")
+	if transforms == nil {
+		return nil, fmt.Errorf("%w: missing transforms", domain.ErrSAMLSignatureInvalid)
+	}
+
+	var enveloped, exclusive bool
+	var prefixes []string
+	for _, t := range transforms.childrenNamed(nsXMLDSig, "Transform") {
+		switch t.attr("Algorithm") {
+		case algEnvelopedSignature:
+			enveloped = true
+		case nsExcC14N:
+			exclusive = true
+			prefixes = inclusivePrefixes(t)
+		default:
+			return nil, fmt.Errorf("%w: unsupported transform", domain.ErrSAMLSignatureInvalid)
+		}
+	}
+	if !enveloped || !exclusive {
+		return nil, fmt.Errorf("%w: unsupported transforms", domain.ErrSAMLSignatureInvalid)
+	}
+	return prefixes, nil
+}
+
+func inclusivePrefixes(method *element) []string {
+	inclusive := method.child(nsExcC14N, "InclusiveNamespaces")
+	if inclusive == nil {
+		return nil
+	}
+	return strings.Fields(inclusive.attr("PrefixList"))
+}
+
+// decodeBase64 tolerates the line breaks IdPs put into long values.
+func decodeBase64(value string) ([]byte, error) {
+	value = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n':
+			return -1
+		}
+		return r
+	}, value)
+	return base64.StdEncoding.DecodeString(value)
+}
+
+// parseCertificates decodes PEM blocks, skipping anything unparsable so a
+// single bad entry does not lock out a connection during key rollover.
+func parseCertificates(pems []string) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for _, p := range pems {
+		block, _ := pem.Decode([]byte(p))
+		if block == nil || block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		certs = append(certs, cert)
+	}
+	return certs
+}
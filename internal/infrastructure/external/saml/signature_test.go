@@ -0,0 +1,253 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+)
+
+const (
+	testConnectionID = "conn-1"
+	testRequestID    = "_request"
+	testIdPEntityID  = "https://idp.example.com"
+	testBaseURL      = "https://sp.example.com"
+)
+
+func TestValidateResponseSignature(t *testing.T) {
+	key := generateKey(t)
+	otherKey := generateKey(t)
+	idp := services.SAMLIdentityProvider{
+		EntityID:     testIdPEntityID,
+		Certificates: []string{selfSignedCertificate(t, key)},
+	}
+
+	signed := signAssertion(t, key, testAssertion("_a1", "alice"), "#_a1")
+
+	tests := []struct {
+		name     string
+		response string
+		wantErr  error
+		wantMsg  string
+	}{
+		{
+			name:     "signed assertion",
+			response: testResponse(signed),
+		},
+		{
+			name:     "unsigned assertion",
+			response: testResponse(strings.Replace(testAssertion("_a1", "alice"), "{{SIGNATURE}}", "", 1)),
+			wantErr:  domain.ErrSAMLSignatureInvalid,
+			wantMsg:  "response is not signed",
+		},
+		{
+			// XSW: the signed assertion is hidden where the SP does not look
+			// and a forged one with the same ID takes its place.
+			name: "signed assertion moved into extensions",
+			response: testResponse(
+				"<samlp:Extensions>" + signed + "</samlp:Extensions>" +
+					strings.Replace(testAssertion("_a1", "mallory"), "{{SIGNATURE}}", "", 1),
+			),
+			wantErr: domain.ErrSAMLSignatureInvalid,
+			wantMsg: "response is not signed",
+		},
+		{
+			// XSW: the forged assertion carries the original signature, which
+			// still references the genuine assertion nested inside it.
+			name: "signature referencing a wrapped assertion",
+			response: testResponse(strings.Replace(
+				testAssertion("_evil", "mallory"), "{{SIGNATURE}}", signatureOf(t, signed)+signed, 1,
+			)),
+			wantErr: domain.ErrSAMLSignatureInvalid,
+			wantMsg: "reference does not cover the signed element",
+		},
+		{
+			name:     "reference to another element",
+			response: testResponse(signAssertion(t, key, testAssertion("_a1", "alice"), "#_other")),
+			wantErr:  domain.ErrSAMLSignatureInvalid,
+			wantMsg:  "reference does not cover the signed element",
+		},
+		{
+			name:     "missing reference",
+			response: testResponse(removeElement(t, signed, "ds:Reference")),
+			wantErr:  domain.ErrSAMLSignatureInvalid,
+			wantMsg:  "expected exactly one reference",
+		},
+		{
+			name:     "digest mismatch",
+			response: testResponse(strings.Replace(signed, ">alice<", ">mallory<", 1)),
+			wantErr:  domain.ErrSAMLSignatureInvalid,
+			wantMsg:  "digest mismatch",
+		},
+		{
+			name:     "signed by an unknown key",
+			response: testResponse(signAssertion(t, otherKey, testAssertion("_a1", "alice"), "#_a1")),
+			wantErr:  domain.ErrSAMLSignatureInvalid,
+		},
+	}
+
+	sp := &ServiceProvider{
+		baseURL: testBaseURL,
+		now:     func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) },
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := base64.StdEncoding.EncodeToString([]byte(tt.response))
+			assertion, err := sp.ValidateResponse(testConnectionID, idp, encoded, testRequestID)
+
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("ValidateResponse() error = %v", err)
+				}
+				if assertion.NameID != "alice" {
+					t.Fatalf("NameID = %q, want %q", assertion.NameID, "alice")
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ValidateResponse() error = %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Fatalf("ValidateResponse() error = %q, want it to mention %q", err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+// testAssertion returns an assertion with a {{SIGNATURE}} placeholder after
+// the issuer, where the enveloped signature belongs.
+func testAssertion(id, nameID string) string {
+	acs := testBaseURL + "/api/v1/saml/" + testConnectionID + "/acs"
+	audience := testBaseURL + "/api/v1/saml/" + testConnectionID + "/metadata"
+	return `<saml:Assertion xmlns:saml="` + nsSAMLAssertion + `" ID="` + id + `" Version="2.0">` +
+		`<saml:Issuer>` + testIdPEntityID + `</saml:Issuer>` +
+		`{{SIGNATURE}}` +
+		`<saml:Subject>` +
+		`<saml:NameID>` + nameID + `</saml:NameID>` +
+		`<saml:SubjectConfirmation Method="` + confirmationBearer + `">` +
+		`<saml:SubjectConfirmationData Recipient="` + acs + `" InResponseTo="` + testRequestID + `" NotOnOrAfter="2030-01-01T00:00:00Z"/>` +
+		`</saml:SubjectConfirmation>` +
+		`</saml:Subject>` +
+		`<saml:Conditions>` +
+		`<saml:AudienceRestriction><saml:Audience>` + audience + `</saml:Audience></saml:AudienceRestriction>` +
+		`</saml:Conditions>` +
+		`</saml:Assertion>`
+}
+
+func testResponse(body string) string {
+	acs := testBaseURL + "/api/v1/saml/" + testConnectionID + "/acs"
+	return `<samlp:Response xmlns:samlp="` + nsSAMLProtocol + `" xmlns:saml="` + nsSAMLAssertion + `"` +
+		` ID="_response" Version="2.0" InResponseTo="` + testRequestID + `" Destination="` + acs + `">` +
+		`<saml:Issuer>` + testIdPEntityID + `</saml:Issuer>` +
+		`<samlp:Status><samlp:StatusCode Value="` + statusSuccess + `"/></samlp:Status>` +
+		body +
+		`</samlp:Response>`
+}
+
+func signatureTemplate(referenceURI, digest, signature string) string {
+	return `<ds:Signature xmlns:ds="` + nsXMLDSig + `">` +
+		`<ds:SignedInfo>` +
+		`<ds:CanonicalizationMethod Algorithm="` + nsExcC14N + `"/>` +
+		`<ds:SignatureMethod Algorithm="` + algRSASHA256 + `"/>` +
+		`<ds:Reference URI="` + referenceURI + `">` +
+		`<ds:Transforms>` +
+		`<ds:Transform Algorithm="` + algEnvelopedSignature + `"/>` +
+		`<ds:Transform Algorithm="` + nsExcC14N + `"/>` +
+		`</ds:Transforms>` +
+		`<ds:DigestMethod Algorithm="` + algSHA256 + `"/>` +
+		`<ds:DigestValue>` + digest + `</ds:DigestValue>` +
+		`</ds:Reference>` +
+		`</ds:SignedInfo>` +
+		`<ds:SignatureValue>` + signature + `</ds:SignatureValue>` +
+		`</ds:Signature>`
+}
+
+// signAssertion fills the placeholder of template with an enveloped
+// signature over the assertion, referencing referenceURI.
+func signAssertion(t *testing.T, key *rsa.PrivateKey, template, referenceURI string) string {
+	t.Helper()
+
+	unsigned := strings.Replace(template, "{{SIGNATURE}}", signatureTemplate(referenceURI, "", ""), 1)
+	el := mustParse(t, unsigned)
+	digest := sha256.Sum256(canonicalize(el, el.child(nsXMLDSig, "Signature"), nil))
+	digestValue := base64.StdEncoding.EncodeToString(digest[:])
+
+	withDigest := strings.Replace(template, "{{SIGNATURE}}", signatureTemplate(referenceURI, digestValue, ""), 1)
+	el = mustParse(t, withDigest)
+	signedInfo := el.child(nsXMLDSig, "Signature").child(nsXMLDSig, "SignedInfo")
+	hashed := sha256.Sum256(canonicalize(signedInfo, nil, nil))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatalf("failed to sign assertion: %v", err)
+	}
+
+	return strings.Replace(template, "{{SIGNATURE}}",
+		signatureTemplate(referenceURI, digestValue, base64.StdEncoding.EncodeToString(signature)), 1)
+}
+
+func signatureOf(t *testing.T, assertion string) string {
+	t.Helper()
+	start := strings.Index(assertion, "<ds:Signature ")
+	end := strings.Index(assertion, "</ds:Signature>")
+	if start < 0 || end < 0 {
+		t.Fatal("assertion has no signature")
+	}
+	return assertion[start : end+len("</ds:Signature>")]
+}
+
+func removeElement(t *testing.T, document, name string) string {
+	t.Helper()
+	start := strings.Index(document, "<"+name+" ")
+	end := strings.Index(document, "</"+name+">")
+	if start < 0 || end < 0 {
+		t.Fatalf("document has no %s element", name)
+	}
+	return document[:start] + document[end+len("</"+name+">"):]
+}
+
+func mustParse(t *testing.T, document string) *element {
+	t.Helper()
+	el, err := parseDocument([]byte(document))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+	return el
+}
+
+func generateKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+func selfSignedCertificate(t *testing.T, key *rsa.PrivateKey) string {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
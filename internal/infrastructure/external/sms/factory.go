@@ -0,0 +1,29 @@
+package sms
+
+import (
+	"fmt"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+)
+
+// NewService builds the configured sender behind the rate limits. It
+// returns nil when SMS is disabled.
+func NewService(cfg config.SMSConfig, limiter services.RateLimiter, logger logging.Logger) (services.SMSService, error) {
+	var sender services.SMSService
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case "twilio":
+		sender = NewTwilioSender(cfg.AccountID, cfg.AuthToken, cfg.FromPhone, cfg.Timeout)
+	case "log":
+		sender = NewLogSender(logger)
+	case "file":
+		sender = NewFileSender(cfg.FilePath)
+	default:
+		return nil, fmt.Errorf("unknown sms provider %q", cfg.Provider)
+	}
+
+	return NewRateLimitedService(sender, limiter, cfg, logger), nil
+}
@@ -0,0 +1,80 @@
+package sms
+
+import (
+	"context"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// RateLimitedService guards another SMSService against SMS pumping: it
+// caps messages per number and per country calling code, and can restrict
+// delivery to an allow-list of calling codes.
+type RateLimitedService struct {
+	next    services.SMSService
+	limiter services.RateLimiter
+	cfg     config.SMSConfig
+	allowed map[string]bool
+	logger  logging.Logger
+}
+
+var _ services.SMSService = (*RateLimitedService)(nil)
+
+func NewRateLimitedService(
+	next services.SMSService,
+	limiter services.RateLimiter,
+	cfg config.SMSConfig,
+	logger logging.Logger,
+) *RateLimitedService {
+	allowed := make(map[string]bool, len(cfg.AllowedCallingCodes))
+	for _, code := range cfg.AllowedCallingCodes {
+		allowed[code] = true
+	}
+
+	return &RateLimitedService{
+		next:    next,
+		limiter: limiter,
+		cfg:     cfg,
+		allowed: allowed,
+		logger:  logger.With(zap.String("service", "sms")),
+	}
+}
+
+func (s *RateLimitedService) Send(ctx context.Context, message services.SMSMessage) error {
+	phone, err := valueobjects.NewPhoneNumber(message.To)
+	if err != nil {
+		return err
+	}
+
+	callingCode := phone.CallingCode()
+	if len(s.allowed) > 0 && !s.allowed[callingCode] {
+		s.logger.Warn(ctx, "SMS to disallowed country refused", zap.String("calling_code", callingCode))
+		return domain.ErrSMSCountryNotAllowed
+	}
+
+	// The number is checked first so one abused number does not use up the
+	// country's budget.
+	ok, err := s.limiter.Allow(ctx, "sms:number:"+phone.String(), s.cfg.PerNumberLimit, s.cfg.RateWindow)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrSMSRateLimited
+	}
+
+	ok, err = s.limiter.Allow(ctx, "sms:country:"+callingCode, s.cfg.PerCountryLimit, s.cfg.RateWindow)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.logger.Warn(ctx, "SMS country limit reached", zap.String("calling_code", callingCode))
+		return domain.ErrSMSRateLimited
+	}
+
+	return s.next.Send(ctx, services.SMSMessage{To: phone.String(), Body: message.Body})
+}
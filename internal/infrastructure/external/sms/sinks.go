@@ -0,0 +1,73 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// LogSender writes messages to the log instead of sending them. It is meant
+// for local development only, since codes end up in the log.
+type LogSender struct {
+	logger logging.Logger
+}
+
+var _ services.SMSService = (*LogSender)(nil)
+
+func NewLogSender(logger logging.Logger) *LogSender {
+	return &LogSender{logger: logger.With(zap.String("service", "sms"))}
+}
+
+func (s *LogSender) Send(ctx context.Context, message services.SMSMessage) error {
+	s.logger.Info(ctx, "SMS message",
+		zap.String("to", message.To),
+		zap.String("body", message.Body),
+	)
+	return nil
+}
+
+// FileSender appends each message as a JSON line to a file, so tests can
+// read back the codes that were sent.
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+var _ services.SMSService = (*FileSender)(nil)
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Send(ctx context.Context, message services.SMSMessage) error {
+	line, err := json.Marshal(struct {
+		To     string    `json:"to"`
+		Body   string    `json:"body"`
+		SentAt time.Time `json:"sent_at"`
+	}{message.To, message.Body, time.Now().UTC()})
+	if err != nil {
+		return fmt.Errorf("failed to encode sms: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open sms file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write sms file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+)
+
+const twilioAPIBase = "https://api.twilio.com/2010-04-01"
+
+// TwilioSender sends messages with the Twilio Messages API.
+type TwilioSender struct {
+	accountID string
+	authToken string
+	from      string
+	client    *http.Client
+}
+
+var _ services.SMSService = (*TwilioSender)(nil)
+
+func NewTwilioSender(accountID, authToken, from string, timeout time.Duration) *TwilioSender {
+	return &TwilioSender{
+		accountID: accountID,
+		authToken: authToken,
+		from:      from,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *TwilioSender) Send(ctx context.Context, message services.SMSMessage) error {
+	endpoint := fmt.Sprintf("%s/Accounts/%s/Messages.json", twilioAPIBase, url.PathEscape(s.accountID))
+	form := url.Values{
+		"To":   {message.To},
+		"From": {s.from},
+		"Body": {message.Body},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to build twilio request: %w", err)
+	}
+	req.SetBasicAuth(s.accountID, s.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrSMSUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%w: twilio returned %d: %s", domain.ErrSMSUnavailable, resp.StatusCode, body)
+	}
+	return nil
+}
@@ -2,12 +2,14 @@ package infrastructure
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
-	"authentication/internal/domain/services"
+	"authentication/internal/application/contracts/services"
 	"authentication/internal/domain/valueobjects"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type JWTTokenService struct {
@@ -15,37 +17,75 @@ type JWTTokenService struct {
 	refreshSecret     []byte
 	accessExpiration  time.Duration
 	refreshExpiration time.Duration
+	serviceExpiration time.Duration
 	issuer            string
 }
 
+// defaultServiceExpiration keeps machine tokens short-lived; services simply
+// request a new one with their credentials.
+const defaultServiceExpiration = 5 * time.Minute
+
 func NewJWTTokenService(accessSecret, refreshSecret string, accessExp, refreshExp time.Duration, issuer string) *JWTTokenService {
 	return &JWTTokenService{
 		accessSecret:      []byte(accessSecret),
 		refreshSecret:     []byte(refreshSecret),
 		accessExpiration:  accessExp,
 		refreshExpiration: refreshExp,
+		serviceExpiration: defaultServiceExpiration,
 		issuer:            issuer,
 	}
 }
 
 type jwtClaims struct {
-	UserID   string `json:"user_id"`
-	Email    string `json:"email"`
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	UserID    string `json:"user_id,omitempty"`
+	TenantID  string `json:"tid,omitempty"`
+	OrgID     string `json:"org_id,omitempty"`
+	OrgRole   string `json:"org_role,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Username  string `json:"username,omitempty"`
+	Role      string `json:"role"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	TokenType string `json:"typ"`
+	SessionID string `json:"sid,omitempty"`
+	// Act names who is acting when the token impersonates UserID (RFC 8693).
+	Act *actorClaim `json:"act,omitempty"`
+	// AuthTime, AMR and ACR describe the sign-in behind the token as in
+	// OpenID Connect Core section 2.
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	AMR      []string         `json:"amr,omitempty"`
+	ACR      string           `json:"acr,omitempty"`
 	jwt.RegisteredClaims
 }
 
+type actorClaim struct {
+	Subject string `json:"sub"`
+}
+
 // GenerateAccessToken creates a signed access token
-func (s *JWTTokenService) GenerateAccessToken(userID, email, username, role string) (*valueobjects.Token, error) {
+func (s *JWTTokenService) GenerateAccessToken(
+	tenantID, userID, email, username, role string,
+	org services.OrganizationClaims,
+	auth services.AuthenticationContext,
+) (*valueobjects.Token, error) {
 	now := time.Now().UTC()
 	expiration := now.Add(s.accessExpiration)
+	if !auth.NotAfter.IsZero() && auth.NotAfter.Before(expiration) {
+		expiration = auth.NotAfter.UTC()
+	}
 
 	claims := &jwtClaims{
-		UserID:   userID,
-		Email:    email,
-		Username: username,
-		Role:     role,
+		UserID:    userID,
+		TenantID:  tenantID,
+		OrgID:     org.ID,
+		OrgRole:   org.Role,
+		Email:     email,
+		Username:  username,
+		Role:      role,
+		TokenType: string(valueobjects.TokenTypeAccess),
+		SessionID: auth.SessionID,
+		AMR:       valueobjects.AuthMethodStrings(valueobjects.MergeAuthMethods(nil, auth.Methods...)),
+		ACR:       auth.Level().String(),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiration),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -55,6 +95,13 @@ func (s *JWTTokenService) GenerateAccessToken(userID, email, username, role stri
 		},
 	}
 
+	if !auth.Time.IsZero() {
+		claims.AuthTime = jwt.NewNumericDate(auth.Time)
+	}
+	if auth.ActorID != "" {
+		claims.Act = &actorClaim{Subject: auth.ActorID}
+	}
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	signed, err := token.SignedString(s.accessSecret)
 	if err != nil {
@@ -86,7 +133,77 @@ func (s *JWTTokenService) GenerateRefreshToken(userID string) (*valueobjects.Tok
 	return valueobjects.NewToken(signed, valueobjects.TokenTypeRefresh, expiration)
 }
 
-// ValidateToken validates an access token
+// GenerateServiceToken creates a short-lived access token for a service
+// account. It carries client_id instead of a user and TokenTypeService so
+// user-only endpoints can reject it.
+func (s *JWTTokenService) GenerateServiceToken(tenantID, clientID, role string, scopes []string) (*valueobjects.Token, error) {
+	now := time.Now().UTC()
+	expiration := now.Add(s.serviceExpiration)
+
+	claims := &jwtClaims{
+		TenantID:  tenantID,
+		Role:      role,
+		ClientID:  clientID,
+		Scope:     strings.Join(scopes, " "),
+		TokenType: string(valueobjects.TokenTypeService),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(expiration),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    s.issuer,
+			Subject:   clientID,
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(s.accessSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign service token: %w", err)
+	}
+
+	return valueobjects.NewToken(signed, valueobjects.TokenTypeService, expiration)
+}
+
+// GenerateImpersonationToken creates an access token for claims.UserID
+// that names claims.ActorID in its act claim. No refresh token goes with
+// it, so the session ends when ttl runs out.
+func (s *JWTTokenService) GenerateImpersonationToken(
+	claims services.ImpersonationClaims,
+	ttl time.Duration,
+) (*valueobjects.Token, error) {
+	now := time.Now().UTC()
+	expiration := now.Add(ttl)
+
+	jwtClaims := &jwtClaims{
+		UserID:    claims.UserID,
+		TenantID:  claims.TenantID,
+		Email:     claims.Email,
+		Username:  claims.Username,
+		Role:      claims.Role,
+		TokenType: string(valueobjects.TokenTypeAccess),
+		SessionID: claims.SessionID,
+		Act:       &actorClaim{Subject: claims.ActorID},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			ExpiresAt: jwt.NewNumericDate(expiration),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    s.issuer,
+			Subject:   claims.UserID,
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims)
+	signed, err := token.SignedString(s.accessSecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign impersonation token: %w", err)
+	}
+
+	return valueobjects.NewToken(signed, valueobjects.TokenTypeAccess, expiration)
+}
+
+// ValidateToken validates an access or service token
 func (s *JWTTokenService) ValidateToken(tokenString string) (*services.TokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &jwtClaims{}, func(t *jwt.Token) (interface{}, error) {
 		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -103,12 +220,34 @@ func (s *JWTTokenService) ValidateToken(tokenString string) (*services.TokenClai
 		return nil, fmt.Errorf("invalid token")
 	}
 
-	return &services.TokenClaims{
-		UserID:   claims.UserID,
-		Email:    claims.Email,
-		Username: claims.Username,
-		Role:     claims.Role,
-	}, nil
+	result := &services.TokenClaims{
+		UserID:      claims.UserID,
+		TenantID:    claims.TenantID,
+		OrgID:       claims.OrgID,
+		OrgRole:     claims.OrgRole,
+		TokenID:     claims.ID,
+		SessionID:   claims.SessionID,
+		Email:       claims.Email,
+		Role:        claims.Role,
+		Scopes:      strings.Fields(claims.Scope),
+		ClientID:    claims.ClientID,
+		TokenType:   claims.TokenType,
+		AuthMethods: claims.AMR,
+		AuthLevel:   claims.ACR,
+	}
+	if claims.AuthTime != nil {
+		result.AuthTime = claims.AuthTime.Time
+	}
+	if claims.Act != nil {
+		result.ActorID = claims.Act.Subject
+	}
+	if claims.IssuedAt != nil {
+		result.IssuedAt = claims.IssuedAt.Time
+	}
+	if claims.ExpiresAt != nil {
+		result.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return result, nil
 }
 
 // ExtractClaims delegates to ValidateToken
@@ -0,0 +1,106 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/valueobjects"
+)
+
+func newTestJWTService() *JWTTokenService {
+	return NewJWTTokenService("access-secret", "refresh-secret", 15*time.Minute, 24*time.Hour, "test")
+}
+
+func TestGenerateImpersonationToken(t *testing.T) {
+	service := newTestJWTService()
+
+	token, err := service.GenerateImpersonationToken(services.ImpersonationClaims{
+		UserID:    "target",
+		Role:      "USER",
+		ActorID:   "support-admin",
+		SessionID: "session-1",
+	}, 10*time.Minute)
+	if err != nil {
+		t.Fatalf("GenerateImpersonationToken() error = %v", err)
+	}
+
+	claims, err := service.ValidateToken(token.Value())
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if claims.UserID != "target" || claims.ActorID != "support-admin" {
+		t.Errorf("claims = (%q, act %q), want (%q, act %q)", claims.UserID, claims.ActorID, "target", "support-admin")
+	}
+	if claims.TokenID == "" {
+		t.Errorf("TokenID is empty, want a jti")
+	}
+}
+
+func TestGenerateAccessTokenImpersonation(t *testing.T) {
+	service := newTestJWTService()
+	notAfter := time.Now().Add(5 * time.Minute).Truncate(time.Second)
+
+	tests := []struct {
+		name        string
+		auth        services.AuthenticationContext
+		wantActor   string
+		wantExpires time.Time
+	}{
+		{
+			name: "regular sign-in",
+			auth: services.AuthenticationContext{
+				SessionID: "session-1",
+				Methods:   []valueobjects.AuthMethod{valueobjects.AuthMethodPassword},
+			},
+		},
+		{
+			// Switching organization during an impersonation session must
+			// neither drop the act claim nor outlive the original token.
+			name: "reissued impersonation token",
+			auth: services.AuthenticationContext{
+				SessionID: "session-1",
+				ActorID:   "support-admin",
+				NotAfter:  notAfter,
+			},
+			wantActor:   "support-admin",
+			wantExpires: notAfter,
+		},
+		{
+			name: "expiry later than the default is ignored",
+			auth: services.AuthenticationContext{
+				SessionID: "session-1",
+				NotAfter:  time.Now().Add(24 * time.Hour),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := service.GenerateAccessToken("tenant", "target", "t@example.com", "target", "USER",
+				services.OrganizationClaims{ID: "org-2", Role: "member"}, tt.auth)
+			if err != nil {
+				t.Fatalf("GenerateAccessToken() error = %v", err)
+			}
+
+			claims, err := service.ValidateToken(token.Value())
+			if err != nil {
+				t.Fatalf("ValidateToken() error = %v", err)
+			}
+			if claims.ActorID != tt.wantActor {
+				t.Errorf("ActorID = %q, want %q", claims.ActorID, tt.wantActor)
+			}
+			if claims.OrgID != "org-2" {
+				t.Errorf("OrgID = %q, want %q", claims.OrgID, "org-2")
+			}
+
+			wantExpires := tt.wantExpires
+			if wantExpires.IsZero() {
+				wantExpires = time.Now().Add(15 * time.Minute)
+			}
+			if diff := claims.ExpiresAt.Sub(wantExpires); diff < -2*time.Second || diff > 2*time.Second {
+				t.Errorf("ExpiresAt = %v, want about %v", claims.ExpiresAt, wantExpires)
+			}
+		})
+	}
+}
@@ -159,3 +159,31 @@ func (i *InstrumentedCache) Exists(ctx context.Context, key string) (bool, error
 
 	return exists, err
 }
+
+func (i *InstrumentedCache) SetIfAbsent(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	ctx, span := i.tracer.StartSpan(ctx, "cache.set_if_absent")
+	defer span.End()
+
+	i.tracer.AddAttributes(span,
+		attribute.String("cache.name", i.cacheName),
+		attribute.String("cache.key", key),
+	)
+
+	start := utils.NowUTC()
+	stored, err := i.wrapped.SetIfAbsent(ctx, key, value, ttl)
+	duration := time.Since(start)
+
+	status := "success"
+	if err != nil {
+		status = "error"
+		i.tracer.RecordError(span, err)
+	}
+
+	i.tracer.AddAttributes(span,
+		attribute.String("status", status),
+		attribute.Bool("cache.stored", stored),
+		attribute.Float64("duration_ms", duration.Seconds()*1000),
+	)
+
+	return stored, err
+}
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"time"
 
+	"authentication/internal/application/contracts/persistence"
+
 	"github.com/redis/go-redis/v9"
 )
 
-var ErrCacheMiss = redis.Nil
+var ErrCacheMiss = persistence.ErrCacheMiss
 
 type RedisCache struct {
 	client *redis.Client
@@ -49,3 +51,11 @@ func (c *RedisCache) Exists(ctx context.Context, key string) (bool, error) {
 	res, err := c.client.Exists(ctx, key).Result()
 	return res > 0, err
 }
+
+func (c *RedisCache) SetIfAbsent(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	return c.client.SetNX(ctx, key, data, ttl).Result()
+}
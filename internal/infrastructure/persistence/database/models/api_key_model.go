@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type APIKeyModel struct {
+	ID            string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID      string         `gorm:"not null;type:varchar(36);index"`
+	UserID        string         `gorm:"not null;type:varchar(36);index"`
+	Name          string         `gorm:"not null;type:varchar(255)"`
+	KeyHash       string         `gorm:"uniqueIndex;not null;type:varchar(64)"`
+	DisplayPrefix string         `gorm:"not null;type:varchar(16)"`
+	Scopes        datatypes.JSON `gorm:"type:json"`
+	AllowedIPs    datatypes.JSON `gorm:"type:json"`
+	ExpiresAt     *time.Time     `gorm:"type:timestamp;index"`
+	LastUsedAt    *time.Time     `gorm:"type:timestamp"`
+	RevokedAt     *time.Time     `gorm:"type:timestamp"`
+	Version       int            `gorm:"not null;default:1"`
+	CreatedAt     time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt     time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+
+	User UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+func (APIKeyModel) TableName() string {
+	return "api_keys"
+}
@@ -7,7 +7,8 @@ import (
 )
 
 type AuditLogModel struct {
-    ID           string         `gorm:"primaryKey;type:varchar(36)"`
+    ID           string         `gorm:"primaryKey;type:varchar(36);index:idx_audit_logs_tenant_created,priority:3,sort:desc"`
+    TenantID     string         `gorm:"not null;type:varchar(36);index;index:idx_audit_logs_tenant_created,priority:1"`
     UserID       string         `gorm:"not null;type:varchar(36);index"`
     Action       string         `gorm:"not null;type:varchar(100);index"`
     ResourceType string         `gorm:"not null;type:varchar(100);index"`
@@ -16,10 +17,14 @@ type AuditLogModel struct {
     UserAgent    string         `gorm:"type:text"`
     Status       string         `gorm:"not null;type:varchar(20);index"`
     ErrorMessage string         `gorm:"type:text"`
-    Metadata     datatypes.JSON `gorm:"type:json"`
+    Metadata     datatypes.JSON `gorm:"type:jsonb"`
     Timestamp    time.Time      `gorm:"not null;index"`
-    CreatedAt    time.Time      `gorm:"not null;autoCreateTime"`
+    CreatedAt    time.Time      `gorm:"not null;autoCreateTime;index:idx_audit_logs_tenant_created,priority:2,sort:desc"`
     DeletedAt    gorm.DeletedAt `gorm:"index"`
+    // SearchVector backs full-text search. Postgres computes it from the
+    // action, resource, error message and the string values in metadata;
+    // the application only reads it.
+    SearchVector string         `gorm:"->;type:tsvector GENERATED ALWAYS AS (to_tsvector('simple', coalesce(action, '') || ' ' || coalesce(resource_type, '') || ' ' || coalesce(resource_id, '') || ' ' || coalesce(error_message, '')) || to_tsvector('simple', coalesce(metadata, '{}'::jsonb))) STORED;index:idx_audit_logs_search,type:gin"`
     
     User         UserModel      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
 }
@@ -9,6 +9,7 @@ import (
 
 type OAuthClientModel struct {
 	ID           string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID     string         `gorm:"not null;type:varchar(36);index"`
 	ClientID     string         `gorm:"uniqueIndex;not null;type:varchar(255)"`
 	ClientSecret string         `gorm:"not null;type:text"`
 	Provider     string         `gorm:"not null;type:varchar(50);index"`
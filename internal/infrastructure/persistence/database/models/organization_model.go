@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OrganizationModel struct {
+	ID        string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID  string         `gorm:"not null;type:varchar(36);index"`
+	Name      string         `gorm:"not null;type:varchar(255)"`
+	OwnerID   string         `gorm:"not null;type:varchar(36);index"`
+	Version   int            `gorm:"not null;default:1"`
+	CreatedAt time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (OrganizationModel) TableName() string {
+	return "organizations"
+}
+
+type OrganizationMemberModel struct {
+	OrganizationID string    `gorm:"primaryKey;type:varchar(36)"`
+	UserID         string    `gorm:"primaryKey;type:varchar(36);index"`
+	Role           string    `gorm:"not null;type:varchar(20)"`
+	InvitedBy      string    `gorm:"type:varchar(36)"`
+	JoinedAt       time.Time `gorm:"not null"`
+}
+
+func (OrganizationMemberModel) TableName() string {
+	return "organization_members"
+}
+
+type InvitationModel struct {
+	ID             string     `gorm:"primaryKey;type:varchar(36)"`
+	TenantID       string     `gorm:"not null;type:varchar(36);index"`
+	OrganizationID string     `gorm:"not null;type:varchar(36);index"`
+	Email          string     `gorm:"not null;type:varchar(255);index"`
+	Role           string     `gorm:"not null;type:varchar(20)"`
+	InvitedBy      string     `gorm:"not null;type:varchar(36)"`
+	ExpiresAt      time.Time  `gorm:"not null;index"`
+	AcceptedBy     string     `gorm:"type:varchar(36)"`
+	AcceptedAt     *time.Time `gorm:"index"`
+	RevokedAt      *time.Time `gorm:"index"`
+	Version        int        `gorm:"not null;default:1"`
+	CreatedAt      time.Time  `gorm:"not null;autoCreateTime"`
+}
+
+func (InvitationModel) TableName() string {
+	return "organization_invitations"
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type RoleModel struct {
+	ID          string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID    string         `gorm:"not null;type:varchar(36);index:idx_roles_tenant_name"`
+	Name        string         `gorm:"not null;type:varchar(50);index:idx_roles_tenant_name"`
+	Description string         `gorm:"type:varchar(255)"`
+	Permissions datatypes.JSON `gorm:"type:json"`
+	Parents     datatypes.JSON `gorm:"type:json"`
+	Source      string         `gorm:"not null;type:varchar(10);default:'admin'"`
+	Version     int            `gorm:"not null;default:1"`
+	CreatedAt   time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+func (RoleModel) TableName() string {
+	return "roles"
+}
+
+type UserRoleModel struct {
+	UserID     string    `gorm:"primaryKey;type:varchar(36)"`
+	RoleName   string    `gorm:"primaryKey;type:varchar(50);index"`
+	AssignedBy string    `gorm:"type:varchar(36)"`
+	AssignedAt time.Time `gorm:"not null;autoCreateTime"`
+
+	User UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+func (UserRoleModel) TableName() string {
+	return "user_roles"
+}
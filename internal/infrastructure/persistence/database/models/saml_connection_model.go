@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type SAMLConnectionModel struct {
+	ID               string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID         string         `gorm:"not null;type:varchar(36);index"`
+	OrganizationID   string         `gorm:"type:varchar(36);index"`
+	Name             string         `gorm:"not null;type:varchar(255)"`
+	IdPEntityID      string         `gorm:"not null;type:varchar(1024)"`
+	SSOURL           string         `gorm:"not null;type:varchar(2048)"`
+	Certificates     datatypes.JSON `gorm:"type:json"`
+	AttributeMapping datatypes.JSON `gorm:"type:json"`
+	Domains          datatypes.JSON `gorm:"type:json"`
+	JITProvisioning  bool           `gorm:"not null;default:false"`
+	DefaultRole      string         `gorm:"not null;type:varchar(50)"`
+	IsActive         bool           `gorm:"not null;default:true;index"`
+	Version          int            `gorm:"not null;default:1"`
+	CreatedAt        time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt        time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt        gorm.DeletedAt `gorm:"index"`
+}
+
+func (SAMLConnectionModel) TableName() string {
+	return "saml_connections"
+}
+
+// SAMLIdentityModel links an IdP subject to a local account. Deleting either
+// side removes the link.
+type SAMLIdentityModel struct {
+	ConnectionID string    `gorm:"primaryKey;type:varchar(36)"`
+	Subject      string    `gorm:"primaryKey;type:varchar(512)"`
+	TenantID     string    `gorm:"not null;type:varchar(36);index"`
+	UserID       string    `gorm:"not null;type:varchar(36);index"`
+	CreatedAt    time.Time `gorm:"not null;autoCreateTime"`
+
+	Connection SAMLConnectionModel `gorm:"foreignKey:ConnectionID;constraint:OnDelete:CASCADE"`
+	User       UserModel           `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+func (SAMLIdentityModel) TableName() string {
+	return "saml_identities"
+}
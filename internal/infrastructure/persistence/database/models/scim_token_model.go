@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type SCIMTokenModel struct {
+	ID            string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID      string         `gorm:"not null;type:varchar(36);index"`
+	Name          string         `gorm:"not null;type:varchar(255)"`
+	TokenHash     string         `gorm:"uniqueIndex;not null;type:varchar(64)"`
+	DisplayPrefix string         `gorm:"not null;type:varchar(16)"`
+	CreatedBy     string         `gorm:"not null;type:varchar(36)"`
+	LastUsedAt    *time.Time     `gorm:"type:timestamp"`
+	RevokedAt     *time.Time     `gorm:"type:timestamp"`
+	Version       int            `gorm:"not null;default:1"`
+	CreatedAt     time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt     time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt     gorm.DeletedAt `gorm:"index"`
+}
+
+func (SCIMTokenModel) TableName() string {
+	return "scim_tokens"
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type ServiceAccountModel struct {
+	ID           string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID     string         `gorm:"not null;type:varchar(36);index"`
+	Name         string         `gorm:"not null;type:varchar(255)"`
+	ClientID     string         `gorm:"uniqueIndex;not null;type:varchar(255)"`
+	SecretHash   string         `gorm:"not null;type:varchar(64)"`
+	PublicKeyPEM string         `gorm:"type:text"`
+	Role         string         `gorm:"not null;type:varchar(50)"`
+	Scopes       datatypes.JSON `gorm:"type:json"`
+	IsActive     bool           `gorm:"not null;default:true;index"`
+	Version      int            `gorm:"not null;default:1"`
+	CreatedAt    time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt    time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt `gorm:"index"`
+}
+
+func (ServiceAccountModel) TableName() string {
+	return "service_accounts"
+}
@@ -8,6 +8,7 @@ import (
 
 type SessionModel struct {
 	ID           string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID     string         `gorm:"not null;type:varchar(36);index"`
 	UserID       string         `gorm:"not null;type:varchar(36);index"`
 	RefreshToken string         `gorm:"uniqueIndex;not null;type:text"`
 	AccessToken  string         `gorm:"not null;type:text"`
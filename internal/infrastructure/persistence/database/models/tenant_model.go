@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+type TenantModel struct {
+	ID        string         `gorm:"primaryKey;type:varchar(36)"`
+	Name      string         `gorm:"not null;type:varchar(255)"`
+	Slug      string         `gorm:"uniqueIndex;not null;type:varchar(63)"`
+	Domains   datatypes.JSON `gorm:"type:json"`
+	IsActive  bool           `gorm:"not null;default:true;index"`
+	Version   int            `gorm:"not null;default:1"`
+	CreatedAt time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func (TenantModel) TableName() string {
+	return "tenants"
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TrustedDeviceModel struct {
+	ID         string         `gorm:"primaryKey;type:varchar(36)"`
+	TenantID   string         `gorm:"not null;type:varchar(36);index"`
+	UserID     string         `gorm:"not null;type:varchar(36);index"`
+	DeviceID   string         `gorm:"not null;type:varchar(255)"`
+	Name       string         `gorm:"not null;type:varchar(255)"`
+	IPAddress  string         `gorm:"type:varchar(45)"`
+	ExpiresAt  time.Time      `gorm:"not null;type:timestamp;index"`
+	LastUsedAt *time.Time     `gorm:"type:timestamp"`
+	RevokedAt  *time.Time     `gorm:"type:timestamp"`
+	Version    int            `gorm:"not null;default:1"`
+	CreatedAt  time.Time      `gorm:"not null;autoCreateTime"`
+	UpdatedAt  time.Time      `gorm:"not null;autoUpdateTime"`
+	DeletedAt  gorm.DeletedAt `gorm:"index"`
+
+	User UserModel `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+}
+
+func (TrustedDeviceModel) TableName() string {
+	return "trusted_devices"
+}
@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type APIKeyMapper struct{}
+
+func NewAPIKeyMapper() *APIKeyMapper {
+	return &APIKeyMapper{}
+}
+
+func (m *APIKeyMapper) ToModel(key *aggregates.APIKey) (*models.APIKeyModel, error) {
+	scopesJSON, err := json.Marshal(key.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedIPsJSON, err := json.Marshal(key.AllowedIPs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.APIKeyModel{
+		ID:            key.ID(),
+		TenantID:      key.TenantID,
+		UserID:        key.UserID,
+		Name:          key.Name,
+		KeyHash:       key.KeyHash,
+		DisplayPrefix: key.DisplayPrefix,
+		Scopes:        scopesJSON,
+		AllowedIPs:    allowedIPsJSON,
+		ExpiresAt:     key.ExpiresAt,
+		LastUsedAt:    key.LastUsedAt,
+		RevokedAt:     key.RevokedAt,
+		Version:       key.Version(),
+		CreatedAt:     key.CreatedAt,
+	}, nil
+}
+
+func (m *APIKeyMapper) ToDomain(model *models.APIKeyModel) (*aggregates.APIKey, error) {
+	var scopes []string
+	if len(model.Scopes) > 0 {
+		if err := json.Unmarshal(model.Scopes, &scopes); err != nil {
+			return nil, err
+		}
+	}
+
+	var allowedIPs []string
+	if len(model.AllowedIPs) > 0 {
+		if err := json.Unmarshal(model.AllowedIPs, &allowedIPs); err != nil {
+			return nil, err
+		}
+	}
+
+	return &aggregates.APIKey{
+		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		TenantID:      model.TenantID,
+		UserID:        model.UserID,
+		Name:          model.Name,
+		KeyHash:       model.KeyHash,
+		DisplayPrefix: model.DisplayPrefix,
+		Scopes:        scopes,
+		AllowedIPs:    allowedIPs,
+		ExpiresAt:     model.ExpiresAt,
+		LastUsedAt:    model.LastUsedAt,
+		RevokedAt:     model.RevokedAt,
+		CreatedAt:     model.CreatedAt,
+	}, nil
+}
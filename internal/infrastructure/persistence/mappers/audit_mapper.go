@@ -26,6 +26,7 @@ func (m *AuditMapper) ToModel(aggregate *aggregates.AuditLog) (*models.AuditLogM
 
 	return &models.AuditLogModel{
 		ID:           aggregate.ID(),
+		TenantID:     aggregate.TenantID,
 		UserID:       aggregate.UserID,
 		Action:       aggregate.Action.String(),
 		ResourceType: aggregate.ResourceType,
@@ -49,6 +50,7 @@ func (m *AuditMapper) ToDomain(model *models.AuditLogModel) (*aggregates.AuditLo
 
 	aggregate := &aggregates.AuditLog{
 		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		TenantID:      model.TenantID,
 		UserID:        model.UserID,
 		Action:        valueobjects.AuditAction(model.Action),
 		ResourceType:  model.ResourceType,
@@ -26,6 +26,7 @@ func (m *OAuthMapper) ClientToModel(aggregate *aggregates.OAuthClient) (*models.
 
     return &models.OAuthClientModel{
         ID:           aggregate.ID(),
+        TenantID:     aggregate.TenantID,
         ClientID:     aggregate.ClientID,
         ClientSecret: aggregate.ClientSecret,
         Provider:     aggregate.Provider,
@@ -49,7 +50,8 @@ func (m *OAuthMapper) ClientToDomain(model *models.OAuthClientModel) (*aggregate
     }
 
     aggregate := &aggregates.OAuthClient{
-        AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+        AggregateRoot: aggregates.RestoreAggregateRoot(model.ID, model.Version, model.CreatedAt, model.UpdatedAt),
+        TenantID:      model.TenantID,
         ClientID:      model.ClientID,
         ClientSecret:  model.ClientSecret,
         Provider:      model.Provider,
@@ -0,0 +1,105 @@
+package mappers
+
+import (
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/valueobjects"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type OrganizationMapper struct{}
+
+func NewOrganizationMapper() *OrganizationMapper {
+	return &OrganizationMapper{}
+}
+
+func (m *OrganizationMapper) ToModel(org *aggregates.Organization) *models.OrganizationModel {
+	return &models.OrganizationModel{
+		ID:        org.ID(),
+		TenantID:  org.TenantID,
+		Name:      org.Name,
+		OwnerID:   org.OwnerID,
+		Version:   org.Version(),
+		CreatedAt: org.CreatedAt,
+		UpdatedAt: org.UpdatedAt,
+	}
+}
+
+func (m *OrganizationMapper) ToDomain(model *models.OrganizationModel) *aggregates.Organization {
+	return &aggregates.Organization{
+		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		TenantID:      model.TenantID,
+		Name:          model.Name,
+		OwnerID:       model.OwnerID,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+}
+
+func (m *OrganizationMapper) MemberToModel(member *entities.OrganizationMember) *models.OrganizationMemberModel {
+	return &models.OrganizationMemberModel{
+		OrganizationID: member.OrganizationID,
+		UserID:         member.UserID,
+		Role:           member.Role.String(),
+		InvitedBy:      member.InvitedBy,
+		JoinedAt:       member.JoinedAt,
+	}
+}
+
+func (m *OrganizationMapper) MemberToDomain(model *models.OrganizationMemberModel) (*entities.OrganizationMember, error) {
+	role, err := valueobjects.NewOrgRole(model.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entities.OrganizationMember{
+		OrganizationID: model.OrganizationID,
+		UserID:         model.UserID,
+		Role:           role,
+		InvitedBy:      model.InvitedBy,
+		JoinedAt:       model.JoinedAt,
+	}, nil
+}
+
+func (m *OrganizationMapper) InvitationToModel(invitation *aggregates.Invitation) *models.InvitationModel {
+	return &models.InvitationModel{
+		ID:             invitation.ID(),
+		TenantID:       invitation.TenantID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email.String(),
+		Role:           invitation.Role.String(),
+		InvitedBy:      invitation.InvitedBy,
+		ExpiresAt:      invitation.ExpiresAt,
+		AcceptedBy:     invitation.AcceptedBy,
+		AcceptedAt:     invitation.AcceptedAt,
+		RevokedAt:      invitation.RevokedAt,
+		Version:        invitation.Version(),
+		CreatedAt:      invitation.CreatedAt,
+	}
+}
+
+func (m *OrganizationMapper) InvitationToDomain(model *models.InvitationModel) (*aggregates.Invitation, error) {
+	email, err := valueobjects.NewEmail(model.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := valueobjects.NewOrgRole(model.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aggregates.Invitation{
+		AggregateRoot:  aggregates.NewAggregateRoot(model.ID),
+		TenantID:       model.TenantID,
+		OrganizationID: model.OrganizationID,
+		Email:          email,
+		Role:           role,
+		InvitedBy:      model.InvitedBy,
+		ExpiresAt:      model.ExpiresAt,
+		AcceptedBy:     model.AcceptedBy,
+		AcceptedAt:     model.AcceptedAt,
+		RevokedAt:      model.RevokedAt,
+		CreatedAt:      model.CreatedAt,
+	}, nil
+}
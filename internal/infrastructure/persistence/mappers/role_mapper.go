@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/valueobjects"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type RoleMapper struct{}
+
+func NewRoleMapper() *RoleMapper {
+	return &RoleMapper{}
+}
+
+func (m *RoleMapper) ToModel(role *aggregates.Role) (*models.RoleModel, error) {
+	permissionsJSON, err := json.Marshal(role.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	parentsJSON, err := json.Marshal(role.Parents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RoleModel{
+		ID:          role.ID(),
+		TenantID:    role.TenantID,
+		Name:        role.Name.String(),
+		Description: role.Description,
+		Permissions: permissionsJSON,
+		Parents:     parentsJSON,
+		Source:      string(role.Source),
+		Version:     role.Version(),
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}, nil
+}
+
+func (m *RoleMapper) ToDomain(model *models.RoleModel) (*aggregates.Role, error) {
+	var permissions []valueobjects.Permission
+	if len(model.Permissions) > 0 {
+		if err := json.Unmarshal(model.Permissions, &permissions); err != nil {
+			return nil, err
+		}
+	}
+
+	var parents []valueobjects.Role
+	if len(model.Parents) > 0 {
+		if err := json.Unmarshal(model.Parents, &parents); err != nil {
+			return nil, err
+		}
+	}
+
+	source := aggregates.RoleSource(model.Source)
+	if source == "" {
+		source = aggregates.RoleSourceAdmin
+	}
+
+	return &aggregates.Role{
+		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		TenantID:      model.TenantID,
+		Name:          valueobjects.Role(model.Name),
+		Description:   model.Description,
+		Permissions:   permissions,
+		Parents:       parents,
+		Source:        source,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/valueobjects"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type SAMLConnectionMapper struct{}
+
+func NewSAMLConnectionMapper() *SAMLConnectionMapper {
+	return &SAMLConnectionMapper{}
+}
+
+func (m *SAMLConnectionMapper) ToModel(conn *aggregates.SAMLConnection) (*models.SAMLConnectionModel, error) {
+	certificatesJSON, err := json.Marshal(conn.Certificates)
+	if err != nil {
+		return nil, err
+	}
+
+	mappingJSON, err := json.Marshal(conn.AttributeMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	domainsJSON, err := json.Marshal(conn.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SAMLConnectionModel{
+		ID:               conn.ID(),
+		TenantID:         conn.TenantID,
+		OrganizationID:   conn.OrganizationID,
+		Name:             conn.Name,
+		IdPEntityID:      conn.IdPEntityID,
+		SSOURL:           conn.SSOURL,
+		Certificates:     certificatesJSON,
+		AttributeMapping: mappingJSON,
+		Domains:          domainsJSON,
+		JITProvisioning:  conn.JITProvisioning,
+		DefaultRole:      conn.DefaultRole.String(),
+		IsActive:         conn.IsActive,
+		Version:          conn.Version(),
+		CreatedAt:        conn.CreatedAt,
+		UpdatedAt:        conn.UpdatedAt,
+	}, nil
+}
+
+func (m *SAMLConnectionMapper) ToDomain(model *models.SAMLConnectionModel) (*aggregates.SAMLConnection, error) {
+	var certificates []string
+	if len(model.Certificates) > 0 {
+		if err := json.Unmarshal(model.Certificates, &certificates); err != nil {
+			return nil, err
+		}
+	}
+
+	mapping := map[string]string{}
+	if len(model.AttributeMapping) > 0 {
+		if err := json.Unmarshal(model.AttributeMapping, &mapping); err != nil {
+			return nil, err
+		}
+	}
+
+	var domains []string
+	if len(model.Domains) > 0 {
+		if err := json.Unmarshal(model.Domains, &domains); err != nil {
+			return nil, err
+		}
+	}
+
+	role, err := valueobjects.NewRole(model.DefaultRole)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aggregates.SAMLConnection{
+		AggregateRoot:    aggregates.NewAggregateRoot(model.ID),
+		TenantID:         model.TenantID,
+		OrganizationID:   model.OrganizationID,
+		Name:             model.Name,
+		IdPEntityID:      model.IdPEntityID,
+		SSOURL:           model.SSOURL,
+		Certificates:     certificates,
+		AttributeMapping: mapping,
+		Domains:          domains,
+		JITProvisioning:  model.JITProvisioning,
+		DefaultRole:      role,
+		IsActive:         model.IsActive,
+		CreatedAt:        model.CreatedAt,
+		UpdatedAt:        model.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package mappers
+
+import (
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type SCIMTokenMapper struct{}
+
+func NewSCIMTokenMapper() *SCIMTokenMapper {
+	return &SCIMTokenMapper{}
+}
+
+func (m *SCIMTokenMapper) ToModel(token *aggregates.SCIMToken) *models.SCIMTokenModel {
+	return &models.SCIMTokenModel{
+		ID:            token.ID(),
+		TenantID:      token.TenantID,
+		Name:          token.Name,
+		TokenHash:     token.TokenHash,
+		DisplayPrefix: token.DisplayPrefix,
+		CreatedBy:     token.CreatedBy,
+		LastUsedAt:    token.LastUsedAt,
+		RevokedAt:     token.RevokedAt,
+		Version:       token.Version(),
+		CreatedAt:     token.CreatedAt,
+	}
+}
+
+func (m *SCIMTokenMapper) ToDomain(model *models.SCIMTokenModel) *aggregates.SCIMToken {
+	return &aggregates.SCIMToken{
+		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		TenantID:      model.TenantID,
+		Name:          model.Name,
+		TokenHash:     model.TokenHash,
+		DisplayPrefix: model.DisplayPrefix,
+		CreatedBy:     model.CreatedBy,
+		LastUsedAt:    model.LastUsedAt,
+		RevokedAt:     model.RevokedAt,
+		CreatedAt:     model.CreatedAt,
+	}
+}
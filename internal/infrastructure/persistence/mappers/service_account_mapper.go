@@ -0,0 +1,63 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/valueobjects"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type ServiceAccountMapper struct{}
+
+func NewServiceAccountMapper() *ServiceAccountMapper {
+	return &ServiceAccountMapper{}
+}
+
+func (m *ServiceAccountMapper) ToModel(account *aggregates.ServiceAccount) (*models.ServiceAccountModel, error) {
+	scopesJSON, err := json.Marshal(account.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ServiceAccountModel{
+		ID:           account.ID(),
+		TenantID:     account.TenantID,
+		Name:         account.Name,
+		ClientID:     account.ClientID,
+		SecretHash:   account.SecretHash,
+		PublicKeyPEM: account.PublicKeyPEM,
+		Role:         account.Role.String(),
+		Scopes:       scopesJSON,
+		IsActive:     account.IsActive,
+		Version:      account.Version(),
+		CreatedAt:    account.CreatedAt,
+	}, nil
+}
+
+func (m *ServiceAccountMapper) ToDomain(model *models.ServiceAccountModel) (*aggregates.ServiceAccount, error) {
+	var scopes []string
+	if len(model.Scopes) > 0 {
+		if err := json.Unmarshal(model.Scopes, &scopes); err != nil {
+			return nil, err
+		}
+	}
+
+	role, err := valueobjects.NewRole(model.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aggregates.ServiceAccount{
+		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		TenantID:      model.TenantID,
+		Name:          model.Name,
+		ClientID:      model.ClientID,
+		SecretHash:    model.SecretHash,
+		PublicKeyPEM:  model.PublicKeyPEM,
+		Role:          role,
+		Scopes:        scopes,
+		IsActive:      model.IsActive,
+		CreatedAt:     model.CreatedAt,
+	}, nil
+}
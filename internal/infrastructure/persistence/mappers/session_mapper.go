@@ -14,6 +14,7 @@ func NewSessionMapper() *SessionMapper {
 func (m *SessionMapper) ToModel(session *entities.Session) *models.SessionModel {
 	return &models.SessionModel{
 		ID:           session.ID,
+		TenantID:     session.TenantID,
 		UserID:       session.UserID,
 		RefreshToken: session.RefreshToken,
 		AccessToken:  session.AccessToken,
@@ -30,6 +31,7 @@ func (m *SessionMapper) ToModel(session *entities.Session) *models.SessionModel
 func (m *SessionMapper) ToDomain(model *models.SessionModel) *entities.Session {
 	return &entities.Session{
 		ID:           model.ID,
+		TenantID:     model.TenantID,
 		UserID:       model.UserID,
 		RefreshToken: model.RefreshToken,
 		AccessToken:  model.AccessToken,
@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"encoding/json"
+
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type TenantMapper struct{}
+
+func NewTenantMapper() *TenantMapper {
+	return &TenantMapper{}
+}
+
+func (m *TenantMapper) ToModel(tenant *aggregates.Tenant) (*models.TenantModel, error) {
+	domainsJSON, err := json.Marshal(tenant.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.TenantModel{
+		ID:        tenant.ID(),
+		Name:      tenant.Name,
+		Slug:      tenant.Slug,
+		Domains:   domainsJSON,
+		IsActive:  tenant.IsActive,
+		Version:   tenant.Version(),
+		CreatedAt: tenant.CreatedAt,
+		UpdatedAt: tenant.UpdatedAt,
+	}, nil
+}
+
+func (m *TenantMapper) ToDomain(model *models.TenantModel) (*aggregates.Tenant, error) {
+	var domains []string
+	if len(model.Domains) > 0 {
+		if err := json.Unmarshal(model.Domains, &domains); err != nil {
+			return nil, err
+		}
+	}
+
+	return &aggregates.Tenant{
+		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		Name:          model.Name,
+		Slug:          model.Slug,
+		Domains:       domains,
+		IsActive:      model.IsActive,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package mappers
+
+import (
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/infrastructure/persistence/database/models"
+)
+
+type TrustedDeviceMapper struct{}
+
+func NewTrustedDeviceMapper() *TrustedDeviceMapper {
+	return &TrustedDeviceMapper{}
+}
+
+func (m *TrustedDeviceMapper) ToModel(device *aggregates.TrustedDevice) *models.TrustedDeviceModel {
+	return &models.TrustedDeviceModel{
+		ID:         device.ID(),
+		TenantID:   device.TenantID,
+		UserID:     device.UserID,
+		DeviceID:   device.DeviceID,
+		Name:       device.Name,
+		IPAddress:  device.IPAddress,
+		ExpiresAt:  device.ExpiresAt,
+		LastUsedAt: device.LastUsedAt,
+		RevokedAt:  device.RevokedAt,
+		Version:    device.Version(),
+		CreatedAt:  device.CreatedAt,
+	}
+}
+
+func (m *TrustedDeviceMapper) ToDomain(model *models.TrustedDeviceModel) *aggregates.TrustedDevice {
+	return &aggregates.TrustedDevice{
+		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		TenantID:      model.TenantID,
+		UserID:        model.UserID,
+		DeviceID:      model.DeviceID,
+		Name:          model.Name,
+		IPAddress:     model.IPAddress,
+		ExpiresAt:     model.ExpiresAt,
+		LastUsedAt:    model.LastUsedAt,
+		RevokedAt:     model.RevokedAt,
+		CreatedAt:     model.CreatedAt,
+	}
+}
@@ -15,20 +15,22 @@ func NewUserMapper() *UserMapper {
 
 func (m *UserMapper) ToModel(aggregate *aggregates.UserAggregate) *models.UserModel {
 	return &models.UserModel{
-		ID:           aggregate.User.ID,
-		Username:     aggregate.User.Username.String(),
-		Email:        aggregate.User.Email.String(),
-		PasswordHash: aggregate.User.Password.Value(),
-		Phone:        aggregate.User.Phone.String(),
-		FirstName:    aggregate.User.FirstName,
-		LastName:     aggregate.User.LastName,
-		Role:         aggregate.User.Role.String(),
-		IsActive:     aggregate.User.IsActive,
-		IsVerified:   aggregate.User.IsVerified,
-		LastLoginAt:  aggregate.User.LastLoginAt,
-		Version:      aggregate.Version(),
-		CreatedAt:    aggregate.User.CreatedAt,
-		UpdatedAt:    aggregate.User.UpdatedAt,
+		ID:            aggregate.User.ID,
+		TenantID:      aggregate.User.TenantID,
+		Username:      aggregate.User.Username.String(),
+		Email:         aggregate.User.Email.String(),
+		PasswordHash:  aggregate.User.Password.Value(),
+		Phone:         aggregate.User.Phone.String(),
+		PhoneVerified: aggregate.User.PhoneVerified,
+		FirstName:     aggregate.User.FirstName,
+		LastName:      aggregate.User.LastName,
+		Role:          aggregate.User.Role.String(),
+		IsActive:      aggregate.User.IsActive,
+		IsVerified:    aggregate.User.IsVerified,
+		LastLoginAt:   aggregate.User.LastLoginAt,
+		Version:       aggregate.Version(),
+		CreatedAt:     aggregate.User.CreatedAt,
+		UpdatedAt:     aggregate.User.UpdatedAt,
 	}
 }
 
@@ -54,23 +56,25 @@ func (m *UserMapper) ToDomain(model *models.UserModel) (*aggregates.UserAggregat
 	}
 
 	user := &entities.User{
-		ID:          model.ID,
-		Username:    username,
-		Email:       email,
-		Password:    valueobjects.NewPassword(model.PasswordHash),
-		Phone:       phone,
-		FirstName:   model.FirstName,
-		LastName:    model.LastName,
-		Role:        role,
-		IsActive:    model.IsActive,
-		IsVerified:  model.IsVerified,
-		LastLoginAt: model.LastLoginAt,
-		CreatedAt:   model.CreatedAt,
-		UpdatedAt:   model.UpdatedAt,
+		ID:            model.ID,
+		TenantID:      model.TenantID,
+		Username:      username,
+		Email:         email,
+		Password:      valueobjects.NewPassword(model.PasswordHash),
+		Phone:         phone,
+		PhoneVerified: model.PhoneVerified,
+		FirstName:     model.FirstName,
+		LastName:      model.LastName,
+		Role:          role,
+		IsActive:      model.IsActive,
+		IsVerified:    model.IsVerified,
+		LastLoginAt:   model.LastLoginAt,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
 	}
 
 	aggregate := &aggregates.UserAggregate{
-		AggregateRoot: aggregates.NewAggregateRoot(model.ID),
+		AggregateRoot: aggregates.RestoreAggregateRoot(model.ID, model.Version, model.CreatedAt, model.UpdatedAt),
 		User:          user,
 		Sessions:      make([]*entities.Session, 0),
 	}
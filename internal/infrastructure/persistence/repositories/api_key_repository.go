@@ -0,0 +1,250 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const apiKeyColumns = `
+	id, tenant_id, user_id, name, key_hash, display_prefix, scopes, allowed_ips,
+	expires_at, last_used_at, revoked_at, version, created_at, updated_at
+`
+
+type postgresAPIKeyRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.APIKeyMapper
+	logger logging.Logger
+}
+
+func NewPostgresAPIKeyRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.APIKeyRepository {
+	return &postgresAPIKeyRepository{
+		uow:    uow,
+		mapper: mappers.NewAPIKeyMapper(),
+		logger: logger.With(zap.String("repository", "api_key")),
+	}
+}
+
+func (r *postgresAPIKeyRepository) Create(ctx context.Context, key *aggregates.APIKey) error {
+	if err := stampTenant(ctx, &key.TenantID); err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(key)
+	if err != nil {
+		return fmt.Errorf("failed to map api key: %w", err)
+	}
+
+	now := time.Now().UTC()
+	query := `
+		INSERT INTO api_keys (` + apiKeyColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+
+	_, err = r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.UserID, model.Name, model.KeyHash, model.DisplayPrefix,
+		model.Scopes, model.AllowedIPs, model.ExpiresAt, model.LastUsedAt,
+		model.RevokedAt, model.Version, model.CreatedAt, now,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create api key",
+			zap.String("user_id", key.UserID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresAPIKeyRepository) FindByID(ctx context.Context, id string) (*aggregates.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, id)
+}
+
+func (r *postgresAPIKeyRepository) FindByHash(ctx context.Context, keyHash string) (*aggregates.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, keyHash)
+}
+
+func (r *postgresAPIKeyRepository) Update(ctx context.Context, key *aggregates.APIKey) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(key)
+	if err != nil {
+		return fmt.Errorf("failed to map api key: %w", err)
+	}
+
+	query := `
+		UPDATE api_keys SET
+			name = $2,
+			scopes = $3,
+			allowed_ips = $4,
+			expires_at = $5,
+			last_used_at = $6,
+			revoked_at = $7,
+			version = $8,
+			updated_at = $9
+		WHERE id = $1 AND tenant_id = $10 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.Scopes, model.AllowedIPs, model.ExpiresAt,
+		model.LastUsedAt, model.RevokedAt, model.Version, time.Now().UTC(), tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update api key",
+			zap.String("id", key.ID()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update api key: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresAPIKeyRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE api_keys SET last_used_at = $2 WHERE id = $1 AND tenant_id = $3 AND deleted_at IS NULL`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, id, usedAt, tenantID); err != nil {
+		return fmt.Errorf("failed to update api key last used: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresAPIKeyRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `DELETE FROM api_keys WHERE user_id = $1 AND tenant_id = $2`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, userID, tenantID); err != nil {
+		return fmt.Errorf("failed to delete api keys: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresAPIKeyRepository) ListByUser(ctx context.Context, userID string, page, pageSize int) ([]*aggregates.APIKey, int64, error) {
+	return r.list(ctx, `user_id = $2 AND deleted_at IS NULL`, []interface{}{userID}, page, pageSize)
+}
+
+func (r *postgresAPIKeyRepository) List(ctx context.Context, page, pageSize int) ([]*aggregates.APIKey, int64, error) {
+	return r.list(ctx, `deleted_at IS NULL`, nil, page, pageSize)
+}
+
+func (r *postgresAPIKeyRepository) list(
+	ctx context.Context,
+	where string,
+	args []interface{},
+	page, pageSize int,
+) ([]*aggregates.APIKey, int64, error) {
+	// $1 is always the tenant; callers number their own placeholders from $2.
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	where = `tenant_id = $1 AND ` + where
+	args = append([]interface{}{tenantID}, args...)
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM api_keys WHERE ` + where
+	if err := r.uow.Con().QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count api keys: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM api_keys
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, apiKeyColumns, where, len(args)+1, len(args)+2)
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, append(args, pageSize, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*aggregates.APIKey
+	for rows.Next() {
+		model, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan api key: %w", err)
+		}
+
+		key, err := r.mapper.ToDomain(model)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to map api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating api keys: %w", err)
+	}
+
+	return keys, total, nil
+}
+
+func (r *postgresAPIKeyRepository) findOne(ctx context.Context, query string, arg interface{}) (*aggregates.APIKey, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := scanAPIKey(r.uow.Con().QueryRowContext(ctx, query, arg, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find api key: %w", err)
+	}
+
+	return r.mapper.ToDomain(model)
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAPIKey(row rowScanner) (*models.APIKeyModel, error) {
+	var model models.APIKeyModel
+	err := row.Scan(
+		&model.ID, &model.TenantID, &model.UserID, &model.Name, &model.KeyHash, &model.DisplayPrefix,
+		&model.Scopes, &model.AllowedIPs, &model.ExpiresAt, &model.LastUsedAt,
+		&model.RevokedAt, &model.Version, &model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
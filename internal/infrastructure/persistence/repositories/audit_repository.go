@@ -4,17 +4,31 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"authentication/internal/domain/aggregates"
 	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
 	"authentication/internal/infrastructure/observability/metrics"
 	"authentication/shared/logging"
 	"authentication/shared/tracing"
+	"authentication/shared/utils"
 
 	//"go.opentelemetry.io/otel/codes"
 	"go.uber.org/zap"
 )
 
+const auditLogColumns = `
+	id, tenant_id, user_id, action, resource_id, resource_type,
+	status, error_message, ip_address, user_agent, metadata, created_at
+`
+
+// auditSearchConfig is the text search configuration of the search_vector
+// column. 'simple' neither stems nor drops stop words, which suits ids,
+// action names and addresses better than a language configuration.
+const auditSearchConfig = "simple"
+
 type PostgresAuditRepository struct {
 	db      *sql.DB
 	logger  logging.Logger
@@ -48,15 +62,22 @@ func (r *PostgresAuditRepository) Create(ctx context.Context, log *aggregates.Au
 		return err
 	}*/
 
+	// Audit writes never fail on a missing tenant: an event recorded before
+	// tenant resolution (e.g. an unknown host) is still worth keeping.
+	if log.TenantID == "" {
+		log.TenantID, _ = utils.TenantIDFromContext(ctx)
+	}
+
 	query := `
 		INSERT INTO audit_logs (
-			id, user_id, action, resource_id, resource_type,
+			id, tenant_id, user_id, action, resource_id, resource_type,
 			status, error_message, ip_address, user_agent, metadata, created_at
-		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
 	`
 
 	_, _ = r.db.ExecContext(ctx, query,
 		log.ID,
+		log.TenantID,
 		log.UserID,
 		string(log.Action),
 		log.ResourceID,
@@ -84,3 +105,196 @@ func (r *PostgresAuditRepository) Create(ctx context.Context, log *aggregates.Au
 
 	return nil
 }
+
+func (r *PostgresAuditRepository) FindByUserID(ctx context.Context, userID string, limit int) ([]*aggregates.AuditLog, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + auditLogColumns + `
+		FROM audit_logs
+		WHERE tenant_id = $1 AND (user_id = $2 OR resource_id = $2)
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantID, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit logs: %w", err)
+	}
+	return collectAuditLogs(rows)
+}
+
+func (r *PostgresAuditRepository) AnonymizeByUserID(ctx context.Context, userID string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE audit_logs
+		SET ip_address = NULL, user_agent = NULL, metadata = '{}'::jsonb
+		WHERE tenant_id = $1 AND (user_id = $2 OR resource_id = $2)
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, tenantID, userID); err != nil {
+		return fmt.Errorf("failed to anonymize audit logs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PostgresAuditRepository) List(
+	ctx context.Context,
+	filter repositories.AuditLogFilter,
+) ([]*aggregates.AuditLog, int64, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	where := auditWhere{clause: "tenant_id = $1", args: []interface{}{tenantID}}
+	if filter.UserID != nil {
+		where.add("user_id = $%d", *filter.UserID)
+	}
+	if filter.Action != nil {
+		where.add("action = $%d", filter.Action.String())
+	}
+	if filter.ResourceType != nil {
+		where.add("resource_type = $%d", *filter.ResourceType)
+	}
+	if filter.ResourceID != nil {
+		where.add("resource_id = $%d", *filter.ResourceID)
+	}
+	if filter.Status != nil {
+		where.add("status = $%d", *filter.Status)
+	}
+	where.addRange(filter.StartDate, filter.EndDate)
+
+	var total int64
+	countQuery := "SELECT COUNT(*) FROM audit_logs WHERE " + where.clause
+	if err := r.db.QueryRowContext(ctx, countQuery, where.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit logs: %w", err)
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	dataQuery := `SELECT ` + auditLogColumns + ` FROM audit_logs WHERE ` + where.clause +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(where.args)+1, len(where.args)+2)
+
+	rows, err := r.db.QueryContext(ctx, dataQuery, append(where.args, filter.PageSize, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit logs: %w", err)
+	}
+	logs, err := collectAuditLogs(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
+
+// Search matches search.Query, in web search syntax (quoted phrases, OR,
+// -exclusion), against the GIN-indexed search_vector column added by
+// scripts/migrations/0002_audit_log_search.sql. Pages follow (created_at,
+// id) so each one is an index range scan however deep it is.
+func (r *PostgresAuditRepository) Search(
+	ctx context.Context,
+	search repositories.AuditLogSearch,
+) ([]*aggregates.AuditLog, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	where := auditWhere{clause: "tenant_id = $1", args: []interface{}{tenantID}}
+	where.add("search_vector @@ websearch_to_tsquery('"+auditSearchConfig+"', $%d)", search.Query)
+	where.addRange(search.StartDate, search.EndDate)
+	if search.After != nil {
+		where.args = append(where.args, search.After.Timestamp, search.After.ID)
+		where.clause += fmt.Sprintf(" AND (created_at, id) < ($%d, $%d)", len(where.args)-1, len(where.args))
+	}
+
+	query := `SELECT ` + auditLogColumns + ` FROM audit_logs WHERE ` + where.clause +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(where.args)+1)
+
+	rows, err := r.db.QueryContext(ctx, query, append(where.args, search.Limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search audit logs: %w", err)
+	}
+	return collectAuditLogs(rows)
+}
+
+// auditWhere accumulates an AND-ed WHERE clause and its positional
+// arguments.
+type auditWhere struct {
+	clause string
+	args   []interface{}
+}
+
+// add appends condition, whose single %d is replaced by the placeholder
+// number of value.
+func (w *auditWhere) add(condition string, value interface{}) {
+	w.args = append(w.args, value)
+	w.clause += " AND " + fmt.Sprintf(condition, len(w.args))
+}
+
+func (w *auditWhere) addRange(start, end *time.Time) {
+	if start != nil {
+		w.add("created_at >= $%d", *start)
+	}
+	if end != nil {
+		w.add("created_at < $%d", *end)
+	}
+}
+
+func collectAuditLogs(rows *sql.Rows) ([]*aggregates.AuditLog, error) {
+	defer rows.Close()
+
+	var logs []*aggregates.AuditLog
+	for rows.Next() {
+		log, err := scanAuditLog(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit log: %w", err)
+		}
+		logs = append(logs, log)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate audit logs: %w", err)
+	}
+
+	return logs, nil
+}
+
+func scanAuditLog(row rowScanner) (*aggregates.AuditLog, error) {
+	var (
+		log          aggregates.AuditLog
+		id, action   string
+		resourceID   sql.NullString
+		resourceType sql.NullString
+		errorMessage sql.NullString
+		ipAddress    sql.NullString
+		userAgent    sql.NullString
+		metadata     []byte
+	)
+	if err := row.Scan(
+		&id, &log.TenantID, &log.UserID, &action, &resourceID, &resourceType,
+		&log.Status, &errorMessage, &ipAddress, &userAgent, &metadata, &log.Timestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	log.AggregateRoot = aggregates.RestoreAggregateRoot(id, 1, log.Timestamp, log.Timestamp)
+	log.Action = valueobjects.AuditAction(action)
+	log.ResourceID = resourceID.String
+	log.ResourceType = resourceType.String
+	log.ErrorMessage = errorMessage.String
+	log.IPAddress = ipAddress.String
+	log.UserAgent = userAgent.String
+	if len(metadata) > 0 {
+		_ = json.Unmarshal(metadata, &log.Metadata)
+	}
+
+	return &log, nil
+}
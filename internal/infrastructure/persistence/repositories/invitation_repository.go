@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+const invitationColumns = `
+	id, tenant_id, organization_id, email, role, invited_by, expires_at,
+	accepted_by, accepted_at, revoked_at, version, created_at
+`
+
+type postgresInvitationRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.OrganizationMapper
+	logger logging.Logger
+}
+
+func NewPostgresInvitationRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.InvitationRepository {
+	return &postgresInvitationRepository{
+		uow:    uow,
+		mapper: mappers.NewOrganizationMapper(),
+		logger: logger.With(zap.String("repository", "invitation")),
+	}
+}
+
+func (r *postgresInvitationRepository) Create(ctx context.Context, invitation *aggregates.Invitation) error {
+	if err := stampTenant(ctx, &invitation.TenantID); err != nil {
+		return err
+	}
+
+	model := r.mapper.InvitationToModel(invitation)
+	query := `
+		INSERT INTO organization_invitations (` + invitationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.OrganizationID, model.Email, model.Role,
+		model.InvitedBy, model.ExpiresAt, model.AcceptedBy, model.AcceptedAt,
+		model.RevokedAt, model.Version, model.CreatedAt,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create invitation",
+			zap.String("organization_id", model.OrganizationID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresInvitationRepository) FindByID(ctx context.Context, id string) (*aggregates.Invitation, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + invitationColumns + ` FROM organization_invitations WHERE id = $1 AND tenant_id = $2`
+	model, err := scanInvitation(r.uow.Con().QueryRowContext(ctx, query, id, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find invitation: %w", err)
+	}
+
+	return r.mapper.InvitationToDomain(model)
+}
+
+func (r *postgresInvitationRepository) Update(ctx context.Context, invitation *aggregates.Invitation) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := r.mapper.InvitationToModel(invitation)
+	query := `
+		UPDATE organization_invitations SET
+			accepted_by = $2,
+			accepted_at = $3,
+			revoked_at = $4,
+			version = $5
+		WHERE id = $1 AND tenant_id = $6
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.AcceptedBy, model.AcceptedAt, model.RevokedAt, model.Version, tenantID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update invitation: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresInvitationRepository) ListPending(ctx context.Context, organizationID string) ([]*aggregates.Invitation, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + invitationColumns + `
+		FROM organization_invitations
+		WHERE organization_id = $1 AND tenant_id = $2
+			AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, organizationID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invitations: %w", err)
+	}
+	defer rows.Close()
+
+	var invitations []*aggregates.Invitation
+	for rows.Next() {
+		model, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+
+		invitation, err := r.mapper.InvitationToDomain(model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map invitation: %w", err)
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating invitations: %w", err)
+	}
+
+	return invitations, nil
+}
+
+func scanInvitation(row rowScanner) (*models.InvitationModel, error) {
+	var model models.InvitationModel
+	err := row.Scan(
+		&model.ID, &model.TenantID, &model.OrganizationID, &model.Email, &model.Role,
+		&model.InvitedBy, &model.ExpiresAt, &model.AcceptedBy, &model.AcceptedAt,
+		&model.RevokedAt, &model.Version, &model.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
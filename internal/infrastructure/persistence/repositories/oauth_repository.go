@@ -1 +1,246 @@
-package repositories
\ No newline at end of file
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const oauthClientColumns = `
+	id, tenant_id, client_id, client_secret, provider, name, redirect_uris, scopes,
+	is_active, version, created_at, updated_at
+`
+
+type postgresOAuthClientRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.OAuthMapper
+	logger logging.Logger
+}
+
+func NewPostgresOAuthClientRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.OAuthClientRepository {
+	return &postgresOAuthClientRepository{
+		uow:    uow,
+		mapper: mappers.NewOAuthMapper(),
+		logger: logger.With(zap.String("repository", "oauth_client")),
+	}
+}
+
+func (r *postgresOAuthClientRepository) Create(ctx context.Context, client *aggregates.OAuthClient) error {
+	if err := stampTenant(ctx, &client.TenantID); err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ClientToModel(client)
+	if err != nil {
+		return fmt.Errorf("failed to map oauth client: %w", err)
+	}
+
+	now := time.Now().UTC()
+	query := `
+		INSERT INTO oauth_clients (` + oauthClientColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.ClientID, model.ClientSecret, model.Provider, model.Name,
+		model.RedirectURIs, model.Scopes, model.IsActive, model.Version, now, now,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create oauth client",
+			zap.String("client_id", client.ClientID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create oauth client: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresOAuthClientRepository) FindByID(ctx context.Context, id string) (*aggregates.OAuthClient, error) {
+	query := `SELECT ` + oauthClientColumns + ` FROM oauth_clients WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, id)
+}
+
+func (r *postgresOAuthClientRepository) FindByClientID(ctx context.Context, clientID string) (*aggregates.OAuthClient, error) {
+	query := `SELECT ` + oauthClientColumns + ` FROM oauth_clients WHERE client_id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, clientID)
+}
+
+func (r *postgresOAuthClientRepository) FindByProvider(ctx context.Context, provider string) ([]*aggregates.OAuthClient, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + oauthClientColumns + `
+		FROM oauth_clients
+		WHERE provider = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, provider, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find oauth clients by provider: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAll(rows)
+}
+
+func (r *postgresOAuthClientRepository) Update(ctx context.Context, client *aggregates.OAuthClient) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ClientToModel(client)
+	if err != nil {
+		return fmt.Errorf("failed to map oauth client: %w", err)
+	}
+
+	query := `
+		UPDATE oauth_clients SET
+			client_secret = $2,
+			name = $3,
+			redirect_uris = $4,
+			scopes = $5,
+			is_active = $6,
+			version = $7,
+			updated_at = $8
+		WHERE id = $1 AND tenant_id = $9 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.ClientSecret, model.Name, model.RedirectURIs,
+		model.Scopes, model.IsActive, model.Version, time.Now().UTC(), tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update oauth client",
+			zap.String("id", client.ID()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update oauth client: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresOAuthClientRepository) Delete(ctx context.Context, id string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE oauth_clients SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	result, err := r.uow.Con().ExecContext(ctx, query, id, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to delete oauth client: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresOAuthClientRepository) List(ctx context.Context, page, pageSize int) ([]*aggregates.OAuthClient, int64, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM oauth_clients WHERE tenant_id = $1 AND deleted_at IS NULL`
+	if err := r.uow.Con().QueryRowContext(ctx, countQuery, tenantID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count oauth clients: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+	query := `
+		SELECT ` + oauthClientColumns + `
+		FROM oauth_clients
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, tenantID, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list oauth clients: %w", err)
+	}
+	defer rows.Close()
+
+	clients, err := r.scanAll(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return clients, total, nil
+}
+
+func (r *postgresOAuthClientRepository) findOne(ctx context.Context, query string, arg interface{}) (*aggregates.OAuthClient, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var model models.OAuthClientModel
+	err = r.uow.Con().QueryRowContext(ctx, query, arg, tenantID).Scan(
+		&model.ID, &model.TenantID, &model.ClientID, &model.ClientSecret, &model.Provider, &model.Name,
+		&model.RedirectURIs, &model.Scopes, &model.IsActive, &model.Version,
+		&model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find oauth client: %w", err)
+	}
+
+	return r.mapper.ClientToDomain(&model)
+}
+
+func (r *postgresOAuthClientRepository) scanAll(rows *sql.Rows) ([]*aggregates.OAuthClient, error) {
+	var clients []*aggregates.OAuthClient
+	for rows.Next() {
+		var model models.OAuthClientModel
+		if err := rows.Scan(
+			&model.ID, &model.TenantID, &model.ClientID, &model.ClientSecret, &model.Provider, &model.Name,
+			&model.RedirectURIs, &model.Scopes, &model.IsActive, &model.Version,
+			&model.CreatedAt, &model.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan oauth client: %w", err)
+		}
+
+		client, err := r.mapper.ClientToDomain(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map oauth client: %w", err)
+		}
+		clients = append(clients, client)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating oauth clients: %w", err)
+	}
+
+	return clients, nil
+}
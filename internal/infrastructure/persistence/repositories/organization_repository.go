@@ -0,0 +1,346 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+const organizationColumns = `
+	id, tenant_id, name, owner_id, version, created_at, updated_at
+`
+
+// Memberships carry no tenant column of their own; every member query joins
+// organizations so the tenant filter still applies.
+type postgresOrganizationRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.OrganizationMapper
+	logger logging.Logger
+}
+
+func NewPostgresOrganizationRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.OrganizationRepository {
+	return &postgresOrganizationRepository{
+		uow:    uow,
+		mapper: mappers.NewOrganizationMapper(),
+		logger: logger.With(zap.String("repository", "organization")),
+	}
+}
+
+func (r *postgresOrganizationRepository) Create(ctx context.Context, org *aggregates.Organization) error {
+	if err := stampTenant(ctx, &org.TenantID); err != nil {
+		return err
+	}
+
+	model := r.mapper.ToModel(org)
+	query := `
+		INSERT INTO organizations (` + organizationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.Name, model.OwnerID,
+		model.Version, model.CreatedAt, model.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create organization",
+			zap.String("organization_id", model.ID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create organization: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresOrganizationRepository) FindByID(ctx context.Context, id string) (*aggregates.Organization, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + organizationColumns + ` FROM organizations WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	model, err := scanOrganization(r.uow.Con().QueryRowContext(ctx, query, id, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization: %w", err)
+	}
+
+	return r.mapper.ToDomain(model), nil
+}
+
+func (r *postgresOrganizationRepository) Update(ctx context.Context, org *aggregates.Organization) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := r.mapper.ToModel(org)
+	query := `
+		UPDATE organizations SET
+			name = $2,
+			owner_id = $3,
+			version = $4,
+			updated_at = $5
+		WHERE id = $1 AND tenant_id = $6 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.OwnerID, model.Version, model.UpdatedAt, tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update organization",
+			zap.String("organization_id", model.ID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update organization: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresOrganizationRepository) ListByUser(ctx context.Context, userID string) ([]*aggregates.Organization, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT o.id, o.tenant_id, o.name, o.owner_id, o.version, o.created_at, o.updated_at
+		FROM organizations o
+		JOIN organization_members m ON m.organization_id = o.id
+		WHERE m.user_id = $1 AND o.tenant_id = $2 AND o.deleted_at IS NULL
+		ORDER BY o.name
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, userID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	defer rows.Close()
+
+	var orgs []*aggregates.Organization
+	for rows.Next() {
+		model, err := scanOrganization(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		orgs = append(orgs, r.mapper.ToDomain(model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organizations: %w", err)
+	}
+
+	return orgs, nil
+}
+
+func (r *postgresOrganizationRepository) AddMember(ctx context.Context, member *entities.OrganizationMember) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := r.mapper.MemberToModel(member)
+	// Inserting through a SELECT on organizations rejects an organization
+	// from another tenant the same way as a missing one.
+	query := `
+		INSERT INTO organization_members (organization_id, user_id, role, invited_by, joined_at)
+		SELECT id, $2, $3, $4, $5 FROM organizations
+		WHERE id = $1 AND tenant_id = $6 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.OrganizationID, model.UserID, model.Role, model.InvitedBy, model.JoinedAt, tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to add organization member",
+			zap.String("organization_id", model.OrganizationID),
+			zap.String("user_id", model.UserID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to add organization member: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresOrganizationRepository) FindMember(ctx context.Context, organizationID, userID string) (*entities.OrganizationMember, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT m.organization_id, m.user_id, m.role, m.invited_by, m.joined_at
+		FROM organization_members m
+		JOIN organizations o ON o.id = m.organization_id
+		WHERE m.organization_id = $1 AND m.user_id = $2 AND o.tenant_id = $3 AND o.deleted_at IS NULL
+	`
+
+	model, err := scanOrganizationMember(r.uow.Con().QueryRowContext(ctx, query, organizationID, userID, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find organization member: %w", err)
+	}
+
+	return r.mapper.MemberToDomain(model)
+}
+
+func (r *postgresOrganizationRepository) UpdateMember(ctx context.Context, member *entities.OrganizationMember) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE organization_members SET role = $3
+		WHERE organization_id = $1 AND user_id = $2
+			AND organization_id IN (SELECT id FROM organizations WHERE tenant_id = $4 AND deleted_at IS NULL)
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		member.OrganizationID, member.UserID, member.Role.String(), tenantID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update organization member: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresOrganizationRepository) RemoveMember(ctx context.Context, organizationID, userID string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		DELETE FROM organization_members
+		WHERE organization_id = $1 AND user_id = $2
+			AND organization_id IN (SELECT id FROM organizations WHERE tenant_id = $3 AND deleted_at IS NULL)
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query, organizationID, userID, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to remove organization member: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresOrganizationRepository) RemoveUserMemberships(ctx context.Context, userID string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		DELETE FROM organization_members
+		WHERE user_id = $1
+			AND organization_id IN (SELECT id FROM organizations WHERE tenant_id = $2)
+	`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, userID, tenantID); err != nil {
+		return fmt.Errorf("failed to remove organization memberships: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresOrganizationRepository) ListMembers(ctx context.Context, organizationID string) ([]*entities.OrganizationMember, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT m.organization_id, m.user_id, m.role, m.invited_by, m.joined_at
+		FROM organization_members m
+		JOIN organizations o ON o.id = m.organization_id
+		WHERE m.organization_id = $1 AND o.tenant_id = $2 AND o.deleted_at IS NULL
+		ORDER BY m.joined_at
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, organizationID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []*entities.OrganizationMember
+	for rows.Next() {
+		model, err := scanOrganizationMember(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan organization member: %w", err)
+		}
+
+		member, err := r.mapper.MemberToDomain(model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map organization member: %w", err)
+		}
+		members = append(members, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating organization members: %w", err)
+	}
+
+	return members, nil
+}
+
+func scanOrganization(row rowScanner) (*models.OrganizationModel, error) {
+	var model models.OrganizationModel
+	err := row.Scan(
+		&model.ID, &model.TenantID, &model.Name, &model.OwnerID,
+		&model.Version, &model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
+
+func scanOrganizationMember(row rowScanner) (*models.OrganizationMemberModel, error) {
+	var model models.OrganizationMemberModel
+	err := row.Scan(
+		&model.OrganizationID, &model.UserID, &model.Role, &model.InvitedBy, &model.JoinedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -17,16 +18,25 @@ func NewPostgresOutbox(db *sql.DB) *PostgresOutbox {
 	return &PostgresOutbox{db: db}
 }
 
+// outboxMaxAttempts is how often a message is dispatched before it is left
+// in the table for manual inspection.
+const outboxMaxAttempts = 10
+
 // Save a new outbox message (must be called within a UoW transaction)
 func (p *PostgresOutbox) Save(ctx context.Context, msg *persistence.OutboxMessage) error {
-	if msg.CreatedAt == 0 {
-		msg.CreatedAt = time.Now().UnixMilli()
+	if msg.OccurredAt == 0 {
+		msg.OccurredAt = time.Now().Unix()
 	}
-	
-	_, err := p.db.ExecContext(ctx,
-		`INSERT INTO outbox (id, event_type, payload, created_at) 
-		 VALUES ($1, $2, $3, to_timestamp($4 / 1000.0))`,
-		msg.ID, msg.EventType, msg.Payload, msg.CreatedAt,
+
+	metadata, err := json.Marshal(msg.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox metadata: %w", err)
+	}
+
+	_, err = p.db.ExecContext(ctx,
+		`INSERT INTO outbox (id, event_type, aggregate_id, payload, metadata, created_at) 
+		 VALUES ($1, $2, $3, $4, $5, to_timestamp($6))`,
+		msg.ID, msg.EventType, msg.AggregateID, msg.Payload, metadata, msg.OccurredAt,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to save outbox message: %w", err)
@@ -37,11 +47,9 @@ func (p *PostgresOutbox) Save(ctx context.Context, msg *persistence.OutboxMessag
 
 // MarkAsSent marks a message as successfully dispatched
 func (p *PostgresOutbox) MarkAsSent(ctx context.Context, id string) error {
-	now := time.Now().UnixMilli()
-	
 	result, err := p.db.ExecContext(ctx,
-		`UPDATE outbox SET sent_at = to_timestamp($1 / 1000.0) WHERE id = $2 AND sent_at IS NULL`,
-		now, id,
+		`UPDATE outbox SET sent_at = NOW() WHERE id = $1 AND sent_at IS NULL`,
+		id,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to mark outbox message as sent: %w", err)
@@ -58,18 +66,36 @@ func (p *PostgresOutbox) MarkAsSent(ctx context.Context, id string) error {
 	return nil
 }
 
-// FetchPending returns unsent messages for background processing
-// IMPROVED: Use int64 directly to avoid float precision issues
+// MarkFailed records a failed dispatch and schedules the next attempt with
+// exponential backoff, capped at one hour.
+func (p *PostgresOutbox) MarkFailed(ctx context.Context, id string, reason string) error {
+	_, err := p.db.ExecContext(ctx,
+		`UPDATE outbox 
+		 SET attempts = attempts + 1,
+		     last_error = $2,
+		     next_attempt_at = NOW() + INTERVAL '1 second' * LEAST(3600, 30 * POWER(2, attempts))
+		 WHERE id = $1 AND sent_at IS NULL`,
+		id, reason,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox message as failed: %w", err)
+	}
+
+	return nil
+}
+
+// FetchPending returns unsent messages that are due for (re)processing
 func (p *PostgresOutbox) FetchPending(ctx context.Context, limit int) ([]*persistence.OutboxMessage, error) {
 	rows, err := p.db.QueryContext(ctx,
-		`SELECT id, event_type, payload, 
-		        EXTRACT(EPOCH FROM created_at)::bigint * 1000 as created_at,
-		        EXTRACT(EPOCH FROM sent_at)::bigint * 1000 as sent_at
+		`SELECT id, event_type, aggregate_id, payload, metadata,
+		        EXTRACT(EPOCH FROM created_at)::bigint as created_at
 		 FROM outbox 
 		 WHERE sent_at IS NULL 
+		   AND attempts < $2
+		   AND (next_attempt_at IS NULL OR next_attempt_at <= NOW())
 		 ORDER BY created_at ASC 
 		 LIMIT $1`,
-		limit,
+		limit, outboxMaxAttempts,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch pending outbox messages: %w", err)
@@ -79,14 +105,16 @@ func (p *PostgresOutbox) FetchPending(ctx context.Context, limit int) ([]*persis
 	var msgs []*persistence.OutboxMessage
 	for rows.Next() {
 		var m persistence.OutboxMessage
-		var sentAt sql.NullInt64
+		var metadata []byte
 
-		if err := rows.Scan(&m.ID, &m.EventType, &m.Payload, &m.CreatedAt, &sentAt); err != nil {
+		if err := rows.Scan(&m.ID, &m.EventType, &m.AggregateID, &m.Payload, &metadata, &m.OccurredAt); err != nil {
 			return nil, fmt.Errorf("failed to scan outbox row: %w", err)
 		}
 
-		if sentAt.Valid {
-			m.SentAt = &sentAt.Int64
+		if len(metadata) > 0 {
+			if err := json.Unmarshal(metadata, &m.Metadata); err != nil {
+				return nil, fmt.Errorf("failed to decode outbox metadata: %w", err)
+			}
 		}
 
 		msgs = append(msgs, &m)
@@ -0,0 +1,366 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+const roleColumns = `
+	id, tenant_id, name, description, permissions, parents, source, version, created_at, updated_at
+`
+
+type postgresRoleRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.RoleMapper
+	logger logging.Logger
+}
+
+func NewPostgresRoleRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.RoleRepository {
+	return &postgresRoleRepository{
+		uow:    uow,
+		mapper: mappers.NewRoleMapper(),
+		logger: logger.With(zap.String("repository", "role")),
+	}
+}
+
+func (r *postgresRoleRepository) Create(ctx context.Context, role *aggregates.Role) error {
+	if err := stampTenant(ctx, &role.TenantID); err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(role)
+	if err != nil {
+		return fmt.Errorf("failed to map role: %w", err)
+	}
+
+	query := `
+		INSERT INTO roles (` + roleColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err = r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.Name, model.Description, model.Permissions, model.Parents,
+		model.Source, model.Version, model.CreatedAt, model.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create role",
+			zap.String("name", model.Name),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+
+	return nil
+}
+
+// FindByName serves the built-in roles from code and custom roles from the
+// database, where each tenant has its own set.
+func (r *postgresRoleRepository) FindByName(ctx context.Context, name valueobjects.Role) (*aggregates.Role, error) {
+	if role, ok := aggregates.SystemRole(name); ok {
+		return role, nil
+	}
+
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + roleColumns + ` FROM roles WHERE name = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	model, err := scanRole(r.uow.Con().QueryRowContext(ctx, query, name.String(), tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find role: %w", err)
+	}
+
+	return r.mapper.ToDomain(model)
+}
+
+func (r *postgresRoleRepository) Update(ctx context.Context, role *aggregates.Role) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(role)
+	if err != nil {
+		return fmt.Errorf("failed to map role: %w", err)
+	}
+
+	query := `
+		UPDATE roles SET
+			description = $2,
+			permissions = $3,
+			parents = $4,
+			version = $5,
+			updated_at = $6
+		WHERE id = $1 AND tenant_id = $7 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Description, model.Permissions, model.Parents,
+		model.Version, model.UpdatedAt, tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update role",
+			zap.String("name", model.Name),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRoleRepository) Delete(ctx context.Context, name valueobjects.Role) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE roles SET deleted_at = NOW() WHERE name = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	result, err := r.uow.Con().ExecContext(ctx, query, name.String(), tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRoleRepository) List(ctx context.Context) ([]*aggregates.Role, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + roleColumns + `
+		FROM roles
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY name
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	defer rows.Close()
+
+	roles := aggregates.SystemRoles()
+	for rows.Next() {
+		model, err := scanRole(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan role: %w", err)
+		}
+
+		role, err := r.mapper.ToDomain(model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+func (r *postgresRoleRepository) CountDependents(ctx context.Context, name valueobjects.Role) (int64, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM user_roles ur JOIN users u ON u.id = ur.user_id
+				WHERE ur.role_name = $1 AND u.tenant_id = $2) +
+			(SELECT COUNT(*) FROM roles WHERE tenant_id = $2 AND deleted_at IS NULL AND parents::jsonb ? $1)
+	`
+
+	var count int64
+	if err := r.uow.Con().QueryRowContext(ctx, query, name.String(), tenantID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count role dependents: %w", err)
+	}
+
+	return count, nil
+}
+
+func (r *postgresRoleRepository) AssignToUser(ctx context.Context, userID string, name valueobjects.Role, assignedBy string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The SELECT only yields a row when the user belongs to the tenant in
+	// scope, so a foreign user id silently assigns nothing.
+	query := `
+		INSERT INTO user_roles (user_id, role_name, assigned_by, assigned_at)
+		SELECT id, $2, $3, NOW() FROM users WHERE id = $1 AND tenant_id = $4
+		ON CONFLICT (user_id, role_name) DO NOTHING
+	`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, userID, name.String(), assignedBy, tenantID); err != nil {
+		r.logger.Error(ctx, "failed to assign role",
+			zap.String("user_id", userID),
+			zap.String("role", name.String()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRoleRepository) UnassignFromUser(ctx context.Context, userID string, name valueobjects.Role) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		DELETE FROM user_roles
+		WHERE user_id = $1 AND role_name = $2
+			AND user_id IN (SELECT id FROM users WHERE tenant_id = $3)
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query, userID, name.String(), tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to unassign role: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresRoleRepository) UnassignAllFromUser(ctx context.Context, userID string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		DELETE FROM user_roles
+		WHERE user_id = $1
+			AND user_id IN (SELECT id FROM users WHERE tenant_id = $2)
+	`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, userID, tenantID); err != nil {
+		return fmt.Errorf("failed to unassign roles: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresRoleRepository) FindUserRoles(ctx context.Context, userID string) ([]valueobjects.Role, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ur.role_name
+		FROM user_roles ur
+		JOIN users u ON u.id = ur.user_id
+		WHERE ur.user_id = $1 AND u.tenant_id = $2
+		ORDER BY ur.role_name
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, userID, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user roles: %w", err)
+	}
+	defer rows.Close()
+
+	var roles []valueobjects.Role
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan user role: %w", err)
+		}
+		roles = append(roles, valueobjects.Role(name))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+func scanRole(row rowScanner) (*models.RoleModel, error) {
+	var model models.RoleModel
+	err := row.Scan(
+		&model.ID, &model.TenantID, &model.Name, &model.Description, &model.Permissions, &model.Parents,
+		&model.Source, &model.Version, &model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
+
+func (r *postgresRoleRepository) FindRoleMembers(ctx context.Context, name valueobjects.Role) ([]string, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ur.user_id
+		FROM user_roles ur
+		JOIN users u ON u.id = ur.user_id
+		WHERE ur.role_name = $1 AND u.tenant_id = $2 AND u.deleted_at IS NULL
+		ORDER BY ur.assigned_at
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, name.String(), tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find role members: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan role member: %w", err)
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating role members: %w", err)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,265 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const samlConnectionColumns = `
+	id, tenant_id, organization_id, name, idp_entity_id, sso_url, certificates,
+	attribute_mapping, domains, jit_provisioning, default_role, is_active, version,
+	created_at, updated_at
+`
+
+type postgresSAMLConnectionRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.SAMLConnectionMapper
+	logger logging.Logger
+}
+
+func NewPostgresSAMLConnectionRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.SAMLConnectionRepository {
+	return &postgresSAMLConnectionRepository{
+		uow:    uow,
+		mapper: mappers.NewSAMLConnectionMapper(),
+		logger: logger.With(zap.String("repository", "saml_connection")),
+	}
+}
+
+func (r *postgresSAMLConnectionRepository) Create(ctx context.Context, conn *aggregates.SAMLConnection) error {
+	if err := stampTenant(ctx, &conn.TenantID); err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(conn)
+	if err != nil {
+		return fmt.Errorf("failed to map saml connection: %w", err)
+	}
+
+	query := `
+		INSERT INTO saml_connections (` + samlConnectionColumns + `)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	_, err = r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.OrganizationID, model.Name, model.IdPEntityID, model.SSOURL,
+		model.Certificates, model.AttributeMapping, model.Domains, model.JITProvisioning, model.DefaultRole,
+		model.IsActive, model.Version, model.CreatedAt, model.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create saml connection",
+			zap.String("idp_entity_id", conn.IdPEntityID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create saml connection: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresSAMLConnectionRepository) FindByID(ctx context.Context, id string) (*aggregates.SAMLConnection, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + samlConnectionColumns + ` FROM saml_connections WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	model, err := scanSAMLConnection(r.uow.Con().QueryRowContext(ctx, query, id, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find saml connection: %w", err)
+	}
+
+	return r.mapper.ToDomain(model)
+}
+
+func (r *postgresSAMLConnectionRepository) Update(ctx context.Context, conn *aggregates.SAMLConnection) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(conn)
+	if err != nil {
+		return fmt.Errorf("failed to map saml connection: %w", err)
+	}
+
+	query := `
+		UPDATE saml_connections SET
+			name = $2,
+			idp_entity_id = $3,
+			sso_url = $4,
+			certificates = $5,
+			attribute_mapping = $6,
+			domains = $7,
+			jit_provisioning = $8,
+			default_role = $9,
+			is_active = $10,
+			version = $11,
+			updated_at = $12
+		WHERE id = $1 AND tenant_id = $13 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.IdPEntityID, model.SSOURL, model.Certificates,
+		model.AttributeMapping, model.Domains, model.JITProvisioning, model.DefaultRole, model.IsActive,
+		model.Version, time.Now().UTC(), tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update saml connection",
+			zap.String("id", conn.ID()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update saml connection: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresSAMLConnectionRepository) List(ctx context.Context) ([]*aggregates.SAMLConnection, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + samlConnectionColumns + `
+		FROM saml_connections
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saml connections: %w", err)
+	}
+	defer rows.Close()
+
+	var conns []*aggregates.SAMLConnection
+	for rows.Next() {
+		model, err := scanSAMLConnection(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saml connection: %w", err)
+		}
+
+		conn, err := r.mapper.ToDomain(model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map saml connection: %w", err)
+		}
+		conns = append(conns, conn)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating saml connections: %w", err)
+	}
+
+	return conns, nil
+}
+
+func (r *postgresSAMLConnectionRepository) FindIdentity(ctx context.Context, connectionID, subject string) (*entities.SAMLIdentity, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT connection_id, subject, user_id, created_at
+		FROM saml_identities
+		WHERE connection_id = $1 AND subject = $2 AND tenant_id = $3
+	`
+
+	var identity entities.SAMLIdentity
+	err = r.uow.Con().QueryRowContext(ctx, query, connectionID, subject, tenantID).Scan(
+		&identity.ConnectionID, &identity.Subject, &identity.UserID, &identity.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find saml identity: %w", err)
+	}
+
+	return &identity, nil
+}
+
+func (r *postgresSAMLConnectionRepository) LinkIdentity(ctx context.Context, identity *entities.SAMLIdentity) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO saml_identities (connection_id, subject, tenant_id, user_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (connection_id, subject) DO UPDATE SET user_id = EXCLUDED.user_id
+	`
+
+	_, err = r.uow.Con().ExecContext(ctx, query,
+		identity.ConnectionID, identity.Subject, tenantID, identity.UserID, identity.CreatedAt,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to link saml identity",
+			zap.String("connection_id", identity.ConnectionID),
+			zap.String("user_id", identity.UserID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to link saml identity: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresSAMLConnectionRepository) HasIdentity(ctx context.Context, connectionID, userID string) (bool, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1 FROM saml_identities
+			WHERE connection_id = $1 AND user_id = $2 AND tenant_id = $3
+		)
+	`
+
+	var exists bool
+	if err := r.uow.Con().QueryRowContext(ctx, query, connectionID, userID, tenantID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check saml identity: %w", err)
+	}
+
+	return exists, nil
+}
+
+func scanSAMLConnection(row rowScanner) (*models.SAMLConnectionModel, error) {
+	var model models.SAMLConnectionModel
+	var organizationID sql.NullString
+	err := row.Scan(
+		&model.ID, &model.TenantID, &organizationID, &model.Name, &model.IdPEntityID, &model.SSOURL,
+		&model.Certificates, &model.AttributeMapping, &model.Domains, &model.JITProvisioning, &model.DefaultRole,
+		&model.IsActive, &model.Version, &model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	model.OrganizationID = organizationID.String
+	return &model, nil
+}
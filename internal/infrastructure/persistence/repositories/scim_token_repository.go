@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const scimTokenColumns = `
+	id, tenant_id, name, token_hash, display_prefix, created_by,
+	last_used_at, revoked_at, version, created_at, updated_at
+`
+
+type postgresSCIMTokenRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.SCIMTokenMapper
+	logger logging.Logger
+}
+
+func NewPostgresSCIMTokenRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.SCIMTokenRepository {
+	return &postgresSCIMTokenRepository{
+		uow:    uow,
+		mapper: mappers.NewSCIMTokenMapper(),
+		logger: logger.With(zap.String("repository", "scim_token")),
+	}
+}
+
+func (r *postgresSCIMTokenRepository) Create(ctx context.Context, token *aggregates.SCIMToken) error {
+	if err := stampTenant(ctx, &token.TenantID); err != nil {
+		return err
+	}
+
+	model := r.mapper.ToModel(token)
+
+	query := `
+		INSERT INTO scim_tokens (` + scimTokenColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.Name, model.TokenHash, model.DisplayPrefix, model.CreatedBy,
+		model.LastUsedAt, model.RevokedAt, model.Version, model.CreatedAt, time.Now().UTC(),
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create scim token",
+			zap.String("name", token.Name),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create scim token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresSCIMTokenRepository) FindByID(ctx context.Context, id string) (*aggregates.SCIMToken, error) {
+	query := `SELECT ` + scimTokenColumns + ` FROM scim_tokens WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, id)
+}
+
+func (r *postgresSCIMTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*aggregates.SCIMToken, error) {
+	query := `SELECT ` + scimTokenColumns + ` FROM scim_tokens WHERE token_hash = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, tokenHash)
+}
+
+func (r *postgresSCIMTokenRepository) Update(ctx context.Context, token *aggregates.SCIMToken) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := r.mapper.ToModel(token)
+
+	query := `
+		UPDATE scim_tokens SET
+			name = $2,
+			last_used_at = $3,
+			revoked_at = $4,
+			version = $5,
+			updated_at = $6
+		WHERE id = $1 AND tenant_id = $7 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.LastUsedAt, model.RevokedAt, model.Version,
+		time.Now().UTC(), tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update scim token",
+			zap.String("id", token.ID()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update scim token: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresSCIMTokenRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE scim_tokens SET last_used_at = $2 WHERE id = $1 AND tenant_id = $3 AND deleted_at IS NULL`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, id, usedAt, tenantID); err != nil {
+		return fmt.Errorf("failed to update scim token last used: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresSCIMTokenRepository) List(ctx context.Context) ([]*aggregates.SCIMToken, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + scimTokenColumns + `
+		FROM scim_tokens
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scim tokens: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []*aggregates.SCIMToken
+	for rows.Next() {
+		model, err := scanSCIMToken(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scim token: %w", err)
+		}
+		tokens = append(tokens, r.mapper.ToDomain(model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating scim tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+func (r *postgresSCIMTokenRepository) findOne(ctx context.Context, query string, arg interface{}) (*aggregates.SCIMToken, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := scanSCIMToken(r.uow.Con().QueryRowContext(ctx, query, arg, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find scim token: %w", err)
+	}
+
+	return r.mapper.ToDomain(model), nil
+}
+
+func scanSCIMToken(row rowScanner) (*models.SCIMTokenModel, error) {
+	var model models.SCIMTokenModel
+	err := row.Scan(
+		&model.ID, &model.TenantID, &model.Name, &model.TokenHash, &model.DisplayPrefix, &model.CreatedBy,
+		&model.LastUsedAt, &model.RevokedAt, &model.Version, &model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
@@ -0,0 +1,199 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const serviceAccountColumns = `
+	id, tenant_id, name, client_id, secret_hash, public_key_pem, role, scopes,
+	is_active, version, created_at, updated_at
+`
+
+type postgresServiceAccountRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.ServiceAccountMapper
+	logger logging.Logger
+}
+
+func NewPostgresServiceAccountRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.ServiceAccountRepository {
+	return &postgresServiceAccountRepository{
+		uow:    uow,
+		mapper: mappers.NewServiceAccountMapper(),
+		logger: logger.With(zap.String("repository", "service_account")),
+	}
+}
+
+func (r *postgresServiceAccountRepository) Create(ctx context.Context, account *aggregates.ServiceAccount) error {
+	if err := stampTenant(ctx, &account.TenantID); err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(account)
+	if err != nil {
+		return fmt.Errorf("failed to map service account: %w", err)
+	}
+
+	now := time.Now().UTC()
+	query := `
+		INSERT INTO service_accounts (` + serviceAccountColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.Name, model.ClientID, model.SecretHash, model.PublicKeyPEM,
+		model.Role, model.Scopes, model.IsActive, model.Version, model.CreatedAt, now,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create service account",
+			zap.String("client_id", account.ClientID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create service account: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresServiceAccountRepository) FindByID(ctx context.Context, id string) (*aggregates.ServiceAccount, error) {
+	query := `SELECT ` + serviceAccountColumns + ` FROM service_accounts WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, id)
+}
+
+func (r *postgresServiceAccountRepository) FindByClientID(ctx context.Context, clientID string) (*aggregates.ServiceAccount, error) {
+	query := `SELECT ` + serviceAccountColumns + ` FROM service_accounts WHERE client_id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, clientID)
+}
+
+func (r *postgresServiceAccountRepository) Update(ctx context.Context, account *aggregates.ServiceAccount) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model, err := r.mapper.ToModel(account)
+	if err != nil {
+		return fmt.Errorf("failed to map service account: %w", err)
+	}
+
+	query := `
+		UPDATE service_accounts SET
+			name = $2,
+			secret_hash = $3,
+			public_key_pem = $4,
+			role = $5,
+			scopes = $6,
+			is_active = $7,
+			version = $8,
+			updated_at = $9
+		WHERE id = $1 AND tenant_id = $10 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.SecretHash, model.PublicKeyPEM, model.Role,
+		model.Scopes, model.IsActive, model.Version, time.Now().UTC(), tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update service account",
+			zap.String("id", account.ID()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update service account: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresServiceAccountRepository) List(ctx context.Context, page, pageSize int) ([]*aggregates.ServiceAccount, int64, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM service_accounts WHERE tenant_id = $1 AND deleted_at IS NULL`
+	if err := r.uow.Con().QueryRowContext(ctx, countQuery, tenantID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count service accounts: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+	query := `
+		SELECT ` + serviceAccountColumns + `
+		FROM service_accounts
+		WHERE tenant_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, tenantID, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list service accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []*aggregates.ServiceAccount
+	for rows.Next() {
+		model, err := scanServiceAccount(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan service account: %w", err)
+		}
+
+		account, err := r.mapper.ToDomain(model)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to map service account: %w", err)
+		}
+		accounts = append(accounts, account)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating service accounts: %w", err)
+	}
+
+	return accounts, total, nil
+}
+
+func (r *postgresServiceAccountRepository) findOne(ctx context.Context, query string, arg interface{}) (*aggregates.ServiceAccount, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := scanServiceAccount(r.uow.Con().QueryRowContext(ctx, query, arg, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find service account: %w", err)
+	}
+
+	return r.mapper.ToDomain(model)
+}
+
+func scanServiceAccount(row rowScanner) (*models.ServiceAccountModel, error) {
+	var model models.ServiceAccountModel
+	err := row.Scan(
+		&model.ID, &model.TenantID, &model.Name, &model.ClientID, &model.SecretHash, &model.PublicKeyPEM,
+		&model.Role, &model.Scopes, &model.IsActive, &model.Version,
+		&model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
@@ -0,0 +1,179 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const tenantColumns = `
+	id, name, slug, domains, is_active, version, created_at, updated_at
+`
+
+type postgresTenantRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.TenantMapper
+	logger logging.Logger
+}
+
+func NewPostgresTenantRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.TenantRepository {
+	return &postgresTenantRepository{
+		uow:    uow,
+		mapper: mappers.NewTenantMapper(),
+		logger: logger.With(zap.String("repository", "tenant")),
+	}
+}
+
+func (r *postgresTenantRepository) Create(ctx context.Context, tenant *aggregates.Tenant) error {
+	model, err := r.mapper.ToModel(tenant)
+	if err != nil {
+		return fmt.Errorf("failed to map tenant: %w", err)
+	}
+
+	query := `
+		INSERT INTO tenants (` + tenantColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err = r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.Slug, model.Domains, model.IsActive,
+		model.Version, model.CreatedAt, model.UpdatedAt,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create tenant",
+			zap.String("slug", model.Slug),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create tenant: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresTenantRepository) FindByID(ctx context.Context, id string) (*aggregates.Tenant, error) {
+	query := `SELECT ` + tenantColumns + ` FROM tenants WHERE id = $1 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, id)
+}
+
+func (r *postgresTenantRepository) FindBySlug(ctx context.Context, slug string) (*aggregates.Tenant, error) {
+	query := `SELECT ` + tenantColumns + ` FROM tenants WHERE slug = $1 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, strings.ToLower(slug))
+}
+
+func (r *postgresTenantRepository) FindByDomain(ctx context.Context, domain string) (*aggregates.Tenant, error) {
+	query := `SELECT ` + tenantColumns + ` FROM tenants WHERE domains::jsonb ? $1 AND deleted_at IS NULL`
+	return r.findOne(ctx, query, strings.ToLower(domain))
+}
+
+func (r *postgresTenantRepository) Update(ctx context.Context, tenant *aggregates.Tenant) error {
+	model, err := r.mapper.ToModel(tenant)
+	if err != nil {
+		return fmt.Errorf("failed to map tenant: %w", err)
+	}
+
+	query := `
+		UPDATE tenants SET
+			name = $2,
+			domains = $3,
+			is_active = $4,
+			version = $5,
+			updated_at = $6
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.Domains, model.IsActive, model.Version, time.Now().UTC(),
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update tenant",
+			zap.String("id", tenant.ID()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update tenant: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresTenantRepository) List(ctx context.Context, page, pageSize int) ([]*aggregates.Tenant, int64, error) {
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM tenants WHERE deleted_at IS NULL`
+	if err := r.uow.Con().QueryRowContext(ctx, countQuery).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count tenants: %w", err)
+	}
+
+	offset := (page - 1) * pageSize
+	query := `
+		SELECT ` + tenantColumns + `
+		FROM tenants
+		WHERE deleted_at IS NULL
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list tenants: %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []*aggregates.Tenant
+	for rows.Next() {
+		model, err := scanTenant(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan tenant: %w", err)
+		}
+
+		tenant, err := r.mapper.ToDomain(model)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to map tenant: %w", err)
+		}
+		tenants = append(tenants, tenant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating tenants: %w", err)
+	}
+
+	return tenants, total, nil
+}
+
+func (r *postgresTenantRepository) findOne(ctx context.Context, query string, arg interface{}) (*aggregates.Tenant, error) {
+	model, err := scanTenant(r.uow.Con().QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find tenant: %w", err)
+	}
+
+	return r.mapper.ToDomain(model)
+}
+
+func scanTenant(row rowScanner) (*models.TenantModel, error) {
+	var model models.TenantModel
+	err := row.Scan(
+		&model.ID, &model.Name, &model.Slug, &model.Domains, &model.IsActive,
+		&model.Version, &model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
@@ -0,0 +1,35 @@
+package repositories
+
+import (
+	"authentication/internal/domain"
+	"authentication/shared/utils"
+	"context"
+)
+
+// tenantScope returns the tenant every query in this request must be filtered
+// by. Repositories refuse to run without one rather than fall back to an
+// unscoped query that could read another tenant's rows.
+func tenantScope(ctx context.Context) (string, error) {
+	tenantID, ok := utils.TenantIDFromContext(ctx)
+	if !ok {
+		return "", domain.ErrTenantRequired
+	}
+	return tenantID, nil
+}
+
+// stampTenant assigns the request tenant to a new record, or verifies that a
+// record created for an explicit tenant matches the one in scope.
+func stampTenant(ctx context.Context, current *string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+	if *current == "" {
+		*current = tenantID
+		return nil
+	}
+	if *current != tenantID {
+		return domain.ErrTenantMismatch
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package repositories
+
+import (
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/infrastructure/persistence/database/models"
+	"authentication/internal/infrastructure/persistence/mappers"
+	"authentication/shared/logging"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const trustedDeviceColumns = `
+	id, tenant_id, user_id, device_id, name, ip_address, expires_at,
+	last_used_at, revoked_at, version, created_at, updated_at
+`
+
+type postgresTrustedDeviceRepository struct {
+	uow    persistence.UnitOfWork
+	mapper *mappers.TrustedDeviceMapper
+	logger logging.Logger
+}
+
+func NewPostgresTrustedDeviceRepository(uow persistence.UnitOfWork, logger logging.Logger) repositories.TrustedDeviceRepository {
+	return &postgresTrustedDeviceRepository{
+		uow:    uow,
+		mapper: mappers.NewTrustedDeviceMapper(),
+		logger: logger.With(zap.String("repository", "trusted_device")),
+	}
+}
+
+func (r *postgresTrustedDeviceRepository) Create(ctx context.Context, device *aggregates.TrustedDevice) error {
+	if err := stampTenant(ctx, &device.TenantID); err != nil {
+		return err
+	}
+
+	model := r.mapper.ToModel(device)
+
+	query := `
+		INSERT INTO trusted_devices (` + trustedDeviceColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.TenantID, model.UserID, model.DeviceID, model.Name, model.IPAddress, model.ExpiresAt,
+		model.LastUsedAt, model.RevokedAt, model.Version, model.CreatedAt, time.Now().UTC(),
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to create trusted device",
+			zap.String("user_id", device.UserID),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to create trusted device: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresTrustedDeviceRepository) FindByID(ctx context.Context, id string) (*aggregates.TrustedDevice, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + trustedDeviceColumns + ` FROM trusted_devices WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	model, err := scanTrustedDevice(r.uow.Con().QueryRowContext(ctx, query, id, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to find trusted device: %w", err)
+	}
+
+	return r.mapper.ToDomain(model), nil
+}
+
+func (r *postgresTrustedDeviceRepository) Update(ctx context.Context, device *aggregates.TrustedDevice) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	model := r.mapper.ToModel(device)
+
+	query := `
+		UPDATE trusted_devices SET
+			name = $2,
+			last_used_at = $3,
+			revoked_at = $4,
+			version = $5,
+			updated_at = $6
+		WHERE id = $1 AND tenant_id = $7 AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query,
+		model.ID, model.Name, model.LastUsedAt, model.RevokedAt, model.Version,
+		time.Now().UTC(), tenantID,
+	)
+	if err != nil {
+		r.logger.Error(ctx, "failed to update trusted device",
+			zap.String("id", device.ID()),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to update trusted device: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *postgresTrustedDeviceRepository) UpdateLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE trusted_devices SET last_used_at = $2 WHERE id = $1 AND tenant_id = $3 AND deleted_at IS NULL`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, id, usedAt, tenantID); err != nil {
+		return fmt.Errorf("failed to update trusted device last used: %w", err)
+	}
+
+	return nil
+}
+
+func (r *postgresTrustedDeviceRepository) ListActiveByUser(ctx context.Context, userID string) ([]*aggregates.TrustedDevice, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + trustedDeviceColumns + `
+		FROM trusted_devices
+		WHERE tenant_id = $1 AND user_id = $2 AND revoked_at IS NULL
+			AND expires_at > $3 AND deleted_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.uow.Con().QueryContext(ctx, query, tenantID, userID, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trusted devices: %w", err)
+	}
+	defer rows.Close()
+
+	var devices []*aggregates.TrustedDevice
+	for rows.Next() {
+		model, err := scanTrustedDevice(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan trusted device: %w", err)
+		}
+		devices = append(devices, r.mapper.ToDomain(model))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating trusted devices: %w", err)
+	}
+
+	return devices, nil
+}
+
+func (r *postgresTrustedDeviceRepository) RevokeAllByUser(ctx context.Context, userID string, revokedAt time.Time) (int64, error) {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+		UPDATE trusted_devices SET
+			revoked_at = $3,
+			version = version + 1,
+			updated_at = $3
+		WHERE tenant_id = $1 AND user_id = $2 AND revoked_at IS NULL AND deleted_at IS NULL
+	`
+
+	result, err := r.uow.Con().ExecContext(ctx, query, tenantID, userID, revokedAt)
+	if err != nil {
+		r.logger.Error(ctx, "failed to revoke trusted devices",
+			zap.String("user_id", userID),
+			zap.Error(err),
+		)
+		return 0, fmt.Errorf("failed to revoke trusted devices: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return rowsAffected, nil
+}
+
+func (r *postgresTrustedDeviceRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	tenantID, err := tenantScope(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `DELETE FROM trusted_devices WHERE tenant_id = $1 AND user_id = $2`
+
+	if _, err := r.uow.Con().ExecContext(ctx, query, tenantID, userID); err != nil {
+		return fmt.Errorf("failed to delete trusted devices: %w", err)
+	}
+
+	return nil
+}
+
+func scanTrustedDevice(row rowScanner) (*models.TrustedDeviceModel, error) {
+	var model models.TrustedDeviceModel
+	err := row.Scan(
+		&model.ID, &model.TenantID, &model.UserID, &model.DeviceID, &model.Name, &model.IPAddress, &model.ExpiresAt,
+		&model.LastUsedAt, &model.RevokedAt, &model.Version, &model.CreatedAt, &model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
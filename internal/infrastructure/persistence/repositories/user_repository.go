@@ -2,6 +2,7 @@ package repositories
 
 import (
     "authentication/internal/application/contracts/persistence"
+    "authentication/internal/domain"
     "authentication/internal/domain/aggregates"
     "authentication/internal/domain/repositories"
     "authentication/internal/domain/valueobjects"
@@ -12,36 +13,48 @@ import (
     "database/sql"
     "errors"
     "fmt"
+    "strings"
+    "time"
 
     "go.uber.org/zap"
 )
 
+// postgresUserRepository writes the aggregate's pending domain events to
+// the outbox along with every create and update, so handlers registered on
+// the event dispatcher see them without the command handlers publishing
+// anything themselves.
 type postgresUserRepository struct {
     mapper *mappers.UserMapper
+    outbox persistence.OutboxRepository
     logger logging.Logger
 }
 
-func NewPostgresUserRepository(logger logging.Logger) repositories.UserRepository {
+func NewPostgresUserRepository(outbox persistence.OutboxRepository, logger logging.Logger) repositories.UserRepository {
     return &postgresUserRepository{
         mapper: mappers.NewUserMapper(),
+        outbox: outbox,
         logger: logger.With(zap.String("repository", "user")),
     }
 }
 
 func (r *postgresUserRepository) Create(ctx context.Context, user *aggregates.UserAggregate) error {
+    if err := stampTenant(ctx, &user.User.TenantID); err != nil {
+        return err
+    }
+
     model := r.mapper.ToModel(user)
 
     query := `
         INSERT INTO users (
-            id, username, email, password_hash, phone, first_name, last_name,
-            role, is_active, is_verified, version, created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+            id, tenant_id, username, email, password_hash, phone, first_name, last_name,
+            role, is_active, is_verified, phone_verified, version, created_at, updated_at
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
     `
 
     _, err := persistence.DB(ctx).ExecContext(ctx, query,
-        model.ID, model.Username, model.Email, model.PasswordHash,
+        model.ID, model.TenantID, model.Username, model.Email, model.PasswordHash,
         model.Phone, model.FirstName, model.LastName, model.Role,
-        model.IsActive, model.IsVerified, model.Version,
+        model.IsActive, model.IsVerified, model.PhoneVerified, model.Version,
         model.CreatedAt, model.UpdatedAt,
     )
 
@@ -53,6 +66,10 @@ func (r *postgresUserRepository) Create(ctx context.Context, user *aggregates.Us
         )
         return fmt.Errorf("failed to create user: %w", err)
     }
+    if err := r.publishEvents(ctx, user); err != nil {
+        return err
+    }
+    user.MarkPersisted()
 
     r.logger.Info(ctx, "user created successfully",
         zap.String("user_id", user.ID()),
@@ -63,19 +80,24 @@ func (r *postgresUserRepository) Create(ctx context.Context, user *aggregates.Us
 }
 
 func (r *postgresUserRepository) FindByID(ctx context.Context, id string) (*aggregates.UserAggregate, error) {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return nil, err
+    }
+
     query := `
         SELECT 
-            id, username, email, password_hash, phone, first_name, last_name,
-            role, is_active, is_verified, last_login_at, version, created_at, updated_at
+            id, tenant_id, username, email, password_hash, phone, first_name, last_name,
+            role, is_active, is_verified, phone_verified, last_login_at, version, created_at, updated_at
         FROM users
-        WHERE id = $1 AND deleted_at IS NULL
+        WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
     `
 
     var model models.UserModel
-    err := persistence.DB(ctx).QueryRowContext(ctx, query, id).Scan(
-        &model.ID, &model.Username, &model.Email, &model.PasswordHash,
+    err = persistence.DB(ctx).QueryRowContext(ctx, query, id, tenantID).Scan(
+        &model.ID, &model.TenantID, &model.Username, &model.Email, &model.PasswordHash,
         &model.Phone, &model.FirstName, &model.LastName, &model.Role,
-        &model.IsActive, &model.IsVerified, &model.LastLoginAt,
+        &model.IsActive, &model.IsVerified, &model.PhoneVerified, &model.LastLoginAt,
         &model.Version, &model.CreatedAt, &model.UpdatedAt,
     )
 
@@ -104,19 +126,24 @@ func (r *postgresUserRepository) FindByID(ctx context.Context, id string) (*aggr
 }
 
 func (r *postgresUserRepository) FindByEmail(ctx context.Context, email valueobjects.Email) (*aggregates.UserAggregate, error) {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return nil, err
+    }
+
     query := `
         SELECT 
-            id, username, email, password_hash, phone, first_name, last_name,
-            role, is_active, is_verified, last_login_at, version, created_at, updated_at
+            id, tenant_id, username, email, password_hash, phone, first_name, last_name,
+            role, is_active, is_verified, phone_verified, last_login_at, version, created_at, updated_at
         FROM users
-        WHERE email = $1 AND deleted_at IS NULL
+        WHERE email = $1 AND tenant_id = $2 AND deleted_at IS NULL
     `
 
     var model models.UserModel
-    err := persistence.DB(ctx).QueryRowContext(ctx, query, email.String()).Scan(
-        &model.ID, &model.Username, &model.Email, &model.PasswordHash,
+    err = persistence.DB(ctx).QueryRowContext(ctx, query, email.String(), tenantID).Scan(
+        &model.ID, &model.TenantID, &model.Username, &model.Email, &model.PasswordHash,
         &model.Phone, &model.FirstName, &model.LastName, &model.Role,
-        &model.IsActive, &model.IsVerified, &model.LastLoginAt,
+        &model.IsActive, &model.IsVerified, &model.PhoneVerified, &model.LastLoginAt,
         &model.Version, &model.CreatedAt, &model.UpdatedAt,
     )
 
@@ -131,19 +158,24 @@ func (r *postgresUserRepository) FindByEmail(ctx context.Context, email valueobj
 }
 
 func (r *postgresUserRepository) FindByUsername(ctx context.Context, username valueobjects.Username) (*aggregates.UserAggregate, error) {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return nil, err
+    }
+
     query := `
         SELECT 
-            id, username, email, password_hash, phone, first_name, last_name,
-            role, is_active, is_verified, last_login_at, version, created_at, updated_at
+            id, tenant_id, username, email, password_hash, phone, first_name, last_name,
+            role, is_active, is_verified, phone_verified, last_login_at, version, created_at, updated_at
         FROM users
-        WHERE username = $1 AND deleted_at IS NULL
+        WHERE username = $1 AND tenant_id = $2 AND deleted_at IS NULL
     `
 
     var model models.UserModel
-    err := persistence.DB(ctx).QueryRowContext(ctx, query, username.String()).Scan(
-        &model.ID, &model.Username, &model.Email, &model.PasswordHash,
+    err = persistence.DB(ctx).QueryRowContext(ctx, query, username.String(), tenantID).Scan(
+        &model.ID, &model.TenantID, &model.Username, &model.Email, &model.PasswordHash,
         &model.Phone, &model.FirstName, &model.LastName, &model.Role,
-        &model.IsActive, &model.IsVerified, &model.LastLoginAt,
+        &model.IsActive, &model.IsVerified, &model.PhoneVerified, &model.LastLoginAt,
         &model.Version, &model.CreatedAt, &model.UpdatedAt,
     )
 
@@ -158,19 +190,24 @@ func (r *postgresUserRepository) FindByUsername(ctx context.Context, username va
 }
 
 func (r *postgresUserRepository) FindByEmailOrUsername(ctx context.Context, identifier string) (*aggregates.UserAggregate, error) {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return nil, err
+    }
+
     query := `
         SELECT 
-            id, username, email, password_hash, phone, first_name, last_name,
-            role, is_active, is_verified, last_login_at, version, created_at, updated_at
+            id, tenant_id, username, email, password_hash, phone, first_name, last_name,
+            role, is_active, is_verified, phone_verified, last_login_at, version, created_at, updated_at
         FROM users
-        WHERE (email = $1 OR username = $1) AND deleted_at IS NULL
+        WHERE (email = $1 OR username = $1) AND tenant_id = $2 AND deleted_at IS NULL
     `
 
     var model models.UserModel
-    err := persistence.DB(ctx).QueryRowContext(ctx, query, identifier).Scan(
-        &model.ID, &model.Username, &model.Email, &model.PasswordHash,
+    err = persistence.DB(ctx).QueryRowContext(ctx, query, identifier, tenantID).Scan(
+        &model.ID, &model.TenantID, &model.Username, &model.Email, &model.PasswordHash,
         &model.Phone, &model.FirstName, &model.LastName, &model.Role,
-        &model.IsActive, &model.IsVerified, &model.LastLoginAt,
+        &model.IsActive, &model.IsVerified, &model.PhoneVerified, &model.LastLoginAt,
         &model.Version, &model.CreatedAt, &model.UpdatedAt,
     )
 
@@ -184,7 +221,14 @@ func (r *postgresUserRepository) FindByEmailOrUsername(ctx context.Context, iden
     return r.mapper.ToDomain(&model)
 }
 
+// Update saves the user only if the stored row is still at the version it
+// was loaded at, so concurrent writers cannot overwrite each other.
 func (r *postgresUserRepository) Update(ctx context.Context, user *aggregates.UserAggregate) error {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return err
+    }
+
     model := r.mapper.ToModel(user)
 
     query := `
@@ -200,15 +244,18 @@ func (r *postgresUserRepository) Update(ctx context.Context, user *aggregates.Us
             is_verified = $10,
             last_login_at = $11,
             version = $12,
-            updated_at = $13
-        WHERE id = $1 AND deleted_at IS NULL
+            updated_at = $13,
+            phone_verified = $16
+        WHERE id = $1 AND tenant_id = $14 AND deleted_at IS NULL
+            AND ($15 = 0 OR version = $15)
     `
 
     result, err := persistence.DB(ctx).ExecContext(ctx, query,
         model.ID, model.Username, model.Email, model.PasswordHash,
         model.Phone, model.FirstName, model.LastName, model.Role,
         model.IsActive, model.IsVerified, model.LastLoginAt,
-        model.Version, model.UpdatedAt,
+        model.Version, model.UpdatedAt, tenantID, user.PersistedVersion(),
+        model.PhoneVerified,
     )
 
     if err != nil {
@@ -221,16 +268,53 @@ func (r *postgresUserRepository) Update(ctx context.Context, user *aggregates.Us
 
     rowsAffected, _ := result.RowsAffected()
     if rowsAffected == 0 {
+        if user.PersistedVersion() > 0 {
+            return fmt.Errorf("user not found or modified concurrently: %w", domain.ErrUserVersionConflict)
+        }
         return fmt.Errorf("user not found or already deleted")
     }
+    if err := r.publishEvents(ctx, user); err != nil {
+        return err
+    }
+    user.MarkPersisted()
+
+    return nil
+}
+
+// publishEvents saves the aggregate's pending events to the outbox in the
+// caller's transaction and clears them, so a retried update does not
+// publish them twice.
+func (r *postgresUserRepository) publishEvents(ctx context.Context, user *aggregates.UserAggregate) error {
+    if r.outbox == nil {
+        return nil
+    }
 
+    for _, event := range user.DomainEvents() {
+        msg := &persistence.OutboxMessage{
+            ID:          event.EventID().String(),
+            EventType:   event.EventName(),
+            AggregateID: event.AggregateID(),
+            Payload:     event.Payload(),
+            Metadata:    event.Metadata(),
+            OccurredAt:  event.OccurredAt().Unix(),
+        }
+        if err := r.outbox.Save(ctx, msg); err != nil {
+            return fmt.Errorf("failed to save user event: %w", err)
+        }
+    }
+    user.ClearEvents()
     return nil
 }
 
 func (r *postgresUserRepository) Delete(ctx context.Context, id string) error {
-    query := `UPDATE users SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return err
+    }
 
-    result, err := persistence.DB(ctx).ExecContext(ctx, query, id)
+    query := `UPDATE users SET deleted_at = NOW() WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+    result, err := persistence.DB(ctx).ExecContext(ctx, query, id, tenantID)
     if err != nil {
         return fmt.Errorf("failed to delete user: %w", err)
     }
@@ -243,26 +327,106 @@ func (r *postgresUserRepository) Delete(ctx context.Context, id string) error {
     return nil
 }
 
+func (r *postgresUserRepository) HardDelete(ctx context.Context, id string) error {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return err
+    }
+
+    query := `DELETE FROM users WHERE id = $1 AND tenant_id = $2`
+
+    result, err := persistence.DB(ctx).ExecContext(ctx, query, id, tenantID)
+    if err != nil {
+        return fmt.Errorf("failed to hard delete user: %w", err)
+    }
+
+    rowsAffected, _ := result.RowsAffected()
+    if rowsAffected == 0 {
+        return fmt.Errorf("user not found")
+    }
+
+    r.logger.Info(ctx, "user hard deleted", zap.String("user_id", id))
+    return nil
+}
+
+func (r *postgresUserRepository) FindDeletedBefore(ctx context.Context, before time.Time, limit int) ([]string, error) {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    query := `
+        SELECT id FROM users
+        WHERE tenant_id = $1 AND deleted_at IS NOT NULL AND deleted_at < $2
+        ORDER BY deleted_at ASC
+        LIMIT $3
+    `
+
+    rows, err := persistence.DB(ctx).QueryContext(ctx, query, tenantID, before, limit)
+    if err != nil {
+        return nil, fmt.Errorf("failed to find deleted users: %w", err)
+    }
+    defer rows.Close()
+
+    var ids []string
+    for rows.Next() {
+        var id string
+        if err := rows.Scan(&id); err != nil {
+            return nil, fmt.Errorf("failed to scan deleted user: %w", err)
+        }
+        ids = append(ids, id)
+    }
+    if err := rows.Err(); err != nil {
+        return nil, fmt.Errorf("failed to iterate deleted users: %w", err)
+    }
+
+    return ids, nil
+}
+
 func (r *postgresUserRepository) List(
     ctx context.Context,
     page, pageSize int,
     role *valueobjects.Role,
     isActive *bool,
 ) ([]*aggregates.UserAggregate, int64, error) {
+    return r.Search(ctx, repositories.UserFilter{
+        Page:       page,
+        PageSize:   pageSize,
+        Role:       role,
+        IsActive:   isActive,
+        Descending: true,
+    })
+}
+
+func (r *postgresUserRepository) Search(
+    ctx context.Context,
+    filter repositories.UserFilter,
+) ([]*aggregates.UserAggregate, int64, error) {
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return nil, 0, err
+    }
+
     // Build query with filters
-    baseQuery := `FROM users WHERE deleted_at IS NULL`
-    var args []interface{}
-    argCount := 1
+    baseQuery := `FROM users WHERE tenant_id = $1 AND deleted_at IS NULL`
+    args := []interface{}{tenantID}
+    argCount := 2
 
-    if role != nil {
+    if filter.Role != nil {
         baseQuery += fmt.Sprintf(" AND role = $%d", argCount)
-        args = append(args, role.String())
+        args = append(args, filter.Role.String())
         argCount++
     }
 
-    if isActive != nil {
+    if filter.IsActive != nil {
         baseQuery += fmt.Sprintf(" AND is_active = $%d", argCount)
-        args = append(args, *isActive)
+        args = append(args, *filter.IsActive)
+        argCount++
+    }
+
+    if search := strings.TrimSpace(filter.Search); search != "" {
+        baseQuery += fmt.Sprintf(" AND (email ILIKE $%d OR username ILIKE $%d)", argCount, argCount)
+        args = append(args, likePrefix(search))
         argCount++
     }
 
@@ -274,13 +438,13 @@ func (r *postgresUserRepository) List(
     }
 
     // Get paginated results
-    offset := (page - 1) * pageSize
+    offset := (filter.Page - 1) * filter.PageSize
     dataQuery := `
-        SELECT id, username, email, password_hash, phone, first_name, last_name,
-               role, is_active, is_verified, last_login_at, version, created_at, updated_at
-    ` + baseQuery + fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", argCount, argCount+1)
+        SELECT id, tenant_id, username, email, password_hash, phone, first_name, last_name,
+               role, is_active, is_verified, phone_verified, last_login_at, version, created_at, updated_at
+    ` + baseQuery + userOrderBy(filter) + fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
 
-    args = append(args, pageSize, offset)
+    args = append(args, filter.PageSize, offset)
 
     rows, err := persistence.DB(ctx).QueryContext(ctx, dataQuery, args...)
     if err != nil {
@@ -292,9 +456,9 @@ func (r *postgresUserRepository) List(
     for rows.Next() {
         var model models.UserModel
         if err := rows.Scan(
-            &model.ID, &model.Username, &model.Email, &model.PasswordHash,
+            &model.ID, &model.TenantID, &model.Username, &model.Email, &model.PasswordHash,
             &model.Phone, &model.FirstName, &model.LastName, &model.Role,
-            &model.IsActive, &model.IsVerified, &model.LastLoginAt,
+            &model.IsActive, &model.IsVerified, &model.PhoneVerified, &model.LastLoginAt,
             &model.Version, &model.CreatedAt, &model.UpdatedAt,
         ); err != nil {
             return nil, 0, fmt.Errorf("failed to scan user: %w", err)
@@ -311,11 +475,38 @@ func (r *postgresUserRepository) List(
     return users, total, nil
 }
 
+// userOrderBy builds the ORDER BY clause from a validated sort field, so no
+// caller input reaches the SQL text. id breaks ties for stable paging.
+func userOrderBy(filter repositories.UserFilter) string {
+    column := repositories.UserSortCreatedAt
+    if filter.SortBy.IsValid() {
+        column = filter.SortBy
+    }
+
+    direction := "ASC"
+    if filter.Descending {
+        direction = "DESC"
+    }
+
+    return fmt.Sprintf(" ORDER BY %s %s NULLS LAST, id %s", column, direction, direction)
+}
+
+// likePrefix escapes LIKE wildcards in value and anchors it at the start.
+func likePrefix(value string) string {
+    escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+    return escaped + "%"
+}
+
 func (r *postgresUserRepository) ExistsByEmail(ctx context.Context, email valueobjects.Email) (bool, error) {
-    query := `SELECT EXISTS(SELECT 1 FROM users WHERE email = $1 AND deleted_at IS NULL)`
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return false, err
+    }
+
+    query := `SELECT EXISTS(SELECT 1 FROM users WHERE email = $1 AND tenant_id = $2 AND deleted_at IS NULL)`
 
     var exists bool
-    err := persistence.DB(ctx).QueryRowContext(ctx, query, email.String()).Scan(&exists)
+    err = persistence.DB(ctx).QueryRowContext(ctx, query, email.String(), tenantID).Scan(&exists)
     if err != nil {
         return false, fmt.Errorf("failed to check email existence: %w", err)
     }
@@ -324,10 +515,15 @@ func (r *postgresUserRepository) ExistsByEmail(ctx context.Context, email valueo
 }
 
 func (r *postgresUserRepository) ExistsByUsername(ctx context.Context, username valueobjects.Username) (bool, error) {
-    query := `SELECT EXISTS(SELECT 1 FROM users WHERE username = $1 AND deleted_at IS NULL)`
+    tenantID, err := tenantScope(ctx)
+    if err != nil {
+        return false, err
+    }
+
+    query := `SELECT EXISTS(SELECT 1 FROM users WHERE username = $1 AND tenant_id = $2 AND deleted_at IS NULL)`
 
     var exists bool
-    err := persistence.DB(ctx).QueryRowContext(ctx, query, username.String()).Scan(&exists)
+    err = persistence.DB(ctx).QueryRowContext(ctx, query, username.String(), tenantID).Scan(&exists)
     if err != nil {
         return false, fmt.Errorf("failed to check username existence: %w", err)
     }
@@ -0,0 +1,103 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+// lastUsedGranularity limits how often a busy key writes its last-used time.
+const lastUsedGranularity = time.Minute
+
+// APIKeyTokenService decorates a TokenService so that VerifyAccess also
+// accepts personal access tokens. Everything downstream of VerifyAccess
+// (auth middleware, introspection) then treats both credential types alike.
+type APIKeyTokenService struct {
+	services.TokenService
+	keyRepo       repositories.APIKeyRepository
+	userRepo      repositories.UserRepository
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewAPIKeyTokenService(
+	inner services.TokenService,
+	keyRepo repositories.APIKeyRepository,
+	userRepo repositories.UserRepository,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) services.TokenService {
+	return &APIKeyTokenService{
+		TokenService:  inner,
+		keyRepo:       keyRepo,
+		userRepo:      userRepo,
+		secretService: secretService,
+		logger:        logger.With(zap.String("service", "api_key_token")),
+	}
+}
+
+func (s *APIKeyTokenService) VerifyAccess(ctx context.Context, token string) (*services.TokenClaims, error) {
+	if !strings.HasPrefix(token, aggregates.APIKeyPrefix) {
+		return s.TokenService.VerifyAccess(ctx, token)
+	}
+
+	key, err := s.keyRepo.FindByHash(ctx, s.secretService.Hash(token))
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to find api key: %w", err)
+	}
+
+	if err := key.Validate(utils.ClientIPFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, key.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find api key owner: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrInvalidToken
+	}
+	if !user.User.IsActive {
+		return nil, domain.ErrInactiveUser
+	}
+
+	now := time.Now().UTC()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedGranularity {
+		if err := s.keyRepo.UpdateLastUsed(ctx, key.ID(), now); err != nil {
+			s.logger.Warn(ctx, "Failed to record api key usage",
+				zap.String("key_id", key.ID()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	claims := &services.TokenClaims{
+		UserID:    key.UserID,
+		TenantID:  key.TenantID,
+		TokenID:   key.ID(),
+		Role:      user.User.Role.String(),
+		Email:     user.User.Email.String(),
+		Scopes:    key.Scopes,
+		TokenType: string(valueobjects.TokenTypePersonalAccess),
+		IssuedAt:  key.CreatedAt,
+	}
+	if key.ExpiresAt != nil {
+		claims.ExpiresAt = *key.ExpiresAt
+	}
+	return claims, nil
+}
@@ -0,0 +1,213 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/utils"
+)
+
+type fakeAPIKeyRepository struct {
+	repositories.APIKeyRepository
+	keys     map[string]*aggregates.APIKey
+	lastUsed map[string]time.Time
+}
+
+func (r *fakeAPIKeyRepository) FindByHash(_ context.Context, keyHash string) (*aggregates.APIKey, error) {
+	key, ok := r.keys[keyHash]
+	if !ok {
+		return nil, repositories.ErrNotFound
+	}
+	return key, nil
+}
+
+func (r *fakeAPIKeyRepository) UpdateLastUsed(_ context.Context, id string, usedAt time.Time) error {
+	r.lastUsed[id] = usedAt
+	return nil
+}
+
+type fakeUserRepository struct {
+	repositories.UserRepository
+	users map[string]*aggregates.UserAggregate
+}
+
+func (r *fakeUserRepository) FindByID(_ context.Context, id string) (*aggregates.UserAggregate, error) {
+	return r.users[id], nil
+}
+
+// jwtOnlyTokenService stands in for the JWT service behind the decorator.
+type jwtOnlyTokenService struct {
+	services.TokenService
+}
+
+func (jwtOnlyTokenService) VerifyAccess(context.Context, string) (*services.TokenClaims, error) {
+	return &services.TokenClaims{UserID: "jwt-user", TokenType: string(valueobjects.TokenTypeAccess)}, nil
+}
+
+func TestAPIKeyTokenServiceVerifyAccess(t *testing.T) {
+	secrets := domainServices.NewSecretService()
+
+	username, _ := valueobjects.NewUsername("alice")
+	email, _ := valueobjects.NewEmail("alice@example.com")
+	owner := aggregates.NewEmailUserAggregate(username, email, valueobjects.NewPassword("hash"), "Alice", "", valueobjects.RoleUser)
+
+	inactiveUsername, _ := valueobjects.NewUsername("bob")
+	inactiveEmail, _ := valueobjects.NewEmail("bob@example.com")
+	inactive := aggregates.NewEmailUserAggregate(inactiveUsername, inactiveEmail, valueobjects.NewPassword("hash"), "Bob", "", valueobjects.RoleUser)
+	inactive.User.IsActive = false
+
+	newKey := func(t *testing.T, userID string, allowedIPs []string) *aggregates.APIKey {
+		t.Helper()
+		key, err := aggregates.NewAPIKey(userID, "ci", "", "pat_", []string{"profile:read"}, allowedIPs, nil)
+		if err != nil {
+			t.Fatalf("NewAPIKey() error = %v", err)
+		}
+		return key
+	}
+
+	valid := newKey(t, owner.ID(), nil)
+	revoked := newKey(t, owner.ID(), nil)
+	_ = revoked.Revoke()
+	expired := newKey(t, owner.ID(), nil)
+	past := time.Now().Add(-time.Hour)
+	expired.ExpiresAt = &past
+	restricted := newKey(t, owner.ID(), []string{"10.0.0.0/8"})
+	orphaned := newKey(t, inactive.ID(), nil)
+
+	keyRepo := &fakeAPIKeyRepository{
+		keys: map[string]*aggregates.APIKey{
+			secrets.Hash("pat_valid"):      valid,
+			secrets.Hash("pat_revoked"):    revoked,
+			secrets.Hash("pat_expired"):    expired,
+			secrets.Hash("pat_restricted"): restricted,
+			secrets.Hash("pat_inactive"):   orphaned,
+		},
+		lastUsed: map[string]time.Time{},
+	}
+	userRepo := &fakeUserRepository{users: map[string]*aggregates.UserAggregate{
+		owner.ID():    owner,
+		inactive.ID(): inactive,
+	}}
+	service := NewAPIKeyTokenService(jwtOnlyTokenService{}, keyRepo, userRepo, secrets, nopLogger{})
+
+	tests := []struct {
+		name     string
+		token    string
+		ip       string
+		wantErr  error
+		wantUser string
+		wantType valueobjects.TokenType
+	}{
+		{
+			name:     "jwt is passed through",
+			token:    "eyJhbGciOi",
+			wantUser: "jwt-user",
+			wantType: valueobjects.TokenTypeAccess,
+		},
+		{
+			name:     "valid key",
+			token:    "pat_valid",
+			wantUser: owner.ID(),
+			wantType: valueobjects.TokenTypePersonalAccess,
+		},
+		{
+			name:    "unknown key",
+			token:   "pat_unknown",
+			wantErr: domain.ErrInvalidToken,
+		},
+		{
+			name:    "revoked key",
+			token:   "pat_revoked",
+			wantErr: domain.ErrAPIKeyRevoked,
+		},
+		{
+			name:    "expired key",
+			token:   "pat_expired",
+			wantErr: domain.ErrAPIKeyExpired,
+		},
+		{
+			name:     "allowed address",
+			token:    "pat_restricted",
+			ip:       "10.1.2.3",
+			wantUser: owner.ID(),
+			wantType: valueobjects.TokenTypePersonalAccess,
+		},
+		{
+			name:    "address outside the allowlist",
+			token:   "pat_restricted",
+			ip:      "192.0.2.1",
+			wantErr: domain.ErrAPIKeyIPNotAllowed,
+		},
+		{
+			name:    "inactive owner",
+			token:   "pat_inactive",
+			wantErr: domain.ErrInactiveUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ip != "" {
+				ctx = utils.WithClientIP(ctx, tt.ip)
+			}
+
+			claims, err := service.VerifyAccess(ctx, tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("VerifyAccess() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("VerifyAccess() error = %v", err)
+			}
+			if claims.UserID != tt.wantUser || claims.TokenType != string(tt.wantType) {
+				t.Errorf("VerifyAccess() = (%q, %q), want (%q, %q)", claims.UserID, claims.TokenType, tt.wantUser, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestAPIKeyTokenServiceCarriesScopes(t *testing.T) {
+	secrets := domainServices.NewSecretService()
+	username, _ := valueobjects.NewUsername("alice")
+	email, _ := valueobjects.NewEmail("alice@example.com")
+	owner := aggregates.NewEmailUserAggregate(username, email, valueobjects.NewPassword("hash"), "Alice", "", valueobjects.RoleAdmin)
+
+	key, err := aggregates.NewAPIKey(owner.ID(), "ci", "", "pat_", []string{"users:read"}, nil, nil)
+	if err != nil {
+		t.Fatalf("NewAPIKey() error = %v", err)
+	}
+	keyRepo := &fakeAPIKeyRepository{
+		keys:     map[string]*aggregates.APIKey{secrets.Hash("pat_key"): key},
+		lastUsed: map[string]time.Time{},
+	}
+	userRepo := &fakeUserRepository{users: map[string]*aggregates.UserAggregate{owner.ID(): owner}}
+	service := NewAPIKeyTokenService(jwtOnlyTokenService{}, keyRepo, userRepo, secrets, nopLogger{})
+
+	claims, err := service.VerifyAccess(context.Background(), "pat_key")
+	if err != nil {
+		t.Fatalf("VerifyAccess() error = %v", err)
+	}
+
+	// The owner is an admin, but the token only carries what it was issued
+	// with; the permission middleware holds it to these scopes.
+	if len(claims.Scopes) != 1 || claims.Scopes[0] != "users:read" {
+		t.Errorf("Scopes = %v, want [users:read]", claims.Scopes)
+	}
+	if claims.Role != valueobjects.RoleAdmin.String() {
+		t.Errorf("Role = %q, want %q", claims.Role, valueobjects.RoleAdmin)
+	}
+	if _, ok := keyRepo.lastUsed[key.ID()]; !ok {
+		t.Errorf("last use was not recorded")
+	}
+}
@@ -0,0 +1,102 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/infrastructure/external/captcha"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+const captchaTimeout = 5 * time.Second
+
+// FailureCounter reports recent failed logins; SignalRiskEngine keeps them.
+type FailureCounter interface {
+	FailureCounts(ctx context.Context, email, ip string) (emailFailures, ipFailures int, err error)
+}
+
+// ThresholdChallengeGate asks for a challenge once failed logins for the
+// email or the IP reach their threshold. If the counters cannot be read it
+// lets the request through, like the risk engine does.
+type ThresholdChallengeGate struct {
+	provider       services.ChallengeProvider
+	failures       FailureCounter
+	emailThreshold int
+	ipThreshold    int
+	logger         logging.Logger
+}
+
+var _ services.ChallengeGate = (*ThresholdChallengeGate)(nil)
+
+func NewThresholdChallengeGate(
+	provider services.ChallengeProvider,
+	failures FailureCounter,
+	cfg config.SecurityConfig,
+	logger logging.Logger,
+) *ThresholdChallengeGate {
+	return &ThresholdChallengeGate{
+		provider:       provider,
+		failures:       failures,
+		emailThreshold: cfg.ChallengeEmailThreshold,
+		ipThreshold:    cfg.ChallengeIPThreshold,
+		logger:         logger.With(zap.String("component", "challenge_gate")),
+	}
+}
+
+// Check counts failures against the address resolved by the client IP
+// middleware rather than remoteIP, which callers may have taken from a
+// forwarding header. remoteIP is only used when no address was resolved.
+func (g *ThresholdChallengeGate) Check(ctx context.Context, email, remoteIP, response string) error {
+	if ip := utils.ClientIPFromContext(ctx); ip != "" {
+		remoteIP = ip
+	}
+
+	emailFailures, ipFailures, err := g.failures.FailureCounts(ctx, email, remoteIP)
+	if err != nil {
+		g.logger.Error(ctx, "Failed to read login failures, skipping challenge", zap.Error(err))
+		return nil
+	}
+	if emailFailures < g.emailThreshold && ipFailures < g.ipThreshold {
+		return nil
+	}
+
+	if response == "" {
+		return domain.ErrChallengeRequired
+	}
+	if err := g.provider.Verify(ctx, response, remoteIP); err != nil {
+		g.logger.Warn(ctx, "Challenge verification failed",
+			zap.Error(err),
+			zap.String("provider", g.provider.Name()),
+			zap.String("ip_address", remoteIP),
+		)
+		return err
+	}
+	return nil
+}
+
+func (g *ThresholdChallengeGate) Issue(ctx context.Context) (services.Challenge, error) {
+	return g.provider.Issue(ctx)
+}
+
+// NewChallengeProvider builds the provider named in cfg, or returns nil for
+// "none".
+func NewChallengeProvider(cfg config.SecurityConfig, cache persistence.Cache) (services.ChallengeProvider, error) {
+	switch cfg.ChallengeProvider {
+	case "none", "":
+		return nil, nil
+	case powProviderName:
+		return NewProofOfWorkProvider(cfg.ChallengeSecret, cfg.ChallengeDifficulty, cfg.ChallengeTTL, cache), nil
+	case "captcha":
+		return captcha.NewSiteVerifyProvider(cfg.CaptchaVerifyURL, cfg.CaptchaSiteKey, cfg.CaptchaSecret, captchaTimeout), nil
+	default:
+		return nil, fmt.Errorf("unknown challenge provider %q", cfg.ChallengeProvider)
+	}
+}
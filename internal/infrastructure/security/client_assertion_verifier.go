@@ -0,0 +1,91 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	assertionJTIKeyPrefix = "client_assertion_jti:"
+	// maxAssertionLifetime rejects assertions minted far into the future, which
+	// would otherwise pin their jti in the cache for a long time.
+	maxAssertionLifetime = 10 * time.Minute
+)
+
+// JWTClientAssertionVerifier implements private_key_jwt client authentication
+// (RFC 7523 section 3) for service accounts.
+type JWTClientAssertionVerifier struct {
+	cache persistence.Cache
+}
+
+func NewJWTClientAssertionVerifier(cache persistence.Cache) services.ClientAssertionVerifier {
+	return &JWTClientAssertionVerifier{cache: cache}
+}
+
+func (v *JWTClientAssertionVerifier) ValidatePublicKey(publicKeyPEM string) error {
+	if _, err := parsePublicKey(publicKeyPEM); err != nil {
+		return domain.ErrInvalidPublicKey
+	}
+	return nil
+}
+
+func (v *JWTClientAssertionVerifier) Verify(
+	ctx context.Context,
+	assertion, clientID, publicKeyPEM, audience string,
+) error {
+	key, err := parsePublicKey(publicKeyPEM)
+	if err != nil {
+		return domain.ErrInvalidPublicKey
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	_, err = jwt.ParseWithClaims(assertion, claims,
+		func(t *jwt.Token) (interface{}, error) { return key, nil },
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384", "EdDSA"}),
+		jwt.WithIssuer(clientID),
+		jwt.WithSubject(clientID),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidClientAssertion, err)
+	}
+
+	if claims.ID == "" {
+		return fmt.Errorf("%w: missing jti", domain.ErrInvalidClientAssertion)
+	}
+
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl > maxAssertionLifetime {
+		return fmt.Errorf("%w: expiry too far in the future", domain.ErrInvalidClientAssertion)
+	}
+
+	jtiKey := assertionJTIKeyPrefix + clientID + ":" + claims.ID
+	claimed, err := v.cache.SetIfAbsent(ctx, jtiKey, true, ttl)
+	if err != nil {
+		return fmt.Errorf("failed to record assertion jti: %w", err)
+	}
+	if !claimed {
+		return domain.ErrClientAssertionReplayed
+	}
+
+	return nil
+}
+
+func parsePublicKey(publicKeyPEM string) (interface{}, error) {
+	data := []byte(publicKeyPEM)
+	if key, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	if key, err := jwt.ParseECPublicKeyFromPEM(data); err == nil {
+		return key, nil
+	}
+	return jwt.ParseEdPublicKeyFromPEM(data)
+}
@@ -0,0 +1,71 @@
+package security
+
+import (
+	"errors"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// emailChangeAudience keeps email change tokens from being accepted anywhere
+// else that shares the signing key, and vice versa.
+const emailChangeAudience = "email-change"
+
+type emailChangeClaims struct {
+	CurrentEmail string `json:"current_email"`
+	NewEmail     string `json:"new_email"`
+	jwt.RegisteredClaims
+}
+
+// HMACEmailChangeSigner signs email change tokens as compact HS256 JWTs.
+type HMACEmailChangeSigner struct {
+	secret []byte
+	issuer string
+}
+
+func NewHMACEmailChangeSigner(secret, issuer string) services.EmailChangeSigner {
+	return &HMACEmailChangeSigner{secret: []byte(secret), issuer: issuer}
+}
+
+func (s *HMACEmailChangeSigner) Sign(change services.EmailChange, expiresAt time.Time) (string, error) {
+	claims := emailChangeClaims{
+		CurrentEmail: change.CurrentEmail,
+		NewEmail:     change.NewEmail,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   change.UserID,
+			Issuer:    s.issuer,
+			Audience:  jwt.ClaimStrings{emailChangeAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+func (s *HMACEmailChangeSigner) Verify(token string) (services.EmailChange, error) {
+	claims := &emailChangeClaims{}
+	_, err := jwt.ParseWithClaims(token, claims,
+		func(t *jwt.Token) (interface{}, error) { return s.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(emailChangeAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return services.EmailChange{}, domain.ErrEmailChangeExpired
+		}
+		return services.EmailChange{}, domain.ErrInvalidEmailChangeToken
+	}
+	if claims.Subject == "" || claims.NewEmail == "" || claims.CurrentEmail == "" {
+		return services.EmailChange{}, domain.ErrInvalidEmailChangeToken
+	}
+	return services.EmailChange{
+		UserID:       claims.Subject,
+		CurrentEmail: claims.CurrentEmail,
+		NewEmail:     claims.NewEmail,
+	}, nil
+}
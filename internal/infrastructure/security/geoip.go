@@ -0,0 +1,165 @@
+package security
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// GeoLocation is an approximate position for an IP address. City and
+// Country are only known when the database carries them.
+type GeoLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	City      string  `json:"city,omitempty"`
+	Country   string  `json:"country,omitempty"`
+}
+
+// String names the place for people, falling back to coordinates.
+func (l GeoLocation) String() string {
+	switch {
+	case l.City != "" && l.Country != "":
+		return l.City + ", " + l.Country
+	case l.City != "" || l.Country != "":
+		return l.City + l.Country
+	default:
+		return fmt.Sprintf("near %.1f, %.1f", l.Latitude, l.Longitude)
+	}
+}
+
+type geoRange struct {
+	start, end net.IP
+	location   GeoLocation
+}
+
+// GeoIPDatabase answers location lookups from a local CSV file in the
+// GeoLite2 City blocks layout: a header row naming at least the network,
+// latitude and longitude columns, then one CIDR per row. Optional city and
+// country columns name the place. The file is read once at startup.
+type GeoIPDatabase struct {
+	ranges []geoRange
+}
+
+func LoadGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open geoip database: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read geoip header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[name] = i
+	}
+	netCol, okNet := columns["network"]
+	latCol, okLat := columns["latitude"]
+	lonCol, okLon := columns["longitude"]
+	if !okNet || !okLat || !okLon {
+		return nil, fmt.Errorf("geoip database needs network, latitude and longitude columns")
+	}
+	cityCol, okCity := columns["city"]
+	countryCol, okCountry := columns["country"]
+
+	db := &GeoIPDatabase{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read geoip database: %w", err)
+		}
+
+		_, network, err := net.ParseCIDR(record[netCol])
+		if err != nil {
+			continue
+		}
+		lat, errLat := strconv.ParseFloat(record[latCol], 64)
+		lon, errLon := strconv.ParseFloat(record[lonCol], 64)
+		if errLat != nil || errLon != nil {
+			continue
+		}
+
+		location := GeoLocation{Latitude: lat, Longitude: lon}
+		if okCity {
+			location.City = record[cityCol]
+		}
+		if okCountry {
+			location.Country = record[countryCol]
+		}
+
+		start, end := networkBounds(network)
+		db.ranges = append(db.ranges, geoRange{
+			start:    start,
+			end:      end,
+			location: location,
+		})
+	}
+
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].start, db.ranges[j].start) < 0
+	})
+	return db, nil
+}
+
+// Lookup returns the location of ip, if the database covers it.
+func (db *GeoIPDatabase) Lookup(ip string) (GeoLocation, bool) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return GeoLocation{}, false
+	}
+	addr := parsed.To16()
+
+	i := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].start, addr) > 0
+	})
+	if i == 0 {
+		return GeoLocation{}, false
+	}
+	r := db.ranges[i-1]
+	if bytes.Compare(addr, r.end) > 0 {
+		return GeoLocation{}, false
+	}
+	return r.location, true
+}
+
+// networkBounds returns the first and last address of network, both in
+// 16-byte form so IPv4 and IPv6 ranges sort together.
+func networkBounds(network *net.IPNet) (net.IP, net.IP) {
+	start := network.IP.To16()
+	mask := network.Mask
+	if len(mask) == net.IPv4len {
+		mask = append(net.CIDRMask(96, 128)[:12:12], mask...)
+	}
+
+	end := make(net.IP, net.IPv6len)
+	for i := range start {
+		end[i] = start[i] | ^mask[i]
+	}
+	return start, end
+}
+
+// distanceKm is the great-circle distance between two points.
+func distanceKm(a, b GeoLocation) float64 {
+	const earthRadiusKm = 6371.0
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(b.Latitude - a.Latitude)
+	dLon := toRad(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.Latitude))*math.Cos(toRad(b.Latitude))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
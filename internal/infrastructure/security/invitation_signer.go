@@ -0,0 +1,57 @@
+package security
+
+import (
+	"errors"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// invitationAudience keeps invitation tokens from being accepted anywhere
+// else that shares the signing key, and vice versa.
+const invitationAudience = "organization-invitation"
+
+// HMACInvitationSigner signs invitation tokens as compact HS256 JWTs.
+type HMACInvitationSigner struct {
+	secret []byte
+	issuer string
+}
+
+func NewHMACInvitationSigner(secret, issuer string) services.InvitationSigner {
+	return &HMACInvitationSigner{secret: []byte(secret), issuer: issuer}
+}
+
+func (s *HMACInvitationSigner) Sign(invitationID string, expiresAt time.Time) (string, error) {
+	claims := jwt.RegisteredClaims{
+		ID:        invitationID,
+		Issuer:    s.issuer,
+		Audience:  jwt.ClaimStrings{invitationAudience},
+		IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+func (s *HMACInvitationSigner) Verify(token string) (string, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims,
+		func(t *jwt.Token) (interface{}, error) { return s.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(invitationAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return "", domain.ErrInvitationExpired
+		}
+		return "", domain.ErrInvalidInvitationToken
+	}
+	if claims.ID == "" {
+		return "", domain.ErrInvalidInvitationToken
+	}
+	return claims.ID, nil
+}
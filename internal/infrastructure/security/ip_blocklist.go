@@ -0,0 +1,72 @@
+package security
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// IPBlocklist holds known-bad addresses and networks loaded from a text
+// file: one IP or CIDR per line, blank lines and # comments ignored.
+type IPBlocklist struct {
+	networks []*net.IPNet
+}
+
+func LoadIPBlocklist(path string) (*IPBlocklist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ip blocklist: %w", err)
+	}
+	defer file.Close()
+
+	list := &IPBlocklist{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if i := strings.IndexByte(entry, '#'); i >= 0 {
+			entry = strings.TrimSpace(entry[:i])
+		}
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid ip blocklist entry on line %d: %q", line, entry)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			list.networks = append(list.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip blocklist entry on line %d: %w", line, err)
+		}
+		list.networks = append(list.networks, network)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read ip blocklist: %w", err)
+	}
+
+	return list, nil
+}
+
+func (l *IPBlocklist) Contains(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range l.networks {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
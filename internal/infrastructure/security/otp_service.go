@@ -0,0 +1,143 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	domainServices "authentication/internal/domain/services"
+	"authentication/shared/config"
+)
+
+const (
+	otpAlphabet      = "0123456789"
+	otpEmailTemplate = "otp"
+)
+
+type otpEntry struct {
+	Hash      string    `json:"hash"`
+	Attempts  int       `json:"attempts"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// CacheOTPService keeps hashed one-time codes in the cache. A code is
+// dropped once used or after MaxAttempts wrong guesses. The identifier is
+// whatever the caller keys codes by, such as an email or a user id.
+type CacheOTPService struct {
+	cache   persistence.Cache
+	secrets domainServices.SecretService
+	email   services.EmailService
+	sms     services.SMSService
+	cfg     config.OTPConfig
+}
+
+var _ services.OTPService = (*CacheOTPService)(nil)
+
+// NewCacheOTPService builds the OTP service. sms may be nil when SMS is
+// disabled, in which case SendSMS returns ErrSMSUnavailable.
+func NewCacheOTPService(
+	cache persistence.Cache,
+	email services.EmailService,
+	sms services.SMSService,
+	cfg config.OTPConfig,
+) *CacheOTPService {
+	return &CacheOTPService{
+		cache:   cache,
+		secrets: domainServices.NewSecretService(),
+		email:   email,
+		sms:     sms,
+		cfg:     cfg,
+	}
+}
+
+func (s *CacheOTPService) Generate(ctx context.Context, identifier string, purpose string) (string, error) {
+	code, err := s.secrets.GenerateCode(s.cfg.Length, otpAlphabet)
+	if err != nil {
+		return "", err
+	}
+
+	entry := otpEntry{
+		Hash:      s.secrets.Hash(code),
+		ExpiresAt: time.Now().Add(s.cfg.ExpiryDuration),
+	}
+	if err := s.cache.Set(ctx, otpKey(identifier, purpose), entry, s.cfg.ExpiryDuration); err != nil {
+		return "", fmt.Errorf("failed to store otp: %w", err)
+	}
+	if err := s.cache.Set(ctx, otpRateKey(identifier), true, s.cfg.RateLimit); err != nil {
+		return "", fmt.Errorf("failed to store otp rate limit: %w", err)
+	}
+
+	return code, nil
+}
+
+func (s *CacheOTPService) Verify(ctx context.Context, identifier string, otpCode string, purpose string) (bool, error) {
+	key := otpKey(identifier, purpose)
+
+	var entry otpEntry
+	if err := s.cache.Get(ctx, key, &entry); err != nil {
+		if errors.Is(err, persistence.ErrCacheMiss) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to load otp: %w", err)
+	}
+
+	if s.secrets.Verify(otpCode, entry.Hash) {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			return false, fmt.Errorf("failed to consume otp: %w", err)
+		}
+		return true, nil
+	}
+
+	entry.Attempts++
+	remaining := time.Until(entry.ExpiresAt)
+	if entry.Attempts >= s.cfg.MaxAttempts || remaining <= 0 {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			return false, fmt.Errorf("failed to discard otp: %w", err)
+		}
+		return false, nil
+	}
+	if err := s.cache.Set(ctx, key, entry, remaining); err != nil {
+		return false, fmt.Errorf("failed to record otp attempt: %w", err)
+	}
+	return false, nil
+}
+
+func (s *CacheOTPService) SendEmail(ctx context.Context, email string, otpCode string, purpose string) error {
+	return s.email.SendEmail(ctx, services.SendEmailInput{
+		To:       email,
+		Subject:  "Your verification code",
+		Template: otpEmailTemplate,
+		Data: map[string]interface{}{
+			"code":            otpCode,
+			"purpose":         purpose,
+			"expires_minutes": int(s.cfg.ExpiryDuration.Minutes()),
+		},
+	})
+}
+
+func (s *CacheOTPService) SendSMS(ctx context.Context, phone string, otpCode string, purpose string) error {
+	if s.sms == nil {
+		return domain.ErrSMSUnavailable
+	}
+	return s.sms.Send(ctx, services.SMSMessage{
+		To: phone,
+		Body: fmt.Sprintf("Your verification code is %s. It expires in %d minutes.",
+			otpCode, int(s.cfg.ExpiryDuration.Minutes())),
+	})
+}
+
+func (s *CacheOTPService) IsRateLimited(ctx context.Context, identifier string) (bool, error) {
+	return s.cache.Exists(ctx, otpRateKey(identifier))
+}
+
+func otpKey(identifier, purpose string) string {
+	return "otp:" + purpose + ":" + identifier
+}
+
+func otpRateKey(identifier string) string {
+	return "otp:rate:" + identifier
+}
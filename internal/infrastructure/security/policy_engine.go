@@ -0,0 +1,307 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const (
+	PolicyEffectAllow = "allow"
+	PolicyEffectDeny  = "deny"
+)
+
+// PolicyDocument is the on-disk format. Each *.json file in the policy
+// directory holds one document:
+//
+//	{
+//	  "policies": [{
+//	    "id": "moderators-cannot-deactivate-admins",
+//	    "effect": "deny",
+//	    "actions": ["DeactivateUserCommand"],
+//	    "conditions": [
+//	      {"attribute": "subject.role", "operator": "equals", "values": ["MODERATOR"]},
+//	      {"attribute": "resource.user_role", "operator": "equals", "values": ["ADMIN"]}
+//	    ]
+//	  }]
+//	}
+//
+// All conditions of a policy must hold for it to apply. Any applicable deny
+// wins over any applicable allow; when nothing applies the request is
+// allowed, leaving the decision to role checks.
+type PolicyDocument struct {
+	Policies []Policy `json:"policies"`
+}
+
+type Policy struct {
+	ID          string            `json:"id"`
+	Description string            `json:"description,omitempty"`
+	Effect      string            `json:"effect"`
+	Actions     []string          `json:"actions"`
+	Conditions  []PolicyCondition `json:"conditions,omitempty"`
+}
+
+// PolicyCondition compares an attribute against Values or, when ValueFrom is
+// set, against another attribute. Attributes are addressed as
+// subject.<name>, resource.<name>, action or environment.<name>.
+type PolicyCondition struct {
+	Attribute string   `json:"attribute"`
+	Operator  string   `json:"operator"`
+	Values    []string `json:"values,omitempty"`
+	ValueFrom string   `json:"value_from,omitempty"`
+}
+
+var policyOperators = map[string]bool{
+	"equals": true, "not_equals": true, "in": true, "not_in": true,
+	"in_cidr": true, "gte": true, "lte": true,
+}
+
+// FilePolicyEngine evaluates policies loaded from a directory of JSON files.
+type FilePolicyEngine struct {
+	policies  []Policy
+	providers []services.ResourceAttributeProvider
+	logger    logging.Logger
+}
+
+func NewFilePolicyEngine(
+	dir string,
+	providers []services.ResourceAttributeProvider,
+	logger logging.Logger,
+) (services.PolicyEvaluator, error) {
+	policies, err := LoadPolicies(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	engine := &FilePolicyEngine{
+		policies:  policies,
+		providers: providers,
+		logger:    logger.With(zap.String("service", "policy_engine")),
+	}
+	engine.logger.Info(context.Background(), "Policies loaded",
+		zap.String("dir", dir),
+		zap.Int("count", len(policies)),
+	)
+	return engine, nil
+}
+
+// LoadPolicies reads every *.json file in dir, in name order, and validates
+// the result. A missing directory yields no policies.
+func LoadPolicies(dir string) ([]Policy, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy files: %w", err)
+	}
+	sort.Strings(files)
+
+	var policies []Policy
+	seen := make(map[string]bool)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy file %s: %w", file, err)
+		}
+
+		var doc PolicyDocument
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to parse policy file %s: %w", file, err)
+		}
+
+		for _, policy := range doc.Policies {
+			if err := validatePolicy(policy); err != nil {
+				return nil, fmt.Errorf("invalid policy in %s: %w", file, err)
+			}
+			if seen[policy.ID] {
+				return nil, fmt.Errorf("duplicate policy id %q in %s", policy.ID, file)
+			}
+			seen[policy.ID] = true
+			policies = append(policies, policy)
+		}
+	}
+
+	return policies, nil
+}
+
+func validatePolicy(policy Policy) error {
+	if policy.ID == "" {
+		return fmt.Errorf("policy id is required")
+	}
+	if policy.Effect != PolicyEffectAllow && policy.Effect != PolicyEffectDeny {
+		return fmt.Errorf("policy %q: effect must be allow or deny", policy.ID)
+	}
+	if len(policy.Actions) == 0 {
+		return fmt.Errorf("policy %q: at least one action is required", policy.ID)
+	}
+	for _, c := range policy.Conditions {
+		if !policyOperators[c.Operator] {
+			return fmt.Errorf("policy %q: unknown operator %q", policy.ID, c.Operator)
+		}
+		if c.Attribute == "" {
+			return fmt.Errorf("policy %q: condition attribute is required", policy.ID)
+		}
+		if c.ValueFrom == "" && len(c.Values) == 0 {
+			return fmt.Errorf("policy %q: condition on %s needs values or value_from", policy.ID, c.Attribute)
+		}
+	}
+	return nil
+}
+
+func (e *FilePolicyEngine) Evaluate(ctx context.Context, request services.AccessRequest) (services.PolicyDecision, error) {
+	var applicable []Policy
+	for _, policy := range e.policies {
+		if matchesAction(policy.Actions, request.Action) {
+			applicable = append(applicable, policy)
+		}
+	}
+	if len(applicable) == 0 {
+		return services.PolicyDecision{Allowed: true, Reason: "no applicable policy"}, nil
+	}
+
+	attrs, err := e.attributes(ctx, request)
+	if err != nil {
+		return services.PolicyDecision{}, err
+	}
+
+	var allowedBy string
+	for _, policy := range applicable {
+		if !conditionsHold(policy.Conditions, attrs) {
+			continue
+		}
+		if policy.Effect == PolicyEffectDeny {
+			return services.PolicyDecision{Allowed: false, PolicyID: policy.ID, Reason: policy.Description}, nil
+		}
+		if allowedBy == "" {
+			allowedBy = policy.ID
+		}
+	}
+
+	if allowedBy != "" {
+		return services.PolicyDecision{Allowed: true, PolicyID: allowedBy}, nil
+	}
+	return services.PolicyDecision{Allowed: true, Reason: "no matching policy"}, nil
+}
+
+func (e *FilePolicyEngine) attributes(ctx context.Context, request services.AccessRequest) (map[string]string, error) {
+	subject := request.Subject
+	attrs := map[string]string{
+		"action":             request.Action,
+		"subject.user_id":    subject.UserID,
+		"subject.role":       strings.ToUpper(subject.Role),
+		"subject.client_id":  subject.ClientID,
+		"subject.token_type": subject.TokenType,
+		"subject.scopes":     strings.Join(subject.Scopes, " "),
+		"subject.org_id":     subject.OrgID,
+		"subject.org_role":   subject.OrgRole,
+		"resource.type":      request.Resource.Type,
+		"resource.id":        request.Resource.ID,
+		"environment.ip":     request.Environment.IPAddress,
+	}
+	if !request.Environment.Time.IsZero() {
+		at := request.Environment.Time.UTC()
+		attrs["environment.hour"] = strconv.Itoa(at.Hour())
+		attrs["environment.weekday"] = strings.ToLower(at.Weekday().String())
+	}
+
+	for k, v := range request.Resource.Attributes {
+		attrs["resource."+k] = v
+	}
+
+	for _, provider := range e.providers {
+		extra, err := provider.ResourceAttributes(ctx, request.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve resource attributes: %w", err)
+		}
+		for k, v := range extra {
+			attrs["resource."+k] = v
+		}
+	}
+
+	return attrs, nil
+}
+
+// matchesAction accepts exact names, "*", and prefix patterns such as
+// "Delete*".
+func matchesAction(patterns []string, action string) bool {
+	for _, p := range patterns {
+		if p == "*" || p == action {
+			return true
+		}
+		if strings.HasSuffix(p, "*") && strings.HasPrefix(action, strings.TrimSuffix(p, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func conditionsHold(conditions []PolicyCondition, attrs map[string]string) bool {
+	for _, c := range conditions {
+		if !conditionHolds(c, attrs) {
+			return false
+		}
+	}
+	return true
+}
+
+func conditionHolds(c PolicyCondition, attrs map[string]string) bool {
+	actual := attrs[c.Attribute]
+	expected := c.Values
+	if c.ValueFrom != "" {
+		expected = []string{attrs[c.ValueFrom]}
+	}
+
+	switch c.Operator {
+	case "equals":
+		return actual == expected[0]
+	case "not_equals":
+		return actual != expected[0]
+	case "in":
+		return containsString(expected, actual)
+	case "not_in":
+		return !containsString(expected, actual)
+	case "in_cidr":
+		ip := net.ParseIP(actual)
+		if ip == nil {
+			return false
+		}
+		for _, cidr := range expected {
+			if _, network, err := net.ParseCIDR(cidr); err == nil && network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	case "gte", "lte":
+		a, err1 := strconv.Atoi(actual)
+		b, err2 := strconv.Atoi(expected[0])
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		if c.Operator == "gte" {
+			return a >= b
+		}
+		return a <= b
+	}
+	return false
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,326 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/shared/logging"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(context.Context, string, ...zap.Field) {}
+func (nopLogger) Info(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Warn(context.Context, string, ...zap.Field)  {}
+func (nopLogger) Error(context.Context, string, ...zap.Field) {}
+func (nopLogger) Fatal(context.Context, string, ...zap.Field) {}
+func (l nopLogger) With(...zap.Field) logging.Logger          { return l }
+
+func TestConditionHolds(t *testing.T) {
+	attrs := map[string]string{
+		"subject.role":     "MODERATOR",
+		"subject.user_id":  "u1",
+		"resource.user_id": "u1",
+		"resource.owner":   "u2",
+		"environment.ip":   "10.1.2.3",
+		"environment.hour": "9",
+	}
+
+	tests := []struct {
+		name      string
+		condition PolicyCondition
+		want      bool
+	}{
+		{"equals", PolicyCondition{Attribute: "subject.role", Operator: "equals", Values: []string{"MODERATOR"}}, true},
+		{"equals is case-sensitive", PolicyCondition{Attribute: "subject.role", Operator: "equals", Values: []string{"moderator"}}, false},
+		{"not_equals", PolicyCondition{Attribute: "subject.role", Operator: "not_equals", Values: []string{"ADMIN"}}, true},
+		{"in", PolicyCondition{Attribute: "subject.role", Operator: "in", Values: []string{"ADMIN", "MODERATOR"}}, true},
+		{"in without match", PolicyCondition{Attribute: "subject.role", Operator: "in", Values: []string{"ADMIN"}}, false},
+		{"not_in", PolicyCondition{Attribute: "subject.role", Operator: "not_in", Values: []string{"ADMIN", "USER"}}, true},
+		{"missing attribute is empty", PolicyCondition{Attribute: "resource.missing", Operator: "equals", Values: []string{""}}, true},
+		{"in_cidr", PolicyCondition{Attribute: "environment.ip", Operator: "in_cidr", Values: []string{"192.168.0.0/16", "10.0.0.0/8"}}, true},
+		{"in_cidr outside", PolicyCondition{Attribute: "environment.ip", Operator: "in_cidr", Values: []string{"192.168.0.0/16"}}, false},
+		{"in_cidr skips bad networks", PolicyCondition{Attribute: "environment.ip", Operator: "in_cidr", Values: []string{"bogus", "10.1.0.0/16"}}, true},
+		{"in_cidr without ip", PolicyCondition{Attribute: "resource.missing", Operator: "in_cidr", Values: []string{"0.0.0.0/0"}}, false},
+		{"gte", PolicyCondition{Attribute: "environment.hour", Operator: "gte", Values: []string{"9"}}, true},
+		{"gte below", PolicyCondition{Attribute: "environment.hour", Operator: "gte", Values: []string{"10"}}, false},
+		{"lte", PolicyCondition{Attribute: "environment.hour", Operator: "lte", Values: []string{"17"}}, true},
+		{"lte above", PolicyCondition{Attribute: "environment.hour", Operator: "lte", Values: []string{"8"}}, false},
+		{"gte on a non-number", PolicyCondition{Attribute: "subject.role", Operator: "gte", Values: []string{"1"}}, false},
+		{"value_from equal", PolicyCondition{Attribute: "resource.user_id", Operator: "equals", ValueFrom: "subject.user_id"}, true},
+		{"value_from different", PolicyCondition{Attribute: "resource.owner", Operator: "equals", ValueFrom: "subject.user_id"}, false},
+		{"unknown operator", PolicyCondition{Attribute: "subject.role", Operator: "matches", Values: []string{".*"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conditionHolds(tt.condition, attrs); got != tt.want {
+				t.Errorf("conditionHolds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesAction(t *testing.T) {
+	tests := []struct {
+		patterns []string
+		action   string
+		want     bool
+	}{
+		{[]string{"DeleteUserCommand"}, "DeleteUserCommand", true},
+		{[]string{"DeleteUserCommand"}, "DeleteRoleCommand", false},
+		{[]string{"Delete*"}, "DeleteRoleCommand", true},
+		{[]string{"Delete*"}, "UndeleteRoleCommand", false},
+		{[]string{"*"}, "AnythingQuery", true},
+		{nil, "AnythingQuery", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesAction(tt.patterns, tt.action); got != tt.want {
+			t.Errorf("matchesAction(%v, %q) = %v, want %v", tt.patterns, tt.action, got, tt.want)
+		}
+	}
+}
+
+type stubAttributeProvider struct {
+	attrs map[string]string
+	err   error
+}
+
+func (p stubAttributeProvider) ResourceAttributes(context.Context, services.Resource) (map[string]string, error) {
+	return p.attrs, p.err
+}
+
+func TestFilePolicyEngineEvaluate(t *testing.T) {
+	engine := &FilePolicyEngine{
+		policies: []Policy{
+			{
+				ID:      "allow-updates",
+				Effect:  PolicyEffectAllow,
+				Actions: []string{"Update*"},
+			},
+			{
+				ID:      "moderators-cannot-deactivate-admins",
+				Effect:  PolicyEffectDeny,
+				Actions: []string{"DeactivateUserCommand"},
+				Conditions: []PolicyCondition{
+					{Attribute: "subject.role", Operator: "equals", Values: []string{"MODERATOR"}},
+					{Attribute: "resource.user_role", Operator: "equals", Values: []string{"ADMIN"}},
+				},
+			},
+			{
+				ID:      "internal-network-only",
+				Effect:  PolicyEffectDeny,
+				Actions: []string{"Update*"},
+				Conditions: []PolicyCondition{
+					{Attribute: "environment.ip", Operator: "in_cidr", Values: []string{"203.0.113.0/24"}},
+				},
+			},
+		},
+		providers: []services.ResourceAttributeProvider{
+			stubAttributeProvider{attrs: map[string]string{"user_role": "ADMIN"}},
+		},
+		logger: nopLogger{},
+	}
+
+	tests := []struct {
+		name        string
+		request     services.AccessRequest
+		wantAllowed bool
+		wantPolicy  string
+	}{
+		{
+			name: "no applicable policy",
+			request: services.AccessRequest{
+				Action:  "GetProfileQuery",
+				Subject: services.Subject{Role: "user"},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "deny applies with provider attributes",
+			request: services.AccessRequest{
+				Action:  "DeactivateUserCommand",
+				Subject: services.Subject{Role: "moderator"},
+			},
+			wantAllowed: false,
+			wantPolicy:  "moderators-cannot-deactivate-admins",
+		},
+		{
+			name: "deny conditions do not all hold",
+			request: services.AccessRequest{
+				Action:  "DeactivateUserCommand",
+				Subject: services.Subject{Role: "ADMIN"},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "allow",
+			request: services.AccessRequest{
+				Action:      "UpdateProfileCommand",
+				Environment: services.Environment{IPAddress: "10.0.0.1"},
+			},
+			wantAllowed: true,
+			wantPolicy:  "allow-updates",
+		},
+		{
+			name: "deny wins over allow",
+			request: services.AccessRequest{
+				Action:      "UpdateProfileCommand",
+				Environment: services.Environment{IPAddress: "203.0.113.9"},
+			},
+			wantAllowed: false,
+			wantPolicy:  "internal-network-only",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, err := engine.Evaluate(context.Background(), tt.request)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			if decision.Allowed != tt.wantAllowed || decision.PolicyID != tt.wantPolicy {
+				t.Errorf("Evaluate() = (%v, %q), want (%v, %q)",
+					decision.Allowed, decision.PolicyID, tt.wantAllowed, tt.wantPolicy)
+			}
+		})
+	}
+}
+
+func TestFilePolicyEngineEnvironmentAttributes(t *testing.T) {
+	engine := &FilePolicyEngine{
+		policies: []Policy{{
+			ID:      "no-weekend-deletes",
+			Effect:  PolicyEffectDeny,
+			Actions: []string{"Delete*"},
+			Conditions: []PolicyCondition{
+				{Attribute: "environment.weekday", Operator: "in", Values: []string{"saturday", "sunday"}},
+			},
+		}},
+		logger: nopLogger{},
+	}
+
+	saturday := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	monday := saturday.AddDate(0, 0, 2)
+	for at, want := range map[time.Time]bool{saturday: false, monday: true} {
+		decision, err := engine.Evaluate(context.Background(), services.AccessRequest{
+			Action:      "DeleteRoleCommand",
+			Environment: services.Environment{Time: at},
+		})
+		if err != nil {
+			t.Fatalf("Evaluate() error = %v", err)
+		}
+		if decision.Allowed != want {
+			t.Errorf("Evaluate() on %s allowed = %v, want %v", at.Weekday(), decision.Allowed, want)
+		}
+	}
+}
+
+func TestFilePolicyEngineProviderError(t *testing.T) {
+	broken := errors.New("user lookup failed")
+	engine := &FilePolicyEngine{
+		policies:  []Policy{{ID: "any", Effect: PolicyEffectAllow, Actions: []string{"*"}}},
+		providers: []services.ResourceAttributeProvider{stubAttributeProvider{err: broken}},
+		logger:    nopLogger{},
+	}
+
+	if _, err := engine.Evaluate(context.Background(), services.AccessRequest{Action: "DeleteUserCommand"}); !errors.Is(err, broken) {
+		t.Fatalf("Evaluate() error = %v, want %v", err, broken)
+	}
+}
+
+func TestLoadPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantIDs []string
+		wantErr string
+	}{
+		{
+			name:    "missing directory",
+			wantIDs: nil,
+		},
+		{
+			name: "files load in name order",
+			files: map[string]string{
+				"b.json": `{"policies":[{"id":"second","effect":"allow","actions":["*"]}]}`,
+				"a.json": `{"policies":[{"id":"first","effect":"deny","actions":["Delete*"],
+					"conditions":[{"attribute":"subject.role","operator":"equals","values":["USER"]}]}]}`,
+				"notes.txt": `ignored`,
+			},
+			wantIDs: []string{"first", "second"},
+		},
+		{
+			name:    "unknown field",
+			files:   map[string]string{"a.json": `{"policies":[{"id":"p","effect":"allow","actions":["*"],"priority":1}]}`},
+			wantErr: "failed to parse",
+		},
+		{
+			name:    "unknown operator",
+			files:   map[string]string{"a.json": `{"policies":[{"id":"p","effect":"deny","actions":["*"],"conditions":[{"attribute":"action","operator":"regex","values":["x"]}]}]}`},
+			wantErr: "unknown operator",
+		},
+		{
+			name:    "condition without values",
+			files:   map[string]string{"a.json": `{"policies":[{"id":"p","effect":"deny","actions":["*"],"conditions":[{"attribute":"action","operator":"equals"}]}]}`},
+			wantErr: "needs values or value_from",
+		},
+		{
+			name:    "bad effect",
+			files:   map[string]string{"a.json": `{"policies":[{"id":"p","effect":"maybe","actions":["*"]}]}`},
+			wantErr: "effect must be allow or deny",
+		},
+		{
+			name: "duplicate id across files",
+			files: map[string]string{
+				"a.json": `{"policies":[{"id":"p","effect":"allow","actions":["*"]}]}`,
+				"b.json": `{"policies":[{"id":"p","effect":"deny","actions":["*"]}]}`,
+			},
+			wantErr: "duplicate policy id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "policies")
+			if tt.files != nil {
+				if err := os.Mkdir(dir, 0o755); err != nil {
+					t.Fatalf("failed to create policy dir: %v", err)
+				}
+				for name, content := range tt.files {
+					if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+						t.Fatalf("failed to write %s: %v", name, err)
+					}
+				}
+			}
+
+			policies, err := LoadPolicies(dir)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadPolicies() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadPolicies() error = %v", err)
+			}
+
+			var ids []string
+			for _, p := range policies {
+				ids = append(ids, p.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("LoadPolicies() ids = %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+}
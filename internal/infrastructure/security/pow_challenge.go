@@ -0,0 +1,129 @@
+package security
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+)
+
+const powProviderName = "pow"
+
+// ProofOfWorkProvider is a hashcash-style challenge that needs no external
+// service. The token is "<difficulty>.<expiry>.<seed>.<mac>"; a client
+// solves it by finding a nonce for which SHA-256("<token>:<nonce>") starts
+// with difficulty zero bits, and answers with "<token>:<nonce>". Tokens are
+// stateless; used seeds are remembered in the cache until they expire.
+type ProofOfWorkProvider struct {
+	secret     []byte
+	difficulty int
+	ttl        time.Duration
+	cache      persistence.Cache
+}
+
+var _ services.ChallengeProvider = (*ProofOfWorkProvider)(nil)
+
+func NewProofOfWorkProvider(secret string, difficulty int, ttl time.Duration, cache persistence.Cache) *ProofOfWorkProvider {
+	return &ProofOfWorkProvider{
+		secret:     []byte(secret),
+		difficulty: difficulty,
+		ttl:        ttl,
+		cache:      cache,
+	}
+}
+
+func (p *ProofOfWorkProvider) Name() string {
+	return powProviderName
+}
+
+func (p *ProofOfWorkProvider) Issue(ctx context.Context) (services.Challenge, error) {
+	seed := make([]byte, 16)
+	if _, err := rand.Read(seed); err != nil {
+		return services.Challenge{}, fmt.Errorf("failed to generate challenge seed: %w", err)
+	}
+
+	expiresAt := time.Now().UTC().Add(p.ttl).Truncate(time.Second)
+	body := strconv.Itoa(p.difficulty) + "." + strconv.FormatInt(expiresAt.Unix(), 10) + "." + hex.EncodeToString(seed)
+
+	return services.Challenge{
+		Provider:   powProviderName,
+		Token:      body + "." + p.mac(body),
+		Difficulty: p.difficulty,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+func (p *ProofOfWorkProvider) Verify(ctx context.Context, response, remoteIP string) error {
+	i := strings.LastIndexByte(response, ':')
+	if i < 0 {
+		return domain.ErrChallengeFailed
+	}
+	token := response[:i]
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return domain.ErrChallengeFailed
+	}
+	body := strings.Join(parts[:3], ".")
+	if !hmac.Equal([]byte(parts[3]), []byte(p.mac(body))) {
+		return domain.ErrChallengeFailed
+	}
+
+	difficulty, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return domain.ErrChallengeFailed
+	}
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return domain.ErrChallengeFailed
+	}
+	remaining := time.Until(time.Unix(expiry, 0))
+	if remaining <= 0 {
+		return domain.ErrChallengeFailed
+	}
+
+	sum := sha256.Sum256([]byte(response))
+	if leadingZeroBits(sum[:]) < difficulty {
+		return domain.ErrChallengeFailed
+	}
+
+	// Each token buys one attempt. Claiming the seed in one step keeps two
+	// concurrent requests from spending the same token.
+	key := "challenge:pow:used:" + parts[2]
+	claimed, err := p.cache.SetIfAbsent(ctx, key, true, remaining)
+	if err != nil {
+		return fmt.Errorf("failed to record challenge use: %w", err)
+	}
+	if !claimed {
+		return domain.ErrChallengeFailed
+	}
+	return nil
+}
+
+func (p *ProofOfWorkProvider) mac(body string) string {
+	m := hmac.New(sha256.New, p.secret)
+	m.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(m.Sum(nil))
+}
+
+func leadingZeroBits(b []byte) int {
+	n := 0
+	for _, c := range b {
+		if c != 0 {
+			return n + bits.LeadingZeros8(c)
+		}
+		n += 8
+	}
+	return n
+}
@@ -1 +1,40 @@
-package security
\ No newline at end of file
+package security
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisRateLimiter counts hits in fixed windows with INCR. The window starts
+// at the first hit and the key expires with it.
+type RedisRateLimiter struct {
+	client *redis.Client
+	prefix string
+}
+
+var _ services.RateLimiter = (*RedisRateLimiter)(nil)
+
+func NewRedisRateLimiter(client *redis.Client, prefix string) *RedisRateLimiter {
+	return &RedisRateLimiter{client: client, prefix: prefix}
+}
+
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	key = l.prefix + key
+
+	var incr *redis.IntCmd
+	_, err := l.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to count rate limit hit: %w", err)
+	}
+
+	return incr.Val() <= int64(limit), nil
+}
@@ -0,0 +1,143 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const (
+	permissionsCacheTTL = 5 * time.Minute
+	// Cached permission sets are keyed by a generation number so that a
+	// change to any role definition can invalidate all of them at once.
+	permissionsGenerationKey = "rbac:generation"
+	permissionsKeyPrefix     = "rbac:permissions:"
+)
+
+// RBACAuthorizer resolves permissions from a user's primary role plus any
+// assigned roles, following role inheritance, and caches the result.
+type RBACAuthorizer struct {
+	roleRepo repositories.RoleRepository
+	userRepo repositories.UserRepository
+	cache    persistence.Cache
+	logger   logging.Logger
+}
+
+func NewRBACAuthorizer(
+	roleRepo repositories.RoleRepository,
+	userRepo repositories.UserRepository,
+	cache persistence.Cache,
+	logger logging.Logger,
+) services.Authorizer {
+	return &RBACAuthorizer{
+		roleRepo: roleRepo,
+		userRepo: userRepo,
+		cache:    cache,
+		logger:   logger.With(zap.String("service", "rbac_authorizer")),
+	}
+}
+
+func (a *RBACAuthorizer) Authorize(ctx context.Context, userID string, permission valueobjects.Permission) error {
+	permissions, err := a.EffectivePermissions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !valueobjects.PermissionsGrant(permissions, permission) {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+func (a *RBACAuthorizer) AuthorizeRoles(ctx context.Context, roles []valueobjects.Role, permission valueobjects.Permission) error {
+	permissions, err := aggregates.ResolvePermissions(roles, a.lookup(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to resolve permissions: %w", err)
+	}
+	if !valueobjects.PermissionsGrant(permissions, permission) {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+func (a *RBACAuthorizer) EffectivePermissions(ctx context.Context, userID string) ([]valueobjects.Permission, error) {
+	key := a.permissionsKey(ctx, userID)
+
+	var cached []valueobjects.Permission
+	err := a.cache.Get(ctx, key, &cached)
+	if err == nil {
+		return cached, nil
+	}
+	if !errors.Is(err, persistence.ErrCacheMiss) {
+		a.logger.Warn(ctx, "Failed to read cached permissions", zap.Error(err))
+	}
+
+	user, err := a.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrPermissionDenied
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil || !user.User.IsActive {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	assigned, err := a.roleRepo.FindUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := append([]valueobjects.Role{user.User.Role}, assigned...)
+	permissions, err := aggregates.ResolvePermissions(roles, a.lookup(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve permissions: %w", err)
+	}
+
+	if err := a.cache.Set(ctx, key, permissions, permissionsCacheTTL); err != nil {
+		a.logger.Warn(ctx, "Failed to cache permissions", zap.Error(err))
+	}
+
+	return permissions, nil
+}
+
+func (a *RBACAuthorizer) InvalidateUser(ctx context.Context, userID string) error {
+	return a.cache.Delete(ctx, a.permissionsKey(ctx, userID))
+}
+
+func (a *RBACAuthorizer) InvalidateAll(ctx context.Context) error {
+	generation := strconv.FormatInt(time.Now().UnixNano(), 10)
+	return a.cache.Set(ctx, permissionsGenerationKey, generation, 0)
+}
+
+func (a *RBACAuthorizer) permissionsKey(ctx context.Context, userID string) string {
+	var generation string
+	if err := a.cache.Get(ctx, permissionsGenerationKey, &generation); err != nil {
+		generation = "0"
+	}
+	return permissionsKeyPrefix + generation + ":" + userID
+}
+
+func (a *RBACAuthorizer) lookup(ctx context.Context) aggregates.RoleLookup {
+	return func(name valueobjects.Role) (*aggregates.Role, error) {
+		role, err := a.roleRepo.FindByName(ctx, name)
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return nil, domain.ErrRoleNotFound
+			}
+			return nil, err
+		}
+		return role, nil
+	}
+}
@@ -0,0 +1,325 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/config"
+	"authentication/shared/utils"
+)
+
+// Signal weights. A known-bad address alone blocks; anything else needs a
+// combination of signals to reach the block threshold.
+const (
+	riskScoreKnownBadIP       = 100
+	riskScoreImpossibleTravel = 50
+	riskScoreIPFailureBurst   = 40
+	riskScoreRepeatedFailures = 30
+	riskScoreNewDevice        = 20
+	riskScoreNewIPRange       = 15
+	riskScorePerFailure       = 5
+
+	// Lookups in free GeoIP databases are only city accurate, so shorter
+	// hops are never treated as travel.
+	minTravelDistanceKm = 100
+	// ipFailureFactor scales the per-account threshold for a whole address,
+	// which may sit in front of many legitimate users.
+	ipFailureFactor = 4
+)
+
+type loginHistory struct {
+	IPAddress string       `json:"ip_address"`
+	Location  *GeoLocation `json:"location,omitempty"`
+	At        time.Time    `json:"at"`
+}
+
+type failureCounter struct {
+	Count int       `json:"count"`
+	Since time.Time `json:"since"`
+}
+
+// SignalRiskEngine scores a login from the user's past sessions, the last
+// successful login location, a local blocklist and failure counters kept
+// in the cache. Counters are updated read-modify-write, so concurrent
+// failures may undercount slightly; that is acceptable for scoring.
+type SignalRiskEngine struct {
+	sessions  repositories.SessionRepository
+	cache     persistence.Cache
+	geoip     *GeoIPDatabase
+	blocklist *IPBlocklist
+	cfg       config.RiskConfig
+}
+
+var _ services.RiskEngine = (*SignalRiskEngine)(nil)
+
+// NewSignalRiskEngine loads the optional GeoIP database and blocklist named
+// in cfg. Without a GeoIP file impossible travel is not detected.
+func NewSignalRiskEngine(
+	sessions repositories.SessionRepository,
+	cache persistence.Cache,
+	cfg config.RiskConfig,
+) (*SignalRiskEngine, error) {
+	engine := &SignalRiskEngine{sessions: sessions, cache: cache, cfg: cfg}
+
+	if cfg.GeoIPPath != "" {
+		db, err := LoadGeoIPDatabase(cfg.GeoIPPath)
+		if err != nil {
+			return nil, err
+		}
+		engine.geoip = db
+	}
+	if cfg.BlocklistPath != "" {
+		list, err := LoadIPBlocklist(cfg.BlocklistPath)
+		if err != nil {
+			return nil, err
+		}
+		engine.blocklist = list
+	}
+
+	return engine, nil
+}
+
+func (e *SignalRiskEngine) Evaluate(ctx context.Context, attempt services.LoginAttempt) (services.RiskAssessment, error) {
+	var assessment services.RiskAssessment
+	add := func(name string, score int, detail string) {
+		assessment.Signals = append(assessment.Signals, services.RiskSignal{Name: name, Score: score, Detail: detail})
+		assessment.Score += score
+	}
+
+	if e.blocklist != nil && e.blocklist.Contains(attempt.IPAddress) {
+		add("known_bad_ip", riskScoreKnownBadIP, attempt.IPAddress)
+	}
+
+	if attempt.UserID != "" {
+		if err := e.deviceSignals(ctx, attempt, add); err != nil {
+			return services.RiskAssessment{}, err
+		}
+		if err := e.travelSignal(ctx, attempt, add); err != nil {
+			return services.RiskAssessment{}, err
+		}
+	}
+
+	emailFailures, err := e.failures(ctx, e.emailFailureKey(ctx, attempt.Email))
+	if err != nil {
+		return services.RiskAssessment{}, err
+	}
+	switch {
+	case emailFailures >= e.cfg.FailureThreshold:
+		add("repeated_failures", riskScoreRepeatedFailures, fmt.Sprintf("%d failed attempts", emailFailures))
+	case emailFailures > 0:
+		add("recent_failures", emailFailures*riskScorePerFailure, fmt.Sprintf("%d failed attempts", emailFailures))
+	}
+
+	ipFailures, err := e.failures(ctx, ipFailureKey(attempt.IPAddress))
+	if err != nil {
+		return services.RiskAssessment{}, err
+	}
+	if ipFailures >= e.cfg.FailureThreshold*ipFailureFactor {
+		add("ip_failure_burst", riskScoreIPFailureBurst, fmt.Sprintf("%d failed attempts from this address", ipFailures))
+	}
+
+	switch {
+	case assessment.Score >= e.cfg.BlockScore:
+		assessment.Decision = services.RiskDecisionBlock
+	case assessment.Score >= e.cfg.StepUpScore:
+		assessment.Decision = services.RiskDecisionStepUp
+	default:
+		assessment.Decision = services.RiskDecisionAllow
+	}
+	return assessment, nil
+}
+
+// deviceSignals compares the attempt with the user's past sessions. A first
+// login has no history to compare with and raises nothing.
+func (e *SignalRiskEngine) deviceSignals(
+	ctx context.Context,
+	attempt services.LoginAttempt,
+	add func(string, int, string),
+) error {
+	sessions, err := e.sessions.FindByUserID(ctx, attempt.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load session history: %w", err)
+	}
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	currentRange := ipRange(attempt.IPAddress)
+	knownDevice, knownRange := false, false
+	for _, s := range sessions {
+		if s.UserAgent == attempt.UserAgent {
+			knownDevice = true
+		}
+		if currentRange != "" && ipRange(s.IPAddress) == currentRange {
+			knownRange = true
+		}
+	}
+
+	if !knownDevice {
+		add("new_device", riskScoreNewDevice, attempt.UserAgent)
+	}
+	if !knownRange {
+		add("new_ip_range", riskScoreNewIPRange, currentRange)
+	}
+	return nil
+}
+
+// travelSignal flags a login whose distance from the previous successful
+// login could not have been covered at MaxTravelSpeedKmh.
+func (e *SignalRiskEngine) travelSignal(
+	ctx context.Context,
+	attempt services.LoginAttempt,
+	add func(string, int, string),
+) error {
+	if e.geoip == nil {
+		return nil
+	}
+	current, ok := e.geoip.Lookup(attempt.IPAddress)
+	if !ok {
+		return nil
+	}
+
+	var last loginHistory
+	if err := e.cache.Get(ctx, historyKey(attempt.UserID), &last); err != nil {
+		if errors.Is(err, persistence.ErrCacheMiss) {
+			return nil
+		}
+		return fmt.Errorf("failed to load login history: %w", err)
+	}
+	if last.Location == nil {
+		return nil
+	}
+
+	distance := distanceKm(*last.Location, current)
+	if distance < minTravelDistanceKm {
+		return nil
+	}
+	elapsed := time.Since(last.At)
+	if elapsed < time.Minute {
+		elapsed = time.Minute
+	}
+	if speed := distance / elapsed.Hours(); speed > e.cfg.MaxTravelSpeedKmh {
+		add("impossible_travel", riskScoreImpossibleTravel,
+			fmt.Sprintf("%.0f km in %s from %s", distance, elapsed.Round(time.Minute), last.IPAddress))
+	}
+	return nil
+}
+
+// RecordSuccess remembers where the user signed in from and clears the
+// failure count for their email.
+func (e *SignalRiskEngine) RecordSuccess(ctx context.Context, attempt services.LoginAttempt) error {
+	history := loginHistory{IPAddress: attempt.IPAddress, At: time.Now().UTC()}
+	if e.geoip != nil {
+		if location, ok := e.geoip.Lookup(attempt.IPAddress); ok {
+			history.Location = &location
+		}
+	}
+
+	if err := e.cache.Set(ctx, historyKey(attempt.UserID), history, e.cfg.HistoryTTL); err != nil {
+		return fmt.Errorf("failed to store login history: %w", err)
+	}
+	if err := e.cache.Delete(ctx, e.emailFailureKey(ctx, attempt.Email)); err != nil {
+		return fmt.Errorf("failed to reset failure count: %w", err)
+	}
+	return nil
+}
+
+func (e *SignalRiskEngine) RecordFailure(ctx context.Context, attempt services.LoginAttempt) error {
+	if err := e.incrementFailures(ctx, e.emailFailureKey(ctx, attempt.Email)); err != nil {
+		return err
+	}
+	return e.incrementFailures(ctx, ipFailureKey(attempt.IPAddress))
+}
+
+// FailureCounts returns the failed logins recorded for email and for ip in
+// the current window.
+func (e *SignalRiskEngine) FailureCounts(ctx context.Context, email, ip string) (int, int, error) {
+	emailFailures, err := e.failures(ctx, e.emailFailureKey(ctx, email))
+	if err != nil {
+		return 0, 0, err
+	}
+	ipFailures, err := e.failures(ctx, ipFailureKey(ip))
+	if err != nil {
+		return 0, 0, err
+	}
+	return emailFailures, ipFailures, nil
+}
+
+func (e *SignalRiskEngine) failures(ctx context.Context, key string) (int, error) {
+	counter, err := e.loadCounter(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	return counter.Count, nil
+}
+
+func (e *SignalRiskEngine) incrementFailures(ctx context.Context, key string) error {
+	counter, err := e.loadCounter(ctx, key)
+	if err != nil {
+		return err
+	}
+	if counter.Count == 0 {
+		counter.Since = time.Now().UTC()
+	}
+	counter.Count++
+
+	ttl := e.cfg.FailureWindow - time.Since(counter.Since)
+	if ttl <= 0 {
+		ttl = e.cfg.FailureWindow
+	}
+	if err := e.cache.Set(ctx, key, counter, ttl); err != nil {
+		return fmt.Errorf("failed to store failure count: %w", err)
+	}
+	return nil
+}
+
+// loadCounter returns the counter for key, or a zero one when it is missing
+// or its window has passed.
+func (e *SignalRiskEngine) loadCounter(ctx context.Context, key string) (failureCounter, error) {
+	var counter failureCounter
+	if err := e.cache.Get(ctx, key, &counter); err != nil {
+		if errors.Is(err, persistence.ErrCacheMiss) {
+			return failureCounter{}, nil
+		}
+		return failureCounter{}, fmt.Errorf("failed to load failure count: %w", err)
+	}
+	if time.Since(counter.Since) > e.cfg.FailureWindow {
+		return failureCounter{}, nil
+	}
+	return counter, nil
+}
+
+// emailFailureKey is tenant scoped because the same email may exist in
+// several tenants.
+func (e *SignalRiskEngine) emailFailureKey(ctx context.Context, email string) string {
+	tenantID, _ := utils.TenantIDFromContext(ctx)
+	return "risk:failures:email:" + tenantID + ":" + strings.ToLower(email)
+}
+
+func ipFailureKey(ip string) string {
+	return "risk:failures:ip:" + ip
+}
+
+func historyKey(userID string) string {
+	return "risk:last_login:" + userID
+}
+
+// ipRange groups addresses by the network an ISP typically hands out from:
+// a /24 for IPv4 and a /48 for IPv6.
+func ipRange(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ""
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String() + "/24"
+	}
+	return parsed.Mask(net.CIDRMask(48, 128)).String() + "/48"
+}
@@ -0,0 +1,73 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	domainServices "authentication/internal/domain/services"
+	"authentication/internal/domain/valueobjects"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+// SCIMTokenVerifier looks SCIM bearer tokens up by hash in the tenant the
+// request resolved to, so a token only ever provisions its own tenant.
+type SCIMTokenVerifier struct {
+	tokenRepo     repositories.SCIMTokenRepository
+	secretService domainServices.SecretService
+	logger        logging.Logger
+}
+
+func NewSCIMTokenVerifier(
+	tokenRepo repositories.SCIMTokenRepository,
+	secretService domainServices.SecretService,
+	logger logging.Logger,
+) services.SCIMTokenVerifier {
+	return &SCIMTokenVerifier{
+		tokenRepo:     tokenRepo,
+		secretService: secretService,
+		logger:        logger.With(zap.String("service", "scim_token")),
+	}
+}
+
+func (v *SCIMTokenVerifier) Verify(ctx context.Context, token string) (*services.TokenClaims, error) {
+	if !strings.HasPrefix(token, aggregates.SCIMTokenPrefix) {
+		return nil, domain.ErrInvalidToken
+	}
+
+	scimToken, err := v.tokenRepo.FindByHash(ctx, v.secretService.Hash(token))
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			return nil, domain.ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to find scim token: %w", err)
+	}
+	if scimToken.IsRevoked() {
+		return nil, domain.ErrSCIMTokenRevoked
+	}
+
+	now := time.Now().UTC()
+	if scimToken.LastUsedAt == nil || now.Sub(*scimToken.LastUsedAt) >= lastUsedGranularity {
+		if err := v.tokenRepo.UpdateLastUsed(ctx, scimToken.ID(), now); err != nil {
+			v.logger.Warn(ctx, "Failed to record scim token usage",
+				zap.String("token_id", scimToken.ID()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return &services.TokenClaims{
+		TenantID:  scimToken.TenantID,
+		TokenID:   scimToken.ID(),
+		ClientID:  scimToken.ID(),
+		TokenType: string(valueobjects.TokenTypeSCIM),
+		IssuedAt:  scimToken.CreatedAt,
+	}, nil
+}
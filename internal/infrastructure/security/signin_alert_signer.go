@@ -0,0 +1,81 @@
+package security
+
+import (
+	"errors"
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// signInAlertAudience keeps sign-in alert tokens from being accepted
+// anywhere else that shares the signing key, and vice versa.
+const signInAlertAudience = "sign-in-alert"
+
+type signInAlertClaims struct {
+	TenantID  string `json:"tenant_id,omitempty"`
+	SessionID string `json:"sid"`
+	Scope     string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+// HMACSignInAlertSigner signs sign-in alert tokens as compact HS256 JWTs.
+type HMACSignInAlertSigner struct {
+	secret []byte
+	issuer string
+}
+
+func NewHMACSignInAlertSigner(secret, issuer string) services.SignInAlertSigner {
+	return &HMACSignInAlertSigner{secret: []byte(secret), issuer: issuer}
+}
+
+func (s *HMACSignInAlertSigner) Sign(alert services.SignInAlert, expiresAt time.Time) (string, error) {
+	claims := signInAlertClaims{
+		TenantID:  alert.TenantID,
+		SessionID: alert.SessionID,
+		Scope:     alert.Scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   alert.UserID,
+			Issuer:    s.issuer,
+			Audience:  jwt.ClaimStrings{signInAlertAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+func (s *HMACSignInAlertSigner) Verify(token string) (services.SignInAlert, error) {
+	claims := &signInAlertClaims{}
+	_, err := jwt.ParseWithClaims(token, claims,
+		func(t *jwt.Token) (interface{}, error) { return s.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(signInAlertAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return services.SignInAlert{}, domain.ErrSignInAlertExpired
+		}
+		return services.SignInAlert{}, domain.ErrInvalidSignInAlertToken
+	}
+	if claims.ID == "" || claims.Subject == "" || claims.SessionID == "" {
+		return services.SignInAlert{}, domain.ErrInvalidSignInAlertToken
+	}
+	if claims.Scope != services.SignInAlertScopeSession && claims.Scope != services.SignInAlertScopeAll {
+		return services.SignInAlert{}, domain.ErrInvalidSignInAlertToken
+	}
+	return services.SignInAlert{
+		ID:        claims.ID,
+		UserID:    claims.Subject,
+		TenantID:  claims.TenantID,
+		SessionID: claims.SessionID,
+		Scope:     claims.Scope,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
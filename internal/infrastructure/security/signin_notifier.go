@@ -0,0 +1,181 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/entities"
+	"authentication/internal/domain/events"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	newSignInTemplate = "new_signin"
+	signInTimeLayout  = "2 Jan 2006 15:04 MST"
+)
+
+// SignInNotifier emails the user when user.logged_in reports a session
+// from a device none of their earlier sessions used. The email carries
+// signed links that revoke the new session, or every session, and lock the
+// password until it is reset. A first login has nothing to compare with
+// and is not reported.
+type SignInNotifier struct {
+	userRepo     repositories.UserRepository
+	sessionRepo  repositories.SessionRepository
+	emailService services.EmailService
+	signer       services.SignInAlertSigner
+	geoip        *GeoIPDatabase
+	linkTTL      time.Duration
+	alertURL     string
+	logger       logging.Logger
+}
+
+var _ messaging.EventHandler = (*SignInNotifier)(nil)
+
+// NewSignInNotifier builds the notifier. geoip may be nil, in which case
+// emails carry no location.
+func NewSignInNotifier(
+	userRepo repositories.UserRepository,
+	sessionRepo repositories.SessionRepository,
+	emailService services.EmailService,
+	signer services.SignInAlertSigner,
+	geoip *GeoIPDatabase,
+	cfg config.SignInAlertConfig,
+	logger logging.Logger,
+) *SignInNotifier {
+	return &SignInNotifier{
+		userRepo:     userRepo,
+		sessionRepo:  sessionRepo,
+		emailService: emailService,
+		signer:       signer,
+		geoip:        geoip,
+		linkTTL:      cfg.TTL,
+		alertURL:     cfg.URL,
+		logger:       logger.With(zap.String("handler", "sign_in_notifier")),
+	}
+}
+
+func (n *SignInNotifier) CanHandle(eventName string) bool {
+	return eventName == events.UserLoggedInEventName
+}
+
+func (n *SignInNotifier) Handle(ctx context.Context, event events.DomainEvent) error {
+	var payload events.UserLoggedInPayload
+	if err := json.Unmarshal(event.Payload(), &payload); err != nil {
+		n.logger.Error(ctx, "Dropping malformed login event",
+			zap.Error(err),
+			zap.String("event_id", event.EventID().String()),
+		)
+		return nil
+	}
+	if payload.SessionID == "" {
+		return nil
+	}
+	if payload.TenantID != "" {
+		ctx = utils.WithTenantID(ctx, payload.TenantID)
+	}
+
+	sessions, err := n.sessionRepo.FindByUserID(ctx, payload.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load sessions: %w", err)
+	}
+	if !unseenDevice(sessions, payload) {
+		return nil
+	}
+
+	user, err := n.userRepo.FindByID(ctx, payload.UserID)
+	if err != nil && !repositories.IsNotFoundError(err) {
+		return fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil || !user.User.IsActive {
+		return nil
+	}
+
+	expiresAt := time.Now().UTC().Add(n.linkTTL)
+	sessionLink, err := n.link(payload, services.SignInAlertScopeSession, expiresAt)
+	if err != nil {
+		return err
+	}
+	allLink, err := n.link(payload, services.SignInAlertScopeAll, expiresAt)
+	if err != nil {
+		return err
+	}
+
+	location := "Unknown location"
+	if n.geoip != nil {
+		if place, ok := n.geoip.Lookup(payload.IPAddress); ok {
+			location = place.String()
+		}
+	}
+
+	err = n.emailService.SendEmail(ctx, services.SendEmailInput{
+		To:       user.User.Email.String(),
+		Subject:  "New sign-in to your account",
+		Template: newSignInTemplate,
+		Data: map[string]interface{}{
+			"username":            user.User.Username.String(),
+			"device":              utils.DescribeUserAgent(payload.UserAgent),
+			"location":            location,
+			"ip_address":          payload.IPAddress,
+			"time":                event.OccurredAt().UTC().Format(signInTimeLayout),
+			"revoke_session_link": sessionLink,
+			"revoke_all_link":     allLink,
+			"expires_at":          expiresAt.Format(signInTimeLayout),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send sign-in notification: %w", err)
+	}
+
+	n.logger.Info(ctx, "New device sign-in notification sent",
+		zap.String("user_id", payload.UserID),
+		zap.String("session_id", payload.SessionID),
+	)
+	return nil
+}
+
+func (n *SignInNotifier) link(payload events.UserLoggedInPayload, scope string, expiresAt time.Time) (string, error) {
+	token, err := n.signer.Sign(services.SignInAlert{
+		UserID:    payload.UserID,
+		TenantID:  payload.TenantID,
+		SessionID: payload.SessionID,
+		Scope:     scope,
+	}, expiresAt)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign sign-in alert: %w", err)
+	}
+
+	separator := "?"
+	if strings.Contains(n.alertURL, "?") {
+		separator = "&"
+	}
+	return n.alertURL + separator + "token=" + url.QueryEscape(token), nil
+}
+
+// unseenDevice reports whether no other session of the user came from the
+// same user agent. Sessions carry no device id, so the user agent stands in
+// for the device.
+func unseenDevice(sessions []*entities.Session, payload events.UserLoggedInPayload) bool {
+	earlier := 0
+	for _, s := range sessions {
+		if s.ID == payload.SessionID {
+			continue
+		}
+		if s.UserAgent == payload.UserAgent {
+			return false
+		}
+		earlier++
+	}
+	return earlier > 0
+}
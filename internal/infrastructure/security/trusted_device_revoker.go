@@ -0,0 +1,67 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"authentication/internal/application/contracts/messaging"
+	"authentication/internal/domain/events"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/logging"
+	"authentication/shared/utils"
+
+	"go.uber.org/zap"
+)
+
+// TrustedDeviceRevoker revokes every trusted device of a user whose password
+// changed, so a device trusted under the old password has to pass the login
+// code step again.
+type TrustedDeviceRevoker struct {
+	deviceRepo repositories.TrustedDeviceRepository
+	logger     logging.Logger
+}
+
+var _ messaging.EventHandler = (*TrustedDeviceRevoker)(nil)
+
+func NewTrustedDeviceRevoker(
+	deviceRepo repositories.TrustedDeviceRepository,
+	logger logging.Logger,
+) *TrustedDeviceRevoker {
+	return &TrustedDeviceRevoker{
+		deviceRepo: deviceRepo,
+		logger:     logger.With(zap.String("handler", "trusted_device_revoker")),
+	}
+}
+
+func (r *TrustedDeviceRevoker) CanHandle(eventName string) bool {
+	return eventName == events.UserPasswordChangedEventName
+}
+
+func (r *TrustedDeviceRevoker) Handle(ctx context.Context, event events.DomainEvent) error {
+	var payload events.PasswordChangedPayload
+	if err := json.Unmarshal(event.Payload(), &payload); err != nil {
+		r.logger.Error(ctx, "Dropping malformed password change event",
+			zap.Error(err),
+			zap.String("event_id", event.EventID().String()),
+		)
+		return nil
+	}
+	if payload.TenantID != "" {
+		ctx = utils.WithTenantID(ctx, payload.TenantID)
+	}
+
+	revoked, err := r.deviceRepo.RevokeAllByUser(ctx, payload.UserID, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke trusted devices: %w", err)
+	}
+
+	if revoked > 0 {
+		r.logger.Info(ctx, "Trusted devices revoked after password change",
+			zap.String("user_id", payload.UserID),
+			zap.Int64("count", revoked),
+		)
+	}
+	return nil
+}
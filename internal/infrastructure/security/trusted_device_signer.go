@@ -0,0 +1,73 @@
+package security
+
+import (
+	"time"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// trustedDeviceAudience keeps trusted device tokens from being accepted
+// anywhere else that shares the signing key, and vice versa.
+const trustedDeviceAudience = "trusted-device"
+
+type trustedDeviceClaims struct {
+	TenantID      string `json:"tenant_id,omitempty"`
+	DeviceID      string `json:"did"`
+	UserAgentHash string `json:"uah"`
+	jwt.RegisteredClaims
+}
+
+// HMACTrustedDeviceSigner signs trusted device tokens as compact HS256 JWTs
+// whose jti is the id of the stored grant.
+type HMACTrustedDeviceSigner struct {
+	secret []byte
+	issuer string
+}
+
+func NewHMACTrustedDeviceSigner(secret, issuer string) services.TrustedDeviceSigner {
+	return &HMACTrustedDeviceSigner{secret: []byte(secret), issuer: issuer}
+}
+
+func (s *HMACTrustedDeviceSigner) Sign(grant services.TrustedDeviceGrant, expiresAt time.Time) (string, error) {
+	claims := trustedDeviceClaims{
+		TenantID:      grant.TenantID,
+		DeviceID:      grant.DeviceID,
+		UserAgentHash: grant.UserAgentHash,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        grant.GrantID,
+			Subject:   grant.UserID,
+			Issuer:    s.issuer,
+			Audience:  jwt.ClaimStrings{trustedDeviceAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.secret)
+}
+
+func (s *HMACTrustedDeviceSigner) Verify(token string) (services.TrustedDeviceGrant, error) {
+	claims := &trustedDeviceClaims{}
+	_, err := jwt.ParseWithClaims(token, claims,
+		func(t *jwt.Token) (interface{}, error) { return s.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(trustedDeviceAudience),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return services.TrustedDeviceGrant{}, domain.ErrInvalidTrustedDeviceToken
+	}
+	if claims.ID == "" || claims.Subject == "" || claims.DeviceID == "" || claims.UserAgentHash == "" {
+		return services.TrustedDeviceGrant{}, domain.ErrInvalidTrustedDeviceToken
+	}
+	return services.TrustedDeviceGrant{
+		GrantID:       claims.ID,
+		UserID:        claims.Subject,
+		TenantID:      claims.TenantID,
+		DeviceID:      claims.DeviceID,
+		UserAgentHash: claims.UserAgentHash,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain/repositories"
+)
+
+// UserAttributeProvider exposes the target user's role and status to
+// policies as resource.user_role and resource.user_active, so rules can
+// depend on who is being acted on.
+type UserAttributeProvider struct {
+	userRepo repositories.UserRepository
+}
+
+func NewUserAttributeProvider(userRepo repositories.UserRepository) services.ResourceAttributeProvider {
+	return &UserAttributeProvider{userRepo: userRepo}
+}
+
+func (p *UserAttributeProvider) ResourceAttributes(ctx context.Context, resource services.Resource) (map[string]string, error) {
+	userID := resource.Attributes["user_id"]
+	if resource.Type == "user" && resource.ID != "" {
+		userID = resource.ID
+	}
+	if userID == "" {
+		return nil, nil
+	}
+
+	user, err := p.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if repositories.IsNotFoundError(err) {
+			// Let the handler report the missing user.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find user: %w", err)
+	}
+	if user == nil {
+		return nil, nil
+	}
+
+	return map[string]string{
+		"user_role":   user.User.Role.String(),
+		"user_active": strconv.FormatBool(user.User.IsActive),
+	}, nil
+}
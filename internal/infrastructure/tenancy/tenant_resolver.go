@@ -0,0 +1,177 @@
+package tenancy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"authentication/internal/application/contracts/persistence"
+	"authentication/internal/application/contracts/services"
+	"authentication/internal/domain"
+	"authentication/internal/domain/aggregates"
+	"authentication/internal/domain/repositories"
+	"authentication/shared/config"
+	"authentication/shared/logging"
+
+	"go.uber.org/zap"
+)
+
+const (
+	tenantCacheTTL       = time.Minute
+	tenantCacheKeyPrefix = "tenant:"
+)
+
+// CachedTenantResolver resolves tenants through the tenant repository and
+// caches the result briefly, since it runs on every request.
+type CachedTenantResolver struct {
+	repo   repositories.TenantRepository
+	cache  persistence.Cache
+	cfg    config.TenancyConfig
+	logger logging.Logger
+}
+
+func NewCachedTenantResolver(
+	repo repositories.TenantRepository,
+	cache persistence.Cache,
+	cfg config.TenancyConfig,
+	logger logging.Logger,
+) services.TenantResolver {
+	return &CachedTenantResolver{
+		repo:   repo,
+		cache:  cache,
+		cfg:    cfg,
+		logger: logger.With(zap.String("service", "tenant_resolver")),
+	}
+}
+
+func (r *CachedTenantResolver) Resolve(ctx context.Context, host, header string) (*services.ResolvedTenant, error) {
+	if header = strings.TrimSpace(header); header != "" {
+		// The header may carry either the tenant id or its slug.
+		tenant, err := r.lookup(ctx, "id:"+header, func() (*aggregates.Tenant, error) {
+			return r.repo.FindByID(ctx, header)
+		})
+		if errors.Is(err, domain.ErrTenantNotFound) {
+			tenant, err = r.lookup(ctx, "slug:"+strings.ToLower(header), func() (*aggregates.Tenant, error) {
+				return r.repo.FindBySlug(ctx, header)
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return withSource(tenant, services.TenantSourceHeader), nil
+	}
+
+	if host = normalizeHost(host); host != "" {
+		tenant, err := r.lookup(ctx, "domain:"+host, func() (*aggregates.Tenant, error) {
+			return r.repo.FindByDomain(ctx, host)
+		})
+		if errors.Is(err, domain.ErrTenantNotFound) {
+			if slug, ok := r.subdomainSlug(host); ok {
+				tenant, err = r.lookup(ctx, "slug:"+slug, func() (*aggregates.Tenant, error) {
+					return r.repo.FindBySlug(ctx, slug)
+				})
+			}
+		}
+		if err == nil {
+			return withSource(tenant, services.TenantSourceHost), nil
+		}
+		if !errors.Is(err, domain.ErrTenantNotFound) {
+			return nil, err
+		}
+	}
+
+	if r.cfg.DefaultTenant == "" {
+		return nil, domain.ErrTenantRequired
+	}
+
+	slug := strings.ToLower(r.cfg.DefaultTenant)
+	tenant, err := r.lookup(ctx, "slug:"+slug, func() (*aggregates.Tenant, error) {
+		return r.repo.FindBySlug(ctx, slug)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return withSource(tenant, services.TenantSourceDefault), nil
+}
+
+func (r *CachedTenantResolver) ResolveByID(ctx context.Context, id string) (*services.ResolvedTenant, error) {
+	tenant, err := r.lookup(ctx, "id:"+id, func() (*aggregates.Tenant, error) {
+		return r.repo.FindByID(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return withSource(tenant, services.TenantSourceDefault), nil
+}
+
+// lookup returns the cached tenant for key, falling back to find. Inactive
+// tenants are cached too so a deactivated tenant is rejected without a query.
+func (r *CachedTenantResolver) lookup(
+	ctx context.Context,
+	key string,
+	find func() (*aggregates.Tenant, error),
+) (*services.ResolvedTenant, error) {
+	cacheKey := tenantCacheKeyPrefix + key
+
+	var cached cachedTenant
+	err := r.cache.Get(ctx, cacheKey, &cached)
+	if err != nil && !errors.Is(err, persistence.ErrCacheMiss) {
+		r.logger.Warn(ctx, "Failed to read cached tenant", zap.Error(err))
+	}
+
+	if err != nil {
+		tenant, err := find()
+		if err != nil {
+			if repositories.IsNotFoundError(err) {
+				return nil, domain.ErrTenantNotFound
+			}
+			return nil, fmt.Errorf("failed to resolve tenant: %w", err)
+		}
+
+		cached = cachedTenant{ID: tenant.ID(), Slug: tenant.Slug, IsActive: tenant.IsActive}
+		if err := r.cache.Set(ctx, cacheKey, cached, tenantCacheTTL); err != nil {
+			r.logger.Warn(ctx, "Failed to cache tenant", zap.Error(err))
+		}
+	}
+
+	if !cached.IsActive {
+		return nil, domain.ErrTenantInactive
+	}
+
+	return &services.ResolvedTenant{ID: cached.ID, Slug: cached.Slug}, nil
+}
+
+// subdomainSlug extracts "acme" from "acme.<base domain>". Only a single
+// label is accepted so deeper hosts never match by accident.
+func (r *CachedTenantResolver) subdomainSlug(host string) (string, bool) {
+	if r.cfg.BaseDomain == "" {
+		return "", false
+	}
+
+	label := strings.TrimSuffix(host, "."+r.cfg.BaseDomain)
+	if label == host || label == "" || strings.Contains(label, ".") {
+		return "", false
+	}
+	return label, true
+}
+
+type cachedTenant struct {
+	ID       string `json:"id"`
+	Slug     string `json:"slug"`
+	IsActive bool   `json:"is_active"`
+}
+
+func withSource(tenant *services.ResolvedTenant, source services.TenantSource) *services.ResolvedTenant {
+	tenant.Source = source
+	return tenant
+}
+
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
@@ -0,0 +1,32 @@
+#!/bin/sh
+# Applies the SQL files in scripts/migrations in name order, each in its own
+# transaction, skipping those already recorded in schema_migrations.
+# Connection settings come from the same DB_* variables as the service.
+set -eu
+
+cd "$(dirname "$0")/migrations"
+
+export PGHOST="${DB_HOST:-localhost}"
+export PGPORT="${DB_PORT:-5432}"
+export PGDATABASE="${DB_NAME:-auth_db}"
+export PGUSER="${DB_USERNAME:-postgres}"
+export PGPASSWORD="${DB_PASSWORD:-}"
+export PGSSLMODE="${DB_SSL_MODE:-disable}"
+
+psql -v ON_ERROR_STOP=1 -q -c \
+    "CREATE TABLE IF NOT EXISTS schema_migrations (version TEXT PRIMARY KEY, applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW())"
+
+for file in *.sql; do
+    version="${file%.sql}"
+    applied=$(psql -tA -c "SELECT 1 FROM schema_migrations WHERE version = '$version'")
+    if [ "$applied" = "1" ]; then
+        continue
+    fi
+
+    echo "Applying $file"
+    {
+        cat "$file"
+        echo
+        echo "INSERT INTO schema_migrations (version) VALUES ('$version');"
+    } | psql -v ON_ERROR_STOP=1 -q --single-transaction
+done
@@ -0,0 +1,8 @@
+-- Record whether a custom role was created through the admin API or for a
+-- SCIM group. SCIM clients may only see and change the latter.
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS source VARCHAR(10) NOT NULL DEFAULT 'admin';
+
+-- Roles provisioned before the column existed carry the description the
+-- SCIM group handler gives them.
+UPDATE roles SET source = 'scim'
+WHERE source = 'admin' AND description LIKE 'Provisioned by SCIM from group %';
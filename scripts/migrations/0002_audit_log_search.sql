@@ -0,0 +1,21 @@
+-- Full-text search over audit logs. The vector is generated by Postgres
+-- from the action, the resource, the error message and the string values in
+-- metadata; the application only reads it. It must stay in step with
+-- models.AuditLogModel.SearchVector and auditSearchConfig.
+ALTER TABLE audit_logs ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        to_tsvector('simple',
+            coalesce(action, '') || ' ' ||
+            coalesce(resource_type, '') || ' ' ||
+            coalesce(resource_id, '') || ' ' ||
+            coalesce(error_message, ''))
+        || to_tsvector('simple', coalesce(metadata, '{}'::jsonb))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_search
+    ON audit_logs USING GIN (search_vector);
+
+-- Listing and search page by (created_at, id) within a tenant, newest
+-- first, so each page is a range scan of this index.
+CREATE INDEX IF NOT EXISTS idx_audit_logs_tenant_created
+    ON audit_logs (tenant_id, created_at DESC, id DESC);
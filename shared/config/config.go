@@ -19,6 +19,14 @@ type Config struct {
 	Tracing  TracerConfig
 	Metrics  MetricsConfig
 	OTP      OTPConfig
+	Tenancy  TenancyConfig
+	Orgs     OrganizationConfig
+	Profile  ProfileConfig
+	SAML     SAMLConfig
+	LDAP     LDAPConfig
+	Risk     RiskConfig
+	SignIn   SignInAlertConfig
+	Devices  TrustedDeviceConfig
 }
 
 type TracerConfig struct {
@@ -74,6 +82,10 @@ type ServerConfig struct {
 	WriteTimeout    time.Duration
 	ShutdownTimeout time.Duration
 	CORS            CORSConfig
+	// TrustedProxies lists the addresses or CIDR ranges of the reverse
+	// proxies in front of the service. X-Forwarded-For is only honoured
+	// for hops added by one of them.
+	TrustedProxies []string
 }
 
 type CORSConfig struct {
@@ -127,23 +139,177 @@ type SecurityConfig struct {
 	LockoutDuration        time.Duration
 	SessionTimeout         time.Duration
 	BcryptCost             int
+	// PolicyDir holds the JSON authorization policies enforced on the
+	// command and query buses.
+	PolicyDir string
+	// Login and registration require a solved challenge once failed logins
+	// for the email reach ChallengeEmailThreshold or those from the IP reach
+	// ChallengeIPThreshold. ChallengeProvider is "pow" (built-in hashcash
+	// proof of work, ChallengeDifficulty leading zero bits), "captcha" (an
+	// external siteverify endpoint such as reCAPTCHA, hCaptcha or Turnstile)
+	// or "none".
+	ChallengeProvider       string
+	ChallengeEmailThreshold int
+	ChallengeIPThreshold    int
+	ChallengeDifficulty     int
+	ChallengeTTL            time.Duration
+	ChallengeSecret         string
+	CaptchaVerifyURL        string
+	CaptchaSiteKey          string
+	CaptchaSecret           string
+	// ImpersonationTTL bounds support sessions started with "login as
+	// user"; they cannot be refreshed.
+	ImpersonationTTL time.Duration
 }
 
+// TenancyConfig controls how a request is mapped to a tenant. The header wins
+// over the host; DefaultTenant is used when neither identifies one, which
+// keeps single-tenant deployments working without any extra setup.
+type TenancyConfig struct {
+	Header        string
+	BaseDomain    string
+	DefaultTenant string
+}
+
+// OrganizationConfig controls organization invitations. InvitationURL is the
+// page that accepts invitations; the signed token is appended as ?token=.
+type OrganizationConfig struct {
+	InvitationSecret string
+	InvitationTTL    time.Duration
+	InvitationURL    string
+}
+
+// ProfileConfig controls self-service profile changes. EmailChangeURL is
+// the page that confirms a new email address; the signed token is appended
+// as ?token=. A deleted account is purged once DeletionGracePeriod has
+// passed; the purge job runs every PurgeInterval.
+type ProfileConfig struct {
+	EmailChangeSecret   string
+	EmailChangeTTL      time.Duration
+	EmailChangeURL      string
+	DeletionGracePeriod time.Duration
+	PurgeInterval       time.Duration
+}
+
+// SAMLConfig configures the SAML service provider. BaseURL is the public
+// origin of this service; each connection's entity id and ACS URL are built
+// from it. The key and certificate sign AuthnRequests and are published in
+// the SP metadata.
+type SAMLConfig struct {
+	Enabled        bool
+	BaseURL        string
+	PrivateKeyPEM  string
+	CertificatePEM string
+	ClockSkew      time.Duration
+	RequestTTL     time.Duration
+}
+
+// LDAPConfig configures the LDAP / Active Directory credential backend used
+// by email login. UserFilter is searched under SearchBaseDN with {login}
+// replaced by the escaped login; the service account in BindDN performs the
+// search. GroupRoleMappings are checked in order and the first group the
+// user belongs to decides the role, falling back to DefaultRole.
+type LDAPConfig struct {
+	Enabled            bool
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	BindDN             string
+	BindPassword       string
+	SearchBaseDN       string
+	UserFilter         string
+	EmailAttribute     string
+	FirstNameAttribute string
+	LastNameAttribute  string
+	UsernameAttribute  string
+	GroupAttribute     string
+	GroupRoleMappings  []LDAPGroupRoleMapping
+	DefaultRole        string
+	JITProvisioning    bool
+	PoolSize           int
+	Timeout            time.Duration
+}
+
+type LDAPGroupRoleMapping struct {
+	GroupDN string
+	Role    string
+}
+
+// RiskConfig tunes login risk scoring. A score at or above StepUpScore
+// requires an emailed one-time code, at or above BlockScore the login is
+// refused. GeoIPPath is a CSV in the GeoLite2 City blocks layout (network,
+// latitude and longitude columns) used to spot impossible travel;
+// BlocklistPath lists known-bad IPs or CIDRs, one per line. Failed logins
+// are counted per email and per IP over FailureWindow.
+type RiskConfig struct {
+	Enabled           bool
+	StepUpScore       int
+	BlockScore        int
+	GeoIPPath         string
+	BlocklistPath     string
+	MaxTravelSpeedKmh float64
+	FailureWindow     time.Duration
+	FailureThreshold  int
+	HistoryTTL        time.Duration
+}
+
+// SignInAlertConfig controls the email sent when a user signs in from a
+// device none of their earlier sessions used. URL is the page behind the
+// "this wasn't me" links; the signed token is appended as ?token=.
+type SignInAlertConfig struct {
+	Enabled bool
+	Secret  string
+	TTL     time.Duration
+	URL     string
+}
+
+// TrustedDeviceConfig controls "trust this device": a device that passed
+// the login code step may skip it for TTL. Secret signs the token handed
+// to the device; the record behind it can be revoked at any time.
+type TrustedDeviceConfig struct {
+	Enabled bool
+	Secret  string
+	TTL     time.Duration
+}
+
+// EmailConfig selects the mail provider: "smtp", or "mailbox" for local
+// testing, which stores messages under MailboxDir and serves them from
+// /dev/mailbox. TLSMode is "starttls", "tls" (implicit, usually port 465)
+// or "none". Templates are embedded; TemplateDir, when set, overrides them
+// per file. AppURL is the frontend origin used to build links in mails.
 type EmailConfig struct {
-	Provider string
-	Host     string
-	Port     int
-	Username string
-	Password string
-	From     string
-	FromName string
+	Provider      string
+	Host          string
+	Port          int
+	Username      string
+	Password      string
+	From          string
+	FromName      string
+	TLSMode       string
+	Timeout       time.Duration
+	TemplateDir   string
+	DefaultLocale string
+	MailboxDir    string
+	AppURL        string
 }
 
+// SMSConfig selects the text message provider: "twilio", or "log" and
+// "file" for local testing, the latter appending messages to FilePath. An
+// empty Provider disables SMS.
+// PerNumberLimit and PerCountryLimit cap messages per RateWindow to stop
+// SMS pumping; AllowedCallingCodes, when set, restricts delivery to those
+// country calling codes (without the +).
 type SMSConfig struct {
-	Provider  string
-	AccountID string
-	AuthToken string
-	FromPhone string
+	Provider            string
+	AccountID           string
+	AuthToken           string
+	FromPhone           string
+	FilePath            string
+	Timeout             time.Duration
+	PerNumberLimit      int
+	PerCountryLimit     int
+	RateWindow          time.Duration
+	AllowedCallingCodes []string
 }
 
 type LoggingConfig struct {
@@ -22,6 +22,14 @@ func Load() (*Config, error) {
 		Tracing:  loadTracingConfig(),
 		Metrics:  loadMetricsConfig(),
 		OTP:      loadOTPConfig(),
+		Tenancy:  loadTenancyConfig(),
+		Orgs:     loadOrganizationConfig(),
+		Profile:  loadProfileConfig(),
+		SAML:     loadSAMLConfig(),
+		LDAP:     loadLDAPConfig(),
+		Risk:     loadRiskConfig(),
+		SignIn:   loadSignInAlertConfig(),
+		Devices:  loadTrustedDeviceConfig(),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -57,6 +65,7 @@ func loadServerConfig() ServerConfig {
 		WriteTimeout:    getEnvDuration("SERVER_WRITE_TIMEOUT", 10*time.Second),
 		ShutdownTimeout: getEnvDuration("SERVER_SHUTDOWN_TIMEOUT", 30*time.Second),
 		CORS:            loadCORSConfig(),
+		TrustedProxies:  getEnvSlice("SERVER_TRUSTED_PROXIES", nil),
 	}
 }
 
@@ -166,7 +175,128 @@ func loadSecurityConfig() SecurityConfig {
 		LockoutDuration:        getEnvDuration("SECURITY_LOCKOUT_DURATION", 15*time.Minute),
 		SessionTimeout:         getEnvDuration("SECURITY_SESSION_TIMEOUT", 24*time.Hour),
 		BcryptCost:             getEnvInt("SECURITY_BCRYPT_COST", 12),
+		PolicyDir:              getEnvOrDefault("SECURITY_POLICY_DIR", "shared/config/policies"),
+
+		ChallengeProvider:       getEnvOrDefault("SECURITY_CHALLENGE_PROVIDER", "pow"),
+		ChallengeEmailThreshold: getEnvInt("SECURITY_CHALLENGE_EMAIL_THRESHOLD", 3),
+		ChallengeIPThreshold:    getEnvInt("SECURITY_CHALLENGE_IP_THRESHOLD", 10),
+		ChallengeDifficulty:     getEnvInt("SECURITY_CHALLENGE_DIFFICULTY", 20),
+		ChallengeTTL:            getEnvDuration("SECURITY_CHALLENGE_TTL", 5*time.Minute),
+		ChallengeSecret:         os.Getenv("SECURITY_CHALLENGE_SECRET"),
+		CaptchaVerifyURL:        os.Getenv("SECURITY_CAPTCHA_VERIFY_URL"),
+		CaptchaSiteKey:          os.Getenv("SECURITY_CAPTCHA_SITE_KEY"),
+		CaptchaSecret:           os.Getenv("SECURITY_CAPTCHA_SECRET"),
+		ImpersonationTTL:        getEnvDuration("SECURITY_IMPERSONATION_TTL", 15*time.Minute),
+	}
+}
+
+func loadTenancyConfig() TenancyConfig {
+	return TenancyConfig{
+		Header:        getEnvOrDefault("TENANCY_HEADER", "X-Tenant-ID"),
+		BaseDomain:    strings.ToLower(getEnvOrDefault("TENANCY_BASE_DOMAIN", "")),
+		DefaultTenant: getEnvOrDefault("TENANCY_DEFAULT_TENANT", "default"),
+	}
+}
+
+func loadOrganizationConfig() OrganizationConfig {
+	return OrganizationConfig{
+		InvitationSecret: os.Getenv("ORG_INVITATION_SECRET"),
+		InvitationTTL:    getEnvDuration("ORG_INVITATION_TTL", 7*24*time.Hour),
+		InvitationURL:    getEnvOrDefault("ORG_INVITATION_URL", "http://localhost:3000/invitations/accept"),
+	}
+}
+
+func loadProfileConfig() ProfileConfig {
+	return ProfileConfig{
+		EmailChangeSecret: os.Getenv("PROFILE_EMAIL_CHANGE_SECRET"),
+		EmailChangeTTL:    getEnvDuration("PROFILE_EMAIL_CHANGE_TTL", 24*time.Hour),
+		EmailChangeURL:    getEnvOrDefault("PROFILE_EMAIL_CHANGE_URL", "http://localhost:3000/profile/confirm-email"),
+
+		DeletionGracePeriod: getEnvDuration("PROFILE_DELETION_GRACE_PERIOD", 30*24*time.Hour),
+		PurgeInterval:       getEnvDuration("PROFILE_PURGE_INTERVAL", time.Hour),
+	}
+}
+
+func loadSAMLConfig() SAMLConfig {
+	return SAMLConfig{
+		Enabled:        getEnvBool("SAML_ENABLED", false),
+		BaseURL:        strings.TrimRight(getEnvOrDefault("SAML_BASE_URL", "http://localhost:8080"), "/"),
+		PrivateKeyPEM:  os.Getenv("SAML_SP_PRIVATE_KEY"),
+		CertificatePEM: os.Getenv("SAML_SP_CERTIFICATE"),
+		ClockSkew:      getEnvDuration("SAML_CLOCK_SKEW", 2*time.Minute),
+		RequestTTL:     getEnvDuration("SAML_REQUEST_TTL", 10*time.Minute),
+	}
+}
+
+func loadRiskConfig() RiskConfig {
+	return RiskConfig{
+		Enabled:           getEnvBool("RISK_ENABLED", true),
+		StepUpScore:       getEnvInt("RISK_STEP_UP_SCORE", 40),
+		BlockScore:        getEnvInt("RISK_BLOCK_SCORE", 80),
+		GeoIPPath:         os.Getenv("RISK_GEOIP_PATH"),
+		BlocklistPath:     os.Getenv("RISK_BLOCKLIST_PATH"),
+		MaxTravelSpeedKmh: getEnvFloat("RISK_MAX_TRAVEL_SPEED_KMH", 900),
+		FailureWindow:     getEnvDuration("RISK_FAILURE_WINDOW", time.Hour),
+		FailureThreshold:  getEnvInt("RISK_FAILURE_THRESHOLD", 5),
+		HistoryTTL:        getEnvDuration("RISK_HISTORY_TTL", 90*24*time.Hour),
+	}
+}
+
+func loadSignInAlertConfig() SignInAlertConfig {
+	return SignInAlertConfig{
+		Enabled: getEnvBool("SIGNIN_ALERT_ENABLED", true),
+		Secret:  os.Getenv("SIGNIN_ALERT_SECRET"),
+		TTL:     getEnvDuration("SIGNIN_ALERT_TTL", 7*24*time.Hour),
+		URL:     getEnvOrDefault("SIGNIN_ALERT_URL", "http://localhost:3000/security/sign-in-alert"),
+	}
+}
+
+func loadTrustedDeviceConfig() TrustedDeviceConfig {
+	return TrustedDeviceConfig{
+		Enabled: getEnvBool("TRUSTED_DEVICE_ENABLED", true),
+		Secret:  os.Getenv("TRUSTED_DEVICE_SECRET"),
+		TTL:     getEnvDuration("TRUSTED_DEVICE_TTL", 30*24*time.Hour),
+	}
+}
+
+func loadLDAPConfig() LDAPConfig {
+	return LDAPConfig{
+		Enabled:            getEnvBool("LDAP_ENABLED", false),
+		URL:                getEnvOrDefault("LDAP_URL", "ldap://localhost:389"),
+		StartTLS:           getEnvBool("LDAP_START_TLS", true),
+		InsecureSkipVerify: getEnvBool("LDAP_INSECURE_SKIP_VERIFY", false),
+		BindDN:             os.Getenv("LDAP_BIND_DN"),
+		BindPassword:       os.Getenv("LDAP_BIND_PASSWORD"),
+		SearchBaseDN:       os.Getenv("LDAP_SEARCH_BASE_DN"),
+		UserFilter:         getEnvOrDefault("LDAP_USER_FILTER", "(&(objectClass=person)(mail={login}))"),
+		EmailAttribute:     getEnvOrDefault("LDAP_EMAIL_ATTRIBUTE", "mail"),
+		FirstNameAttribute: getEnvOrDefault("LDAP_FIRST_NAME_ATTRIBUTE", "givenName"),
+		LastNameAttribute:  getEnvOrDefault("LDAP_LAST_NAME_ATTRIBUTE", "sn"),
+		UsernameAttribute:  getEnvOrDefault("LDAP_USERNAME_ATTRIBUTE", "sAMAccountName"),
+		GroupAttribute:     getEnvOrDefault("LDAP_GROUP_ATTRIBUTE", "memberOf"),
+		GroupRoleMappings:  parseLDAPGroupRoleMappings(os.Getenv("LDAP_GROUP_ROLE_MAPPINGS")),
+		DefaultRole:        getEnvOrDefault("LDAP_DEFAULT_ROLE", "USER"),
+		JITProvisioning:    getEnvBool("LDAP_JIT_PROVISIONING", true),
+		PoolSize:           getEnvInt("LDAP_POOL_SIZE", 10),
+		Timeout:            getEnvDuration("LDAP_TIMEOUT", 5*time.Second),
+	}
+}
+
+// parseLDAPGroupRoleMappings reads "groupDN=>ROLE" pairs separated by ";".
+// Group DNs contain commas and equals signs, hence the separators.
+func parseLDAPGroupRoleMappings(value string) []LDAPGroupRoleMapping {
+	var mappings []LDAPGroupRoleMapping
+	for _, pair := range strings.Split(value, ";") {
+		groupDN, role, ok := strings.Cut(pair, "=>")
+		if !ok {
+			continue
+		}
+		mappings = append(mappings, LDAPGroupRoleMapping{
+			GroupDN: strings.TrimSpace(groupDN),
+			Role:    strings.ToUpper(strings.TrimSpace(role)),
+		})
 	}
+	return mappings
 }
 
 func loadEmailConfig() EmailConfig {
@@ -178,15 +308,29 @@ func loadEmailConfig() EmailConfig {
 		Password: getEnvOrDefault("EMAIL_PASSWORD", ""),
 		From:     getEnvOrDefault("EMAIL_FROM", "noreply@example.com"),
 		FromName: getEnvOrDefault("EMAIL_FROM_NAME", "Authentication Service"),
+
+		TLSMode:       getEnvOrDefault("EMAIL_TLS_MODE", "starttls"),
+		Timeout:       getEnvDuration("EMAIL_TIMEOUT", 15*time.Second),
+		TemplateDir:   os.Getenv("EMAIL_TEMPLATE_DIR"),
+		DefaultLocale: getEnvOrDefault("EMAIL_DEFAULT_LOCALE", "en"),
+		MailboxDir:    getEnvOrDefault("EMAIL_MAILBOX_DIR", "mailbox"),
+		AppURL:        getEnvOrDefault("EMAIL_APP_URL", "http://localhost:3000"),
 	}
 }
 
 func loadSMSConfig() SMSConfig {
 	return SMSConfig{
-		Provider:  getEnvOrDefault("SMS_PROVIDER", "twilio"),
+		Provider:  os.Getenv("SMS_PROVIDER"),
 		AccountID: getEnvOrDefault("SMS_ACCOUNT_ID", ""),
 		AuthToken: getEnvOrDefault("SMS_AUTH_TOKEN", ""),
 		FromPhone: getEnvOrDefault("SMS_FROM_PHONE", ""),
+		FilePath:  getEnvOrDefault("SMS_FILE_PATH", "sms.log"),
+		Timeout:   getEnvDuration("SMS_TIMEOUT", 10*time.Second),
+
+		PerNumberLimit:      getEnvInt("SMS_PER_NUMBER_LIMIT", 5),
+		PerCountryLimit:     getEnvInt("SMS_PER_COUNTRY_LIMIT", 500),
+		RateWindow:          getEnvDuration("SMS_RATE_WINDOW", time.Hour),
+		AllowedCallingCodes: getEnvSlice("SMS_ALLOWED_CALLING_CODES", nil),
 	}
 }
 
@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"strings"
+	"time"
 )
 
 func (c *Config) Validate() error {
@@ -12,6 +14,8 @@ func (c *Config) Validate() error {
 		c.validateDatabase,
 		c.validateJWT,
 		c.validateSecurity,
+		c.validateOrganizations,
+		c.validateProfile,
 	}
 
 	for _, validator := range validators {
@@ -37,6 +41,27 @@ func (c *Config) Validate() error {
 	if err := c.validateLogging(); err != nil {
 		return err
 	}
+	if err := c.validateSAML(); err != nil {
+		return err
+	}
+	if err := c.validateLDAP(); err != nil {
+		return err
+	}
+	if err := c.validateRisk(); err != nil {
+		return err
+	}
+	if err := c.validateSignInAlert(); err != nil {
+		return err
+	}
+	if err := c.validateTrustedDevices(); err != nil {
+		return err
+	}
+	if err := c.validateSMS(); err != nil {
+		return err
+	}
+	if err := c.validateEmail(); err != nil {
+		return err
+	}
 
 	return nil
 
@@ -74,6 +99,12 @@ func (c *Config) validateServer() error {
 	if c.Server.ShutdownTimeout < 0 {
 		return fmt.Errorf("shutdown timeout cannot be negative")
 	}
+	for _, proxy := range c.Server.TrustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if _, _, err := net.ParseCIDR(proxy); err != nil && net.ParseIP(proxy) == nil {
+			return fmt.Errorf("invalid trusted proxy: %q", proxy)
+		}
+	}
 	return nil
 }
 
@@ -164,6 +195,75 @@ func (c *Config) validateSecurity() error {
 	if c.Security.BcryptCost < 4 || c.Security.BcryptCost > 31 {
 		return fmt.Errorf("bcrypt cost must be between 4 and 31")
 	}
+	if c.Security.ImpersonationTTL <= 0 || c.Security.ImpersonationTTL > time.Hour {
+		return fmt.Errorf("SECURITY_IMPERSONATION_TTL must be positive and at most one hour")
+	}
+	return c.validateChallenge()
+}
+
+func (c *Config) validateChallenge() error {
+	switch c.Security.ChallengeProvider {
+	case "none":
+		return nil
+	case "pow":
+		if len(c.Security.ChallengeSecret) < 32 {
+			return fmt.Errorf("SECURITY_CHALLENGE_SECRET must be at least 32 characters")
+		}
+		if c.Security.ChallengeDifficulty < 8 || c.Security.ChallengeDifficulty > 32 {
+			return fmt.Errorf("SECURITY_CHALLENGE_DIFFICULTY must be between 8 and 32 bits")
+		}
+		if c.Security.ChallengeTTL < time.Minute {
+			return fmt.Errorf("SECURITY_CHALLENGE_TTL must be at least 1m")
+		}
+	case "captcha":
+		if c.Security.CaptchaVerifyURL == "" || c.Security.CaptchaSecret == "" {
+			return fmt.Errorf("SECURITY_CAPTCHA_VERIFY_URL and SECURITY_CAPTCHA_SECRET are required for the captcha provider")
+		}
+	default:
+		return fmt.Errorf("SECURITY_CHALLENGE_PROVIDER must be pow, captcha or none")
+	}
+	if c.Security.ChallengeEmailThreshold < 1 || c.Security.ChallengeIPThreshold < 1 {
+		return fmt.Errorf("challenge thresholds must be at least 1")
+	}
+	return nil
+}
+
+func (c *Config) validateOrganizations() error {
+	if len(c.Orgs.InvitationSecret) < 32 {
+		return fmt.Errorf("organization invitation secret must be at least 32 characters")
+	}
+	if c.Orgs.InvitationSecret == c.JWT.AccessSecret || c.Orgs.InvitationSecret == c.JWT.RefreshSecret {
+		return fmt.Errorf("organization invitation secret must differ from the JWT secrets")
+	}
+	if c.Orgs.InvitationTTL < 1 {
+		return fmt.Errorf("organization invitation ttl must be positive")
+	}
+	if c.Orgs.InvitationURL == "" {
+		return fmt.Errorf("organization invitation url cannot be empty")
+	}
+	return nil
+}
+
+func (c *Config) validateProfile() error {
+	secret := c.Profile.EmailChangeSecret
+	if len(secret) < 32 {
+		return fmt.Errorf("profile email change secret must be at least 32 characters")
+	}
+	if secret == c.JWT.AccessSecret || secret == c.JWT.RefreshSecret || secret == c.Orgs.InvitationSecret {
+		return fmt.Errorf("profile email change secret must differ from the JWT and invitation secrets")
+	}
+	if c.Profile.EmailChangeTTL < 1 {
+		return fmt.Errorf("profile email change ttl must be positive")
+	}
+	if c.Profile.EmailChangeURL == "" {
+		return fmt.Errorf("profile email change url cannot be empty")
+	}
+	if c.Profile.DeletionGracePeriod < 0 {
+		return fmt.Errorf("profile deletion grace period cannot be negative")
+	}
+	if c.Profile.PurgeInterval < time.Minute {
+		return fmt.Errorf("profile purge interval must be at least one minute")
+	}
 	return nil
 }
 
@@ -202,6 +302,177 @@ func (c *Config) validateTracing() error {
 	return nil
 }
 
+func (c *Config) validateSAML() error {
+	if c.SAML.Enabled {
+		if c.SAML.BaseURL == "" {
+			return fmt.Errorf("SAML_BASE_URL is required when SAML is enabled")
+		}
+		if c.SAML.PrivateKeyPEM == "" || c.SAML.CertificatePEM == "" {
+			return fmt.Errorf("SAML_SP_PRIVATE_KEY and SAML_SP_CERTIFICATE are required when SAML is enabled")
+		}
+		if c.SAML.ClockSkew < 0 || c.SAML.ClockSkew > 10*time.Minute {
+			return fmt.Errorf("SAML_CLOCK_SKEW must be between 0 and 10m")
+		}
+		if c.SAML.RequestTTL < time.Minute {
+			return fmt.Errorf("SAML_REQUEST_TTL must be at least 1m")
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateRisk() error {
+	if !c.Risk.Enabled {
+		return nil
+	}
+	if c.Risk.StepUpScore < 1 || c.Risk.BlockScore <= c.Risk.StepUpScore {
+		return fmt.Errorf("RISK_STEP_UP_SCORE must be positive and below RISK_BLOCK_SCORE")
+	}
+	if c.Risk.MaxTravelSpeedKmh <= 0 {
+		return fmt.Errorf("RISK_MAX_TRAVEL_SPEED_KMH must be positive")
+	}
+	if c.Risk.FailureWindow <= 0 || c.Risk.FailureThreshold < 1 {
+		return fmt.Errorf("RISK_FAILURE_WINDOW and RISK_FAILURE_THRESHOLD must be positive")
+	}
+	if c.Risk.HistoryTTL <= 0 {
+		return fmt.Errorf("RISK_HISTORY_TTL must be positive")
+	}
+	return nil
+}
+
+func (c *Config) validateSignInAlert() error {
+	if !c.SignIn.Enabled {
+		return nil
+	}
+	secret := c.SignIn.Secret
+	if len(secret) < 32 {
+		return fmt.Errorf("SIGNIN_ALERT_SECRET must be at least 32 characters")
+	}
+	if secret == c.JWT.AccessSecret || secret == c.JWT.RefreshSecret || secret == c.Profile.EmailChangeSecret {
+		return fmt.Errorf("SIGNIN_ALERT_SECRET must differ from the JWT and email change secrets")
+	}
+	if c.SignIn.TTL <= 0 {
+		return fmt.Errorf("SIGNIN_ALERT_TTL must be positive")
+	}
+	if c.SignIn.URL == "" {
+		return fmt.Errorf("SIGNIN_ALERT_URL is required when sign-in alerts are enabled")
+	}
+	return nil
+}
+
+func (c *Config) validateTrustedDevices() error {
+	if !c.Devices.Enabled {
+		return nil
+	}
+	secret := c.Devices.Secret
+	if len(secret) < 32 {
+		return fmt.Errorf("TRUSTED_DEVICE_SECRET must be at least 32 characters")
+	}
+	if secret == c.JWT.AccessSecret || secret == c.JWT.RefreshSecret || secret == c.SignIn.Secret {
+		return fmt.Errorf("TRUSTED_DEVICE_SECRET must differ from the JWT and sign-in alert secrets")
+	}
+	if c.Devices.TTL <= 0 {
+		return fmt.Errorf("TRUSTED_DEVICE_TTL must be positive")
+	}
+	return nil
+}
+
+func (c *Config) validateLDAP() error {
+	if c.LDAP.Enabled {
+		if !strings.HasPrefix(c.LDAP.URL, "ldap://") && !strings.HasPrefix(c.LDAP.URL, "ldaps://") {
+			return fmt.Errorf("LDAP_URL must start with ldap:// or ldaps://")
+		}
+		if c.LDAP.SearchBaseDN == "" {
+			return fmt.Errorf("LDAP_SEARCH_BASE_DN is required when LDAP is enabled")
+		}
+		if !strings.Contains(c.LDAP.UserFilter, "{login}") {
+			return fmt.Errorf("LDAP_USER_FILTER must contain the {login} placeholder")
+		}
+		if c.LDAP.EmailAttribute == "" {
+			return fmt.Errorf("LDAP_EMAIL_ATTRIBUTE cannot be empty")
+		}
+		if c.LDAP.PoolSize < 1 || c.LDAP.PoolSize > 100 {
+			return fmt.Errorf("LDAP_POOL_SIZE must be between 1 and 100")
+		}
+		if c.LDAP.Timeout <= 0 {
+			return fmt.Errorf("LDAP_TIMEOUT must be positive")
+		}
+		for _, mapping := range c.LDAP.GroupRoleMappings {
+			if mapping.GroupDN == "" || mapping.Role == "" {
+				return fmt.Errorf("LDAP_GROUP_ROLE_MAPPINGS entries must be groupDN=>ROLE")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateSMS() error {
+	switch c.SMS.Provider {
+	case "":
+		return nil
+	case "twilio":
+		if c.SMS.AccountID == "" || c.SMS.AuthToken == "" || c.SMS.FromPhone == "" {
+			return fmt.Errorf("SMS_ACCOUNT_ID, SMS_AUTH_TOKEN and SMS_FROM_PHONE are required for twilio")
+		}
+	case "file":
+		if c.SMS.FilePath == "" {
+			return fmt.Errorf("SMS_FILE_PATH is required for the file provider")
+		}
+	case "log":
+	default:
+		return fmt.Errorf("SMS_PROVIDER must be twilio, log, file or empty")
+	}
+	if c.SMS.Timeout <= 0 {
+		return fmt.Errorf("SMS_TIMEOUT must be positive")
+	}
+	if c.SMS.PerNumberLimit < 1 || c.SMS.PerCountryLimit < c.SMS.PerNumberLimit {
+		return fmt.Errorf("SMS_PER_NUMBER_LIMIT must be positive and not above SMS_PER_COUNTRY_LIMIT")
+	}
+	if c.SMS.RateWindow <= 0 {
+		return fmt.Errorf("SMS_RATE_WINDOW must be positive")
+	}
+	if c.App.IsProduction() && c.SMS.Provider != "twilio" {
+		return fmt.Errorf("SMS_PROVIDER %q is for local testing only", c.SMS.Provider)
+	}
+	return nil
+}
+
+func (c *Config) validateEmail() error {
+	switch c.Email.Provider {
+	case "smtp":
+		if c.Email.Host == "" || c.Email.Port <= 0 || c.Email.Port > 65535 {
+			return fmt.Errorf("EMAIL_HOST and a valid EMAIL_PORT are required for smtp")
+		}
+		switch c.Email.TLSMode {
+		case "starttls", "tls":
+		case "none":
+			if c.App.IsProduction() {
+				return fmt.Errorf("EMAIL_TLS_MODE none is not allowed in production")
+			}
+		default:
+			return fmt.Errorf("EMAIL_TLS_MODE must be starttls, tls or none")
+		}
+	case "mailbox":
+		if c.Email.MailboxDir == "" {
+			return fmt.Errorf("EMAIL_MAILBOX_DIR is required for the mailbox provider")
+		}
+		if c.App.IsProduction() {
+			return fmt.Errorf("EMAIL_PROVIDER mailbox is for local testing only")
+		}
+	default:
+		return fmt.Errorf("EMAIL_PROVIDER must be smtp or mailbox")
+	}
+	if c.Email.From == "" {
+		return fmt.Errorf("EMAIL_FROM cannot be empty")
+	}
+	if c.Email.Timeout <= 0 {
+		return fmt.Errorf("EMAIL_TIMEOUT must be positive")
+	}
+	if c.Email.DefaultLocale == "" {
+		return fmt.Errorf("EMAIL_DEFAULT_LOCALE cannot be empty")
+	}
+	return nil
+}
+
 func (c *Config) validateMetrics() error {
 	if c.Metrics.Enabled {
 		if c.Metrics.Port <= 0 || c.Metrics.Port > 65535 {
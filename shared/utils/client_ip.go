@@ -1,22 +1,109 @@
 package utils
 
 import (
+	"context"
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
 )
 
-func GetClientIP(r *http.Request) string {
-	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
-		parts := strings.Split(xff, ",")
-		return strings.TrimSpace(parts[0])
+// ClientIPResolver finds the address of the caller behind a chain of
+// reverse proxies. Forwarding headers are attacker-controlled unless the hop
+// that added them is a trusted proxy, so X-Forwarded-For is read from the
+// right and the first address not belonging to a trusted proxy wins.
+type ClientIPResolver struct {
+	trusted []*net.IPNet
+}
+
+// NewClientIPResolver accepts proxy addresses and CIDR ranges. With no
+// trusted proxies only the connection's remote address is used.
+func NewClientIPResolver(trustedProxies []string) (*ClientIPResolver, error) {
+	r := &ClientIPResolver{}
+	for _, entry := range trustedProxies {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			r.trusted = append(r.trusted, network)
+			continue
+		}
+		ip := net.ParseIP(entry)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid trusted proxy: %q", entry)
+		}
+		bits := 8 * net.IPv4len
+		if ip.To4() == nil {
+			bits = 8 * net.IPv6len
+		}
+		r.trusted = append(r.trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return r, nil
+}
+
+// Resolve returns the client address of r.
+func (c *ClientIPResolver) Resolve(r *http.Request) string {
+	ip := remoteHost(r)
+	if !c.isTrusted(ip) {
+		return ip
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			// A malformed hop means the chain can't be followed further.
+			return ip
+		}
+		ip = hop
+		if !c.isTrusted(hop) {
+			return hop
+		}
+	}
+	return ip
+}
+
+func (c *ClientIPResolver) isTrusted(ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
 	}
-	if xrip := r.Header.Get("X-Real-IP"); xrip != "" {
-		return xrip
+	for _, network := range c.trusted {
+		if network.Contains(addr) {
+			return true
+		}
 	}
+	return false
+}
+
+// GetClientIP returns the address stored by the client IP middleware, or the
+// connection's remote address when none was stored. Forwarding headers are
+// never read here; see ClientIPResolver.
+func GetClientIP(r *http.Request) string {
+	if ip := ClientIPFromContext(r.Context()); ip != "" {
+		return ip
+	}
+	return remoteHost(r)
+}
+
+func remoteHost(r *http.Request) string {
 	host, _, err := net.SplitHostPort(r.RemoteAddr)
 	if err != nil {
 		return r.RemoteAddr
 	}
 	return host
 }
+
+type clientIPKey struct{}
+
+// WithClientIP stores the caller's address on ctx for code below the HTTP
+// layer, such as credential checks that enforce IP allowlists.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
@@ -1 +1,33 @@
-package utils
\ No newline at end of file
+package utils
+
+import (
+	"net/http"
+	"strconv"
+)
+
+const (
+	DefaultPage     = 1
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// NormalizePagination clamps page and page size to sane bounds.
+func NormalizePagination(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = DefaultPage
+	}
+	if pageSize < 1 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+	return page, pageSize
+}
+
+// ParsePagination reads the page and page_size query parameters.
+func ParsePagination(r *http.Request) (int, int) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+	return NormalizePagination(page, pageSize)
+}
@@ -0,0 +1,16 @@
+package utils
+
+import "context"
+
+type tenantIDKey struct{}
+
+// WithTenantID stores the tenant the request was resolved to. Repositories
+// read it to scope every query, so it must be set before any data access.
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, tenantIDKey{}, tenantID)
+}
+
+func TenantIDFromContext(ctx context.Context) (string, bool) {
+	tenantID, ok := ctx.Value(tenantIDKey{}).(string)
+	return tenantID, ok && tenantID != ""
+}
@@ -0,0 +1,45 @@
+package utils
+
+import "strings"
+
+// DescribeUserAgent turns a user agent into "Browser on OS" for people. Order
+// matters: Edge and Opera also claim to be Chrome, Chrome claims Safari,
+// and Android claims Linux.
+func DescribeUserAgent(userAgent string) string {
+	browser := firstMatch(userAgent, [][2]string{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	})
+	platform := firstMatch(userAgent, [][2]string{
+		{"Windows", "Windows"},
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	})
+
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "" || platform != "":
+		return browser + platform
+	case userAgent != "":
+		return userAgent
+	default:
+		return "Unknown device"
+	}
+}
+
+func firstMatch(s string, candidates [][2]string) string {
+	for _, c := range candidates {
+		if strings.Contains(s, c[0]) {
+			return c[1]
+		}
+	}
+	return ""
+}